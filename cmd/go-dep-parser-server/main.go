@@ -0,0 +1,44 @@
+// Command go-dep-parser-server serves the parsers over HTTP. See the server package for the API.
+//
+// Usage:
+//
+//	go-dep-parser-server [flags]
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/server"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	maxUploadSize := flag.Int64("max-upload-size", 32<<20, "maximum size of a request in bytes")
+	maxEntries := flag.Int("max-entries", 0, "maximum number of libraries in a file, 0 for no limit")
+	network := flag.Bool("network", false, "look up uploaded artifacts over the network, such as JARs in Maven Central")
+	flag.Parse()
+
+	opts := []server.Option{
+		server.WithMaxUploadSize(*maxUploadSize),
+		server.WithLimits(types.Limits{
+			MaxInputSize:      *maxUploadSize,
+			MaxEntries:        *maxEntries,
+			MaxExpansionRatio: 100,
+		}),
+	}
+	if *network {
+		opts = append(opts, server.WithNetwork())
+	}
+	handler := server.NewHandler(opts...)
+	s := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("Listening on %s", *addr)
+	log.Fatal(s.ListenAndServe())
+}
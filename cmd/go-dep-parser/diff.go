@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/diff"
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// runDiff prints the changes of the packages between two files.
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("go-dep-parser diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: go-dep-parser diff [flags] <before> <after>")
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", string(output.FormatJSON), "output format [json table]")
+	typ := flags.String("type", "", "purl type comparing the names and versions as the ecosystem does, e.g. npm")
+	name := flags.String("name", "", "file name looking up the parser of both files, e.g. package-lock.json")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	f := output.Format(*format)
+	if flags.NArg() != 2 || (f != output.FormatJSON && f != output.FormatTable) {
+		flags.Usage()
+		return exitUsage
+	}
+
+	var results [2]types.Result
+	for i, path := range flags.Args() {
+		lookupName := path
+		if *name != "" {
+			lookupName = *name
+		}
+		app, err := parseFile(path, lookupName)
+		var partial *types.ErrPartialResult
+		if xerrors.As(err, &partial) {
+			// The changes of the other packages are still printed
+			for _, e := range partial.Errs {
+				fmt.Fprintf(stderr, "go-dep-parser: %v\n", e)
+			}
+		} else if err != nil {
+			fmt.Fprintf(stderr, "go-dep-parser: %v\n", err)
+			return exitParseError
+		}
+		results[i] = types.Result{Libraries: app.Libraries, Dependencies: app.Dependencies}
+	}
+
+	var opts []diff.Option
+	if *typ != "" {
+		opts = append(opts, diff.WithType(*typ))
+	}
+	changes := diff.Diff(results[0], results[1], opts...)
+
+	var err error
+	if f == output.FormatTable {
+		err = writeChangeTable(stdout, changes)
+	} else {
+		if changes == nil {
+			changes = []diff.Change{}
+		}
+		e := json.NewEncoder(stdout)
+		e.SetIndent("", "  ")
+		err = e.Encode(changes)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "go-dep-parser: %v\n", err)
+		return exitParseError
+	}
+	return exitOK
+}
+
+func writeChangeTable(w io.Writer, changes []diff.Change) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "KIND\tNAME\tBEFORE\tAFTER\tVIA")
+	for _, c := range changes {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.Kind, c.Name, c.Before, c.After, strings.Join(c.Via, ", "))
+	}
+	return tw.Flush()
+}
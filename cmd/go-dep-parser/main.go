@@ -0,0 +1,201 @@
+// Command go-dep-parser parses a manifest or lock file, or every file with a parser under a directory or in an archive,
+// and prints the libraries and the dependency graph as JSON, a table, or an SBOM in CycloneDX or SPDX.
+//
+// Usage:
+//
+//	go-dep-parser [flags] <file, directory, archive or URL>
+//	go-dep-parser diff [flags] <before> <after>
+//
+// The parsers are looked up by the file names in the registry package.
+// Tar and zip archives without parsers, such as sdists, are scanned without extracting them.
+// HTTP(S) URLs of single files, such as raw files of Git repositories, are fetched and parsed.
+// Files failing to be parsed are reported to stderr, and the others are still printed.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/remote"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Exit codes
+const (
+	exitOK = iota
+	// Some files failed to be parsed, while the others are printed
+	exitParseError
+	exitUsage
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "diff" {
+		return runDiff(args[1:], stdout, stderr)
+	}
+
+	flags := flag.NewFlagSet("go-dep-parser", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: go-dep-parser [flags] <file, directory, archive or URL>")
+		flags.PrintDefaults()
+	}
+	parallel := flags.Int("parallel", 1, "number of files parsed concurrently in a directory or an archive")
+	followSymlinks := flags.Bool("follow-symlinks", false, "follow symlinks in a directory")
+	format := flags.String("format", string(output.FormatJSON), fmt.Sprintf("output format %v", output.Formats))
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if flags.NArg() != 1 || !output.Format(*format).Valid() {
+		flags.Usage()
+		return exitUsage
+	}
+
+	apps, err := parsePath(flags.Arg(0), *parallel, *followSymlinks)
+	var partial *types.ErrPartialResult
+	if err != nil && !xerrors.As(err, &partial) {
+		fmt.Fprintf(stderr, "go-dep-parser: %v\n", err)
+		return exitParseError
+	}
+
+	// The SPDX document is named after the file or directory
+	name := filepath.Base(filepath.Clean(flags.Arg(0)))
+	if err := output.Write(stdout, output.Format(*format), name, apps); err != nil {
+		fmt.Fprintf(stderr, "go-dep-parser: %v\n", err)
+		return exitParseError
+	}
+
+	if partial != nil {
+		for _, e := range partial.Errs {
+			fmt.Fprintf(stderr, "go-dep-parser: %v\n", e)
+		}
+		return exitParseError
+	}
+	return exitOK
+}
+
+// Suffixes of the archives scanned by scanner.ScanArchive, unless they have parsers. e.g. jar files
+var archiveExts = []string{".tar", ".tar.gz", ".tgz", ".tar.zst", ".zip"}
+
+// Suffixes of compressed files, which are parsed by the parser of the name without it as the scanner does
+var compressedExts = []string{".gz", ".zst"}
+
+// parsePath parses the file or the URL, or scans the directory or the archive.
+func parsePath(path string, parallel int, followSymlinks bool) ([]scanner.Application, error) {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		app, err := remote.Parse(context.Background(), path)
+		return []scanner.Application{app}, withPath(app.FilePath, err)
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	opts := []scanner.Option{scanner.WithParallel(parallel)}
+	if !fi.IsDir() {
+		if isArchive(path) {
+			return scanArchive(path, opts...)
+		}
+		app, err := parseFile(path, path)
+		return []scanner.Application{app}, err
+	}
+
+	if followSymlinks {
+		opts = append(opts, scanner.WithFollowSymlinks())
+	}
+	return scanner.Scan(os.DirFS(path), ".", opts...)
+}
+
+func isArchive(path string) bool {
+	if _, ok := registry.Lookup(filepath.ToSlash(path)); ok {
+		return false
+	}
+	for _, ext := range archiveExts {
+		if strings.HasSuffix(path, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func scanArchive(path string, opts ...scanner.Option) ([]scanner.Application, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Zip archives are read in place instead of being read into memory
+	apps, err := scanner.ScanArchive(io.NewSectionReader(f, 0, fi.Size()), opts...)
+	return apps, withPath(path, err)
+}
+
+// parseFile parses the file with the parser of the name, which is usually the path itself.
+// Compressed files are parsed by the parser of the name without ".gz" or ".zst", as the scanner does.
+func parseFile(path, name string) (scanner.Application, error) {
+	app := scanner.Application{FilePath: path}
+	_, compressed := trimCompressedExt(path)
+	name, _ = trimCompressedExt(name)
+	p, ok := registry.Lookup(filepath.ToSlash(name))
+	if !ok {
+		return app, xerrors.Errorf("no parser for %s", path)
+	}
+
+	var err error
+	if fp, ok := p.(types.FSParser); ok && !compressed {
+		// References to other files are resolved from the directory of the file
+		app.Libraries, app.Dependencies, err = fp.ParseFS(os.DirFS(filepath.Dir(path)), filepath.Base(path))
+	} else {
+		var f *os.File
+		if f, err = os.Open(path); err != nil {
+			return app, err
+		}
+		defer f.Close()
+		app.Libraries, app.Dependencies, err = utils.ParseCompressed(types.Limits{}, p, f)
+	}
+	return app, withPath(path, err)
+}
+
+func trimCompressedExt(name string) (string, bool) {
+	for _, ext := range compressedExts {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext), true
+		}
+	}
+	return name, false
+}
+
+// withPath prefixes the error with the path, as the scanner does.
+// The errors of skipped entries are prefixed one by one.
+func withPath(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var partial *types.ErrPartialResult
+	if !xerrors.As(err, &partial) {
+		return xerrors.Errorf("%s: %w", path, err)
+	}
+	var errs []error
+	for _, e := range partial.Errs {
+		errs = append(errs, xerrors.Errorf("%s: %w", path, e))
+	}
+	return types.NewErrPartialResult(errs)
+}
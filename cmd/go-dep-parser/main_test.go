@@ -0,0 +1,223 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "app"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app", "requirements.txt"), []byte("Flask==2.0.0\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "package-lock.json"), []byte("{"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# app\n"), 0o644))
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("app-1.0.0/requirements.txt")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("Flask==2.0.0\n"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app-1.0.0.zip"), archive.Bytes(), 0o644))
+
+	flask := types.Library{
+		Name:      "Flask",
+		Version:   "2.0.0",
+		Locations: []types.Location{{StartLine: 1, EndLine: 1}},
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       []output.Application
+		wantCode   int
+		wantStderr string
+	}{
+		{
+			name: "file",
+			args: []string{filepath.Join(dir, "app", "requirements.txt")},
+			want: []output.Application{
+				{
+					FilePath:      filepath.Join(dir, "app", "requirements.txt"),
+					SchemaVersion: types.SchemaVersion,
+					Libraries: []types.Library{
+						func() types.Library {
+							lib := flask
+							lib.FilePath = "requirements.txt"
+							return lib
+						}(),
+					},
+					Dependencies: []types.Dependency{},
+				},
+			},
+		},
+		{
+			name: "directory",
+			args: []string{"-parallel", "2", dir},
+			want: []output.Application{
+				{
+					FilePath:      "app/requirements.txt",
+					SchemaVersion: types.SchemaVersion,
+					Libraries: []types.Library{
+						func() types.Library {
+							lib := flask
+							lib.FilePath = "app/requirements.txt"
+							return lib
+						}(),
+					},
+					Dependencies: []types.Dependency{},
+				},
+			},
+			wantCode:   exitParseError,
+			wantStderr: "go-dep-parser: package-lock.json: ",
+		},
+		{
+			name: "archive",
+			args: []string{filepath.Join(dir, "app-1.0.0.zip")},
+			want: []output.Application{
+				{
+					FilePath:      "app-1.0.0/requirements.txt",
+					SchemaVersion: types.SchemaVersion,
+					Libraries: []types.Library{
+						func() types.Library {
+							lib := flask
+							lib.FilePath = "app-1.0.0/requirements.txt"
+							return lib
+						}(),
+					},
+					Dependencies: []types.Dependency{},
+				},
+			},
+		},
+		{
+			name:       "no parser",
+			args:       []string{filepath.Join(dir, "README.md")},
+			wantCode:   exitParseError,
+			wantStderr: "go-dep-parser: no parser for " + filepath.Join(dir, "README.md"),
+		},
+		{
+			name:       "unknown format",
+			args:       []string{"-format", "xml", dir},
+			wantCode:   exitUsage,
+			wantStderr: "output format [json table cyclonedx spdx]",
+		},
+		{
+			name:       "no argument",
+			wantCode:   exitUsage,
+			wantStderr: "Usage: go-dep-parser [flags] <file, directory, archive or URL>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			assert.Equal(t, tt.wantCode, code, stderr.String())
+			if tt.wantStderr == "" {
+				assert.Empty(t, stderr.String())
+			} else {
+				assert.Contains(t, stderr.String(), tt.wantStderr)
+			}
+
+			if tt.want == nil {
+				assert.Empty(t, stdout.String())
+				return
+			}
+			var got []output.Application
+			require.NoError(t, json.Unmarshal(stdout.Bytes(), &got))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "requirements.txt"), []byte("Flask==2.0.0\nclick==8.0.0\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "requirements.new"), []byte("Flask==2.1.0\nJinja2==3.1.0\n"), 0o644))
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantCode   int
+		wantStderr string
+	}{
+		{
+			name: "json",
+			args: []string{"diff", "-name", "requirements.txt", filepath.Join(dir, "requirements.txt"), filepath.Join(dir, "requirements.new")},
+			want: `[
+  {
+    "Kind": "upgraded",
+    "Name": "Flask",
+    "Before": "2.0.0",
+    "After": "2.1.0"
+  },
+  {
+    "Kind": "added",
+    "Name": "Jinja2",
+    "After": "3.1.0"
+  },
+  {
+    "Kind": "removed",
+    "Name": "click",
+    "Before": "8.0.0"
+  }
+]
+`,
+		},
+		{
+			name: "table",
+			args: []string{"diff", "-format", "table", "-name", "requirements.txt", filepath.Join(dir, "requirements.new"), filepath.Join(dir, "requirements.new")},
+			want: "KIND  NAME  BEFORE  AFTER  VIA\n",
+		},
+		{
+			name:       "no parser",
+			args:       []string{"diff", filepath.Join(dir, "requirements.txt"), filepath.Join(dir, "requirements.new")},
+			wantCode:   exitParseError,
+			wantStderr: "go-dep-parser: no parser for " + filepath.Join(dir, "requirements.new"),
+		},
+		{
+			name:       "one argument",
+			args:       []string{"diff", filepath.Join(dir, "requirements.txt")},
+			wantCode:   exitUsage,
+			wantStderr: "Usage: go-dep-parser diff [flags] <before> <after>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run(tt.args, &stdout, &stderr)
+			assert.Equal(t, tt.wantCode, code, stderr.String())
+			if tt.wantStderr == "" {
+				assert.Empty(t, stderr.String())
+			} else {
+				assert.Contains(t, stderr.String(), tt.wantStderr)
+			}
+			assert.Equal(t, tt.want, stdout.String())
+		})
+	}
+}
+
+func TestRun_URL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("Flask==2.0.0\n"))
+	}))
+	defer ts.Close()
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-format", "table", ts.URL + "/app/requirements.txt"}, &stdout, &stderr)
+	require.Equal(t, exitOK, code, stderr.String())
+	assert.Contains(t, stdout.String(), ts.URL+"/app/requirements.txt  Flask  2.0.0")
+}
@@ -1,11 +1,13 @@
 module github.com/aquasecurity/go-dep-parser
 
-go 1.12
+go 1.16
 
 require (
 	github.com/BurntSushi/toml v0.4.1
 	github.com/hashicorp/go-retryablehttp v0.7.0
+	github.com/klauspost/compress v1.15.9
 	github.com/stretchr/testify v1.7.0
 	go.uber.org/zap v1.16.0
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,73 @@
+// Package cache stores the responses of remote lookups, such as the searches of Maven Central,
+// so that they are shared between parsers and runs.
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// Cache stores values by keys. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value of key, or false if it is not stored or expired.
+	Get(key string) ([]byte, bool)
+	// Set stores value for ttl. Zero ttl means the value doesn't expire.
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+type entry struct {
+	value []byte
+	// Zero if the entry doesn't expire
+	expires time.Time
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+func expiration(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// Memory is Cache in memory. Expired entries are removed when they are got.
+type Memory struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		entries: map[string]entry{},
+		now:     time.Now,
+	}
+}
+
+func (m *Memory) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if e.expired(m.now()) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (m *Memory) Set(key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries[key] = entry{
+		value:   append([]byte(nil), value...),
+		expires: expiration(m.now(), ttl),
+	}
+	return nil
+}
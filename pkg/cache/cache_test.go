@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	memory := NewMemory()
+	memory.now = clock
+
+	fsCache, err := NewFS(filepath.Join(t.TempDir(), "cache"))
+	require.NoError(t, err)
+	fsCache.now = clock
+
+	tests := []struct {
+		name  string
+		cache Cache
+	}{
+		{name: "memory", cache: memory},
+		{name: "fs", cache: fsCache},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := tt.cache.Get("missing")
+			assert.False(t, ok)
+
+			require.NoError(t, tt.cache.Set("permanent", []byte("a"), 0))
+			require.NoError(t, tt.cache.Set("expiring", []byte("b"), time.Hour))
+
+			got, ok := tt.cache.Get("expiring")
+			require.True(t, ok)
+			assert.Equal(t, []byte("b"), got)
+
+			now = now.Add(time.Hour)
+			defer func() { now = now.Add(-time.Hour) }()
+
+			_, ok = tt.cache.Get("expiring")
+			assert.False(t, ok)
+
+			got, ok = tt.cache.Get("permanent")
+			require.True(t, ok)
+			assert.Equal(t, []byte("a"), got)
+		})
+	}
+}
+
+func TestFS_Get(t *testing.T) {
+	dir := t.TempDir()
+	c, err := NewFS(dir)
+	require.NoError(t, err)
+
+	// Broken files are treated as missing
+	require.NoError(t, ioutil.WriteFile(c.path("broken"), []byte("{"), 0o600))
+	_, ok := c.Get("broken")
+	assert.False(t, ok)
+
+	require.NoError(t, c.Set("broken", []byte("fixed"), 0))
+	got, ok := c.Get("broken")
+	require.True(t, ok)
+	assert.Equal(t, []byte("fixed"), got)
+
+	// No temporary file is left
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
@@ -0,0 +1,87 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+// FS is Cache in a directory, which is shared between processes.
+// Each value is stored in the file named after the SHA-256 digest of the key.
+type FS struct {
+	dir string
+	now func() time.Time
+}
+
+// fsEntry is the content of the files
+type fsEntry struct {
+	Key     string
+	Value   []byte
+	Expires time.Time `json:",omitempty"`
+}
+
+// NewFS returns Cache in dir, which is created if it doesn't exist.
+func NewFS(dir string) (*FS, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, xerrors.Errorf("unable to create the cache directory: %w", err)
+	}
+	return &FS{dir: dir, now: time.Now}, nil
+}
+
+func (f *FS) path(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(h[:]))
+}
+
+// Get treats broken files as missing, which are overwritten by Set.
+func (f *FS) Get(key string) ([]byte, bool) {
+	b, err := ioutil.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var e fsEntry
+	if err = json.Unmarshal(b, &e); err != nil || e.Key != key {
+		return nil, false
+	}
+	if (entry{expires: e.Expires}).expired(f.now()) {
+		_ = os.Remove(f.path(key))
+		return nil, false
+	}
+	return e.Value, true
+}
+
+// Set writes the value to a temporary file and renames it, so that the other processes don't read it halfway.
+func (f *FS) Set(key string, value []byte, ttl time.Duration) error {
+	b, err := json.Marshal(fsEntry{
+		Key:     key,
+		Value:   value,
+		Expires: expiration(f.now(), ttl),
+	})
+	if err != nil {
+		return xerrors.Errorf("json encode error: %w", err)
+	}
+
+	tmp, err := ioutil.TempFile(f.dir, ".tmp-")
+	if err != nil {
+		return xerrors.Errorf("unable to create a temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err = tmp.Write(b); err != nil {
+		tmp.Close()
+		return xerrors.Errorf("write error: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return xerrors.Errorf("close error: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), f.path(key)); err != nil {
+		return xerrors.Errorf("rename error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package fetchcontent
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+type command struct {
+	name string
+	args []string
+}
+
+// parseCommands splits a CMake script into command invocations.
+// Nested parentheses in arguments are kept as plain arguments.
+func parseCommands(src string) ([]command, error) {
+	var cmds []command
+	i := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == '#':
+			i = skipComment(src, i)
+		case isIdentStart(c):
+			start := i
+			for i < len(src) && isIdent(src[i]) {
+				i++
+			}
+			name := src[start:i]
+			for i < len(src) && (src[i] == ' ' || src[i] == '\t') {
+				i++
+			}
+			if i >= len(src) || src[i] != '(' {
+				continue
+			}
+			args, next, err := parseArgs(src, i+1)
+			if err != nil {
+				return nil, xerrors.Errorf("%s: %w", name, err)
+			}
+			cmds = append(cmds, command{name: name, args: args})
+			i = next
+		default:
+			i++
+		}
+	}
+	return cmds, nil
+}
+
+// parseArgs parses arguments until the closing parenthesis and returns the next position
+func parseArgs(src string, i int) ([]string, int, error) {
+	var args []string
+	depth := 0
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '#':
+			i = skipComment(src, i)
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			if depth == 0 {
+				return args, i + 1, nil
+			}
+			depth--
+			i++
+		case c == '"':
+			end := i + 1
+			var sb strings.Builder
+			for end < len(src) && src[end] != '"' {
+				if src[end] == '\\' && end+1 < len(src) {
+					end++
+				}
+				sb.WriteByte(src[end])
+				end++
+			}
+			if end >= len(src) {
+				return nil, 0, xerrors.New("unterminated quoted argument")
+			}
+			args = append(args, sb.String())
+			i = end + 1
+		case c == '[' && bracketLevel(src, i) >= 0:
+			level := bracketLevel(src, i)
+			open := 2 + level
+			closing := "]" + strings.Repeat("=", level) + "]"
+			end := strings.Index(src[i+open:], closing)
+			if end < 0 {
+				return nil, 0, xerrors.New("unterminated bracket argument")
+			}
+			args = append(args, strings.TrimPrefix(src[i+open:i+open+end], "\n"))
+			i += open + end + len(closing)
+		default:
+			start := i
+			for i < len(src) && !strings.ContainsRune(" \t\r\n()#\"", rune(src[i])) {
+				i++
+			}
+			args = append(args, src[start:i])
+		}
+	}
+	return nil, 0, xerrors.New("unterminated command")
+}
+
+// skipComment skips a line comment or a bracket comment
+//
+// e.g. # comment, #[[ comment ]]
+func skipComment(src string, i int) int {
+	if level := bracketLevel(src, i+1); level >= 0 {
+		closing := "]" + strings.Repeat("=", level) + "]"
+		if end := strings.Index(src[i+1:], closing); end >= 0 {
+			return i + 1 + end + len(closing)
+		}
+		return len(src)
+	}
+	if end := strings.IndexByte(src[i:], '\n'); end >= 0 {
+		return i + end + 1
+	}
+	return len(src)
+}
+
+// bracketLevel returns the number of "=" in a bracket opening such as "[==[", or -1
+func bracketLevel(src string, i int) int {
+	if i >= len(src) || src[i] != '[' {
+		return -1
+	}
+	j := i + 1
+	for j < len(src) && src[j] == '=' {
+		j++
+	}
+	if j >= len(src) || src[j] != '[' {
+		return -1
+	}
+	return j - i - 1
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isIdent(c byte) bool {
+	return isIdentStart(c) || ('0' <= c && c <= '9')
+}
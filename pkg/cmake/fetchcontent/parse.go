@@ -0,0 +1,321 @@
+package fetchcontent
+
+import (
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// e.g. v1.2.3, 1.2.3
+	versionRegexp = regexp.MustCompile(`^v?\d+(\.\d+)*([-+].*)?$`)
+
+	// Archive extensions to be removed from a URL to guess the version
+	archiveExtensions = []string{".tar.gz", ".tar.xz", ".tar.bz2", ".tgz", ".zip"}
+
+	// Hosts of the repositories given by CPM keywords and shorthands
+	cpmHosts = map[string]string{
+		"GITHUB_REPOSITORY":    "https://github.com/",
+		"GITLAB_REPOSITORY":    "https://gitlab.com/",
+		"BITBUCKET_REPOSITORY": "https://bitbucket.org/",
+		"gh":                   "https://github.com/",
+		"gl":                   "https://gitlab.com/",
+		"bb":                   "https://bitbucket.org/",
+	}
+)
+
+// Parser implements types.Parser for CMakeLists.txt
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses FetchContent_Declare and CPMAddPackage calls in CMakeLists.txt
+//
+// e.g.
+//
+//	FetchContent_Declare(
+//	  googletest
+//	  GIT_REPOSITORY https://github.com/google/googletest.git
+//	  GIT_TAG        release-1.12.1
+//	)
+//	CPMAddPackage("gh:fmtlib/fmt#9.1.0")
+//
+// This is a best-effort parser. Variables are only resolved when they are set
+// by a plain set() call in the same file, and calls without a version are skipped.
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	cmds, err := parseCommands(string(b))
+	if err != nil {
+		return nil, xerrors.Errorf("parse error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	vars := newVariables()
+	var libs []types.Library
+	for _, cmd := range cmds {
+		args := make([]string, len(cmd.args))
+		for i, arg := range cmd.args {
+			args[i] = vars.expand(arg)
+		}
+
+		var lib types.Library
+		switch strings.ToLower(cmd.name) {
+		case "set":
+			if len(args) == 2 {
+				vars.set(args[0], args[1])
+			}
+			continue
+		case "fetchcontent_declare":
+			lib = parseFetchContent(args)
+		case "cpmaddpackage", "cpmfindpackage", "cpmdeclarepackage":
+			lib = parseCPM(args)
+		default:
+			continue
+		}
+
+		if lib.Name == "" || lib.Version == "" {
+			continue
+		}
+		libs = append(libs, lib)
+	}
+	return libs, nil
+}
+
+// parseFetchContent parses the arguments of FetchContent_Declare
+func parseFetchContent(args []string) types.Library {
+	if len(args) == 0 {
+		return types.Library{}
+	}
+	kv := keywordArgs(args[1:])
+
+	lib := types.Library{Name: args[0], ExternalReferences: externalReferences(kv)}
+	switch {
+	case kv["GIT_TAG"] != "":
+		lib.Version = kv["GIT_TAG"]
+	case kv["URL"] != "":
+		lib.Version = versionFromURL(kv["URL"])
+		lib.Digest = urlHash(kv)
+	}
+	return lib
+}
+
+// parseCPM parses the arguments of CPMAddPackage
+//
+// e.g.
+//
+//	CPMAddPackage("gh:fmtlib/fmt#9.1.0")
+//	CPMAddPackage(NAME cxxopts GITHUB_REPOSITORY jarro2783/cxxopts VERSION 3.0.0)
+func parseCPM(args []string) types.Library {
+	if len(args) == 1 {
+		return parseCPMShorthand(args[0])
+	}
+	kv := keywordArgs(args)
+
+	name := kv["NAME"]
+	if name == "" {
+		for _, key := range []string{"GITHUB_REPOSITORY", "GITLAB_REPOSITORY", "BITBUCKET_REPOSITORY", "GIT_REPOSITORY"} {
+			if kv[key] != "" {
+				name = strings.TrimSuffix(path.Base(kv[key]), ".git")
+				break
+			}
+		}
+	}
+
+	lib := types.Library{Name: name, ExternalReferences: externalReferences(kv)}
+	switch {
+	case kv["VERSION"] != "":
+		lib.Version = kv["VERSION"]
+	case kv["GIT_TAG"] != "":
+		lib.Version = kv["GIT_TAG"]
+	case kv["URL"] != "":
+		lib.Version = versionFromURL(kv["URL"])
+	}
+	if kv["URL"] != "" {
+		lib.Digest = urlHash(kv)
+	}
+	return lib
+}
+
+// parseCPMShorthand parses the single argument syntax
+//
+// e.g. gh:fmtlib/fmt#9.1.0, gl:group/project@1.0.0, https://example.com/repo.git@1.2.3
+func parseCPMShorthand(arg string) types.Library {
+	var version, ref string
+	if i := strings.LastIndex(arg, "#"); i >= 0 {
+		arg, version = arg[:i], arg[i+1:]
+		ref = version
+	} else if i = strings.LastIndex(arg, "@"); i > strings.LastIndex(arg, "/") {
+		arg, version = arg[:i], arg[i+1:]
+	}
+
+	repo := arg
+	if ss := strings.SplitN(arg, ":", 2); len(ss) == 2 && cpmHosts[ss[0]] != "" {
+		repo = cpmHosts[ss[0]] + ss[1]
+	}
+	return types.Library{
+		Name:               strings.TrimSuffix(path.Base(arg), ".git"),
+		Version:            version,
+		ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: repo, Ref: ref}},
+	}
+}
+
+// externalReferences returns the repository and the download URL given by the keywords
+func externalReferences(kv map[string]string) []types.ExternalReference {
+	var refs []types.ExternalReference
+	for _, key := range []string{"GIT_REPOSITORY", "GITHUB_REPOSITORY", "GITLAB_REPOSITORY", "BITBUCKET_REPOSITORY"} {
+		if kv[key] != "" {
+			refs = append(refs, types.ExternalReference{
+				Type: types.RefTypeVCS,
+				URL:  cpmHosts[key] + kv[key],
+				Ref:  kv["GIT_TAG"],
+			})
+			break
+		}
+	}
+	if kv["URL"] != "" {
+		refs = append(refs, types.ExternalReference{Type: types.RefTypeDistribution, URL: kv["URL"]})
+	}
+	return refs
+}
+
+// keywordArgs maps each upper case keyword to the argument that follows it
+func keywordArgs(args []string) map[string]string {
+	kv := map[string]string{}
+	for i := 0; i+1 < len(args); i++ {
+		key := args[i]
+		if key == "" || strings.ToUpper(key) != key {
+			continue
+		}
+		kv[key] = args[i+1]
+		i++
+	}
+	return kv
+}
+
+// urlHash returns the checksum of URL downloads
+//
+// e.g. URL_HASH SHA256=5c1b6fb6... => sha256:5c1b6fb6...
+func urlHash(kv map[string]string) string {
+	if h := kv["URL_HASH"]; h != "" {
+		ss := strings.SplitN(h, "=", 2)
+		if len(ss) == 2 {
+			return strings.ToLower(ss[0]) + ":" + strings.ToLower(ss[1])
+		}
+	}
+	if h := kv["URL_MD5"]; h != "" {
+		return "md5:" + strings.ToLower(h)
+	}
+	return ""
+}
+
+// versionFromURL guesses the version from an archive URL
+//
+// e.g. https://github.com/nlohmann/json/releases/download/v3.11.2/json.tar.xz => v3.11.2
+func versionFromURL(url string) string {
+	for _, s := range []string{path.Base(url), path.Base(path.Dir(url))} {
+		for _, ext := range archiveExtensions {
+			s = strings.TrimSuffix(s, ext)
+		}
+		if i := strings.LastIndex(s, "-"); i >= 0 && !versionRegexp.MatchString(s) {
+			s = s[i+1:]
+		}
+		if versionRegexp.MatchString(s) {
+			return s
+		}
+	}
+	return ""
+}
+
+// variables holds the variables set so far.
+// Expanded arguments are cached until a variable is set, as the same references are repeated in large files.
+type variables struct {
+	values   map[string]string
+	expanded map[string]string
+}
+
+func newVariables() *variables {
+	return &variables{
+		values:   map[string]string{},
+		expanded: map[string]string{},
+	}
+}
+
+func (v *variables) set(name, value string) {
+	v.values[name] = value
+	v.expanded = map[string]string{}
+}
+
+func (v *variables) expand(s string) string {
+	if !strings.Contains(s, "${") {
+		return s
+	}
+	if e, ok := v.expanded[s]; ok {
+		return e
+	}
+	e := expand(s, v.values)
+	v.expanded[s] = e
+	return e
+}
+
+// expand replaces the references to the variables in s. Unknown variables are kept as they are.
+//
+// e.g. ${FMT_VERSION}
+func expand(s string, vars map[string]string) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "${")
+		if i == -1 {
+			break
+		}
+		j := strings.IndexByte(s[i+2:], '}')
+		if j == -1 {
+			break
+		}
+		name := s[i+2 : i+2+j]
+		value, ok := vars[name]
+		if !ok || !validVariableName(name) {
+			// e.g. ${A_${B}} might contain the next reference
+			b.WriteString(s[:i+2])
+			s = s[i+2:]
+			continue
+		}
+		b.WriteString(s[:i])
+		b.WriteString(value)
+		s = s[i+3+j:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func validVariableName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		switch {
+		case 'A' <= r && r <= 'Z', 'a' <= r && r <= 'z', '0' <= r && r <= '9':
+		case r == '_', r == '.', r == '+', r == '-':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package fetchcontent
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/CMakeLists.txt",
+			want: fetchContentNormal,
+		},
+		{
+			file:    "testdata/invalid.txt",
+			wantErr: "unterminated quoted argument",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	vars := map[string]string{"VERSION": "1.2.3", "NAME": "fmt", "A_1": "nested"}
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{s: "v${VERSION}", want: "v1.2.3"},
+		{s: "${NAME}-${VERSION}.tar.gz", want: "fmt-1.2.3.tar.gz"},
+		{s: "${UNKNOWN}/${NAME}", want: "${UNKNOWN}/fmt"},
+		{s: "${A_${VERSION}}", want: "${A_1.2.3}"},
+		{s: "${}${VERSION", want: "${}${VERSION"},
+		{s: "no reference", want: "no reference"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			assert.Equal(t, tt.want, expand(tt.s, vars))
+		})
+	}
+}
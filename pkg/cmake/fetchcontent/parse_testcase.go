@@ -0,0 +1,16 @@
+package fetchcontent
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// FetchContent and CPM.cmake examples from their documentation
+	fetchContentNormal = []types.Library{
+		{Name: "googletest", Version: "release-1.12.1", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/google/googletest.git", Ref: "release-1.12.1"}}},
+		{Name: "json", Version: "v3.11.2", Digest: "sha256:8c4b26bf4b422252e13f332bc5e388ec0ab5c3443d24399acb675e68278d341f", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeDistribution, URL: "https://github.com/nlohmann/json/releases/download/v3.11.2/json.tar.xz"}}},
+		{Name: "zlib", Version: "1.2.13", Digest: "md5:9b8aa094c4e5765dabf4da391f00d15c", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeDistribution, URL: "https://zlib.net/fossils/zlib-1.2.13.tar.gz"}}},
+		{Name: "fmt", Version: "9.1.0", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/fmtlib/fmt", Ref: "9.1.0"}}},
+		{Name: "Catch2", Version: "3.3.2", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/catchorg/Catch2"}}},
+		{Name: "cxxopts", Version: "3.0.0", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/jarro2783/cxxopts"}}},
+		{Name: "spdlog", Version: "v1.11.0", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/gabime/spdlog.git", Ref: "v1.11.0"}}},
+	}
+)
@@ -0,0 +1,219 @@
+// Package cpe generates CPE 2.3 candidates of the libraries returned by the parsers,
+// for matching against vulnerability databases keyed on CPEs such as NVD.
+// See https://nvlpubs.nist.gov/nistpubs/Legacy/IR/nistir7695.pdf
+//
+// CPEs are assigned by hand, so vendors and products can only be guessed from the names.
+// All the candidates should be looked up, and the ones not in the CPE dictionary ignored.
+//
+// e.g.
+//
+//	for _, c := range cpe.Candidates(purl.TypeMaven, lib) {
+//		fmt.Println(c) // cpe:2.3:a:fasterxml:jackson-databind:2.9.10.6:*:*:*:*:*:*:*
+//	}
+package cpe
+
+import (
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// PartApplication is the part of libraries. Operating systems and hardware are not generated.
+const PartApplication = "a"
+
+// Target software of each purl type, as used in the CPE dictionary
+var targetSoftware = map[string]string{
+	purl.TypeCargo:    "rust",
+	purl.TypeComposer: "php",
+	purl.TypeGem:      "ruby",
+	purl.TypeGolang:   "go",
+	purl.TypeHex:      "erlang",
+	purl.TypeMaven:    "java",
+	purl.TypeNPM:      "node.js",
+	purl.TypeNuGet:    ".net",
+	purl.TypePyPI:     "python",
+	purl.TypeSwift:    "swift",
+}
+
+// Top-level domains skipped when vendors are taken from Maven groupIds
+var topLevelDomains = map[string]struct{}{
+	"com": {}, "org": {}, "net": {}, "io": {}, "dev": {}, "de": {}, "uk": {}, "co": {}, "jp": {}, "cn": {},
+}
+
+// Hosts whose first path element is the owner. e.g. github.com/owner/repo
+var codeHosts = map[string]struct{}{
+	"github.com": {}, "gitlab.com": {}, "bitbucket.org": {},
+}
+
+// CPE is a well-formed name of CPE 2.3. Empty attributes are bound to ANY ("*").
+type CPE struct {
+	Part     string
+	Vendor   string
+	Product  string
+	Version  string
+	TargetSW string
+}
+
+// Candidates returns the CPEs the library may be registered with.
+// typ is one of the purl types, and the vendors and products are guessed in the way of the ecosystem.
+// The version is given as it is, and the target software is not set, since NVD rarely fills it.
+func Candidates(typ string, lib types.Library) []CPE {
+	if lib.Name == "" {
+		return nil
+	}
+
+	vendors, products := guess(typ, lib.Name)
+
+	var cpes []CPE
+	unique := map[CPE]struct{}{}
+	for _, vendor := range vendors {
+		for _, product := range products {
+			c := CPE{
+				Part:    PartApplication,
+				Vendor:  normalize(vendor),
+				Product: normalize(product),
+				Version: lib.Version,
+			}
+			if c.Vendor == "" || c.Product == "" {
+				continue
+			}
+			if _, ok := unique[c]; ok {
+				continue
+			}
+			unique[c] = struct{}{}
+			cpes = append(cpes, c)
+		}
+	}
+	return cpes
+}
+
+// TargetSoftware returns the target software of the purl type. e.g. "node.js" for npm
+// It can be set to the candidates to narrow down the matches.
+func TargetSoftware(typ string) string {
+	return targetSoftware[typ]
+}
+
+// guess returns the vendors and products, most likely first.
+func guess(typ, name string) ([]string, []string) {
+	switch typ {
+	case purl.TypeMaven:
+		// e.g. com.fasterxml.jackson.core:jackson-databind => fasterxml, jackson-databind
+		groupID, artifactID := name, name
+		if i := strings.Index(name, ":"); i != -1 {
+			groupID, artifactID = name[:i], name[i+1:]
+		}
+		return domainVendors(strings.Split(groupID, ".")), products(artifactID)
+	case purl.TypeGolang:
+		// e.g. github.com/gin-gonic/gin => gin-gonic, gin
+		elems := strings.Split(name, "/")
+		product := elems[len(elems)-1]
+		// Major version suffixes are not a part of the product. e.g. github.com/go-redis/redis/v8
+		if len(elems) > 1 && isMajorVersion(product) {
+			elems = elems[:len(elems)-1]
+			product = elems[len(elems)-1]
+		}
+		var vendors []string
+		if _, ok := codeHosts[elems[0]]; ok && len(elems) >= 3 {
+			vendors = append(vendors, elems[1])
+		} else if labels := strings.Split(elems[0], "."); len(labels) >= 2 {
+			// e.g. go.uber.org/zap => uber
+			vendors = append(vendors, labels[len(labels)-2])
+		}
+		vendors = append(vendors, product, product+"_project")
+		return vendors, products(product)
+	case purl.TypeNPM:
+		// e.g. @angular/core => angular, core
+		if strings.HasPrefix(name, "@") {
+			if i := strings.Index(name, "/"); i != -1 {
+				scope, product := name[1:i], name[i+1:]
+				return []string{scope, scope + "_project"}, products(product)
+			}
+		}
+	case purl.TypeComposer:
+		// e.g. laravel/framework => laravel, framework
+		if i := strings.Index(name, "/"); i != -1 {
+			vendor, product := name[:i], name[i+1:]
+			return []string{vendor, vendor + "_project"}, products(product, vendor)
+		}
+	case purl.TypeNuGet:
+		// e.g. Newtonsoft.Json => newtonsoft, newtonsoft.json
+		elems := strings.Split(name, ".")
+		return []string{elems[0], name + "_project"}, products(name, elems[len(elems)-1])
+	}
+
+	// The project has the name of the package. e.g. lodash:lodash and lodash_project:lodash
+	return []string{name, name + "_project"}, products(name)
+}
+
+// domainVendors returns the domain name under the top-level domain,
+// and the last one for groupIds not made from domains. e.g. org.apache.commons => apache, commons
+func domainVendors(elems []string) []string {
+	var vendors []string
+	if len(elems) > 1 {
+		if _, ok := topLevelDomains[elems[0]]; ok {
+			vendors = append(vendors, elems[1])
+		}
+	}
+	return append(vendors, elems[len(elems)-1])
+}
+
+// products returns the names with hyphens as they are, and replaced with underscores,
+// as the CPE dictionary has both.
+func products(names ...string) []string {
+	var ps []string
+	for _, name := range names {
+		ps = append(ps, name)
+		if strings.Contains(name, "-") {
+			ps = append(ps, strings.ReplaceAll(name, "-", "_"))
+		}
+	}
+	return ps
+}
+
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize lowercases the value and replaces whitespaces, as the CPE dictionary does.
+func normalize(s string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), " ", "_")
+}
+
+// String returns the formatted string binding of the CPE.
+// e.g. cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*
+func (c CPE) String() string {
+	attrs := []string{c.Part, c.Vendor, c.Product, c.Version, "", "", "", "", c.TargetSW, "", ""}
+	for i, attr := range attrs {
+		attrs[i] = bind(attr)
+	}
+	return "cpe:2.3:" + strings.Join(attrs, ":")
+}
+
+// bind quotes the special characters with backslashes.
+// Periods and hyphens are left as is, as the CPE dictionary does.
+func bind(s string) string {
+	if s == "" {
+		return "*"
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '_', c == '.', c == '-':
+		default:
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
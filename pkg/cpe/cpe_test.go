@@ -0,0 +1,119 @@
+package cpe_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/cpe"
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestCandidates(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		lib  types.Library
+		want []string
+	}{
+		{
+			name: "maven",
+			typ:  purl.TypeMaven,
+			lib:  types.Library{Name: "com.fasterxml.jackson.core:jackson-databind", Version: "2.9.10.6"},
+			want: []string{
+				"cpe:2.3:a:fasterxml:jackson-databind:2.9.10.6:*:*:*:*:*:*:*",
+				"cpe:2.3:a:fasterxml:jackson_databind:2.9.10.6:*:*:*:*:*:*:*",
+				"cpe:2.3:a:core:jackson-databind:2.9.10.6:*:*:*:*:*:*:*",
+				"cpe:2.3:a:core:jackson_databind:2.9.10.6:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "npm",
+			typ:  purl.TypeNPM,
+			lib:  types.Library{Name: "lodash", Version: "4.17.20"},
+			want: []string{
+				"cpe:2.3:a:lodash:lodash:4.17.20:*:*:*:*:*:*:*",
+				"cpe:2.3:a:lodash_project:lodash:4.17.20:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "npm scoped",
+			typ:  purl.TypeNPM,
+			lib:  types.Library{Name: "@angular/core", Version: "10.0.0"},
+			want: []string{
+				"cpe:2.3:a:angular:core:10.0.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:angular_project:core:10.0.0:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "golang",
+			typ:  purl.TypeGolang,
+			lib:  types.Library{Name: "github.com/go-redis/redis/v8", Version: "8.11.4"},
+			want: []string{
+				"cpe:2.3:a:go-redis:redis:8.11.4:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis:redis:8.11.4:*:*:*:*:*:*:*",
+				"cpe:2.3:a:redis_project:redis:8.11.4:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "golang vanity import path",
+			typ:  purl.TypeGolang,
+			lib:  types.Library{Name: "go.uber.org/zap", Version: "1.21.0"},
+			want: []string{
+				"cpe:2.3:a:uber:zap:1.21.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:zap:zap:1.21.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:zap_project:zap:1.21.0:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "composer",
+			typ:  purl.TypeComposer,
+			lib:  types.Library{Name: "laravel/framework", Version: "8.0.0"},
+			want: []string{
+				"cpe:2.3:a:laravel:framework:8.0.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:laravel:laravel:8.0.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:laravel_project:framework:8.0.0:*:*:*:*:*:*:*",
+				"cpe:2.3:a:laravel_project:laravel:8.0.0:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "nuget",
+			typ:  purl.TypeNuGet,
+			lib:  types.Library{Name: "Newtonsoft.Json", Version: "12.0.3"},
+			want: []string{
+				"cpe:2.3:a:newtonsoft:newtonsoft.json:12.0.3:*:*:*:*:*:*:*",
+				"cpe:2.3:a:newtonsoft:json:12.0.3:*:*:*:*:*:*:*",
+				"cpe:2.3:a:newtonsoft.json_project:newtonsoft.json:12.0.3:*:*:*:*:*:*:*",
+				"cpe:2.3:a:newtonsoft.json_project:json:12.0.3:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "special characters",
+			typ:  purl.TypeGem,
+			lib:  types.Library{Name: "Rails", Version: "7.0.0+build"},
+			want: []string{
+				"cpe:2.3:a:rails:rails:7.0.0\\+build:*:*:*:*:*:*:*",
+				"cpe:2.3:a:rails_project:rails:7.0.0\\+build:*:*:*:*:*:*:*",
+			},
+		},
+		{
+			name: "no name",
+			typ:  purl.TypePyPI,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, c := range cpe.Candidates(tt.typ, tt.lib) {
+				got = append(got, c.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCPE_String(t *testing.T) {
+	c := cpe.CPE{Part: cpe.PartApplication, Vendor: "nodejs", Product: "node.js", TargetSW: cpe.TargetSoftware(purl.TypeNPM)}
+	assert.Equal(t, "cpe:2.3:a:nodejs:node.js:*:*:*:*:*:node.js:*:*", c.String())
+}
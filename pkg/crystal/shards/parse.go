@@ -0,0 +1,85 @@
+package shards
+
+import (
+	"io"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type shardFile struct {
+	// shard.lock
+	Shards map[string]shard `yaml:"shards"`
+
+	// shard.yml
+	// development_dependencies are not installed by dependents, so they are ignored.
+	Dependencies map[string]shard `yaml:"dependencies"`
+}
+
+type shard struct {
+	Version string `yaml:"version"`
+	Commit  string `yaml:"commit"`
+	Tag     string `yaml:"tag"`
+	Branch  string `yaml:"branch"`
+}
+
+// Parser implements types.Parser for shard.lock and shard.yml
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses shard.lock, or shard.yml when the lock file doesn't exist
+// shard.yml has no resolved versions, and its requirements are returned as constraints as well. e.g. ~> 1.1.0
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var file shardFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	shards, manifest := file.Shards, false
+	if shards == nil {
+		shards, manifest = file.Dependencies, true
+	}
+
+	var libs []types.Library
+	for name, s := range shards {
+		lib := types.Library{
+			Name:    name,
+			Version: s.version(),
+		}
+		if manifest {
+			lib.Constraint = lib.Version
+		}
+		libs = append(libs, lib)
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
+
+// version returns the resolved version, or the git reference when no version is specified.
+// e.g. 0.4.1+git.commit.3a8d4e2bbbf3c3bf6a4b9fb82bcc0e6cb2f8a09f
+func (s shard) version() string {
+	switch {
+	case s.Version != "":
+		return s.Version
+	case s.Commit != "":
+		// shard.lock v1.0
+		return s.Commit
+	case s.Tag != "":
+		return s.Tag
+	}
+	return s.Branch
+}
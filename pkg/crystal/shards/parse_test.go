@@ -0,0 +1,55 @@
+package shards
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/shard.lock",
+			want: shardLock,
+		},
+		{
+			file: "testdata/shard_v1.lock",
+			want: shardLockV1,
+		},
+		{
+			file: "testdata/shard.yml",
+			want: shardYML,
+		},
+		{
+			file:    "testdata/invalid.lock",
+			wantErr: "decode error",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
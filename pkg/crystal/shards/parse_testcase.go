@@ -0,0 +1,28 @@
+package shards
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name crystal --rm -it crystallang/crystal:1.5.0 bash
+	// crystal init app app && cd app
+	// (add kemal to dependencies and ameba to development_dependencies in shard.yml)
+	// shards install
+	shardLock = []types.Library{
+		{Name: "ameba", Version: "1.0.0"},
+		{Name: "exception_page", Version: "0.2.2"},
+		{Name: "kemal", Version: "1.1.2"},
+		{Name: "radix", Version: "0.4.1+git.commit.3a8d4e2bbbf3c3bf6a4b9fb82bcc0e6cb2f8a09f"},
+	}
+
+	shardLockV1 = []types.Library{
+		{Name: "kemal", Version: "0.26.1"},
+		{Name: "radix", Version: "3a8d4e2bbbf3c3bf6a4b9fb82bcc0e6cb2f8a09f"},
+	}
+
+	// The requirements are constraints as well
+	shardYML = []types.Library{
+		{Name: "kemal", Version: "~> 1.1.0", Constraint: "~> 1.1.0"},
+		{Name: "pg", Version: "master", Constraint: "master"},
+		{Name: "radix", Version: "v0.4.1", Constraint: "v0.4.1"},
+	}
+)
@@ -0,0 +1,80 @@
+package dub
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type selections struct {
+	FileVersion int                        `json:"fileVersion"`
+	Versions    map[string]json.RawMessage `json:"versions"`
+}
+
+// selection is the object form of a selected version.
+// It is used for packages fetched from a repository or the local file system.
+type selection struct {
+	Version    string `json:"version"`
+	Repository string `json:"repository"`
+	Path       string `json:"path"`
+}
+
+// Parser implements types.Parser for dub.selections.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses dub.selections.json
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var sel selections
+	if err := json.NewDecoder(r).Decode(&sel); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	if sel.FileVersion != 1 {
+		return nil, &types.ErrUnsupportedLockfileVersion{File: "file", Version: strconv.Itoa(sel.FileVersion)}
+	}
+
+	var libs []types.Library
+	var errs []error
+	for name, raw := range sel.Versions {
+		// The selection is either a version string or an object
+		var version string
+		if err := json.Unmarshal(raw, &version); err != nil {
+			var s selection
+			if err = json.Unmarshal(raw, &s); err != nil {
+				errs = append(errs, xerrors.Errorf("invalid selection for %s: %w", name, &types.ErrMalformedInput{Err: err}))
+				continue
+			}
+
+			// Packages on the local file system are part of the project
+			if s.Path != "" {
+				continue
+			}
+			// For repositories, the version is the commit
+			version = s.Version
+		}
+
+		libs = append(libs, types.Library{
+			Name:    name,
+			Version: version,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, types.NewErrPartialResult(errs)
+}
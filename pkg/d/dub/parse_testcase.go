@@ -0,0 +1,16 @@
+package dub
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name dub --rm -it dlang2/ldc-ubuntu:1.30.0 bash
+	// dub init app vibe-d && cd app
+	// dub add mir-linux-kernel && dub upgrade
+	dubNormal = []types.Library{
+		{Name: "dfmt", Version: "8e6d5c3f1b8a2f0e1c9a1f5b2e8c0a7d6b5c4e3f"},
+		{Name: "diet-ng", Version: "1.8.1"},
+		{Name: "eventcore", Version: "0.9.20"},
+		{Name: "mir-linux-kernel", Version: "1.0.1"},
+		{Name: "vibe-d", Version: "0.9.5"},
+	}
+)
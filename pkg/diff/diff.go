@@ -0,0 +1,202 @@
+// Package diff compares the results of parsing a file before and after a change, such as a lock file in a pull request.
+//
+// e.g.
+//
+//	for _, c := range diff.Diff(before, after, diff.WithType(purl.TypeNPM)) {
+//		fmt.Println(c.Kind, c.Name, c.Before, c.After, c.Via)
+//	}
+package diff
+
+import (
+	"sort"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/merge"
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+	"github.com/aquasecurity/go-dep-parser/pkg/version"
+)
+
+// Kind is the kind of a change.
+type Kind string
+
+const (
+	Added      Kind = "added"
+	Removed    Kind = "removed"
+	Upgraded   Kind = "upgraded"
+	Downgraded Kind = "downgraded"
+)
+
+// Change is a package added, removed or changed to another version.
+type Change struct {
+	Kind Kind   `json:"Kind"`
+	Name string `json:"Name"`
+	// Before is the version removed or changed from, and empty for added packages.
+	Before string `json:"Before,omitempty"`
+	// After is the version added or changed to, and empty for removed packages.
+	After string `json:"After,omitempty"`
+	// Via lists the packages depending on the changed one, as name@version, which tell why it is changed.
+	// They are taken from the dependency graph after the change, or before it for removed packages.
+	// It is empty for packages nothing depends on, and when the parser doesn't build the graph.
+	Via []string `json:"Via,omitempty"`
+}
+
+type options struct {
+	typ     string
+	compare func(v1, v2 string) int
+}
+
+type Option func(*options)
+
+// WithType compares the names and the versions of the packages as the ecosystem of the purl type does,
+// such as purl.TypePyPI, with version.Compare. e.g. Django_Rest 1.0-RC1 is the same as django-rest 1.0rc1
+func WithType(typ string) Option {
+	return func(o *options) {
+		o.typ = typ
+		o.compare = func(v1, v2 string) int {
+			return version.Compare(typ, v1, v2)
+		}
+	}
+}
+
+// WithCompare sets the comparison of versions telling upgrades from downgrades. The default is merge.CompareVersions.
+func WithCompare(compare func(v1, v2 string) int) Option {
+	return func(o *options) {
+		o.compare = compare
+	}
+}
+
+// Diff returns the changes from before to after, sorted by the names.
+//
+// Packages are matched by their names, and the same versions on both sides are not changes.
+// When several versions of a package are found, such as in node_modules, the rest of the versions are
+// matched from the lowest, and the versions left are added or removed.
+func Diff(before, after types.Result, opts ...Option) []Change {
+	o := options{compare: merge.CompareVersions}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	beforePkgs := o.packages(before)
+	afterPkgs := o.packages(after)
+
+	var names []string
+	for name := range beforePkgs {
+		names = append(names, name)
+	}
+	for name := range afterPkgs {
+		if _, ok := beforePkgs[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []Change
+	for _, name := range names {
+		removed, added := o.versionDiff(beforePkgs[name], afterPkgs[name])
+		for len(removed) > 0 && len(added) > 0 {
+			from, to := removed[0], added[0]
+			c := Change{Kind: Upgraded, Name: to.lib.Name, Before: from.lib.Version, After: to.lib.Version, Via: to.via}
+			if o.compare(from.lib.Version, to.lib.Version) > 0 {
+				c.Kind = Downgraded
+			}
+			changes = append(changes, c)
+			removed, added = removed[1:], added[1:]
+		}
+		for _, p := range removed {
+			changes = append(changes, Change{Kind: Removed, Name: p.lib.Name, Before: p.lib.Version, Via: p.via})
+		}
+		for _, p := range added {
+			changes = append(changes, Change{Kind: Added, Name: p.lib.Name, After: p.lib.Version, Via: p.via})
+		}
+	}
+	return changes
+}
+
+// pkg is a version of a package with the packages depending on it.
+type pkg struct {
+	lib types.Library
+	via []string
+}
+
+// packages returns the versions of the packages by the names.
+func (o options) packages(result types.Result) map[string][]pkg {
+	libs := map[string]types.Library{}
+	for _, lib := range result.Libraries {
+		if lib.ID != "" {
+			libs[lib.ID] = lib
+		}
+	}
+	via := map[string][]string{}
+	for _, dep := range result.Dependencies {
+		parent, ok := libs[dep.ID]
+		if !ok {
+			continue
+		}
+		for _, id := range dep.DependsOn {
+			via[id] = append(via[id], utils.PackageID(parent.Name, parent.Version))
+		}
+	}
+
+	pkgs := map[string][]pkg{}
+	seen := map[[2]string]struct{}{}
+	for _, lib := range result.Libraries {
+		name := o.name(lib)
+		k := [2]string{name, lib.Version}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+
+		p := pkg{lib: lib}
+		if lib.ID != "" {
+			p.via = via[lib.ID]
+			sort.Strings(p.via)
+		}
+		pkgs[name] = append(pkgs[name], p)
+	}
+	return pkgs
+}
+
+// name returns the name packages are matched by, which is normalized as purl.New does if the type is given.
+func (o options) name(lib types.Library) string {
+	if o.typ == "" {
+		return lib.Name
+	}
+	p := purl.New(o.typ, lib)
+	if p.Namespace == "" {
+		return p.Name
+	}
+	return p.Namespace + "/" + p.Name
+}
+
+// versionDiff returns the versions only found before and after, sorted in ascending order.
+func (o options) versionDiff(before, after []pkg) ([]pkg, []pkg) {
+	removed := o.subtract(before, after)
+	added := o.subtract(after, before)
+	for _, pkgs := range [][]pkg{removed, added} {
+		pkgs := pkgs
+		sort.SliceStable(pkgs, func(i, j int) bool {
+			return o.compare(pkgs[i].lib.Version, pkgs[j].lib.Version) < 0
+		})
+	}
+	return removed, added
+}
+
+// subtract returns the versions in pkgs which are not in others.
+func (o options) subtract(pkgs, others []pkg) []pkg {
+	var diff []pkg
+	for _, p := range pkgs {
+		found := false
+		for _, other := range others {
+			if o.compare(p.lib.Version, other.lib.Version) == 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diff = append(diff, p)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,98 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/diff"
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func lib(name, version string) types.Library {
+	return types.Library{ID: name + "@" + version, Name: name, Version: version}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		before types.Result
+		after  types.Result
+		opts   []diff.Option
+		want   []diff.Change
+	}{
+		{
+			name: "graph",
+			before: types.Result{
+				Libraries: []types.Library{lib("app", "1.0.0"), lib("debug", "2.6.9"), lib("ms", "2.0.0"), lib("left-pad", "1.3.0")},
+				Dependencies: []types.Dependency{
+					{ID: "app@1.0.0", DependsOn: []string{"debug@2.6.9", "left-pad@1.3.0"}},
+					{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+				},
+			},
+			after: types.Result{
+				Libraries: []types.Library{lib("app", "1.0.0"), lib("debug", "4.3.4"), lib("ms", "2.1.2"), lib("chalk", "5.0.0")},
+				Dependencies: []types.Dependency{
+					{ID: "app@1.0.0", DependsOn: []string{"chalk@5.0.0", "debug@4.3.4"}},
+					{ID: "debug@4.3.4", DependsOn: []string{"ms@2.1.2"}},
+				},
+			},
+			want: []diff.Change{
+				{Kind: diff.Added, Name: "chalk", After: "5.0.0", Via: []string{"app@1.0.0"}},
+				{Kind: diff.Upgraded, Name: "debug", Before: "2.6.9", After: "4.3.4", Via: []string{"app@1.0.0"}},
+				{Kind: diff.Removed, Name: "left-pad", Before: "1.3.0", Via: []string{"app@1.0.0"}},
+				{Kind: diff.Upgraded, Name: "ms", Before: "2.0.0", After: "2.1.2", Via: []string{"debug@4.3.4"}},
+			},
+		},
+		{
+			name: "versions compared numerically",
+			before: types.Result{
+				Libraries: []types.Library{{Name: "Flask", Version: "2.10.0"}},
+			},
+			after: types.Result{
+				Libraries: []types.Library{{Name: "Flask", Version: "2.9.0"}},
+			},
+			want: []diff.Change{
+				{Kind: diff.Downgraded, Name: "Flask", Before: "2.10.0", After: "2.9.0"},
+			},
+		},
+		{
+			name: "several versions",
+			before: types.Result{
+				Libraries: []types.Library{lib("ms", "2.0.0"), lib("ms", "2.1.1"), lib("ms", "2.1.3")},
+			},
+			after: types.Result{
+				Libraries: []types.Library{lib("ms", "2.1.3"), lib("ms", "2.1.2")},
+			},
+			want: []diff.Change{
+				{Kind: diff.Upgraded, Name: "ms", Before: "2.0.0", After: "2.1.2"},
+				{Kind: diff.Removed, Name: "ms", Before: "2.1.1"},
+			},
+		},
+		{
+			name: "with type",
+			before: types.Result{
+				Libraries: []types.Library{{Name: "Django_Rest", Version: "1.0-RC1"}, {Name: "requests", Version: "2.0.0rc1"}},
+			},
+			after: types.Result{
+				Libraries: []types.Library{{Name: "django-rest", Version: "1.0rc1"}, {Name: "requests", Version: "2.0.0"}},
+			},
+			opts: []diff.Option{diff.WithType(purl.TypePyPI)},
+			want: []diff.Change{
+				{Kind: diff.Upgraded, Name: "requests", Before: "2.0.0rc1", After: "2.0.0"},
+			},
+		},
+		{
+			name:   "no changes",
+			before: types.Result{Libraries: []types.Library{lib("ms", "2.0.0")}},
+			after:  types.Result{Libraries: []types.Library{lib("ms", "2.0.0")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diff.Diff(tt.before, tt.after, tt.opts...)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
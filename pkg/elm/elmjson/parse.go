@@ -0,0 +1,84 @@
+package elmjson
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type elmJSON struct {
+	// "application" or "package"
+	Type         string          `json:"type"`
+	Dependencies json.RawMessage `json:"dependencies"`
+}
+
+// Applications pin exact versions of direct and indirect dependencies
+type applicationDependencies struct {
+	Direct   map[string]string `json:"direct"`
+	Indirect map[string]string `json:"indirect"`
+}
+
+// Parser implements types.Parser for elm.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses elm.json
+// test-dependencies are not included.
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var e elmJSON
+	if err := json.NewDecoder(r).Decode(&e); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var deps map[string]string
+	indirect := map[string]bool{}
+	switch e.Type {
+	case "application":
+		var appDeps applicationDependencies
+		if err := json.Unmarshal(e.Dependencies, &appDeps); err != nil {
+			return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		deps = map[string]string{}
+		for name, version := range appDeps.Indirect {
+			deps[name] = version
+			indirect[name] = true
+		}
+		for name, version := range appDeps.Direct {
+			deps[name] = version
+			delete(indirect, name)
+		}
+	case "package":
+		// Packages only declare constraints. e.g. "1.0.0 <= v < 2.0.0"
+		if err := json.Unmarshal(e.Dependencies, &deps); err != nil {
+			return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+	default:
+		return nil, &types.ErrMalformedInput{Err: xerrors.Errorf("unknown elm.json type: %s", e.Type)}
+	}
+
+	var libs []types.Library
+	for name, version := range deps {
+		libs = append(libs, types.Library{
+			Name:     name,
+			Version:  version,
+			Indirect: indirect[name],
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
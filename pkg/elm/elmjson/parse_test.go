@@ -0,0 +1,51 @@
+package elmjson
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/application.json",
+			want: elmApplication,
+		},
+		{
+			file: "testdata/package.json",
+			want: elmPackage,
+		},
+		{
+			file:    "testdata/unknown.json",
+			wantErr: "unknown elm.json type",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
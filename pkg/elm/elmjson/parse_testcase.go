@@ -0,0 +1,23 @@
+package elmjson
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name elm --rm -it node:16 bash
+	// npm install -g elm && elm init
+	// elm install elm/browser
+	elmApplication = []types.Library{
+		{Name: "elm/browser", Version: "1.0.2"},
+		{Name: "elm/core", Version: "1.0.5"},
+		{Name: "elm/html", Version: "1.0.0"},
+		{Name: "elm/json", Version: "1.1.3", Indirect: true},
+		{Name: "elm/time", Version: "1.0.0", Indirect: true},
+		{Name: "elm/url", Version: "1.0.0", Indirect: true},
+		{Name: "elm/virtual-dom", Version: "1.0.3", Indirect: true},
+	}
+
+	elmPackage = []types.Library{
+		{Name: "elm/core", Version: "1.0.0 <= v < 2.0.0"},
+		{Name: "elm/json", Version: "1.1.0 <= v < 2.0.0"},
+	}
+)
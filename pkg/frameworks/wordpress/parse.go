@@ -8,9 +8,26 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
+// Parser implements types.Parser for wp-includes/version.php
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	lib, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{lib}, nil, nil
+}
+
 func Parse(r io.Reader) (lib types.Library, err error) {
+	defer utils.RecoverPanic(&err)
 
 	// If wordpress file, open file and
 	// find line with content
@@ -68,7 +85,7 @@ func Parse(r io.Reader) (lib types.Library, err error) {
 	}
 
 	if err = scanner.Err(); err != nil || version == "" {
-		return types.Library{}, xerrors.New("version.php could not be parsed")
+		return types.Library{}, &types.ErrMalformedInput{Err: xerrors.New("version.php could not be parsed")}
 	}
 
 	return types.Library{
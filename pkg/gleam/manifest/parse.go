@@ -0,0 +1,74 @@
+package manifest
+
+import (
+	"io"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type Manifest struct {
+	Packages []struct {
+		Name         string   `toml:"name"`
+		Version      string   `toml:"version"`
+		BuildTools   []string `toml:"build_tools"`
+		OtpApp       string   `toml:"otp_app"`
+		Source       string   `toml:"source"`
+		Requirements []string `toml:"requirements"`
+		// SHA-256 of the Hex tarball
+		OuterChecksum string `toml:"outer_checksum"`
+		Commit        string `toml:"commit"`
+	} `toml:"packages"`
+	Requirements map[string]interface{} `toml:"requirements"`
+}
+
+// Parser implements types.Parser for manifest.toml of Gleam projects
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses manifest.toml of Gleam projects
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var manifest Manifest
+	if _, err := toml.DecodeReader(r, &manifest); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var libs []types.Library
+	for _, pkg := range manifest.Packages {
+		// Direct dependencies are listed in the requirements table
+		_, direct := manifest.Requirements[pkg.Name]
+		lib := types.Library{
+			Name:     pkg.Name,
+			Version:  pkg.Version,
+			Indirect: !direct,
+		}
+
+		switch pkg.Source {
+		case "hex":
+			if pkg.OuterChecksum != "" {
+				lib.Digest = "sha256:" + strings.ToLower(pkg.OuterChecksum)
+			}
+		case "git":
+			lib.Version = pkg.Commit
+		default:
+			// Local packages are part of the project
+			continue
+		}
+		libs = append(libs, lib)
+	}
+	return libs, nil
+}
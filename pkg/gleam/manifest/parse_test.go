@@ -0,0 +1,52 @@
+package manifest
+
+import (
+	"os"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/manifest.toml",
+			want: manifestNormal,
+		},
+		{
+			file:    "testdata/invalid.toml",
+			wantErr: "decode error",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].Name < got[j].Name
+			})
+
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package manifest
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name gleam --rm -it ghcr.io/gleam-lang/gleam:v0.30.2-erlang-alpine sh
+	// gleam new app && cd app
+	// gleam add gleam_erlang && gleam build
+	manifestNormal = []types.Library{
+		{Name: "gleam_erlang", Version: "0.19.0", Digest: "sha256:720d1e0a0cebbd51c4aa1d2a2d8c1fbe0e6e6a1a0c4a3d1f0b7c6e5d4c3b2a19"},
+		{Name: "gleam_stdlib", Version: "0.30.2", Indirect: true, Digest: "sha256:8d8bf3790aa31176b1e1c0b517dd74c86da8235cf3389ea02043ee4c2d94b7a1"},
+		{Name: "gleeunit", Version: "0.10.1", Digest: "sha256:ecea2de4be6528d36afe74f42a21cdf99966ec36d7f25deb34d47dd0f7977baf"},
+		{Name: "mist", Version: "c2f3f0b3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9"},
+	}
+)
@@ -10,7 +10,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"io/ioutil"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 var (
@@ -28,7 +29,7 @@ type exe interface {
 
 // openExe opens file and returns it as an exe.
 func openExe(r io.Reader) (exe, error) {
-	b, err := ioutil.ReadAll(r)
+	b, err := utils.ReadAll(r)
 	if err != nil {
 		return nil, err
 	}
@@ -11,10 +11,25 @@ import (
 	"strings"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
+// Parser implements types.Parser for Go binaries
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
 // Parse scans file to try to report the Go and module versions.
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	x, err := openExe(r)
 	if err != nil {
 		return nil, err
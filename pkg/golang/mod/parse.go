@@ -2,40 +2,64 @@ package mod
 
 import (
 	"bufio"
+	"bytes"
 	"io"
-	"strings"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
+// Parser implements types.Parser for go.sum
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
 // Parse parses a go.sum file
-func Parse(r io.Reader) ([]types.Library, error) {
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var libs []types.Library
-	uniqueLibs := make(map[string]string)
+	// The indices of the modules in libs
+	uniqueLibs := make(map[string]int)
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		s := strings.Fields(line)
+		// Lines are parsed as bytes, and only the names and versions are converted into strings.
+		// Most modules have two lines of the same version, for the module and its go.mod, and the second one
+		// is skipped without garbage.
+		s := bytes.Fields(scanner.Bytes())
 		if len(s) < 2 {
 			continue
 		}
+		version := bytes.TrimSuffix(bytes.TrimPrefix(s[1], []byte("v")), []byte("/go.mod"))
 
 		// go.sum records and sorts all non-major versions
 		// with the latest version as last entry
-		uniqueLibs[s[0]] = strings.TrimSuffix(strings.TrimPrefix(s[1], "v"), "/go.mod")
+		if i, ok := uniqueLibs[string(s[0])]; ok {
+			if libs[i].Version != string(version) {
+				libs[i].Version = string(version)
+			}
+			continue
+		}
+		uniqueLibs[string(s[0])] = len(libs)
+		libs = append(libs, types.Library{
+			Name:    string(s[0]),
+			Version: string(version),
+		})
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, xerrors.Errorf("scan error: %w", err)
 	}
 
-	for k, v := range uniqueLibs {
-		libs = append(libs, types.Library{
-			Name:    k,
-			Version: v,
-		})
-	}
-
+	// go.sum is sorted by module paths and versions, but it might be edited by hand
+	utils.SortLibraries(libs)
 	return libs, nil
 }
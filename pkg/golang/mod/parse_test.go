@@ -3,7 +3,6 @@ package mod
 import (
 	"os"
 	"path"
-	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -43,13 +42,6 @@ func TestParse(t *testing.T) {
 			got, err := Parse(f)
 			require.NoError(t, err)
 
-			sort.Slice(got, func(i, j int) bool {
-				return got[i].Name < got[j].Name
-			})
-			sort.Slice(v.want, func(i, j int) bool {
-				return v.want[i].Name < v.want[j].Name
-			})
-
 			assert.Equal(t, v.want, got)
 		})
 	}
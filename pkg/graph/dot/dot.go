@@ -0,0 +1,147 @@
+// Package dot renders the dependency graphs returned by the parsers in the DOT language of Graphviz.
+// See https://graphviz.org/doc/info/lang.html
+//
+// e.g.
+//
+//	libs, deps, _ := npm.NewParser().Parse(f)
+//	_ = dot.Encode(os.Stdout, libs, deps, dot.WithHighlight("minimist@0.0.8"))
+//
+// and render it with "dot -Tsvg -o graph.svg".
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type options struct {
+	highlight []string
+}
+
+type Option func(*options)
+
+// WithHighlight colors the libraries with the IDs, such as vulnerable ones,
+// and the paths to them from the other libraries, which tell why they are present.
+func WithHighlight(ids ...string) Option {
+	return func(o *options) {
+		o.highlight = append(o.highlight, ids...)
+	}
+}
+
+// Encode writes the libraries and the dependency graph as a DOT digraph.
+// Duplicated libraries are merged, and the edges from and to unknown libraries are dropped.
+// Roots are drawn in bold and indirect libraries dashed.
+//
+// Nodes are identified by Library.ID, or name@version for libraries without IDs.
+func Encode(w io.Writer, libs []types.Library, deps []types.Dependency, opts ...Option) error {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	libs = utils.UniqueLibraries(libs)
+	known := map[string]bool{}
+	for _, lib := range libs {
+		known[nodeID(lib)] = true
+	}
+
+	var edges [][2]string
+	for _, dep := range deps {
+		if !known[dep.ID] {
+			continue
+		}
+		for _, id := range dep.DependsOn {
+			if known[id] {
+				edges = append(edges, [2]string{dep.ID, id})
+			}
+		}
+	}
+	highlighted := reaching(o.highlight, edges)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph dependencies {")
+	fmt.Fprintln(bw, "  rankdir=LR;")
+	fmt.Fprintln(bw, "  node [shape=box];")
+	written := map[string]bool{}
+	for _, lib := range libs {
+		id := nodeID(lib)
+		if written[id] {
+			continue
+		}
+		written[id] = true
+
+		attrs := []string{"label=" + quote(label(lib))}
+		var styles []string
+		if lib.Root {
+			styles = append(styles, "bold")
+		}
+		if lib.Indirect {
+			styles = append(styles, "dashed")
+		}
+		if len(styles) > 0 {
+			attrs = append(attrs, "style="+quote(strings.Join(styles, ",")))
+		}
+		if highlighted[id] {
+			attrs = append(attrs, "color=red")
+		}
+		fmt.Fprintf(bw, "  %s [%s];\n", quote(id), strings.Join(attrs, ", "))
+	}
+	for _, e := range edges {
+		if highlighted[e[0]] && highlighted[e[1]] {
+			fmt.Fprintf(bw, "  %s -> %s [color=red];\n", quote(e[0]), quote(e[1]))
+			continue
+		}
+		fmt.Fprintf(bw, "  %s -> %s;\n", quote(e[0]), quote(e[1]))
+	}
+	fmt.Fprintln(bw, "}")
+
+	if err := bw.Flush(); err != nil {
+		return xerrors.Errorf("write error: %w", err)
+	}
+	return nil
+}
+
+// reaching returns the targets and the nodes which depend on them directly or indirectly.
+func reaching(targets []string, edges [][2]string) map[string]bool {
+	reached := map[string]bool{}
+	for _, t := range targets {
+		reached[t] = true
+	}
+	// Walk the edges backwards until no node is added
+	for changed := len(targets) > 0; changed; {
+		changed = false
+		for _, e := range edges {
+			if reached[e[1]] && !reached[e[0]] {
+				reached[e[0]] = true
+				changed = true
+			}
+		}
+	}
+	return reached
+}
+
+func nodeID(lib types.Library) string {
+	if lib.ID != "" {
+		return lib.ID
+	}
+	return utils.PackageID(lib.Name, lib.Version)
+}
+
+func label(lib types.Library) string {
+	if lib.Version == "" {
+		return lib.Name
+	}
+	return lib.Name + "@" + lib.Version
+}
+
+// quote returns s as a double-quoted string of DOT.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
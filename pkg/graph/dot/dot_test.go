@@ -0,0 +1,60 @@
+package dot_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/graph/dot"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestEncode(t *testing.T) {
+	libs := []types.Library{
+		{ID: "app@1.0.0", Name: "app", Version: "1.0.0", Root: true},
+		{ID: "express@4.18.1", Name: "express", Version: "4.18.1"},
+		{ID: "body-parser@1.20.0", Name: "body-parser", Version: "1.20.0", Indirect: true},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Indirect: true},
+		{ID: "lodash@4.17.21", Name: "lodash", Version: "4.17.21"},
+		// Without ID
+		{Name: `"quoted"`, Version: "1.0.0"},
+		// Duplicated
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Indirect: true},
+	}
+	deps := []types.Dependency{
+		{ID: "app@1.0.0", DependsOn: []string{"express@4.18.1", "lodash@4.17.21"}},
+		{ID: "express@4.18.1", DependsOn: []string{"body-parser@1.20.0", "debug@2.6.9", "unknown@1.0.0"}},
+		{ID: "body-parser@1.20.0", DependsOn: []string{"debug@2.6.9"}},
+		{ID: "unknown@1.0.0", DependsOn: []string{"debug@2.6.9"}},
+	}
+
+	tests := []struct {
+		name   string
+		opts   []dot.Option
+		golden string
+	}{
+		{
+			name:   "graph",
+			golden: "testdata/graph.dot",
+		},
+		{
+			name:   "highlight",
+			opts:   []dot.Option{dot.WithHighlight("debug@2.6.9")},
+			golden: "testdata/highlight.dot",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := dot.Encode(&buf, libs, deps, tt.opts...)
+			require.NoError(t, err)
+
+			want, err := os.ReadFile(tt.golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), buf.String())
+		})
+	}
+}
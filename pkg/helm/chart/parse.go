@@ -0,0 +1,79 @@
+package chart
+
+import (
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Chart.yaml, Chart.lock and the legacy requirements.yaml/requirements.lock
+// share the same "dependencies" section.
+type chartFile struct {
+	Dependencies []dependency `yaml:"dependencies"`
+
+	// Only lock files have the digest of the dependencies
+	Digest string `yaml:"digest"`
+}
+
+type dependency struct {
+	Name       string `yaml:"name"`
+	Version    string `yaml:"version"`
+	Repository string `yaml:"repository"`
+}
+
+// Parser implements types.Parser for Chart.lock and Chart.yaml
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Chart.lock and Chart.yaml
+// Versions of Chart.yaml are ranges, which are returned as constraints as well. e.g. ~11.6.0
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var chart chartFile
+	if err := yaml.NewDecoder(r).Decode(&chart); err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var libs []types.Library
+	for _, dep := range chart.Dependencies {
+		if dep.Name == "" {
+			continue
+		}
+		lib := types.Library{
+			Name:               dep.Name,
+			Version:            dep.Version,
+			ExternalReferences: dep.externalReferences(),
+		}
+		if chart.Digest == "" {
+			lib.Constraint = dep.Version
+		}
+		libs = append(libs, lib)
+	}
+	return libs, nil
+}
+
+// externalReferences returns the chart repository or the OCI registry as the registry of the chart.
+// Aliases of the repositories added by "helm repo add", such as "@bitnami", and local charts
+// referred to by "file://" are skipped, as they are not URLs of registries.
+func (dep dependency) externalReferences() []types.ExternalReference {
+	for _, scheme := range []string{"https://", "http://", "oci://"} {
+		if strings.HasPrefix(dep.Repository, scheme) {
+			return []types.ExternalReference{{Type: types.RefTypeRegistry, URL: dep.Repository}}
+		}
+	}
+	return nil
+}
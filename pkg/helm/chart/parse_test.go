@@ -0,0 +1,53 @@
+package chart
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/Chart.lock",
+			want: chartLock,
+		},
+		{
+			file: "testdata/Chart.yaml",
+			want: chartYAML,
+		},
+		{
+			file: "testdata/Chart_no_deps.yaml",
+		},
+		{
+			file:    "testdata/invalid.yaml",
+			wantErr: "decode error",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
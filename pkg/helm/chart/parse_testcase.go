@@ -0,0 +1,26 @@
+package chart
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name helm --rm -it alpine/helm:3.9.1 sh
+	// helm create myapp && cd myapp
+	// (add common, postgresql and redis to dependencies in Chart.yaml)
+	// helm dependency update
+	chartLock = []types.Library{
+		{Name: "common", Version: "1.16.0", ExternalReferences: bitnami},
+		{Name: "postgresql", Version: "11.6.6", ExternalReferences: bitnami},
+		{Name: "redis", Version: "17.0.1", ExternalReferences: bitnamiOCI},
+	}
+
+	// Versions are constraints. nginx refers to the repository by its alias
+	chartYAML = []types.Library{
+		{Name: "common", Version: "1.x.x", Constraint: "1.x.x", ExternalReferences: bitnami},
+		{Name: "postgresql", Version: "~11.6.0", Constraint: "~11.6.0", ExternalReferences: bitnami},
+		{Name: "redis", Version: "17.0.1", Constraint: "17.0.1", ExternalReferences: bitnamiOCI},
+		{Name: "nginx", Version: "13.1.0", Constraint: "13.1.0"},
+	}
+
+	bitnami    = []types.ExternalReference{{Type: types.RefTypeRegistry, URL: "https://charts.bitnami.com/bitnami"}}
+	bitnamiOCI = []types.ExternalReference{{Type: types.RefTypeRegistry, URL: "oci://registry-1.docker.io/bitnamicharts"}}
+)
@@ -0,0 +1,49 @@
+// Package identity tells whether libraries reported by different files or parsers are the same,
+// so that deduplication, diffing and map keys agree between consumers.
+//
+// e.g.
+//
+//	seen := map[string]bool{}
+//	for _, lib := range libs {
+//		seen[identity.Key(purl.TypePyPI, lib)] = true
+//	}
+package identity
+
+import (
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/version"
+)
+
+// Key returns the canonical identity of the library in the ecosystem of the purl type,
+// which is its Package URL with the version normalized by version.Normalize.
+// The name is normalized as purl.New does, and Library.Qualifiers are included.
+// e.g. pkg:pypi/django-rest@1.0rc1 for Django_Rest 1.0-RC1
+func Key(typ string, lib types.Library) string {
+	lib.Version = version.Normalize(typ, lib.Version)
+	return purl.New(typ, lib).String()
+}
+
+// Equal reports whether the libraries have the same Key.
+func Equal(typ string, lib1, lib2 types.Library) bool {
+	return Key(typ, lib1) == Key(typ, lib2)
+}
+
+// Compare orders the libraries by their normalized names, their versions in the order of the ecosystem,
+// and then their keys. It returns 0 only if the libraries are Equal, so it can be used for sorting and deduplication.
+func Compare(typ string, lib1, lib2 types.Library) int {
+	p1, p2 := purl.New(typ, lib1), purl.New(typ, lib2)
+	if c := strings.Compare(p1.Namespace, p2.Namespace); c != 0 {
+		return c
+	}
+	if c := strings.Compare(p1.Name, p2.Name); c != 0 {
+		return c
+	}
+	if c := version.Compare(typ, lib1.Version, lib2.Version); c != 0 {
+		return c
+	}
+	// e.g. 1.0 and 1.0.0 of Maven are in the same order, but are different versions
+	return strings.Compare(Key(typ, lib1), Key(typ, lib2))
+}
@@ -0,0 +1,88 @@
+package identity_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/identity"
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		lib  types.Library
+		want string
+	}{
+		{
+			name: "pypi",
+			typ:  purl.TypePyPI,
+			lib:  types.Library{Name: "Django_Rest", Version: "1.0-RC1"},
+			want: "pkg:pypi/django-rest@1.0rc1",
+		},
+		{
+			name: "npm",
+			typ:  purl.TypeNPM,
+			lib:  types.Library{ID: "@Babel/Core@v7.18.6", Name: "@Babel/Core", Version: "v7.18.6"},
+			want: "pkg:npm/%40babel/core@7.18.6",
+		},
+		{
+			name: "golang",
+			typ:  purl.TypeGolang,
+			lib:  types.Library{Name: "github.com/pkg/errors", Version: "0.9.1"},
+			want: "pkg:golang/github.com/pkg/errors@v0.9.1",
+		},
+		{
+			name: "maven with qualifiers",
+			typ:  purl.TypeMaven,
+			lib: types.Library{
+				Name:       "org.typelevel:cats-core_2.13",
+				Version:    "2.8.0-RC1",
+				Qualifiers: map[string]string{purl.QualifierClassifier: "sources"},
+			},
+			want: "pkg:maven/org.typelevel/cats-core_2.13@2.8.0-rc1?classifier=sources",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, identity.Key(tt.typ, tt.lib))
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	assert.True(t, identity.Equal(purl.TypePyPI,
+		types.Library{Name: "Flask", Version: "2.0"},
+		types.Library{Name: "flask", Version: "2.0", FilePath: "requirements.txt"}))
+	assert.False(t, identity.Equal(purl.TypeGem,
+		types.Library{Name: "nokogiri", Version: "1.13.10"},
+		types.Library{Name: "nokogiri", Version: "1.13.10", Qualifiers: map[string]string{purl.QualifierPlatform: "java"}}))
+}
+
+func TestCompare(t *testing.T) {
+	libs := []types.Library{
+		{Name: "org.example:b", Version: "1.0"},
+		{Name: "org.example:a", Version: "1.10"},
+		{Name: "org.example:a", Version: "1.0.0"},
+		{Name: "org.example:a", Version: "1.9"},
+		{Name: "org.example:a", Version: "1.0"},
+	}
+	sort.Slice(libs, func(i, j int) bool {
+		return identity.Compare(purl.TypeMaven, libs[i], libs[j]) < 0
+	})
+
+	want := []types.Library{
+		// 1.0 and 1.0.0 are equal in Maven, and ordered by their keys
+		{Name: "org.example:a", Version: "1.0"},
+		{Name: "org.example:a", Version: "1.0.0"},
+		{Name: "org.example:a", Version: "1.9"},
+		{Name: "org.example:a", Version: "1.10"},
+		{Name: "org.example:b", Version: "1.0"},
+	}
+	assert.Equal(t, want, libs)
+	assert.Zero(t, identity.Compare(purl.TypeMaven, libs[0], libs[0]))
+}
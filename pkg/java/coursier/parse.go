@@ -0,0 +1,114 @@
+package coursier
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// The JSON report written by "cs fetch --json-output-file" and "cs resolve"
+type report struct {
+	Version      string
+	Dependencies []dependency
+}
+
+type dependency struct {
+	// e.g. org.typelevel:cats-core_2.13:2.8.0
+	//      org.typelevel:cats-core_2.13:jar:sources:2.8.0
+	Coord              string
+	DirectDependencies []string
+	Dependencies       []string
+}
+
+// Parser implements types.Parser for the JSON report of coursier
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return Parse(r)
+}
+
+// Parse parses the JSON report of coursier.
+// The dependency graph is built from the direct dependencies of each artifact,
+// which refer to the artifacts by name@version regardless of their classifiers.
+func Parse(r io.Reader) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var rep report
+	if err := json.NewDecoder(r).Decode(&rep); err != nil {
+		return nil, nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	if !strings.HasPrefix(rep.Version, "0.") {
+		return nil, nil, &types.ErrUnsupportedLockfileVersion{File: "report", Version: rep.Version}
+	}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	var errs []error
+	seen := map[string]struct{}{}
+	for _, dep := range rep.Dependencies {
+		lib, err := parseCoord(dep.Coord)
+		if err != nil {
+			errs = append(errs, xerrors.Errorf("invalid dependency: %w", &types.ErrMalformedInput{Err: err}))
+			continue
+		}
+		libs = append(libs, lib)
+
+		var dependsOn []string
+		for _, coord := range dep.DirectDependencies {
+			child, err := parseCoord(coord)
+			if err != nil {
+				errs = append(errs, xerrors.Errorf("invalid direct dependency of %s: %w", lib.ID, &types.ErrMalformedInput{Err: err}))
+				continue
+			}
+			dependsOn = append(dependsOn, child.ID)
+		}
+		// Artifacts with classifiers have the same dependencies as the main one, or none
+		if _, ok := seen[lib.ID]; ok || len(dependsOn) == 0 {
+			continue
+		}
+		seen[lib.ID] = struct{}{}
+		deps = append(deps, types.Dependency{ID: lib.ID, DependsOn: dependsOn})
+	}
+	utils.SortDependencies(deps)
+
+	// The same module can be listed multiple times with different classifiers, which are kept as qualifiers
+	return utils.UniqueLibraries(libs), deps, types.NewErrPartialResult(errs)
+}
+
+// parseCoord parses groupId:artifactId[:type[:classifier]]:version
+func parseCoord(coord string) (types.Library, error) {
+	ss := strings.Split(coord, ":")
+	if len(ss) < 3 {
+		return types.Library{}, xerrors.Errorf("invalid coordinate: %s", coord)
+	}
+	name, version := fmt.Sprintf("%s:%s", ss[0], ss[1]), ss[len(ss)-1]
+	lib := types.Library{
+		ID:      utils.PackageID(name, version),
+		Name:    name,
+		Version: version,
+	}
+
+	qualifiers := map[string]string{}
+	if len(ss) >= 4 && ss[2] != "jar" {
+		qualifiers[purl.QualifierType] = ss[2]
+	}
+	if len(ss) >= 5 && ss[3] != "" {
+		qualifiers[purl.QualifierClassifier] = ss[3]
+	}
+	if len(qualifiers) > 0 {
+		lib.Qualifiers = qualifiers
+	}
+	return lib, nil
+}
@@ -0,0 +1,62 @@
+package coursier
+
+import (
+	"os"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
+		wantErr  string
+	}{
+		{
+			file:     "testdata/report.json",
+			want:     coursierNormal,
+			wantDeps: coursierNormalDeps,
+		},
+		{
+			file:    "testdata/unsupported.json",
+			wantErr: "unsupported report version",
+		},
+		{
+			file:    "testdata/partial.json",
+			want:    coursierPartial,
+			wantErr: "invalid coordinate: org.typelevel",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				assert.Equal(t, v.want, got)
+				assert.Equal(t, v.wantDeps, gotDeps)
+				return
+			}
+			require.NoError(t, err)
+
+			sort.Slice(got, func(i, j int) bool {
+				return got[i].Name < got[j].Name
+			})
+
+			assert.Equal(t, v.want, got)
+			assert.Equal(t, v.wantDeps, gotDeps)
+		})
+	}
+}
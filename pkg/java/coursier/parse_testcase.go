@@ -0,0 +1,25 @@
+package coursier
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name coursier --rm -it virtuslab/scala-cli:0.1.10 bash
+	// cs fetch org.typelevel:cats-core_2.13:2.8.0 --sources --default=true --json-output-file report.json
+	coursierNormal = []types.Library{
+		{ID: "org.scala-lang:scala-library@2.13.8", Name: "org.scala-lang:scala-library", Version: "2.13.8"},
+		{ID: "org.typelevel:cats-core_2.13@2.8.0", Name: "org.typelevel:cats-core_2.13", Version: "2.8.0"},
+		{ID: "org.typelevel:cats-core_2.13@2.8.0", Name: "org.typelevel:cats-core_2.13", Version: "2.8.0", Qualifiers: map[string]string{"classifier": "sources"}},
+		{ID: "org.typelevel:cats-kernel_2.13@2.8.0", Name: "org.typelevel:cats-kernel_2.13", Version: "2.8.0"},
+	}
+
+	coursierNormalDeps = []types.Dependency{
+		{ID: "org.typelevel:cats-core_2.13@2.8.0", DependsOn: []string{"org.scala-lang:scala-library@2.13.8", "org.typelevel:cats-kernel_2.13@2.8.0"}},
+		{ID: "org.typelevel:cats-kernel_2.13@2.8.0", DependsOn: []string{"org.scala-lang:scala-library@2.13.8"}},
+	}
+
+	// The coordinate without the version is skipped
+	coursierPartial = []types.Library{
+		{ID: "org.scala-lang:scala-library@2.13.8", Name: "org.scala-lang:scala-library", Version: "2.13.8"},
+		{ID: "org.typelevel:cats-kernel_2.13@2.8.0", Name: "org.typelevel:cats-kernel_2.13", Version: "2.8.0"},
+	}
+)
@@ -0,0 +1,211 @@
+package ivy
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/metadata"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// ivy.xml and the resolution report in the resolution cache
+// e.g. ~/.ivy2/cache/com.example-app-default.xml
+type ivyFile struct {
+	XMLName xml.Name
+	// ivy.xml
+	Dependencies []dependency `xml:"dependencies>dependency"`
+	// ivy-report
+	Info    info     `xml:"info"`
+	Modules []module `xml:"dependencies>module"`
+}
+
+type info struct {
+	Organisation string `xml:"organisation,attr"`
+	Module       string `xml:"module,attr"`
+}
+
+type dependency struct {
+	Org  string `xml:"org,attr"`
+	Name string `xml:"name,attr"`
+	Rev  string `xml:"rev,attr"`
+	Conf string `xml:"conf,attr"`
+}
+
+type module struct {
+	Organisation string     `xml:"organisation,attr"`
+	Name         string     `xml:"name,attr"`
+	Revisions    []revision `xml:"revision"`
+}
+
+type revision struct {
+	Name    string   `xml:"name,attr"`
+	Evicted string   `xml:"evicted,attr"`
+	Callers []caller `xml:"caller"`
+}
+
+// caller is a module depending on the revision
+type caller struct {
+	Organisation string `xml:"organisation,attr"`
+	Name         string `xml:"name,attr"`
+}
+
+type options struct {
+	resolver *metadata.Resolver
+}
+
+type Option func(*options)
+
+// WithResolver resolves the version ranges of ivy.xml, such as [1.0,2.0[, to the versions in the repositories.
+// Ranges failing to be resolved are kept as they are. Resolution reports have no ranges.
+func WithResolver(r *metadata.Resolver) Option {
+	return func(o *options) {
+		o.resolver = r
+	}
+}
+
+// Parser implements types.Parser for ivy.xml and Ivy resolution reports
+type Parser struct {
+	opts []Option
+}
+
+func NewParser(opts ...Option) types.Parser {
+	return &Parser{opts: opts}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r, p.opts...)
+	return libs, nil, err
+}
+
+// Parse parses ivy.xml and Ivy resolution reports
+func Parse(r io.Reader, opts ...Option) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var file ivyFile
+	decoder := xml.NewDecoder(utils.NewUTF8Reader(r))
+	decoder.CharsetReader = utils.CharsetReader
+	if err := decoder.Decode(&file); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	switch file.XMLName.Local {
+	case "ivy-module":
+		return parseModule(file.Dependencies, o), nil
+	case "ivy-report":
+		return parseReport(file.Info, file.Modules), nil
+	}
+	return nil, &types.ErrMalformedInput{Err: xerrors.Errorf("unknown root element: %s", file.XMLName.Local)}
+}
+
+func parseModule(deps []dependency, o options) []types.Library {
+	var libs []types.Library
+	for _, dep := range deps {
+		if dep.Name == "" || testOnly(dep.Conf) {
+			continue
+		}
+		org := dep.Org
+		if org == "" {
+			// The organisation defaults to the one of the module itself,
+			// which can't be determined reliably here.
+			continue
+		}
+		libs = append(libs, types.Library{
+			Name:    fmt.Sprintf("%s:%s", org, dep.Name),
+			Version: o.resolve(org, dep.Name, dep.Rev),
+		})
+	}
+	return libs
+}
+
+// resolve returns the version the range resolves to, or the revision as it is.
+func (o options) resolve(org, name, rev string) string {
+	spec := mavenRange(rev)
+	if o.resolver == nil || spec == "" {
+		return rev
+	}
+	v, err := o.resolver.Resolve(context.Background(), org, name, spec)
+	if err != nil {
+		log.Logger.Debugw("Unable to resolve the version range", zap.String("module", org+":"+name),
+			zap.String("rev", rev), zap.Error(err))
+		return rev
+	}
+	return v
+}
+
+// mavenRange returns the range in the Maven syntax, or "" if the revision isn't a range.
+// Ivy also excludes the bounds by the brackets facing outward. e.g. [1.0,2.0[ and ]1.0,2.0]
+func mavenRange(rev string) string {
+	rev = strings.TrimSpace(rev)
+	if len(rev) < 2 || !strings.ContainsAny(rev[:1], "[]()") || !strings.ContainsAny(rev[len(rev)-1:], "[]()") {
+		return ""
+	}
+	if rev[0] == ']' {
+		rev = "(" + rev[1:]
+	}
+	if rev[len(rev)-1] == '[' {
+		rev = rev[:len(rev)-1] + ")"
+	}
+	return rev
+}
+
+func parseReport(root info, modules []module) []types.Library {
+	var libs []types.Library
+	for _, m := range modules {
+		for _, rev := range m.Revisions {
+			// Evicted revisions lost a conflict and are not part of the resolved graph
+			if rev.Evicted != "" {
+				continue
+			}
+			libs = append(libs, types.Library{
+				Name:     fmt.Sprintf("%s:%s", m.Organisation, m.Name),
+				Version:  rev.Name,
+				Indirect: !rev.calledBy(root),
+			})
+		}
+	}
+	return libs
+}
+
+// calledBy reports whether the revision is a direct dependency of the module.
+// Revisions without callers are considered direct as it can't be determined.
+func (r revision) calledBy(root info) bool {
+	if len(r.Callers) == 0 {
+		return true
+	}
+	for _, c := range r.Callers {
+		if c.Organisation == root.Organisation && c.Name == root.Module {
+			return true
+		}
+	}
+	return false
+}
+
+// testOnly reports whether the configuration mapping only applies to the "test" configuration.
+// e.g. "test->default", "test"
+func testOnly(conf string) bool {
+	if conf == "" {
+		return false
+	}
+	for _, mapping := range strings.Split(conf, ";") {
+		master := strings.TrimSpace(strings.SplitN(mapping, "->", 2)[0])
+		for _, c := range strings.Split(master, ",") {
+			if strings.TrimSpace(c) != "test" {
+				return false
+			}
+		}
+	}
+	return true
+}
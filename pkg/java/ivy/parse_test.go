@@ -0,0 +1,102 @@
+package ivy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/metadata"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/settings"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/ivy.xml",
+			want: ivyModule,
+		},
+		{
+			file: "testdata/com.example-app-default.xml",
+			want: ivyReport,
+		},
+		{
+			file:    "testdata/unknown.xml",
+			wantErr: "unknown root element",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
+
+func TestWithResolver(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/com/google/guava/guava/maven-metadata.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`<metadata><versioning><versions>
+<version>29.0-jre</version><version>30.0-jre</version><version>31.1-jre</version>
+</versions></versioning></metadata>`))
+	}))
+	defer ts.Close()
+
+	s := settings.Settings{Mirrors: []settings.Mirror{{ID: "mirror", URL: ts.URL, MirrorOf: "*"}}}
+	resolver := metadata.NewResolver(metadata.WithSettings(s))
+
+	f, err := os.Open("testdata/ivy.xml")
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := Parse(f, WithResolver(resolver))
+	require.NoError(t, err)
+	want := []types.Library{
+		{Name: "commons-lang:commons-lang", Version: "2.6"},
+		{Name: "org.slf4j:slf4j-api", Version: "1.7.36"},
+		{Name: "com.google.guava:guava", Version: "31.1-jre"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestMavenRange(t *testing.T) {
+	tests := []struct {
+		rev  string
+		want string
+	}{
+		{rev: "[1.0,2.0)", want: "[1.0,2.0)"},
+		{rev: "[1.0,2.0[", want: "[1.0,2.0)"},
+		{rev: "]1.0,2.0]", want: "(1.0,2.0]"},
+		{rev: "[1.0,)", want: "[1.0,)"},
+		{rev: "1.0", want: ""},
+		{rev: "latest.integration", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rev, func(t *testing.T) {
+			assert.Equal(t, tt.want, mavenRange(tt.rev))
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package ivy
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	ivyModule = []types.Library{
+		{Name: "commons-lang:commons-lang", Version: "2.6"},
+		{Name: "org.slf4j:slf4j-api", Version: "1.7.36"},
+		{Name: "com.google.guava:guava", Version: "[30.0-jre,)"},
+	}
+
+	// docker run --name ant --rm -it frekele/ant:1.10.3-jdk8 bash
+	// ant resolve (with <ivy:resolve/>)
+	// cat ~/.ivy2/cache/com.example-app-default.xml
+	ivyReport = []types.Library{
+		{Name: "commons-lang:commons-lang", Version: "2.6"},
+		{Name: "commons-logging:commons-logging", Version: "1.2", Indirect: true},
+		{Name: "org.slf4j:slf4j-api", Version: "1.7.36"},
+	}
+)
@@ -1,22 +1,26 @@
 package jar
 
-import "github.com/aquasecurity/go-dep-parser/pkg/log"
+import (
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/redact"
+)
 
 // logger implements LeveledLogger
 // https://github.com/hashicorp/go-retryablehttp/blob/991b9d0a42d13014e3689dd49a94c02be01f4237/client.go#L285-L290
+// The URLs of the requests and the errors are logged with the credentials masked.
 type logger struct{}
 
 func (logger) Error(msg string, keysAndValues ...interface{}) {
 	// Use Debugw to suppress errors on failure
 	if msg == "request failed" {
-		log.Logger.Debugw(msg, keysAndValues...)
+		log.Logger.Debugw(msg, redact.KeysAndValues(keysAndValues)...)
 		return
 	}
-	log.Logger.Errorw(msg, keysAndValues)
+	log.Logger.Errorw(msg, redact.KeysAndValues(keysAndValues)...)
 }
 
 func (logger) Info(msg string, keysAndValues ...interface{}) {
-	log.Logger.Infow(msg, keysAndValues...)
+	log.Logger.Infow(msg, redact.KeysAndValues(keysAndValues)...)
 }
 
 func (logger) Debug(msg string, keysAndValues ...interface{}) {
@@ -24,9 +28,9 @@ func (logger) Debug(msg string, keysAndValues ...interface{}) {
 	if msg == "performing request" {
 		return
 	}
-	log.Logger.Debugw(msg, keysAndValues...)
+	log.Logger.Debugw(msg, redact.KeysAndValues(keysAndValues)...)
 }
 
 func (logger) Warn(msg string, keysAndValues ...interface{}) {
-	log.Logger.Warnw(msg, keysAndValues...)
+	log.Logger.Warnw(msg, redact.KeysAndValues(keysAndValues)...)
 }
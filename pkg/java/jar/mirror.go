@@ -0,0 +1,247 @@
+package jar
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
+	"github.com/aquasecurity/go-dep-parser/pkg/redact"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// maxParents bounds the parent POMs walked up for the licenses, as parents might refer to each other.
+const maxParents = 10
+
+// mirror is the mirror of Maven Central with the credentials of its server
+type mirror struct {
+	url      string
+	username string
+	password string
+}
+
+// fileURL returns the URL of the file under the directory of the artifact.
+// e.g. org/example/example/maven-metadata.xml and org/example/example/1.0/example-1.0.pom
+func (m mirror) fileURL(p properties, elems ...string) string {
+	segments := append(strings.Split(p.groupID, "."), p.artifactID)
+	segments = append(segments, elems...)
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return m.url + "/" + strings.Join(segments, "/")
+}
+
+// existsInMirror checks maven-metadata.xml of the artifact in the mirror of Maven Central.
+// The results are memoized as the searches are, and are not cached.
+func existsInMirror(c conf, p properties) (bool, error) {
+	u := c.mirror.fileURL(p, "maven-metadata.xml")
+	res, err := c.searched.do(u, func() (apiResponse, error) {
+		return headMirror(c, u)
+	})
+	if err != nil {
+		return false, xerrors.Errorf("exists mirror error: %w", err)
+	}
+	return res.Response.NumFound > 0, nil
+}
+
+// headMirror returns the response finding one artifact if the file exists, and none if it doesn't.
+func headMirror(c conf, u string) (apiResponse, error) {
+	resp, err := requestMirror(c, http.MethodHead, u)
+	if err != nil {
+		return apiResponse{}, err
+	}
+	resp.Body.Close()
+
+	var res apiResponse
+	switch resp.StatusCode {
+	case http.StatusOK:
+		res.Response.NumFound = 1
+	case http.StatusNotFound:
+	default:
+		return apiResponse{}, xerrors.Errorf("status %s from %s", resp.Status, redact.URL(u))
+	}
+	return res, nil
+}
+
+// requestMirror sends the request to the mirror with its credentials, reporting it to the hooks.
+func requestMirror(c conf, method, u string) (*http.Response, error) {
+	req, err := http.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, redact.Error(err)
+	}
+	req = req.WithContext(c.ctx)
+	if c.mirror.username != "" || c.mirror.password != "" {
+		req.SetBasicAuth(c.mirror.username, c.mirror.password)
+	}
+
+	if c.requests != nil {
+		select {
+		case c.requests <- struct{}{}:
+			defer func() { <-c.requests }()
+		case <-c.ctx.Done():
+			return nil, xerrors.Errorf("canceled: %w", c.ctx.Err())
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	event := metrics.RequestEvent{Parser: parserName, URL: redact.URL(u), Duration: time.Since(start), Err: redact.Error(err)}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	c.hooks.Requested(event)
+	if err != nil {
+		return nil, xerrors.Errorf("http error: %w", redact.Error(err))
+	}
+	return resp, nil
+}
+
+// pomMemo memoizes the parent POMs for the duration of a Parse, as the artifacts of a project share their parents.
+// POMs being looked up are waited for instead of being fetched again. Failures are memoized as well,
+// as the same parent would fail again during the Parse.
+type pomMemo struct {
+	mu    sync.Mutex
+	calls map[string]*pomCall
+}
+
+type pomCall struct {
+	done chan struct{}
+	pom  pom
+	err  error
+}
+
+// do returns the memoized POM of key, or calls fn once.
+// The panic of fn is memoized as ErrMalformedInput, so that none of the callers waits forever.
+func (m *pomMemo) do(key string, fn func() (pom, error)) (pom, error) {
+	m.mu.Lock()
+	if call, ok := m.calls[key]; ok {
+		m.mu.Unlock()
+		<-call.done
+		return call.pom, call.err
+	}
+	call := &pomCall{done: make(chan struct{})}
+	m.calls[key] = call
+	m.mu.Unlock()
+	defer close(call.done)
+
+	func() {
+		defer utils.RecoverPanic(&call.err)
+		call.pom, call.err = fn()
+	}()
+	return call.pom, call.err
+}
+
+// inheritLicenses sets the licenses inherited from the parent POMs to the libraries of the same indices.
+// Up to WithParallel of them are resolved at the same time, as the parents might be fetched from the mirror,
+// and the requests are limited by WithMaxRequests.
+func (c conf) inheritLicenses(libs map[int]types.Library, poms map[int]pom) {
+	if len(poms) == 0 || (c.localRepository == "" && c.mirror.url == "") {
+		return
+	}
+	n := c.parallel
+	if n < 1 {
+		n = 1
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, n)
+	for i, p := range poms {
+		i, p := i, p
+		workers <- struct{}{}
+		wg.Add(1)
+		go func() {
+			// A broken parent leaves the licenses empty, instead of crashing the process
+			var err error
+			defer func() {
+				if err != nil {
+					log.Logger.Debugw("Unable to inherit the licenses", zap.String("parent", p.parent.String()), zap.Error(err))
+				}
+				<-workers
+				wg.Done()
+			}()
+			defer utils.RecoverPanic(&err)
+			licenses := c.licenses(p)
+
+			mu.Lock()
+			defer mu.Unlock()
+			lib := libs[i]
+			lib.SetLicenses(licenses)
+			libs[i] = lib
+		}()
+	}
+	wg.Wait()
+}
+
+// licenses returns the licenses of the POM. POMs declaring none inherit the licenses of their parents,
+// which are looked up in the local repository and the mirror of WithSettings.
+func (c conf) licenses(p pom) []string {
+	for i := 0; len(p.licenses) == 0 && p.parent.valid() && i < maxParents; i++ {
+		parent, err := c.parentPom(p.parent)
+		if err != nil {
+			log.Logger.Debugw("Unable to resolve the parent POM", zap.String("parent", p.parent.String()), zap.Error(err))
+			return nil
+		}
+		p = parent
+	}
+	return p.licenses
+}
+
+// parentPom returns the parent POM in the local repository, or in the mirror unless WithOffline is given.
+func (c conf) parentPom(p properties) (pom, error) {
+	return c.parents.do(p.String(), func() (pom, error) {
+		fileName := p.artifactID + "-" + p.version + ".pom"
+		switch {
+		case c.localRepository != "" && localPomExists(c.localRepository, p, fileName):
+			return readLocalPom(c.localRepository, p, fileName)
+		case c.mirror.url != "" && !c.offline:
+			return fetchPom(c, c.mirror.fileURL(p, p.version, fileName))
+		}
+		return pom{}, &types.ErrArtifactNotFound{GroupID: p.groupID, ArtifactID: p.artifactID, Version: p.version}
+	})
+}
+
+func localPomPath(localRepository string, p properties, fileName string) string {
+	return filepath.Join(localRepository, filepath.FromSlash(strings.ReplaceAll(p.groupID, ".", "/")), p.artifactID, p.version, fileName)
+}
+
+func localPomExists(localRepository string, p properties, fileName string) bool {
+	_, err := os.Stat(localPomPath(localRepository, p, fileName))
+	return err == nil
+}
+
+func readLocalPom(localRepository string, p properties, fileName string) (pom, error) {
+	f, err := os.Open(localPomPath(localRepository, p, fileName))
+	if err != nil {
+		return pom{}, xerrors.Errorf("unable to open the POM: %w", err)
+	}
+	defer f.Close()
+	return decodePom(f)
+}
+
+func fetchPom(c conf, u string) (pom, error) {
+	resp, err := requestMirror(c, http.MethodGet, u)
+	if err != nil {
+		return pom{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return pom{}, xerrors.Errorf("status %s from %s", resp.Status, redact.URL(u))
+	}
+	var r io.Reader = resp.Body
+	if c.limits.MaxInputSize > 0 {
+		r = io.LimitReader(r, c.limits.MaxInputSize)
+	}
+	return decodePom(r)
+}
@@ -4,25 +4,36 @@ import (
 	"archive/zip"
 	"bufio"
 	"bytes"
+	"context"
 	"crypto/sha1"
 	"encoding/hex"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"go.uber.org/zap"
 	"golang.org/x/xerrors"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/cache"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/settings"
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
 	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
+	"github.com/aquasecurity/go-dep-parser/pkg/redact"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 const (
@@ -30,18 +41,69 @@ const (
 	idQuery         = `g:"%s" AND a:"%s"`
 	artifactIdQuery = `a:"%s" AND p:"jar"`
 	sha1Query       = `1:"%s"`
+
+	// The name of this parser in the events of metrics.Hooks
+	parserName = "java/jar"
+
+	// Prefix of the keys of the search results in cache.Cache
+	cacheKeyPrefix = "maven-central:"
 )
 
 var (
+	// defaultClient is shared by all the parses, so that connections to Maven Central are kept alive and reused.
+	defaultClient = newRetryClient(nil)
+
 	jarFileRegEx = regexp.MustCompile(`^([a-zA-Z0-9\._-]*[^-*])-(\d\S*(?:-SNAPSHOT)?).jar$`)
 
-	ArtifactNotFoundErr = xerrors.New("no artifact found")
+	// Deprecated: use errors.As with *types.ErrArtifactNotFound, which has the searched coordinates.
+	ArtifactNotFoundErr error = &types.ErrArtifactNotFound{}
+
+	// ErrOffline is wrapped by the errors of the artifacts which can't be identified without searching Maven Central
+	// in offline mode. See WithOffline.
+	ErrOffline = xerrors.New("no access to remote repositories in offline mode")
 )
 
+// newRetryClient returns the client retrying failed requests, whose transport pools connections per host.
+// Requests are sent through the proxy if given, or the proxy of the environment variables.
+func newRetryClient(proxy func(*http.Request) (*url.URL, error)) *http.Client {
+	retryClient := retryablehttp.NewClient()
+	if t, ok := retryClient.HTTPClient.Transport.(*http.Transport); ok && proxy != nil {
+		t.Proxy = proxy
+	}
+	retryClient.Logger = logger{}
+	retryClient.RetryWaitMin = 20 * time.Second
+	retryClient.RetryWaitMax = 5 * time.Minute
+	retryClient.RetryMax = 5
+	return retryClient.StandardClient()
+}
+
 type conf struct {
+	ctx          context.Context
 	baseURL      string
 	rootFilePath string
 	httpClient   *http.Client
+	limits       types.Limits
+	cache        cache.Cache
+	cacheTTL     time.Duration
+	hooks        metrics.Hooks
+	// onWarning is set by ParseWithWarnings
+	onWarning func(types.Warning)
+	// searched memoizes the searches of Maven Central by the URL for the duration of a Parse,
+	// as fat JARs often contain the same artifact several times
+	searched *searchMemo
+	parents  *pomMemo
+	// workers limits the nested artifacts analyzed concurrently. See WithParallel.
+	parallel int
+	workers  chan struct{}
+	// requests limits the searches of Maven Central in flight. See WithMaxRequests.
+	maxRequests int
+	requests    chan struct{}
+	// nested is true for the artifacts inside the parsed one
+	nested bool
+	// localRepository and mirror are given by WithSettings
+	localRepository string
+	mirror          mirror
+	offline         bool
 }
 
 type Option func(*conf)
@@ -64,110 +126,357 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithCache caches the searches of Maven Central for ttl, which are shared with the other parsers using c.
+// Zero ttl means they don't expire. Expired searches with ETag or Last-Modified are sent as conditional requests,
+// and the cached results are used again if they are not modified.
+func WithCache(c cache.Cache, ttl time.Duration) Option {
+	return func(conf *conf) {
+		conf.cache = c
+		conf.cacheTTL = ttl
+	}
+}
+
+// WithHooks reports the searches of Maven Central and the cache lookups to hooks.
+func WithHooks(hooks metrics.Hooks) Option {
+	return func(c *conf) {
+		c.hooks = hooks
+	}
+}
+
+// WithParallel analyzes up to n nested artifacts concurrently, such as the modules packaged in an EAR.
+// Idle workers also search Maven Central for the outer artifacts while their nested artifacts are analyzed,
+// and up to n parent POMs of the artifacts in each JAR are resolved at the same time for the licenses.
+// The result is the same as the serial analysis. Functions given by ParseWithWarnings and WithHooks are
+// called from multiple goroutines, though warnings are never reported at the same time.
+// n <= 1 analyzes them one by one, which is the default.
+func WithParallel(n int) Option {
+	return func(c *conf) {
+		c.parallel = n
+	}
+}
+
+// WithMaxRequests sends up to n searches to Maven Central at the same time.
+// Nested artifacts analyzed concurrently by WithParallel search for themselves in parallel,
+// and n <= 0, the default, doesn't limit them.
+func WithMaxRequests(n int) Option {
+	return func(c *conf) {
+		c.maxRequests = n
+	}
+}
+
+// WithLimits bounds the size of artifacts and the expansion ratio of the files in them, including nested artifacts.
+// MaxDepth and MaxEntries are not used.
+func WithLimits(limits types.Limits) Option {
+	return func(c *conf) {
+		c.limits = limits
+	}
+}
+
+// WithSettings applies the Maven settings, such as those read by settings.Load.
+//   - Requests are sent through the active proxy, unless the client is given by WithHTTPClient.
+//   - Artifacts in the local repository are known to exist without searching Maven Central.
+//   - The existence of the other artifacts is checked in the mirror of Maven Central, with the credentials of
+//     the server of the same ID, as search.maven.org is often unreachable where Maven Central is mirrored.
+//   - Artifacts whose pom.xml declares no licenses inherit those of the parent POMs,
+//     which are read from the local repository or fetched from the mirror.
+//
+// Artifacts are still searched for by SHA-1 in Maven Central or the URL given by WithURL,
+// as repositories can't be searched by digests.
+func WithSettings(s settings.Settings) Option {
+	var client *http.Client
+	if p, ok := s.ActiveProxy(); ok {
+		client = newRetryClient(p.ProxyFunc())
+	}
+	var m mirror
+	if central, ok := s.Mirror(settings.CentralID); ok && central.URL != "" {
+		m.url = strings.TrimSuffix(central.URL, "/")
+		if srv, ok := s.Server(central.ID); ok {
+			m.username, m.password = srv.Username, srv.Password
+		}
+	}
+
+	return func(c *conf) {
+		if client != nil && c.httpClient == defaultClient {
+			c.httpClient = client
+		}
+		c.localRepository = s.LocalRepository
+		c.mirror = m
+	}
+}
+
+// WithOffline sends no requests, neither to Maven Central nor to the mirror of WithSettings, so that parsing
+// doesn't wait for unreachable networks, such as in CI without network access.
+// Artifacts with pom.properties are detected as usual, and those named by MANIFEST.MF are looked up in
+// the local repository of WithSettings. The others are skipped, and reported by *types.ErrPartialResult
+// together with the libraries found. Their errors wrap ErrOffline and name the artifacts. e.g.
+//
+//	unable to identify org.example:example:1.0: no access to remote repositories in offline mode
+func WithOffline() Option {
+	return func(c *conf) {
+		c.offline = true
+	}
+}
+
+// Parser implements types.Parser for JAR, WAR and EAR files.
+// Options are applied to a new configuration for each call, so that the parser can be used concurrently.
+type Parser struct {
+	opts []Option
+}
+
+func NewParser(opts ...Option) types.Parser {
+	return &Parser{opts: opts}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return p.ParseWithContext(context.Background(), r)
+}
+
+func (p *Parser) ParseWithContext(ctx context.Context, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := ParseWithContext(ctx, r, p.opts...)
+	return libs, nil, err
+}
+
+// ParseResult reports artifacts guessed from their file names or not found in Maven Central as warnings,
+// as well as broken inner artifacts.
+func (p *Parser) ParseResult(r io.Reader) (types.Result, error) {
+	var result types.Result
+	libs, _, err := p.ParseWithWarnings(r, func(w types.Warning) {
+		result.Warnings = append(result.Warnings, w)
+	})
+	if err != nil {
+		return types.Result{}, err
+	}
+	result.Libraries = libs
+	return result, nil
+}
+
+// ParseWithWarnings reports artifacts guessed from their file names or not found in Maven Central to fn
+// as soon as they are searched for. Broken inner artifacts are reported after parsing.
+func (p *Parser) ParseWithWarnings(r io.Reader, fn func(types.Warning)) ([]types.Library, []types.Dependency, error) {
+	opts := append(append([]Option{}, p.opts...), func(c *conf) {
+		c.onWarning = fn
+	})
+
+	libs, err := ParseWithContext(context.Background(), r, opts...)
+	var partial *types.ErrPartialResult
+	if err != nil && !xerrors.As(err, &partial) {
+		return nil, nil, err
+	}
+	if partial != nil {
+		for _, w := range utils.SkippedEntryWarnings(partial) {
+			fn(w)
+		}
+	}
+	return libs, nil, nil
+}
+
 func Parse(r io.Reader, opts ...Option) ([]types.Library, error) {
-	// for HTTP retry
-	retryClient := retryablehttp.NewClient()
-	retryClient.Logger = logger{}
-	retryClient.RetryWaitMin = 20 * time.Second
-	retryClient.RetryWaitMax = 5 * time.Minute
-	retryClient.RetryMax = 5
-	client := retryClient.StandardClient()
+	return ParseWithContext(context.Background(), r, opts...)
+}
+
+// ParseWithContext is the same as Parse, but the requests to Maven Central and
+// the retries in between are given up when ctx is done.
+func ParseWithContext(ctx context.Context, r io.Reader, opts ...Option) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
 
 	c := conf{
+		ctx:        ctx,
 		baseURL:    baseURL,
-		httpClient: client,
+		httpClient: defaultClient,
+		searched:   &searchMemo{calls: map[string]*searchCall{}},
+		parents:    &pomMemo{calls: map[string]*pomCall{}},
 	}
 	for _, opt := range opts {
 		opt(&c)
 	}
+	if c.maxRequests > 0 {
+		c.requests = make(chan struct{}, c.maxRequests)
+	}
+	if c.parallel > 1 {
+		// The current goroutine is one of the workers
+		c.workers = make(chan struct{}, c.parallel-1)
+		if c.onWarning != nil {
+			var mu sync.Mutex
+			onWarning := c.onWarning
+			c.onWarning = func(w types.Warning) {
+				mu.Lock()
+				defer mu.Unlock()
+				onWarning(w)
+			}
+		}
+	}
 
-	return parseArtifact(c, c.rootFilePath, ioutil.NopCloser(r))
+	return parseArtifact(c, c.rootFilePath, r)
 }
 
-func parseArtifact(c conf, fileName string, r io.ReadCloser) ([]types.Library, error) {
-	defer r.Close()
+func parseArtifact(c conf, fileName string, r io.Reader) ([]types.Library, error) {
+	// Fat JARs might contain a lot of nested JARs
+	if err := c.ctx.Err(); err != nil {
+		return nil, xerrors.Errorf("canceled: %w", err)
+	}
 
 	log.Logger.Debugw("Parsing Java artifacts...", zap.String("file", fileName))
 
-	b, err := ioutil.ReadAll(r)
+	b, err := c.readArtifact(r)
 	if err != nil {
 		return nil, xerrors.Errorf("unable to read the jar file: %w", err)
 	}
 	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
 	if err != nil {
-		return nil, xerrors.Errorf("zip error: %w", err)
+		return nil, xerrors.Errorf("zip error: %w", &types.ErrMalformedInput{Err: err})
 	}
 
+	// Libraries found in nested artifacts are reported with the path inside the outer one
+	// e.g. WEB-INF/lib/commons-lang3-3.11.jar
+	filePath := fileName
+
 	// Try to extract artifactId and version from the file name
 	// e.g. spring-core-5.3.4-SNAPSHOT.jar => sprint-core, 5.3.4-SNAPSHOT
 	fileName = filepath.Base(fileName)
 	fileProps := parseFileName(fileName)
 
-	var libs []types.Library
+	// pom.xml is placed next to pom.properties
+	// e.g. META-INF/maven/org.example/example/pom.xml
+	poms := map[string]pom{}
+	for _, fileInJar := range zr.File {
+		if filepath.Base(fileInJar.Name) != "pom.xml" {
+			continue
+		}
+		if err := c.checkExpansion(fileInJar); err != nil {
+			log.Logger.Debugw("Unable to parse pom.xml", zap.String("file", fileInJar.Name), zap.Error(err))
+			continue
+		}
+		// Licenses are optional, so a broken pom.xml should not stop detecting the artifact.
+		embedded, err := parsePom(fileInJar)
+		if err != nil {
+			log.Logger.Debugw("Unable to parse pom.xml", zap.String("file", fileInJar.Name), zap.Error(err))
+			continue
+		}
+		poms[filepath.Dir(fileInJar.Name)] = embedded
+	}
+
 	var m manifest
 	var foundPomProps bool
-
-	for _, fileInJar := range zr.File {
-		switch {
-		case filepath.Base(fileInJar.Name) == "pom.properties":
+	pomLibs := map[int]types.Library{}
+	// POMs inheriting the licenses of their parents
+	inheriting := map[int]pom{}
+	for i, fileInJar := range zr.File {
+		switch filepath.Base(fileInJar.Name) {
+		case "pom.properties":
 			props, err := parsePomProperties(fileInJar)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to parse %s: %w", fileInJar.Name, err)
 			}
-			libs = append(libs, props.library())
+			lib := props.library(filePath)
+			embedded := poms[filepath.Dir(fileInJar.Name)]
+			lib.SetLicenses(embedded.licenses)
+			if len(embedded.licenses) == 0 && embedded.parent.valid() {
+				inheriting[i] = embedded
+			}
 
 			// Check if the pom.properties is for the original JAR/WAR/EAR
 			if fileProps.artifactID == props.artifactID && fileProps.version == props.version {
 				foundPomProps = true
+				lib.Root = !c.nested
 			}
-		case filepath.Base(fileInJar.Name) == "MANIFEST.MF":
+			pomLibs[i] = lib
+		case "MANIFEST.MF":
 			m, err = parseManifest(fileInJar)
 			if err != nil {
 				return nil, xerrors.Errorf("failed to parse MANIFEST.MF: %w", err)
 			}
-		case isArtifact(fileInJar.Name):
-			fr, err := fileInJar.Open()
-			if err != nil {
-				return nil, xerrors.Errorf("unable to open %s: %w", fileInJar.Name, err)
-			}
+		}
+	}
+	c.inheritLicenses(pomLibs, inheriting)
 
-			// parse jar/war/ear recursively
-			innerLibs, err := parseArtifact(c, fileInJar.Name, fr)
-			if err != nil {
-				return nil, xerrors.Errorf("failed to parse %s: %w", fileInJar.Name, err)
+	// The first search identifying the artifact is sent while the nested artifacts are parsed
+	manifestProps := m.properties()
+	var found bool
+	var p properties
+	var searchErr error
+	search := func() {
+		if manifestProps.valid() {
+			// Errors are ignored, as the artifact is searched for by SHA-1 next
+			found, _ = exists(c, manifestProps)
+			return
+		}
+		p, searchErr = searchBySHA1(c, b)
+	}
+	var searched <-chan error
+	if !foundPomProps {
+		searched = c.searchInBackground(search)
+	}
+	defer func() {
+		if searched != nil {
+			<-searched
+		}
+	}()
+
+	nested := c.parseNestedArtifacts(zr.File)
+
+	var libs []types.Library
+	// Broken inner artifacts are skipped, so that the others are still detected
+	var errs []error
+
+	for i, fileInJar := range zr.File {
+		if lib, ok := pomLibs[i]; ok {
+			libs = append(libs, lib)
+			continue
+		}
+		if isArtifact(fileInJar.Name) {
+			res := nested[i]
+			if res.openErr != nil {
+				errs = append(errs, xerrors.Errorf("unable to open %s: %w", fileInJar.Name, res.openErr))
+				continue
+			}
+			libs = append(libs, res.libs...)
+			if res.err != nil {
+				if c.ctx.Err() != nil {
+					return nil, xerrors.Errorf("failed to parse %s: %w", fileInJar.Name, res.err)
+				}
+				errs = append(errs, xerrors.Errorf("failed to parse %s: %w", fileInJar.Name, res.err))
 			}
-			libs = append(libs, innerLibs...)
 		}
 	}
 
 	// If pom.properties is found, it should be preferred than MANIFEST.MF.
 	if foundPomProps {
-		return libs, nil
+		return libs, types.NewErrPartialResult(errs)
 	}
 
-	manifestProps := m.properties()
+	if searched != nil {
+		if err := <-searched; err != nil {
+			return nil, xerrors.Errorf("failed to search %s: %w", fileName, err)
+		}
+	} else {
+		search()
+	}
 	if manifestProps.valid() {
 		// Even if MANIFEST.MF is found, the groupId and artifactId might not be valid.
 		// We have to make sure that the artifact exists actually.
-		if ok, _ := exists(c, manifestProps); ok {
+		if found {
 			// If groupId and artifactId are valid, they will be returned.
-			return append(libs, manifestProps.library()), nil
+			return append(libs, c.artifact(manifestProps, filePath)), types.NewErrPartialResult(errs)
 		}
+		// If groupId and artifactId are not found, call Maven Central's search API with SHA-1 digest.
+		p, searchErr = searchBySHA1(c, b)
 	}
 
-	// If groupId and artifactId are not found, call Maven Central's search API with SHA-1 digest.
-	p, err := searchBySHA1(c, b)
-	if err == nil {
-		return append(libs, p.library()), nil
-	} else if !xerrors.Is(err, ArtifactNotFoundErr) {
-		return nil, xerrors.Errorf("failed to search by SHA1: %w", err)
+	if searchErr == nil {
+		return append(libs, c.artifact(p, filePath)), types.NewErrPartialResult(errs)
+	} else if xerrors.Is(searchErr, ErrOffline) {
+		errs = append(errs, xerrors.Errorf("unable to identify %s: %w", unidentified(manifestProps, fileProps, fileName), ErrOffline))
+		return libs, types.NewErrPartialResult(errs)
+	} else if !xerrors.Is(searchErr, ArtifactNotFoundErr) {
+		return nil, xerrors.Errorf("failed to search by SHA1: %w", searchErr)
 	}
 
 	log.Logger.Debugw("No such POM in the central repositories", zap.String("file", fileName))
 
 	// Return when artifactId or version from the file name are empty
 	if fileProps.artifactID == "" || fileProps.version == "" {
-		return libs, nil
+		c.warn(types.WarningUnresolved, filePath, "no such artifact in the central repositories")
+		return libs, types.NewErrPartialResult(errs)
 	}
 
 	// Try to search groupId by artifactId via sonatype API
@@ -176,12 +485,145 @@ func parseArtifact(c conf, fileName string, r io.ReadCloser) ([]types.Library, e
 	if err == nil {
 		log.Logger.Debugw("POM was determined in a heuristic way", zap.String("file", fileName),
 			zap.String("artifact", fileProps.String()))
-		libs = append(libs, fileProps.library())
+		c.warn(types.WarningUnresolved, filePath, fmt.Sprintf("groupId of %s was guessed from the artifactId", fileProps))
+		libs = append(libs, c.artifact(fileProps, filePath))
 	} else if !xerrors.Is(err, ArtifactNotFoundErr) {
 		return nil, xerrors.Errorf("failed to search by artifact id: %w", err)
+	} else {
+		c.warn(types.WarningUnresolved, filePath, "no such artifact in the central repositories")
+	}
+
+	return libs, types.NewErrPartialResult(errs)
+}
+
+// unidentified names the artifact which couldn't be identified, by the most specific properties found.
+func unidentified(manifestProps, fileProps properties, fileName string) string {
+	switch {
+	case manifestProps.valid():
+		return manifestProps.String()
+	case fileProps.artifactID != "" && fileProps.version != "":
+		return fileProps.artifactID + ":" + fileProps.version
 	}
+	return fileName
+}
+
+// nestedResult is the result of a nested artifact.
+type nestedResult struct {
+	libs    []types.Library
+	err     error
+	openErr error
+}
 
-	return libs, nil
+// parseNestedArtifacts parses jar/war/ear in files recursively. The results are indexed in the same way as files.
+// They are parsed by idle workers if WithParallel is given, or by the current goroutine otherwise.
+func (c conf) parseNestedArtifacts(files []*zip.File) []nestedResult {
+	results := make([]nestedResult, len(files))
+	var wg sync.WaitGroup
+	for i, f := range files {
+		if !isArtifact(f.Name) {
+			continue
+		}
+		i, f := i, f
+		select {
+		case c.workers <- struct{}{}:
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-c.workers
+					wg.Done()
+				}()
+				results[i] = c.parseNestedArtifact(f)
+			}()
+		default:
+			// All the workers are busy, or it is not parallel.
+			// Waiting for a worker here could deadlock, as the workers might be waiting for their nested artifacts.
+			results[i] = c.parseNestedArtifact(f)
+		}
+	}
+	wg.Wait()
+	return results
+}
+
+// parseNestedArtifact recovers from panics, so that they don't crash the workers and the artifact is skipped
+// as other broken ones.
+func (c conf) parseNestedArtifact(f *zip.File) (res nestedResult) {
+	defer utils.RecoverPanic(&res.err)
+
+	if err := c.checkExpansion(f); err != nil {
+		return nestedResult{openErr: err}
+	}
+	r, err := f.Open()
+	if err != nil {
+		return nestedResult{openErr: err}
+	}
+	defer r.Close()
+
+	inner := c
+	inner.nested = true
+	libs, err := parseArtifact(inner, f.Name, r)
+	return nestedResult{libs: libs, err: err}
+}
+
+// searchInBackground calls fn on an idle worker if WithParallel is given, and returns the channel closed after it.
+// The panic of fn, if any, is sent to the channel as ErrMalformedInput before it is closed.
+// It returns nil without calling fn if all the workers are busy, or it is not parallel.
+func (c conf) searchInBackground(fn func()) <-chan error {
+	select {
+	case c.workers <- struct{}{}:
+		done := make(chan error, 1)
+		go func() {
+			var err error
+			defer func() {
+				<-c.workers
+				done <- err
+				close(done)
+			}()
+			defer utils.RecoverPanic(&err)
+			fn()
+		}()
+		return done
+	default:
+		return nil
+	}
+}
+
+// artifact returns the library of the artifact being parsed, which is the root unless it is nested.
+func (c conf) artifact(p properties, filePath string) types.Library {
+	lib := p.library(filePath)
+	lib.Root = !c.nested
+	return lib
+}
+
+// warn reports the issue for ParseWithWarnings.
+func (c conf) warn(kind types.WarningKind, filePath, msg string) {
+	if c.onWarning == nil {
+		return
+	}
+	c.onWarning(types.Warning{Kind: kind, Message: msg, FilePath: filePath})
+}
+
+// readArtifact returns the contents of r up to MaxInputSize.
+// Artifacts already in memory, such as utils.MappedFile, are not copied.
+func (c conf) readArtifact(r io.Reader) ([]byte, error) {
+	br, ok := r.(utils.BytesReader)
+	if !ok {
+		return ioutil.ReadAll(utils.NewLimitReader(r, types.Limits{MaxInputSize: c.limits.MaxInputSize}))
+	}
+	b := br.Bytes()
+	if max := c.limits.MaxInputSize; max > 0 && int64(len(b)) > max {
+		return nil, &types.ErrLimitExceeded{Limit: "MaxInputSize", Max: max}
+	}
+	return b, nil
+}
+
+// checkExpansion refuses the file when it is decompressed to more than MaxExpansionRatio times of the compressed size.
+// The declared size can be trusted, as archive/zip fails when more data is decompressed.
+func (c conf) checkExpansion(f *zip.File) error {
+	ratio := uint64(c.limits.MaxExpansionRatio)
+	if ratio == 0 || f.UncompressedSize64 <= f.CompressedSize64*ratio {
+		return nil
+	}
+	return &types.ErrLimitExceeded{Limit: "MaxExpansionRatio", Max: int64(ratio)}
 }
 
 func isArtifact(name string) bool {
@@ -237,10 +679,101 @@ func parsePomProperties(f *zip.File) (properties, error) {
 	return p, nil
 }
 
-func (p properties) library() types.Library {
+type pomLicense struct {
+	Name string `xml:"name"`
+}
+
+// pom is the part of pom.xml the licenses are resolved from.
+type pom struct {
+	licenses []string
+	// parent is the parent POM the licenses are inherited from, if the POM declares none
+	parent properties
+}
+
+// parsePom returns the license names and the parent declared in pom.xml.
+func parsePom(f *zip.File) (pom, error) {
+	file, err := f.Open()
+	if err != nil {
+		return pom{}, xerrors.Errorf("unable to open pom.xml: %w", err)
+	}
+	defer file.Close()
+
+	return decodePom(file)
+}
+
+// decodePom reads the tokens of pom.xml until <project><licenses> is decoded.
+// Generated BOMs can have thousands of managed dependencies, so the other sections are skipped
+// without being decoded into memory.
+func decodePom(r io.Reader) (pom, error) {
+	d := xml.NewDecoder(r)
+	var p pom
+	var depth int
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return p, nil
+		} else if err != nil {
+			return pom{}, xerrors.Errorf("xml decode error: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			// The root element is <project>
+			if depth == 1 {
+				continue
+			}
+			switch t.Name.Local {
+			case "parent":
+				var parent struct {
+					GroupID    string `xml:"groupId"`
+					ArtifactID string `xml:"artifactId"`
+					Version    string `xml:"version"`
+				}
+				if err = d.DecodeElement(&parent, &t); err != nil {
+					return pom{}, xerrors.Errorf("xml decode error: %w", err)
+				}
+				p.parent = properties{
+					groupID:    strings.TrimSpace(parent.GroupID),
+					artifactID: strings.TrimSpace(parent.ArtifactID),
+					version:    strings.TrimSpace(parent.Version),
+				}
+				depth--
+				continue
+			case "licenses":
+			default:
+				if err = d.Skip(); err != nil {
+					return pom{}, xerrors.Errorf("xml decode error: %w", err)
+				}
+				depth--
+				continue
+			}
+
+			var decoded struct {
+				Licenses []pomLicense `xml:"license"`
+			}
+			if err = d.DecodeElement(&decoded, &t); err != nil {
+				return pom{}, xerrors.Errorf("xml decode error: %w", err)
+			}
+			var licenses []string
+			for _, l := range decoded.Licenses {
+				if name := strings.TrimSpace(l.Name); name != "" {
+					licenses = append(licenses, name)
+				}
+			}
+			p.licenses = license.NormalizeAll(licenses)
+			return p, nil
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
+
+func (p properties) library(filePath string) types.Library {
 	return types.Library{
-		Name:    fmt.Sprintf("%s:%s", p.groupID, p.artifactID),
-		Version: p.version,
+		Name:     fmt.Sprintf("%s:%s", p.groupID, p.artifactID),
+		Version:  p.version,
+		FilePath: filePath,
 	}
 }
 
@@ -318,7 +851,7 @@ type apiResponse struct {
 			ArtifactID   string `json:"a"`
 			Version      string `json:"v"`
 			P            string `json:"p"`
-			VersionCount int    `json:versionCount`
+			VersionCount int    `json:"versionCount"`
 		} `json:"docs"`
 	} `json:"response"`
 }
@@ -396,7 +929,20 @@ func (m manifest) determineVersion() (string, error) {
 }
 
 func exists(c conf, p properties) (bool, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL, nil)
+	if c.localRepository != "" {
+		dir := filepath.Join(c.localRepository, filepath.FromSlash(strings.ReplaceAll(p.groupID, ".", "/")), p.artifactID)
+		if fi, err := os.Stat(dir); err == nil && fi.IsDir() {
+			return true, nil
+		}
+	}
+	if c.offline {
+		return false, ErrOffline
+	}
+	if c.mirror.url != "" {
+		return existsInMirror(c, p)
+	}
+
+	req, err := newRequest(c)
 	if err != nil {
 		return false, xerrors.Errorf("unable to initialize HTTP client: %w", err)
 	}
@@ -406,15 +952,9 @@ func exists(c conf, p properties) (bool, error) {
 	q.Set("rows", "1")
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	res, err := search(c, req)
 	if err != nil {
-		return false, xerrors.Errorf("http error: %w", err)
-	}
-	defer resp.Body.Close()
-
-	var res apiResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return false, xerrors.Errorf("json decode error: %w", err)
+		return false, xerrors.Errorf("exists search error: %w", err)
 	}
 	return res.Response.NumFound > 0, nil
 }
@@ -427,7 +967,7 @@ func searchBySHA1(c conf, data []byte) (properties, error) {
 	}
 	digest := hex.EncodeToString(h.Sum(nil))
 
-	req, err := http.NewRequest(http.MethodGet, c.baseURL, nil)
+	req, err := newRequest(c)
 	if err != nil {
 		return properties{}, xerrors.Errorf("unable to initialize HTTP client: %w", err)
 	}
@@ -438,28 +978,19 @@ func searchBySHA1(c conf, data []byte) (properties, error) {
 	q.Set("wt", "json")
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	res, err := search(c, req)
 	if err != nil {
 		return properties{}, xerrors.Errorf("sha1 search error: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return properties{}, xerrors.Errorf("status %s from %s", resp.Status, req.URL.String())
-	}
-
-	var res apiResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return properties{}, xerrors.Errorf("json decode error: %w", err)
-	}
 
 	if len(res.Response.Docs) == 0 {
-		return properties{}, xerrors.Errorf("digest %s: %w", digest, ArtifactNotFoundErr)
+		return properties{}, &types.ErrArtifactNotFound{Digest: digest}
 	}
 
 	// Some artifacts might have the same SHA-1 digests.
 	// e.g. "javax.servlet:jstl" and "jstl:jstl"
-	docs := res.Response.Docs
+	// The response might be memoized, so it is not sorted in place
+	docs := append(res.Response.Docs[:0:0], res.Response.Docs...)
 	sort.Slice(docs, func(i, j int) bool {
 		return docs[i].ID < docs[j].ID
 	})
@@ -473,7 +1004,7 @@ func searchBySHA1(c conf, data []byte) (properties, error) {
 }
 
 func searchByArtifactID(c conf, artifactID string) (string, error) {
-	req, err := http.NewRequest(http.MethodGet, c.baseURL, nil)
+	req, err := newRequest(c)
 	if err != nil {
 		return "", xerrors.Errorf("unable to initialize HTTP client: %w", err)
 	}
@@ -484,28 +1015,19 @@ func searchByArtifactID(c conf, artifactID string) (string, error) {
 	q.Set("wt", "json")
 	req.URL.RawQuery = q.Encode()
 
-	resp, err := c.httpClient.Do(req)
+	res, err := search(c, req)
 	if err != nil {
 		return "", xerrors.Errorf("artifactID search error: %w", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return "", xerrors.Errorf("status %s from %s", resp.Status, req.URL.String())
-	}
-
-	var res apiResponse
-	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
-		return "", xerrors.Errorf("json decode error: %w", err)
-	}
 
 	if len(res.Response.Docs) == 0 {
-		return "", xerrors.Errorf("artifactID %s: %w", artifactID, ArtifactNotFoundErr)
+		return "", &types.ErrArtifactNotFound{ArtifactID: artifactID}
 	}
 
 	// Some artifacts might have the same artifactId.
 	// e.g. "javax.servlet:jstl" and "jstl:jstl"
-	docs := res.Response.Docs
+	// The response might be memoized, so it is not sorted in place
+	docs := append(res.Response.Docs[:0:0], res.Response.Docs...)
 	sort.Slice(docs, func(i, j int) bool {
 		return docs[i].VersionCount > docs[j].VersionCount
 	})
@@ -513,3 +1035,166 @@ func searchByArtifactID(c conf, artifactID string) (string, error) {
 
 	return d.GroupID, nil
 }
+
+// search sends req to Maven Central. Responses are cached by the URL if the cache is set,
+// including the ones finding no artifact.
+func search(c conf, req *http.Request) (apiResponse, error) {
+	if c.offline {
+		return apiResponse{}, ErrOffline
+	}
+	return c.searched.do(req.URL.String(), func() (apiResponse, error) {
+		return searchRemote(c, req)
+	})
+}
+
+// searchMemo is shared by the nested artifacts parsed concurrently.
+// Searches in flight are waited for instead of being sent again.
+type searchMemo struct {
+	mu    sync.Mutex
+	calls map[string]*searchCall
+}
+
+type searchCall struct {
+	done chan struct{}
+	res  apiResponse
+	err  error
+}
+
+// do returns the memoized response of url, or calls fn once. Failed searches are not memoized.
+// The panic of fn is returned as ErrMalformedInput to all the callers, so that none of them waits forever.
+func (m *searchMemo) do(url string, fn func() (apiResponse, error)) (apiResponse, error) {
+	m.mu.Lock()
+	if call, ok := m.calls[url]; ok {
+		m.mu.Unlock()
+		<-call.done
+		return call.res, call.err
+	}
+	call := &searchCall{done: make(chan struct{})}
+	m.calls[url] = call
+	m.mu.Unlock()
+	defer close(call.done)
+
+	func() {
+		defer utils.RecoverPanic(&call.err)
+		call.res, call.err = fn()
+	}()
+	if call.err != nil {
+		m.mu.Lock()
+		delete(m.calls, url)
+		m.mu.Unlock()
+	}
+	return call.res, call.err
+}
+
+// cachedSearch is the search result in cache.Cache.
+// Expired results are revalidated with their ETag and Last-Modified, instead of being downloaded again.
+type cachedSearch struct {
+	Response     apiResponse
+	ETag         string `json:",omitempty"`
+	LastModified string `json:",omitempty"`
+	Expires      time.Time
+}
+
+func (s cachedSearch) fresh() bool {
+	return s.Expires.IsZero() || time.Now().Before(s.Expires)
+}
+
+func (s cachedSearch) revalidatable() bool {
+	return s.ETag != "" || s.LastModified != ""
+}
+
+func searchRemote(c conf, req *http.Request) (apiResponse, error) {
+	var cached cachedSearch
+	key := cacheKeyPrefix + req.URL.String()
+	if c.cache != nil {
+		b, ok := c.cache.Get(key)
+		ok = ok && json.Unmarshal(b, &cached) == nil
+		hit := ok && cached.fresh()
+		c.hooks.CacheLookedUp(parserName, redact.String(key), hit)
+		if hit {
+			return cached.Response, nil
+		}
+		if ok && cached.revalidatable() {
+			if cached.ETag != "" {
+				req.Header.Set("If-None-Match", cached.ETag)
+			}
+			if cached.LastModified != "" {
+				req.Header.Set("If-Modified-Since", cached.LastModified)
+			}
+		}
+	}
+
+	if c.requests != nil {
+		select {
+		case c.requests <- struct{}{}:
+			defer func() { <-c.requests }()
+		case <-c.ctx.Done():
+			return apiResponse{}, xerrors.Errorf("canceled: %w", c.ctx.Err())
+		}
+	}
+
+	start := time.Now()
+	resp, err := c.httpClient.Do(req)
+	event := metrics.RequestEvent{Parser: parserName, URL: redact.URL(req.URL.String()), Duration: time.Since(start), Err: redact.Error(err)}
+	if resp != nil {
+		event.StatusCode = resp.StatusCode
+	}
+	c.hooks.Requested(event)
+	if err != nil {
+		return apiResponse{}, xerrors.Errorf("http error: %w", redact.Error(err))
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var res apiResponse
+		if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			return apiResponse{}, xerrors.Errorf("json decode error: %w", err)
+		}
+		cached = cachedSearch{
+			Response:     res,
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		}
+	case http.StatusNotModified:
+		if !cached.revalidatable() {
+			return apiResponse{}, xerrors.Errorf("status %s from %s", resp.Status, redact.URL(req.URL.String()))
+		}
+	default:
+		return apiResponse{}, xerrors.Errorf("status %s from %s", resp.Status, redact.URL(req.URL.String()))
+	}
+
+	if c.cache != nil {
+		setCache(c, key, cached)
+	}
+	return cached.Response, nil
+}
+
+// setCache caches the search result for the TTL. Revalidatable results are kept after they expire.
+func setCache(c conf, key string, cached cachedSearch) {
+	ttl := c.cacheTTL
+	cached.Expires = time.Time{}
+	if ttl > 0 {
+		cached.Expires = time.Now().Add(ttl)
+		if cached.revalidatable() {
+			ttl = 0
+		}
+	}
+
+	// The search succeeded even if the response can't be cached
+	b, err := json.Marshal(cached)
+	if err == nil {
+		err = c.cache.Set(key, b, ttl)
+	}
+	if err != nil {
+		log.Logger.Debugw("Unable to cache the search result", zap.String("key", redact.String(key)), zap.Error(err))
+	}
+}
+
+func newRequest(c conf) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, c.baseURL, nil)
+	if err != nil {
+		return nil, redact.Error(err)
+	}
+	return req.WithContext(c.ctx), nil
+}
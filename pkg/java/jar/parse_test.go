@@ -1,19 +1,34 @@
 package jar_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha1"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/cache"
 	"github.com/aquasecurity/go-dep-parser/pkg/java/jar"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/settings"
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 var (
@@ -22,43 +37,43 @@ var (
 	// mvn dependency:list
 	// mvn dependency:tree -Dscope=compile -Dscope=runtime | awk '/:tree/,/BUILD SUCCESS/' | awk 'NR > 1 { print }' | head -n -2 | awk '{print $NF}' | awk -F":" '{printf("{\""$1":"$2"\", \""$4 "\", \"\"},\n")}'
 	wantMaven = []types.Library{
-		{"com.example:web-app", "1.0-SNAPSHOT", ""},
-		{"com.fasterxml.jackson.core:jackson-databind", "2.9.10.6", ""},
-		{"com.fasterxml.jackson.core:jackson-annotations", "2.9.10", ""},
-		{"com.fasterxml.jackson.core:jackson-core", "2.9.10", ""},
-		{"com.cronutils:cron-utils", "9.1.2", ""},
-		{"org.slf4j:slf4j-api", "1.7.30", ""},
-		{"org.glassfish:javax.el", "3.0.0", ""},
-		{"org.apache.commons:commons-lang3", "3.11", ""},
+		{Name: "com.example:web-app", Version: "1.0-SNAPSHOT", FilePath: "testdata/maven.war"},
+		{Name: "com.fasterxml.jackson.core:jackson-databind", Version: "2.9.10.6", FilePath: "WEB-INF/lib/jackson-databind-2.9.10.6.jar"},
+		{Name: "com.fasterxml.jackson.core:jackson-annotations", Version: "2.9.10", FilePath: "WEB-INF/lib/jackson-annotations-2.9.10.jar"},
+		{Name: "com.fasterxml.jackson.core:jackson-core", Version: "2.9.10", FilePath: "WEB-INF/lib/jackson-core-2.9.10.jar"},
+		{Name: "com.cronutils:cron-utils", Version: "9.1.2", Licenses: []string{"Apache-2.0"}, License: "Apache-2.0", FilePath: "WEB-INF/lib/cron-utils-9.1.2.jar"},
+		{Name: "org.slf4j:slf4j-api", Version: "1.7.30", FilePath: "WEB-INF/lib/slf4j-api-1.7.30.jar"},
+		{Name: "org.glassfish:javax.el", Version: "3.0.0", Licenses: []string{"CDDL + GPLv2 with classpath exception"}, License: "CDDL + GPLv2 with classpath exception", FilePath: "WEB-INF/lib/javax.el-3.0.0.jar"},
+		{Name: "org.apache.commons:commons-lang3", Version: "3.11", FilePath: "WEB-INF/lib/commons-lang3-3.11.jar"},
 	}
 
 	// cd testdata/testimage/gradle && docker build -t test .
 	// docker run --rm --name test -it test bash
 	// gradle app:dependencies --configuration implementation | grep "[+\]---" | cut -d" " -f2 | awk -F":" '{printf("{\""$1":"$2"\", \""$3"\", \"\"},\n")}'
 	wantGradle = []types.Library{
-		{"commons-dbcp:commons-dbcp", "1.4", ""},
-		{"commons-pool:commons-pool", "1.6", ""},
-		{"log4j:log4j", "1.2.17", ""},
-		{"org.apache.commons:commons-compress", "1.19", ""},
+		{Name: "commons-dbcp:commons-dbcp", Version: "1.4", FilePath: "WEB-INF/lib/commons-dbcp-1.4.jar"},
+		{Name: "commons-pool:commons-pool", Version: "1.6", FilePath: "WEB-INF/lib/commons-pool-1.6.jar"},
+		{Name: "log4j:log4j", Version: "1.2.17", Licenses: []string{"Apache-2.0"}, License: "Apache-2.0", FilePath: "WEB-INF/lib/log4j-1.2.17.jar"},
+		{Name: "org.apache.commons:commons-compress", Version: "1.19", FilePath: "WEB-INF/lib/commons-compress-1.19.jar"},
 	}
 
 	// manually created
 	wantSHA1 = []types.Library{
-		{"org.springframework:spring-core", "5.3.3", ""},
+		{Name: "org.springframework:spring-core", Version: "5.3.3", Root: true, FilePath: "testdata/test.jar"},
 	}
 
 	// manually created
 	wantHeuristic = []types.Library{
-		{"com.example:heuristic", "1.0.0-SNAPSHOT", ""},
+		{Name: "com.example:heuristic", Version: "1.0.0-SNAPSHOT", Root: true, FilePath: "testdata/heuristic-1.0.0-SNAPSHOT.jar"},
 	}
 
 	// manually created
 	wantFatjar = []types.Library{
-		{"com.google.guava:failureaccess", "1.0.1", ""},
-		{"com.google.guava:guava", "29.0-jre", ""},
-		{"com.google.guava:listenablefuture", "9999.0-empty-to-avoid-conflict-with-guava", ""},
-		{"com.google.j2objc:j2objc-annotations", "1.3", ""},
-		{"org.apache.hadoop.thirdparty:hadoop-shaded-guava", "1.1.0-SNAPSHOT", ""},
+		{Name: "com.google.guava:failureaccess", Version: "1.0.1", FilePath: "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"},
+		{Name: "com.google.guava:guava", Version: "29.0-jre", FilePath: "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"},
+		{Name: "com.google.guava:listenablefuture", Version: "9999.0-empty-to-avoid-conflict-with-guava", FilePath: "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"},
+		{Name: "com.google.j2objc:j2objc-annotations", Version: "1.3", Licenses: []string{"Apache-2.0"}, License: "Apache-2.0", FilePath: "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"},
+		{Name: "org.apache.hadoop.thirdparty:hadoop-shaded-guava", Version: "1.1.0-SNAPSHOT", Root: true, FilePath: "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"},
 	}
 )
 
@@ -77,7 +92,7 @@ type doc struct {
 	ArtifactID   string `json:"a"`
 	Version      string `json:"v"`
 	P            string `json:"p"`
-	VersionCount int    `json:versionCount`
+	VersionCount int    `json:"versionCount"`
 }
 
 func TestParse(t *testing.T) {
@@ -170,3 +185,767 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_Mapped(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(apiResponse{})
+	}))
+	defer ts.Close()
+
+	f, err := os.Open("testdata/maven.war")
+	require.NoError(t, err)
+	defer f.Close()
+	want, err := jar.Parse(f, jar.WithURL(ts.URL), jar.WithFilePath("testdata/maven.war"), jar.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+
+	mf, err := utils.OpenMapped("testdata/maven.war")
+	require.NoError(t, err)
+	defer mf.Close()
+	got, err := jar.Parse(mf, jar.WithURL(ts.URL), jar.WithFilePath("testdata/maven.war"), jar.WithHTTPClient(ts.Client()))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// The size of mapped files is checked without reading them
+	_, err = jar.Parse(mf, jar.WithLimits(types.Limits{MaxInputSize: 1024}))
+	var limitErr *types.ErrLimitExceeded
+	assert.True(t, errors.As(err, &limitErr), err)
+}
+
+func TestParseWithContext(t *testing.T) {
+	// The server doesn't respond until the client gives up
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	f, err := os.Open("testdata/test.jar")
+	require.NoError(t, err)
+	defer f.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = jar.ParseWithContext(ctx, f, jar.WithURL(ts.URL), jar.WithHTTPClient(ts.Client()))
+	require.Error(t, err)
+	assert.True(t, xerrors.Is(err, context.DeadlineExceeded), err)
+}
+
+func TestWithLimits(t *testing.T) {
+	// A nested artifact filled with zeros is highly compressed, like zip bombs
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("META-INF/maven/org.example/app/pom.properties")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("groupId=org.example\nartifactId=app\nversion=1.0.0\n"))
+	require.NoError(t, err)
+	w, err = zw.Create("WEB-INF/lib/bomb-1.0.0.jar")
+	require.NoError(t, err)
+	_, err = w.Write(make([]byte, 1<<20))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	t.Run("expansion ratio", func(t *testing.T) {
+		got, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithFilePath("app-1.0.0.jar"),
+			jar.WithLimits(types.Limits{MaxExpansionRatio: 100}))
+		require.Error(t, err)
+
+		var limitErr *types.ErrLimitExceeded
+		require.True(t, errors.As(err, &limitErr), err)
+		assert.Equal(t, "MaxExpansionRatio", limitErr.Limit)
+
+		// The other libraries are still returned
+		assert.Equal(t, []types.Library{{Name: "org.example:app", Version: "1.0.0", Root: true, FilePath: "app-1.0.0.jar"}}, got)
+	})
+
+	t.Run("input size", func(t *testing.T) {
+		_, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithLimits(types.Limits{MaxInputSize: 1024}))
+		require.Error(t, err)
+
+		var limitErr *types.ErrLimitExceeded
+		require.True(t, errors.As(err, &limitErr), err)
+		assert.Equal(t, "MaxInputSize", limitErr.Limit)
+	})
+}
+
+func TestWithCache_Revalidate(t *testing.T) {
+	var statuses []int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + r.URL.Query().Get("q") + `"`
+		if r.Header.Get("If-None-Match") == etag {
+			statuses = append(statuses, http.StatusNotModified)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		statuses = append(statuses, http.StatusOK)
+		w.Header().Set("ETag", etag)
+
+		var res apiResponse
+		if strings.Contains(r.URL.Query().Get("q"), "c666f5bc47eb64ed3bbd13505a26f58be71f33f0") {
+			res.Response.NumFound = 1
+			res.Response.Docs = []doc{{ID: "org.springframework.spring-core", GroupID: "org.springframework", ArtifactID: "spring-core", Version: "5.3.3"}}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	// The cached searches expire at once
+	c := cache.NewMemory()
+	for i := 0; i < 2; i++ {
+		f, err := os.Open("testdata/test.jar")
+		require.NoError(t, err)
+
+		got, err := jar.Parse(f, jar.WithURL(ts.URL), jar.WithFilePath("testdata/test.jar"), jar.WithHTTPClient(ts.Client()),
+			jar.WithCache(c, time.Nanosecond))
+		f.Close()
+		require.NoError(t, err)
+		assert.Equal(t, wantSHA1, got)
+	}
+
+	// The expired searches are revalidated with the ETag
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusNotModified, http.StatusNotModified}, statuses)
+}
+
+func TestParse_LargePom(t *testing.T) {
+	// BOMs manage thousands of dependencies before the licenses
+	var pom strings.Builder
+	pom.WriteString("<project><dependencyManagement><dependencies>")
+	for i := 0; i < 10000; i++ {
+		fmt.Fprintf(&pom, "<dependency><groupId>org.example</groupId><artifactId>lib-%d</artifactId><version>1.0.0</version></dependency>", i)
+	}
+	pom.WriteString("</dependencies></dependencyManagement>")
+	pom.WriteString("<licenses><license><name>The Apache Software License, Version 2.0</name></license></licenses>")
+	// Sections after the licenses are not read
+	pom.WriteString("<build><broken></project>")
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("META-INF/maven/org.example/bom/pom.properties")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("groupId=org.example\nartifactId=bom\nversion=1.0.0\n"))
+	require.NoError(t, err)
+	w, err = zw.Create("META-INF/maven/org.example/bom/pom.xml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(pom.String()))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	got, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithFilePath("bom-1.0.0.jar"))
+	require.NoError(t, err)
+	want := []types.Library{
+		{Name: "org.example:bom", Version: "1.0.0", Root: true, Licenses: []string{"Apache-2.0"}, License: "Apache-2.0", FilePath: "bom-1.0.0.jar"},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestParser_ParseResult(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res apiResponse
+		if strings.Contains(r.URL.Query().Get("q"), "heuristic") {
+			res.Response = response{
+				NumFound: 1,
+				Docs:     []doc{{ID: "com.example.heuristic", GroupID: "com.example", ArtifactID: "heuristic", VersionCount: 1}},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	f, err := os.Open("testdata/heuristic-1.0.0-SNAPSHOT.jar")
+	require.NoError(t, err)
+	defer f.Close()
+
+	p := jar.NewParser(jar.WithURL(ts.URL), jar.WithFilePath("testdata/heuristic-1.0.0-SNAPSHOT.jar"), jar.WithHTTPClient(ts.Client()))
+	got, err := p.(types.ResultParser).ParseResult(f)
+	require.NoError(t, err)
+
+	want := types.Result{
+		Libraries: wantHeuristic,
+		Warnings: []types.Warning{
+			{
+				Kind:     types.WarningUnresolved,
+				Message:  "groupId of com.example:heuristic:1.0.0-SNAPSHOT was guessed from the artifactId",
+				FilePath: "testdata/heuristic-1.0.0-SNAPSHOT.jar",
+			},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestWithCache(t *testing.T) {
+	var requests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var res apiResponse
+		if strings.Contains(r.URL.Query().Get("q"), "c666f5bc47eb64ed3bbd13505a26f58be71f33f0") {
+			res.Response.NumFound = 1
+			res.Response.Docs = []doc{{ID: "org.springframework.spring-core", GroupID: "org.springframework", ArtifactID: "spring-core", Version: "5.3.3"}}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	var requested []int
+	hits := map[bool]int{}
+	hooks := metrics.Hooks{
+		OnRequest: func(e metrics.RequestEvent) {
+			requested = append(requested, e.StatusCode)
+		},
+		OnCache: func(e metrics.CacheEvent) {
+			assert.Equal(t, "java/jar", e.Parser)
+			hits[e.Hit]++
+		},
+	}
+
+	c := cache.NewMemory()
+	for i := 0; i < 2; i++ {
+		f, err := os.Open("testdata/test.jar")
+		require.NoError(t, err)
+
+		got, err := jar.Parse(f, jar.WithURL(ts.URL), jar.WithFilePath("testdata/test.jar"), jar.WithHTTPClient(ts.Client()),
+			jar.WithCache(c, time.Hour), jar.WithHooks(hooks))
+		f.Close()
+		require.NoError(t, err)
+		assert.Equal(t, wantSHA1, got)
+	}
+
+	// The search by the manifest and by SHA-1 digest are cached, including the one finding nothing
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK}, requested)
+	assert.Equal(t, map[bool]int{false: 2, true: 2}, hits)
+}
+
+func TestParse_SameNestedArtifacts(t *testing.T) {
+	var inner bytes.Buffer
+	zw := zip.NewWriter(&inner)
+	_, err := zw.Create("com/example/Example.class")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	// A fat JAR containing the same artifact twice
+	var buf bytes.Buffer
+	zw = zip.NewWriter(&buf)
+	for _, name := range []string{"BOOT-INF/lib/example-1.0.0.jar", "lib/example-1.0.0.jar"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(inner.Bytes())
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	var mu sync.Mutex
+	var requests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query().Get("q")
+		mu.Lock()
+		requests = append(requests, q)
+		mu.Unlock()
+		var res apiResponse
+		if q != fmt.Sprintf(`1:"%x"`, sha1.Sum(buf.Bytes())) {
+			res.Response.NumFound = 1
+			res.Response.Docs = []doc{{ID: "com.example.example", GroupID: "com.example", ArtifactID: "example", Version: "1.0.0"}}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	want := []types.Library{
+		{Name: "com.example:example", Version: "1.0.0", FilePath: "BOOT-INF/lib/example-1.0.0.jar"},
+		{Name: "com.example:example", Version: "1.0.0", FilePath: "lib/example-1.0.0.jar"},
+	}
+	for _, parallel := range []int{1, 2} {
+		requests = nil
+		got, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithURL(ts.URL), jar.WithHTTPClient(ts.Client()),
+			jar.WithParallel(parallel))
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		// The nested artifact is searched for once, even by concurrent workers, and the fat JAR once
+		assert.Len(t, requests, 2)
+	}
+}
+
+func TestWithParallel(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(apiResponse{})
+	}))
+	defer ts.Close()
+
+	for _, file := range []string{"testdata/maven.war", "testdata/gradle.war", "testdata/hadoop-shaded-guava-1.1.0-SNAPSHOT.jar"} {
+		t.Run(file, func(t *testing.T) {
+			b, err := os.ReadFile(file)
+			require.NoError(t, err)
+
+			parse := func(opts ...jar.Option) types.Result {
+				p := jar.NewParser(append(opts, jar.WithURL(ts.URL), jar.WithFilePath(file), jar.WithHTTPClient(ts.Client()))...)
+				got, err := p.(types.ResultParser).ParseResult(bytes.NewReader(b))
+				require.NoError(t, err)
+				return got
+			}
+
+			// The libraries are in the same order as the serial analysis, while warnings are reported as soon as found
+			want := parse()
+			got := parse(jar.WithParallel(4))
+			assert.Equal(t, want.Libraries, got.Libraries)
+			assert.ElementsMatch(t, want.Warnings, got.Warnings)
+		})
+	}
+}
+
+func TestWithParallel_SearchWhileNested(t *testing.T) {
+	var inner bytes.Buffer
+	zw := zip.NewWriter(&inner)
+	_, err := zw.Create("com/example/Example.class")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var buf bytes.Buffer
+	zw = zip.NewWriter(&buf)
+	w, err := zw.Create("lib/example-1.0.0.jar")
+	require.NoError(t, err)
+	_, err = w.Write(inner.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	// The search of the nested artifact is answered after the fat JAR is searched for
+	outer := fmt.Sprintf(`1:"%x"`, sha1.Sum(buf.Bytes()))
+	outerSearched := make(chan struct{})
+	var once sync.Once
+	var pipelined bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("q") == outer {
+			once.Do(func() { close(outerSearched) })
+		} else {
+			select {
+			case <-outerSearched:
+				pipelined = true
+			case <-time.After(5 * time.Second):
+			}
+		}
+		_ = json.NewEncoder(w).Encode(apiResponse{})
+	}))
+	defer ts.Close()
+
+	_, err = jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithURL(ts.URL), jar.WithHTTPClient(ts.Client()),
+		jar.WithParallel(2))
+	require.NoError(t, err)
+	assert.True(t, pipelined)
+}
+
+type panicTransport struct{}
+
+func (panicTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	panic("broken transport")
+}
+
+func TestWithParallel_Panic(t *testing.T) {
+	var inner bytes.Buffer
+	zw := zip.NewWriter(&inner)
+	_, err := zw.Create("com/example/Example.class")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var buf bytes.Buffer
+	zw = zip.NewWriter(&buf)
+	for _, name := range []string{"lib/example-1.0.0.jar", "lib/other-1.0.0.jar"} {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(inner.Bytes())
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	// The panics of the workers are returned as in the current goroutine
+	for _, parallel := range []int{1, 2, 4} {
+		t.Run(fmt.Sprint(parallel), func(t *testing.T) {
+			_, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithHTTPClient(&http.Client{Transport: panicTransport{}}),
+				jar.WithParallel(parallel))
+			assert.ErrorAs(t, err, new(*types.ErrMalformedInput))
+		})
+	}
+}
+
+func TestWithMaxRequests(t *testing.T) {
+	// A fat JAR containing different artifacts without pom.properties, which are searched for by SHA-1
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := 0; i < 6; i++ {
+		var inner bytes.Buffer
+		innerZW := zip.NewWriter(&inner)
+		_, err := innerZW.Create(fmt.Sprintf("com/example/Example%d.class", i))
+		require.NoError(t, err)
+		require.NoError(t, innerZW.Close())
+
+		w, err := zw.Create(fmt.Sprintf("lib/example%d-1.0.0.jar", i))
+		require.NoError(t, err)
+		_, err = w.Write(inner.Bytes())
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	var inFlight, maxInFlight, requests int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(apiResponse{})
+	}))
+	defer ts.Close()
+
+	_, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithURL(ts.URL), jar.WithHTTPClient(ts.Client()),
+		jar.WithParallel(6), jar.WithMaxRequests(2))
+	require.NoError(t, err)
+
+	// SHA-1 and artifactId searches of the nested artifacts, and the SHA-1 search of the fat JAR
+	assert.Equal(t, int32(13), atomic.LoadInt32(&requests))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var res apiResponse
+		if strings.Contains(r.URL.Query().Get("q"), "c666f5bc47eb64ed3bbd13505a26f58be71f33f0") {
+			res.Response.NumFound = 1
+			res.Response.Docs = []doc{{ID: "org.springframework.spring-core", GroupID: "org.springframework", ArtifactID: "spring-core", Version: "5.3.3"}}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	}))
+	defer ts.Close()
+
+	b, err := os.ReadFile("testdata/test.jar")
+	require.NoError(t, err)
+
+	var requests int64
+	hooks := metrics.Hooks{
+		OnRequest: func(metrics.RequestEvent) {
+			atomic.AddInt64(&requests, 1)
+		},
+	}
+
+	// A single parser with shared options is used from multiple goroutines
+	p := jar.NewParser(jar.WithURL(ts.URL), jar.WithFilePath("testdata/test.jar"), jar.WithHTTPClient(ts.Client()),
+		jar.WithCache(cache.NewMemory(), time.Hour), jar.WithHooks(hooks))
+
+	var wg sync.WaitGroup
+	results := make([][]types.Library, 8)
+	errs := make([]error, len(results))
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _, errs[i] = p.Parse(bytes.NewReader(b))
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range results {
+		require.NoError(t, errs[i])
+		assert.Equal(t, wantSHA1, results[i])
+	}
+	assert.NotZero(t, atomic.LoadInt64(&requests))
+}
+
+func TestParse_RedactsCredentials(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	var urls []string
+	hooks := metrics.Hooks{
+		OnRequest: func(e metrics.RequestEvent) {
+			urls = append(urls, e.URL)
+		},
+	}
+	baseURL := strings.Replace(ts.URL, "://", "://user:secret@", 1) + "/select?api_key=secret"
+
+	f, err := os.Open("testdata/test.jar")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = jar.Parse(f, jar.WithURL(baseURL), jar.WithFilePath("testdata/test.jar"), jar.WithHTTPClient(ts.Client()),
+		jar.WithHooks(hooks))
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Contains(t, err.Error(), "user:xxxxx@")
+
+	require.NotEmpty(t, urls)
+	for _, u := range urls {
+		assert.NotContains(t, u, "secret")
+	}
+}
+
+func TestWithSettings(t *testing.T) {
+	localRepository := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(localRepository, "org", "springframework", "Spring Framework"), 0o755))
+
+	tests := []struct {
+		name            string
+		localRepository string
+		mirrorStatus    int
+		want            string
+	}{
+		{
+			name:            "local repository",
+			localRepository: localRepository,
+			want:            "org.springframework:Spring Framework",
+		},
+		{
+			name:         "found in mirror",
+			mirrorStatus: http.StatusOK,
+			want:         "org.springframework:Spring Framework",
+		},
+		{
+			name:         "not found in mirror",
+			mirrorStatus: http.StatusNotFound,
+			want:         "org.springframework:spring-core",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mirrored []string
+			mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				username, password, _ := r.BasicAuth()
+				assert.Equal(t, "deployer", username)
+				assert.Equal(t, "secret", password)
+				mirrored = append(mirrored, r.URL.EscapedPath())
+				w.WriteHeader(tt.mirrorStatus)
+			}))
+			defer mirror.Close()
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				q := r.URL.Query().Get("q")
+				assert.NotContains(t, q, "g:", "existence checked in Maven Central")
+				res := apiResponse{Response: response{NumFound: 1, Docs: []doc{
+					{ID: "org.springframework.spring-core", GroupID: "org.springframework", ArtifactID: "spring-core", Version: "5.3.3"},
+				}}}
+				_ = json.NewEncoder(w).Encode(res)
+			}))
+			defer ts.Close()
+
+			s := settings.Settings{
+				LocalRepository: tt.localRepository,
+				Mirrors:         []settings.Mirror{{ID: "nexus", URL: mirror.URL + "/repository/maven-public/", MirrorOf: "*"}},
+				Servers:         []settings.Server{{ID: "nexus", Username: "deployer", Password: "secret"}},
+			}
+
+			f, err := os.Open("testdata/test.jar")
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := jar.Parse(f, jar.WithURL(ts.URL), jar.WithHTTPClient(ts.Client()), jar.WithSettings(s))
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.want, got[0].Name)
+
+			if tt.mirrorStatus != 0 {
+				assert.Equal(t, []string{"/repository/maven-public/org/springframework/Spring%20Framework/maven-metadata.xml"}, mirrored)
+			} else {
+				assert.Empty(t, mirrored)
+			}
+		})
+	}
+}
+
+func TestWithOffline(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL)
+	}))
+	defer ts.Close()
+
+	localRepository := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(localRepository, "org", "springframework", "Spring Framework"), 0o755))
+
+	tests := []struct {
+		name            string
+		file            string
+		localRepository string
+		want            []string
+		wantErr         string
+	}{
+		{
+			name:            "local repository",
+			file:            "testdata/test.jar",
+			localRepository: localRepository,
+			want:            []string{"org.springframework:Spring Framework"},
+		},
+		{
+			name:    "manifest",
+			file:    "testdata/test.jar",
+			wantErr: "unable to identify org.springframework:Spring Framework:",
+		},
+		{
+			name: "nested artifacts",
+			file: "testdata/gradle.war",
+			want: []string{
+				"commons-dbcp:commons-dbcp",
+				"commons-pool:commons-pool",
+				"log4j:log4j",
+				"org.apache.commons:commons-compress",
+			},
+			wantErr: "unable to identify gradle.war:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			s := settings.Settings{LocalRepository: tt.localRepository}
+			libs, err := jar.Parse(f, jar.WithURL(ts.URL), jar.WithFilePath(tt.file), jar.WithHTTPClient(ts.Client()),
+				jar.WithSettings(s), jar.WithOffline())
+			if tt.wantErr != "" {
+				var partial *types.ErrPartialResult
+				require.True(t, errors.As(err, &partial), err)
+				assert.True(t, errors.Is(err, jar.ErrOffline))
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var got []string
+			for _, lib := range libs {
+				got = append(got, lib.Name)
+			}
+			sort.Strings(got)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWithSettings_ParentLicenses(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.Create("META-INF/maven/org.example/app/pom.properties")
+	require.NoError(t, err)
+	_, err = w.Write([]byte("groupId=org.example\nartifactId=app\nversion=1.0.0\n"))
+	require.NoError(t, err)
+	w, err = zw.Create("META-INF/maven/org.example/app/pom.xml")
+	require.NoError(t, err)
+	_, err = w.Write([]byte(`<project><parent><groupId>org.example</groupId><artifactId>parent</artifactId><version>1.0.0</version></parent></project>`))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	// The parent is in the local repository, and the grandparent declaring the licenses is in the mirror
+	localRepository := t.TempDir()
+	dir := filepath.Join(localRepository, "org", "example", "parent", "1.0.0")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "parent-1.0.0.pom"),
+		[]byte(`<project><parent><groupId>org.example</groupId><artifactId>root</artifactId><version>2</version></parent></project>`), 0o644))
+
+	var fetched []string
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetched = append(fetched, r.URL.Path)
+		if r.URL.Path != "/org/example/root/2/root-2.pom" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`<project><licenses><license><name>The Apache Software License, Version 2.0</name></license></licenses></project>`))
+	}))
+	defer mirror.Close()
+
+	s := settings.Settings{
+		LocalRepository: localRepository,
+		Mirrors:         []settings.Mirror{{ID: "mirror", URL: mirror.URL, MirrorOf: "central"}},
+	}
+	got, err := jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithFilePath("app-1.0.0.jar"),
+		jar.WithHTTPClient(mirror.Client()), jar.WithSettings(s))
+	require.NoError(t, err)
+	want := []types.Library{
+		{Name: "org.example:app", Version: "1.0.0", Root: true, Licenses: []string{"Apache-2.0"}, License: "Apache-2.0", FilePath: "app-1.0.0.jar"},
+	}
+	assert.Equal(t, want, got)
+	assert.Equal(t, []string{"/org/example/root/2/root-2.pom"}, fetched)
+
+	// The mirror isn't accessed in offline mode
+	fetched = nil
+	got, err = jar.Parse(bytes.NewReader(buf.Bytes()), jar.WithFilePath("app-1.0.0.jar"),
+		jar.WithHTTPClient(mirror.Client()), jar.WithSettings(s), jar.WithOffline())
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Empty(t, got[0].Licenses)
+	assert.Empty(t, fetched)
+}
+
+// newParentPomsJar returns a fat JAR of lib0 with the artifacts of 3 projects, whose licenses are declared by their parents.
+func newParentPomsJar(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for i := 0; i < 6; i++ {
+		dir := fmt.Sprintf("META-INF/maven/org.example/lib%d/", i)
+		w, err := zw.Create(dir + "pom.properties")
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(w, "groupId=org.example\nartifactId=lib%d\nversion=1.0.0\n", i)
+		require.NoError(t, err)
+		w, err = zw.Create(dir + "pom.xml")
+		require.NoError(t, err)
+		_, err = fmt.Fprintf(w, "<project><parent><groupId>org.example</groupId><artifactId>parent%d</artifactId><version>1</version></parent></project>", i%3)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestWithParallel_ParentPoms(t *testing.T) {
+	b := newParentPomsJar(t)
+
+	var inFlight, maxInFlight int32
+	var mu sync.Mutex
+	fetched := map[string]int{}
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		fetched[r.URL.Path]++
+		mu.Unlock()
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`<project><licenses><license><name>MIT</name></license></licenses></project>`))
+	}))
+	defer mirror.Close()
+
+	s := settings.Settings{Mirrors: []settings.Mirror{{ID: "mirror", URL: mirror.URL, MirrorOf: "*"}}}
+	got, err := jar.Parse(bytes.NewReader(b), jar.WithFilePath("lib0-1.0.0.jar"), jar.WithHTTPClient(mirror.Client()),
+		jar.WithSettings(s), jar.WithParallel(3))
+	require.NoError(t, err)
+
+	require.Len(t, got, 6)
+	for _, lib := range got {
+		assert.Equal(t, []string{"MIT"}, lib.Licenses, lib.Name)
+	}
+	// Each parent is fetched once, while the others are fetched at the same time
+	assert.Equal(t, map[string]int{
+		"/org/example/parent0/1/parent0-1.pom": 1,
+		"/org/example/parent1/1/parent1-1.pom": 1,
+		"/org/example/parent2/1/parent2-1.pom": 1,
+	}, fetched)
+	assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+}
+
+func TestWithParallel_BrokenParentPoms(t *testing.T) {
+	// The parents shared by the workers panic while being fetched
+	s := settings.Settings{Mirrors: []settings.Mirror{{ID: "mirror", URL: "http://mirror.example.com", MirrorOf: "*"}}}
+	got, err := jar.Parse(bytes.NewReader(newParentPomsJar(t)), jar.WithFilePath("lib0-1.0.0.jar"),
+		jar.WithHTTPClient(&http.Client{Transport: panicTransport{}}), jar.WithSettings(s), jar.WithParallel(3))
+	require.NoError(t, err)
+
+	require.Len(t, got, 6)
+	for _, lib := range got {
+		assert.Empty(t, lib.Licenses, lib.Name)
+	}
+}
@@ -0,0 +1,311 @@
+// Package metadata resolves Maven version ranges to the versions listed in maven-metadata.xml
+// of the local repository and the remote repositories, as Maven does for dependencies like [1.2,2.0).
+//
+// e.g.
+//
+//	s, err := settings.Load()
+//	r := metadata.NewResolver(metadata.WithSettings(s))
+//	v, err := r.Resolve(ctx, "org.slf4j", "slf4j-api", "[1.7,2.0)")
+package metadata
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/settings"
+	"github.com/aquasecurity/go-dep-parser/pkg/redact"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/maven"
+)
+
+// CentralURL is the URL of Maven Central, which is replaced by its mirror in the settings.
+const CentralURL = "https://repo.maven.apache.org/maven2"
+
+type metadataXML struct {
+	Version    string `xml:"version"`
+	Versioning struct {
+		Versions []string `xml:"versions>version"`
+	} `xml:"versioning"`
+}
+
+type repository struct {
+	url      string
+	username string
+	password string
+}
+
+type Option func(*Resolver)
+
+// WithHTTPClient fetches maven-metadata.xml with client. The default is http.DefaultClient,
+// or the client sending the requests through the active proxy of WithSettings.
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *Resolver) {
+		r.client = client
+	}
+}
+
+// WithRepository looks up the versions in the remote repository as well, with the credentials if not empty.
+// Repositories are looked up after Maven Central.
+func WithRepository(url, username, password string) Option {
+	return func(r *Resolver) {
+		r.repositories = append(r.repositories, repository{url: strings.TrimSuffix(url, "/"), username: username, password: password})
+	}
+}
+
+// WithSettings looks up the versions in the local repository, and in the mirror of Maven Central instead of it,
+// with the credentials of the server of the same ID. Requests are sent through the active proxy.
+func WithSettings(s settings.Settings) Option {
+	return func(r *Resolver) {
+		r.localRepository = s.LocalRepository
+		if m, ok := s.Mirror(settings.CentralID); ok && m.URL != "" {
+			central := repository{url: strings.TrimSuffix(m.URL, "/")}
+			if srv, ok := s.Server(m.ID); ok {
+				central.username, central.password = srv.Username, srv.Password
+			}
+			r.repositories[0] = central
+		}
+		if p, ok := s.ActiveProxy(); ok {
+			t := http.DefaultTransport.(*http.Transport).Clone()
+			t.Proxy = p.ProxyFunc()
+			r.proxyClient = &http.Client{Transport: t}
+		}
+	}
+}
+
+// WithLowest resolves the ranges to the lowest versions in them instead of the highest ones.
+// e.g. to check the versions the ranges of a library are pinned to at the minimum
+func WithLowest() Option {
+	return func(r *Resolver) {
+		r.lowest = true
+	}
+}
+
+// WithOffline looks up the versions only in the local repository, sending no requests.
+func WithOffline() Option {
+	return func(r *Resolver) {
+		r.offline = true
+	}
+}
+
+// Resolver resolves version ranges, remembering the versions of each artifact.
+// It can be used concurrently, and the versions of an artifact are looked up once.
+type Resolver struct {
+	client          *http.Client
+	proxyClient     *http.Client
+	localRepository string
+	// Maven Central or its mirror comes first
+	repositories []repository
+	lowest       bool
+	offline      bool
+
+	mu    sync.Mutex
+	calls map[string]*versionsCall
+}
+
+type versionsCall struct {
+	done     chan struct{}
+	versions []string
+	err      error
+}
+
+func NewResolver(opts ...Option) *Resolver {
+	r := &Resolver{
+		repositories: []repository{{url: CentralURL}},
+		calls:        map[string]*versionsCall{},
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.client == nil {
+		r.client = http.DefaultClient
+		if r.proxyClient != nil {
+			r.client = r.proxyClient
+		}
+	}
+	return r
+}
+
+// Resolve returns the highest version of the artifact in the range, or the lowest one with WithLowest.
+// Versions which aren't ranges, such as 1.0, are returned as they are.
+// SNAPSHOT versions are picked only if the range is bounded by one.
+func (r *Resolver) Resolve(ctx context.Context, groupID, artifactID, spec string) (string, error) {
+	rng, err := maven.ParseRange(spec)
+	if err != nil {
+		return "", xerrors.Errorf("range error: %w", err)
+	} else if rng.Recommended != "" {
+		return spec, nil
+	}
+
+	versions, err := r.versions(ctx, groupID, artifactID)
+	if err != nil {
+		return "", err
+	}
+
+	snapshots := strings.Contains(spec, "-SNAPSHOT")
+	var picked string
+	for _, v := range versions {
+		if !rng.Contains(v) || (!snapshots && strings.HasSuffix(v, "-SNAPSHOT")) {
+			continue
+		}
+		if picked == "" {
+			picked = v
+			continue
+		}
+		if c := maven.Compare(v, picked); (r.lowest && c < 0) || (!r.lowest && c > 0) {
+			picked = v
+		}
+	}
+	if picked == "" {
+		return "", &types.ErrArtifactNotFound{GroupID: groupID, ArtifactID: artifactID, Version: spec}
+	}
+	return picked, nil
+}
+
+// versions returns the versions of the artifact, waiting for the lookup in flight if any.
+// Failed lookups are tried again by the next call. Panics are returned as ErrMalformedInput to all the callers.
+func (r *Resolver) versions(ctx context.Context, groupID, artifactID string) ([]string, error) {
+	key := groupID + ":" + artifactID
+	r.mu.Lock()
+	if call, ok := r.calls[key]; ok {
+		r.mu.Unlock()
+		<-call.done
+		return call.versions, call.err
+	}
+	call := &versionsCall{done: make(chan struct{})}
+	r.calls[key] = call
+	r.mu.Unlock()
+	defer close(call.done)
+
+	func() {
+		defer utils.RecoverPanic(&call.err)
+		call.versions, call.err = r.lookUp(ctx, groupID, artifactID)
+	}()
+	if call.err != nil {
+		r.mu.Lock()
+		delete(r.calls, key)
+		r.mu.Unlock()
+	}
+	return call.versions, call.err
+}
+
+// lookUp merges the versions in the local repository and the remote repositories.
+// Repositories without the artifact are skipped, and the errors fail only if no versions are found.
+func (r *Resolver) lookUp(ctx context.Context, groupID, artifactID string) ([]string, error) {
+	segments := append(strings.Split(groupID, "."), artifactID)
+
+	seen := map[string]struct{}{}
+	var versions []string
+	add := func(vs []string) {
+		for _, v := range vs {
+			if _, ok := seen[v]; !ok && v != "" {
+				seen[v] = struct{}{}
+				versions = append(versions, v)
+			}
+		}
+	}
+
+	var errs []error
+	if r.localRepository != "" {
+		// e.g. maven-metadata-central.xml and maven-metadata-local.xml
+		dir := filepath.Join(r.localRepository, filepath.Join(segments...))
+		files, _ := filepath.Glob(filepath.Join(dir, "maven-metadata*.xml"))
+		for _, file := range files {
+			vs, err := parseFile(file)
+			if err != nil {
+				errs = append(errs, xerrors.Errorf("%s: %w", file, err))
+				continue
+			}
+			add(vs)
+		}
+	}
+
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	repositories := r.repositories
+	if r.offline {
+		repositories = nil
+	}
+	for _, repo := range repositories {
+		vs, err := r.fetch(ctx, repo, repo.url+"/"+strings.Join(segments, "/")+"/maven-metadata.xml")
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		add(vs)
+	}
+
+	if len(versions) == 0 && len(errs) > 0 {
+		return nil, xerrors.Errorf("unable to look up the versions of %s:%s: %w", groupID, artifactID, errs[0])
+	}
+	return versions, nil
+}
+
+func parseFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parse(f)
+}
+
+// fetch returns the versions in maven-metadata.xml of the repository, and none if it is missing.
+func (r *Resolver) fetch(ctx context.Context, repo repository, u string) ([]string, error) {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, redact.Error(err)
+	}
+	req = req.WithContext(ctx)
+	if repo.username != "" || repo.password != "" {
+		req.SetBasicAuth(repo.username, repo.password)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, xerrors.Errorf("http error: %w", redact.Error(err))
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		vs, err := parse(resp.Body)
+		if err != nil {
+			return nil, xerrors.Errorf("%s: %w", redact.URL(u), err)
+		}
+		return vs, nil
+	case http.StatusNotFound:
+		return nil, nil
+	}
+	return nil, xerrors.Errorf("status %s from %s", resp.Status, redact.URL(u))
+}
+
+// parse returns the versions listed in maven-metadata.xml, including the version of the local metadata.
+func parse(r io.Reader) (_ []string, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var m metadataXML
+	decoder := xml.NewDecoder(utils.NewUTF8Reader(r))
+	decoder.CharsetReader = utils.CharsetReader
+	if err := decoder.Decode(&m); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var versions []string
+	for _, v := range append(m.Versioning.Versions, m.Version) {
+		if v = strings.TrimSpace(v); v != "" {
+			versions = append(versions, v)
+		}
+	}
+	return versions, nil
+}
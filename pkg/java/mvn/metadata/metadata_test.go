@@ -0,0 +1,129 @@
+package metadata_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/metadata"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/mvn/settings"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// newMirror serves testdata/maven-metadata.xml as the metadata of guava, with the credentials of nexus.
+func newMirror(requests *int64) (*httptest.Server, settings.Settings) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt64(requests, 1)
+		if username, password, _ := r.BasicAuth(); username != "deployer" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/maven2/com/google/guava/guava/maven-metadata.xml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		http.ServeFile(w, r, "testdata/maven-metadata.xml")
+	}))
+	s := settings.Settings{
+		Mirrors: []settings.Mirror{{ID: "nexus", URL: ts.URL + "/maven2/", MirrorOf: "central"}},
+		Servers: []settings.Server{{ID: "nexus", Username: "deployer", Password: "secret"}},
+	}
+	return ts, s
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	tests := []struct {
+		name            string
+		artifactID      string
+		spec            string
+		lowest          bool
+		localRepository string
+		want            string
+		wantErr         bool
+	}{
+		{name: "highest", artifactID: "guava", spec: "[30.0-jre,32.0)", want: "31.0-jre"},
+		{name: "lowest", artifactID: "guava", spec: "[30.0-jre,32.0)", lowest: true, want: "30.0-jre"},
+		{name: "exclusive lower bound", artifactID: "guava", spec: "(30.0-jre,31.0-jre]", lowest: true, want: "30.1-jre"},
+		{name: "unbounded", artifactID: "guava", spec: "[30.0-jre,)", want: "33.0.0-jre"},
+		{name: "snapshot", artifactID: "guava", spec: "[33.0.0-jre,34.0-SNAPSHOT]", want: "34.0-SNAPSHOT"},
+		{
+			name:            "local repository",
+			artifactID:      "guava",
+			spec:            "[30.0-jre,)",
+			localRepository: "testdata/repository",
+			want:            "33.1.0-local",
+		},
+		{name: "not a range", artifactID: "guava", spec: "1.0", want: "1.0"},
+		{name: "no version in range", artifactID: "guava", spec: "[40.0,)", wantErr: true},
+		{name: "unknown artifact", artifactID: "unknown", spec: "[1.0,)", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int64
+			ts, s := newMirror(&requests)
+			defer ts.Close()
+			s.LocalRepository = tt.localRepository
+
+			opts := []metadata.Option{metadata.WithSettings(s)}
+			if tt.lowest {
+				opts = append(opts, metadata.WithLowest())
+			}
+			r := metadata.NewResolver(opts...)
+
+			got, err := r.Resolve(context.Background(), "com.google.guava", tt.artifactID, tt.spec)
+			if tt.wantErr {
+				assert.ErrorAs(t, err, new(*types.ErrArtifactNotFound))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolver_Memoized(t *testing.T) {
+	var requests int64
+	ts, s := newMirror(&requests)
+	defer ts.Close()
+
+	r := metadata.NewResolver(metadata.WithSettings(s))
+	for _, spec := range []string{"[30.0-jre,)", "[29.0-jre,30.0-jre]"} {
+		_, err := r.Resolve(context.Background(), "com.google.guava", "guava", spec)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), atomic.LoadInt64(&requests))
+}
+
+func TestResolver_Unauthorized(t *testing.T) {
+	var requests int64
+	ts, s := newMirror(&requests)
+	defer ts.Close()
+	s.Servers = nil
+
+	r := metadata.NewResolver(metadata.WithSettings(s))
+	_, err := r.Resolve(context.Background(), "com.google.guava", "guava", "[30.0-jre,)")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401 Unauthorized")
+}
+
+func TestWithOffline(t *testing.T) {
+	var requests int64
+	ts, s := newMirror(&requests)
+	defer ts.Close()
+	s.LocalRepository = "testdata/repository"
+
+	r := metadata.NewResolver(metadata.WithSettings(s), metadata.WithOffline())
+	got, err := r.Resolve(context.Background(), "com.google.guava", "guava", "[30.0-jre,)")
+	require.NoError(t, err)
+	assert.Equal(t, "33.1.0-local", got)
+
+	_, err = r.Resolve(context.Background(), "com.google.guava", "guava", "[30.0-jre,33.0.0-jre]")
+	assert.ErrorAs(t, err, new(*types.ErrArtifactNotFound))
+	assert.Zero(t, atomic.LoadInt64(&requests))
+}
@@ -0,0 +1,291 @@
+package mvn
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+const logPrefix = "[INFO] "
+
+type artifact struct {
+	groupID    string
+	artifactID string
+	// e.g. jar, war and pom
+	typ string
+	// e.g. linux-x86_64 and sources
+	classifier string
+	version    string
+	scope      string
+	optional   bool
+	// underOptional is true for the transitive dependencies of optional ones, which aren't shipped with them either
+	underOptional bool
+	// The depth in the dependency tree. The root is 0.
+	depth int
+	line  int
+}
+
+type options struct {
+	// nil returns all the scopes except for test
+	scopes          map[string]struct{}
+	withoutOptional bool
+}
+
+type Option func(*options)
+
+// WithScopes returns only the dependencies in the Maven scopes, such as "compile" and "runtime" for the shipped artifacts.
+// By default, the dependencies in all the scopes except for "test" are returned.
+// Transitive dependencies are in the scopes Maven resolved them to, which are printed by the plugin.
+func WithScopes(scopes ...string) Option {
+	return func(o *options) {
+		o.scopes = map[string]struct{}{}
+		for _, scope := range scopes {
+			o.scopes[scope] = struct{}{}
+		}
+	}
+}
+
+// WithoutOptional excludes the optional dependencies together with their transitive dependencies,
+// as they are not shipped to the dependents of the project. By default, they are returned with types.ScopeOptional.
+func WithoutOptional() Option {
+	return func(o *options) {
+		o.withoutOptional = true
+	}
+}
+
+// Parser implements types.Parser for the output of mvn dependency:tree and dependency:list
+type Parser struct {
+	opts []Option
+}
+
+func NewParser(opts ...Option) types.Parser {
+	return &Parser{opts: opts}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return Parse(r, p.opts...)
+}
+
+// Parse parses the text output of "mvn dependency:tree" and "mvn dependency:list"
+// The dependency graph is only returned for dependency:tree, whose first line is the project itself.
+// It is returned as the root, which depends on the direct dependencies.
+// Artifacts with classifiers are told apart by their IDs and qualifiers. e.g. io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final
+//
+// e.g. dependency:tree
+//
+//	com.example:app:jar:1.0.0
+//	+- org.apache.commons:commons-lang3:jar:3.12.0:compile
+//	\- junit:junit:jar:4.13.2:test
+//	   \- org.hamcrest:hamcrest-core:jar:1.3:test
+//
+// e.g. dependency:list
+//
+//	The following files have been resolved:
+//	   org.apache.commons:commons-lang3:jar:3.12.0:compile
+func Parse(r io.Reader, opts ...Option) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	artifacts, err := parseArtifacts(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	depIndex := map[string]int{}
+
+	// parents[i] is the last artifact printed at depth i
+	var parents []artifact
+	for _, a := range artifacts {
+		// dependency:list doesn't print the root
+		for len(parents) < a.depth {
+			parents = append(parents, artifact{})
+		}
+		if a.depth > 0 {
+			parent := parents[a.depth-1]
+			a.underOptional = parent.optional || parent.underOptional
+		}
+		parents = append(parents[:a.depth], a)
+		if o.skip(a) {
+			continue
+		}
+		libs = append(libs, a.library())
+
+		if a.depth == 0 {
+			continue
+		}
+		parent := parents[a.depth-1]
+		if parent.groupID == "" || o.skip(parent) {
+			continue
+		}
+		parentID := parent.id()
+		if i, ok := depIndex[parentID]; ok {
+			deps[i].DependsOn = append(deps[i].DependsOn, a.id())
+			continue
+		}
+		depIndex[parentID] = len(deps)
+		deps = append(deps, types.Dependency{
+			ID:        parentID,
+			DependsOn: []string{a.id()},
+		})
+	}
+	return libs, deps, nil
+}
+
+// parseArtifacts returns the artifacts printed in r.
+// The same groupIds and scopes are printed on many lines, so the fields are interned rather than sliced from the lines.
+func parseArtifacts(r io.Reader) ([]artifact, error) {
+	var artifacts []artifact
+	var lineNum int
+	var in utils.Interner
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimPrefix(scanner.Text(), logPrefix)
+
+		prefixLen := treePrefixLen(line)
+		a, ok := parseCoordinate(line[prefixLen:])
+		if !ok {
+			continue
+		}
+		a.groupID, a.artifactID = in.Intern(a.groupID), in.Intern(a.artifactID)
+		a.typ, a.classifier = in.Intern(a.typ), in.Intern(a.classifier)
+		a.version, a.scope = in.Intern(a.version), in.Intern(a.scope)
+		a.depth = prefixLen / 3
+		a.line = lineNum
+		artifacts = append(artifacts, a)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return artifacts, nil
+}
+
+// treePrefixLen returns the length of the tree drawing in front of the coordinate.
+// Each level is drawn with 3 characters. e.g. "|  ", "+- ", "\- " and "   "
+func treePrefixLen(line string) int {
+	i := 0
+	for i+3 <= len(line) {
+		switch line[i : i+3] {
+		case "|  ", "+- ", "\\- ", "   ":
+			i += 3
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// parseCoordinate parses the coordinate printed by the maven-dependency-plugin.
+// e.g. groupId:artifactId:type[:classifier]:version[:scope]
+func parseCoordinate(s string) (artifact, bool) {
+	// Remove annotations such as " (optional)" and " -- module junit (auto)"
+	var optional bool
+	if i := strings.Index(s, " "); i != -1 {
+		optional = strings.Contains(s[i:], "(optional)")
+		s = s[:i]
+	}
+
+	ss := strings.Split(s, ":")
+	for _, field := range ss {
+		if field == "" {
+			return artifact{}, false
+		}
+	}
+
+	switch len(ss) {
+	case 4:
+		// The root doesn't have a scope
+		return artifact{groupID: ss[0], artifactID: ss[1], typ: ss[2], version: ss[3]}, true
+	case 5:
+		return artifact{groupID: ss[0], artifactID: ss[1], typ: ss[2], version: ss[3], scope: ss[4], optional: optional}, true
+	case 6:
+		return artifact{groupID: ss[0], artifactID: ss[1], typ: ss[2], classifier: ss[3], version: ss[4], scope: ss[5], optional: optional}, true
+	}
+	return artifact{}, false
+}
+
+// libraryScope maps the scope of Maven into the normalized one.
+func (a artifact) libraryScope() types.Scope {
+	if a.optional {
+		return types.ScopeOptional
+	}
+	switch a.scope {
+	case "compile", "runtime":
+		return types.ScopeRuntime
+	case "provided", "system":
+		return types.ScopeProvided
+	case "test":
+		return types.ScopeTest
+	}
+	return ""
+}
+
+// root reports whether the artifact is the project itself, which is printed without a scope at the top of the tree.
+func (a artifact) root() bool {
+	return a.depth == 0 && a.scope == ""
+}
+
+// skip reports whether the artifact is excluded from the result. The root is always returned.
+// Test dependencies are not shipped unless their scope is given by WithScopes.
+// Optional dependencies are excluded by WithoutOptional.
+func (o options) skip(a artifact) bool {
+	if a.root() {
+		return false
+	}
+	if a.scope == "" || (o.withoutOptional && (a.optional || a.underOptional)) {
+		return true
+	}
+	if o.scopes == nil {
+		return a.scope == "test"
+	}
+	_, ok := o.scopes[a.scope]
+	return !ok
+}
+
+// id returns name@version, with the classifier after the name if any, so that the artifacts of the same version
+// with different classifiers are not merged.
+func (a artifact) id() string {
+	name := fmt.Sprintf("%s:%s", a.groupID, a.artifactID)
+	if a.classifier != "" {
+		name += ":" + a.classifier
+	}
+	return utils.PackageID(name, a.version)
+}
+
+func (a artifact) library() types.Library {
+	lib := types.Library{
+		ID:      a.id(),
+		Name:    fmt.Sprintf("%s:%s", a.groupID, a.artifactID),
+		Version: a.version,
+		// Only dependency:tree prints nested artifacts
+		Indirect:  a.depth > 1,
+		Root:      a.root(),
+		Scope:     a.libraryScope(),
+		Locations: []types.Location{{StartLine: a.line, EndLine: a.line}},
+	}
+
+	qualifiers := map[string]string{}
+	if a.typ != "" && a.typ != "jar" {
+		qualifiers[purl.QualifierType] = a.typ
+	}
+	if a.classifier != "" {
+		qualifiers[purl.QualifierClassifier] = a.classifier
+	}
+	if len(qualifiers) > 0 {
+		lib.Qualifiers = qualifiers
+	}
+	return lib
+}
@@ -0,0 +1,152 @@
+package mvn
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
+	}{
+		{
+			file:     "testdata/tree.txt",
+			want:     mvnTree,
+			wantDeps: mvnTreeDeps,
+		},
+		{
+			file:     "testdata/tree_console.txt",
+			want:     mvnTreeConsole,
+			wantDeps: mvnTreeConsoleDeps,
+		},
+		{
+			file: "testdata/list.txt",
+			want: mvnList,
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := Parse(f)
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+			assert.Equal(t, v.wantDeps, gotDeps)
+		})
+	}
+}
+
+func TestWithScopes(t *testing.T) {
+	tests := []struct {
+		name     string
+		scopes   []string
+		want     []string
+		wantDeps []types.Dependency
+	}{
+		{
+			name:   "runtime",
+			scopes: []string{"compile", "runtime"},
+			want: []string{
+				"com.example:happy@1.0.0",
+				"org.apache.commons:commons-lang3@3.12.0",
+				"com.fasterxml.jackson.core:jackson-databind@2.13.3",
+				"com.fasterxml.jackson.core:jackson-annotations@2.13.3",
+				"com.fasterxml.jackson.core:jackson-core@2.13.3",
+				"io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final",
+				"io.netty:netty-common@4.1.79.Final",
+			},
+			wantDeps: []types.Dependency{
+				{ID: "com.example:happy@1.0.0", DependsOn: []string{"org.apache.commons:commons-lang3@3.12.0", "com.fasterxml.jackson.core:jackson-databind@2.13.3", "io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final"}},
+				mvnTreeDeps[1],
+				mvnTreeDeps[2],
+			},
+		},
+		{
+			name:   "test",
+			scopes: []string{"test"},
+			want: []string{
+				"com.example:happy@1.0.0",
+				"junit:junit@4.13.2",
+				"org.hamcrest:hamcrest-core@1.3",
+			},
+			wantDeps: []types.Dependency{
+				{ID: "com.example:happy@1.0.0", DependsOn: []string{"junit:junit@4.13.2"}},
+				{ID: "junit:junit@4.13.2", DependsOn: []string{"org.hamcrest:hamcrest-core@1.3"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open("testdata/tree.txt")
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, gotDeps, err := NewParser(WithScopes(tt.scopes...)).Parse(f)
+			require.NoError(t, err)
+
+			var ids []string
+			for _, lib := range got {
+				ids = append(ids, lib.ID)
+			}
+			assert.Equal(t, tt.want, ids)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
+
+func TestWithoutOptional(t *testing.T) {
+	input := `[INFO] com.example:app:jar:1.0.0
+[INFO] +- org.apache.commons:commons-lang3:jar:3.12.0:compile
+[INFO] +- com.google.code.findbugs:jsr305:jar:3.0.2:compile (optional)
+[INFO] \- org.example:plugin:jar:1.0.0:compile (optional)
+[INFO]    \- org.example:plugin-api:jar:1.0.0:compile
+`
+	tests := []struct {
+		name string
+		opts []Option
+		want []string
+	}{
+		{
+			name: "default",
+			want: []string{
+				"com.example:app@1.0.0 ",
+				"org.apache.commons:commons-lang3@3.12.0 runtime",
+				"com.google.code.findbugs:jsr305@3.0.2 optional",
+				"org.example:plugin@1.0.0 optional",
+				"org.example:plugin-api@1.0.0 runtime",
+			},
+		},
+		{
+			name: "without optional",
+			opts: []Option{WithoutOptional()},
+			want: []string{
+				"com.example:app@1.0.0 ",
+				"org.apache.commons:commons-lang3@3.12.0 runtime",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := Parse(strings.NewReader(input), tt.opts...)
+			require.NoError(t, err)
+
+			var libs []string
+			for _, lib := range got {
+				libs = append(libs, lib.ID+" "+string(lib.Scope))
+			}
+			assert.Equal(t, tt.want, libs)
+		})
+	}
+}
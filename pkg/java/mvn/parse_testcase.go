@@ -0,0 +1,41 @@
+package mvn
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// mvn dependency:tree -DoutputType=text -DoutputFile=tree.txt
+	mvnTree = []types.Library{
+		{ID: "com.example:happy@1.0.0", Name: "com.example:happy", Version: "1.0.0", Root: true, Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		{ID: "org.apache.commons:commons-lang3@3.12.0", Name: "org.apache.commons:commons-lang3", Version: "3.12.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+		{ID: "com.fasterxml.jackson.core:jackson-databind@2.13.3", Name: "com.fasterxml.jackson.core:jackson-databind", Version: "2.13.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 3, EndLine: 3}}},
+		{ID: "com.fasterxml.jackson.core:jackson-annotations@2.13.3", Name: "com.fasterxml.jackson.core:jackson-annotations", Version: "2.13.3", Indirect: true, Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{ID: "com.fasterxml.jackson.core:jackson-core@2.13.3", Name: "com.fasterxml.jackson.core:jackson-core", Version: "2.13.3", Indirect: true, Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
+		{ID: "io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final", Name: "io.netty:netty-transport-native-epoll", Version: "4.1.79.Final", Qualifiers: map[string]string{"classifier": "linux-x86_64"}, Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
+		{ID: "io.netty:netty-common@4.1.79.Final", Name: "io.netty:netty-common", Version: "4.1.79.Final", Indirect: true, Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 7, EndLine: 7}}},
+		{ID: "javax.servlet:javax.servlet-api@4.0.1", Name: "javax.servlet:javax.servlet-api", Version: "4.0.1", Scope: types.ScopeProvided, Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
+	}
+
+	// mvn dependency:tree | tee tree_console.txt
+	mvnTreeConsole = []types.Library{
+		{ID: "com.example:happy@1.0.0", Name: "com.example:happy", Version: "1.0.0", Root: true, Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
+		{ID: "org.apache.commons:commons-lang3@3.12.0", Name: "org.apache.commons:commons-lang3", Version: "3.12.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 9, EndLine: 9}}},
+		{ID: "com.google.code.findbugs:jsr305@3.0.2", Name: "com.google.code.findbugs:jsr305", Version: "3.0.2", Scope: types.ScopeOptional, Locations: []types.Location{{StartLine: 10, EndLine: 10}}},
+	}
+
+	// mvn dependency:list -DoutputFile=list.txt
+	mvnList = []types.Library{
+		{ID: "org.apache.commons:commons-lang3@3.12.0", Name: "org.apache.commons:commons-lang3", Version: "3.12.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 3, EndLine: 3}}},
+		{ID: "com.fasterxml.jackson.core:jackson-annotations@2.13.3", Name: "com.fasterxml.jackson.core:jackson-annotations", Version: "2.13.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+	}
+
+	// The root depends on the direct dependencies
+	mvnTreeDeps = []types.Dependency{
+		{ID: "com.example:happy@1.0.0", DependsOn: []string{"org.apache.commons:commons-lang3@3.12.0", "com.fasterxml.jackson.core:jackson-databind@2.13.3", "io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final", "javax.servlet:javax.servlet-api@4.0.1"}},
+		{ID: "com.fasterxml.jackson.core:jackson-databind@2.13.3", DependsOn: []string{"com.fasterxml.jackson.core:jackson-annotations@2.13.3", "com.fasterxml.jackson.core:jackson-core@2.13.3"}},
+		{ID: "io.netty:netty-transport-native-epoll:linux-x86_64@4.1.79.Final", DependsOn: []string{"io.netty:netty-common@4.1.79.Final"}},
+	}
+
+	mvnTreeConsoleDeps = []types.Dependency{
+		{ID: "com.example:happy@1.0.0", DependsOn: []string{"org.apache.commons:commons-lang3@3.12.0", "com.google.code.findbugs:jsr305@3.0.2"}},
+	}
+)
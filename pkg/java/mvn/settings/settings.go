@@ -0,0 +1,326 @@
+// Package settings reads the Maven settings, so that artifacts are looked up in the local repository and
+// through the mirrors and proxies of corporate environments, such as Nexus and Artifactory mirroring Maven Central.
+//
+// e.g.
+//
+//	s, err := settings.Load()
+//	libs, err := jar.Parse(f, jar.WithSettings(s))
+//
+// Encrypted passwords, those between braces decrypted with settings-security.xml, are kept as they are.
+package settings
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// CentralID is the id of Maven Central, which mirrors replace by mirrorOf.
+const CentralID = "central"
+
+// ${user.home} and ${env.NAME} in the values
+var propertyRegexp = regexp.MustCompile(`\$\{(user\.home|env\.[^}]+)\}`)
+
+// Settings is the content of settings.xml.
+type Settings struct {
+	// LocalRepository is the directory of the local repository. e.g. ~/.m2/repository
+	LocalRepository string
+	Mirrors         []Mirror
+	Proxies         []Proxy
+	Servers         []Server
+}
+
+// Mirror replaces the repositories matching MirrorOf. e.g. "central", "*" and "external:*,!snapshots"
+type Mirror struct {
+	ID       string `xml:"id"`
+	Name     string `xml:"name"`
+	URL      string `xml:"url"`
+	MirrorOf string `xml:"mirrorOf"`
+}
+
+// Proxy is the HTTP proxy requests are sent through.
+type Proxy struct {
+	ID       string
+	Active   bool
+	Protocol string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// NonProxyHosts are the hosts connected to directly, separated by "|". e.g. "*.example.com|localhost"
+	NonProxyHosts string
+}
+
+// Server holds the credentials of the repository or the mirror of the same ID.
+type Server struct {
+	ID       string `xml:"id"`
+	Username string `xml:"username"`
+	Password string `xml:"password"`
+}
+
+type settingsXML struct {
+	LocalRepository string     `xml:"localRepository"`
+	Mirrors         []Mirror   `xml:"mirrors>mirror"`
+	Proxies         []proxyXML `xml:"proxies>proxy"`
+	Servers         []Server   `xml:"servers>server"`
+}
+
+type proxyXML struct {
+	ID            string `xml:"id"`
+	Active        string `xml:"active"`
+	Protocol      string `xml:"protocol"`
+	Host          string `xml:"host"`
+	Port          string `xml:"port"`
+	Username      string `xml:"username"`
+	Password      string `xml:"password"`
+	NonProxyHosts string `xml:"nonProxyHosts"`
+}
+
+// Load reads the global settings in $M2_HOME/conf/settings.xml, or $MAVEN_HOME/conf/settings.xml,
+// and the user settings in ~/.m2/settings.xml. Missing files are skipped.
+// The user settings take precedence, as in Maven. The local repository defaults to ~/.m2/repository.
+func Load() (Settings, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return Settings{}, xerrors.Errorf("home directory error: %w", err)
+	}
+
+	var paths []string
+	for _, env := range []string{"M2_HOME", "MAVEN_HOME"} {
+		if dir := os.Getenv(env); dir != "" {
+			paths = append(paths, filepath.Join(dir, "conf", "settings.xml"))
+			break
+		}
+	}
+	paths = append(paths, filepath.Join(home, ".m2", "settings.xml"))
+
+	s := Settings{LocalRepository: filepath.Join(home, ".m2", "repository")}
+	for _, path := range paths {
+		fileSettings, err := parseFile(path)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return Settings{}, xerrors.Errorf("%s: %w", path, err)
+		}
+		s = s.merge(fileSettings)
+	}
+	return s, nil
+}
+
+func parseFile(path string) (Settings, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Settings{}, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse parses settings.xml. ${user.home} and ${env.NAME} in the values are expanded.
+func Parse(r io.Reader) (_ Settings, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var file settingsXML
+	decoder := xml.NewDecoder(utils.NewUTF8Reader(r))
+	decoder.CharsetReader = utils.CharsetReader
+	if err := decoder.Decode(&file); err != nil {
+		return Settings{}, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	s := Settings{LocalRepository: expand(file.LocalRepository)}
+	for _, m := range file.Mirrors {
+		s.Mirrors = append(s.Mirrors, Mirror{
+			ID:       expand(m.ID),
+			Name:     expand(m.Name),
+			URL:      expand(m.URL),
+			MirrorOf: expand(m.MirrorOf),
+		})
+	}
+	for _, p := range file.Proxies {
+		proxy := Proxy{
+			ID:            expand(p.ID),
+			Active:        expand(p.Active) != "false",
+			Protocol:      expand(p.Protocol),
+			Host:          expand(p.Host),
+			Username:      expand(p.Username),
+			Password:      expand(p.Password),
+			NonProxyHosts: expand(p.NonProxyHosts),
+		}
+		if proxy.Protocol == "" {
+			proxy.Protocol = "http"
+		}
+		if port := expand(p.Port); port != "" {
+			if proxy.Port, err = strconv.Atoi(port); err != nil {
+				return Settings{}, xerrors.Errorf("invalid port of proxy %q: %w", proxy.ID, &types.ErrMalformedInput{Err: err})
+			}
+		}
+		s.Proxies = append(s.Proxies, proxy)
+	}
+	for _, srv := range file.Servers {
+		s.Servers = append(s.Servers, Server{
+			ID:       expand(srv.ID),
+			Username: expand(srv.Username),
+			Password: expand(srv.Password),
+		})
+	}
+	return s, nil
+}
+
+func expand(s string) string {
+	s = strings.TrimSpace(s)
+	return propertyRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		name := m[2 : len(m)-1]
+		if name == "user.home" {
+			home, _ := os.UserHomeDir()
+			return home
+		}
+		return os.Getenv(strings.TrimPrefix(name, "env."))
+	})
+}
+
+// merge returns s overridden by the dominant settings. Entries of the same ID are taken from dominant.
+func (s Settings) merge(dominant Settings) Settings {
+	merged := Settings{LocalRepository: s.LocalRepository}
+	if dominant.LocalRepository != "" {
+		merged.LocalRepository = dominant.LocalRepository
+	}
+
+	mirrors := map[string]struct{}{}
+	for _, m := range dominant.Mirrors {
+		mirrors[m.ID] = struct{}{}
+		merged.Mirrors = append(merged.Mirrors, m)
+	}
+	for _, m := range s.Mirrors {
+		if _, ok := mirrors[m.ID]; !ok {
+			merged.Mirrors = append(merged.Mirrors, m)
+		}
+	}
+
+	proxies := map[string]struct{}{}
+	for _, p := range dominant.Proxies {
+		proxies[p.ID] = struct{}{}
+		merged.Proxies = append(merged.Proxies, p)
+	}
+	for _, p := range s.Proxies {
+		if _, ok := proxies[p.ID]; !ok {
+			merged.Proxies = append(merged.Proxies, p)
+		}
+	}
+
+	servers := map[string]struct{}{}
+	for _, srv := range dominant.Servers {
+		servers[srv.ID] = struct{}{}
+		merged.Servers = append(merged.Servers, srv)
+	}
+	for _, srv := range s.Servers {
+		if _, ok := servers[srv.ID]; !ok {
+			merged.Servers = append(merged.Servers, srv)
+		}
+	}
+	return merged
+}
+
+// Mirror returns the mirror of the repository, as Maven picks it.
+// Mirrors of the exact ID take precedence over the patterns, and then the first one is picked.
+func (s Settings) Mirror(repoID string) (Mirror, bool) {
+	for _, m := range s.Mirrors {
+		if m.MirrorOf == repoID {
+			return m, true
+		}
+	}
+	for _, m := range s.Mirrors {
+		if m.matches(repoID) {
+			return m, true
+		}
+	}
+	return Mirror{}, false
+}
+
+// matches tells whether the repository is mirrored. Repositories excluded by "!id" are not,
+// and "external:*" is the same as "*" as only remote repositories are looked up.
+func (m Mirror) matches(repoID string) bool {
+	matched := false
+	for _, pattern := range strings.Split(m.MirrorOf, ",") {
+		switch pattern = strings.TrimSpace(pattern); pattern {
+		case "!" + repoID:
+			return false
+		case "*", "external:*", repoID:
+			matched = true
+		}
+	}
+	return matched
+}
+
+// Server returns the server of the ID.
+func (s Settings) Server(id string) (Server, bool) {
+	for _, srv := range s.Servers {
+		if srv.ID == id {
+			return srv, true
+		}
+	}
+	return Server{}, false
+}
+
+// ActiveProxy returns the first active proxy, which Maven sends the requests through.
+func (s Settings) ActiveProxy() (Proxy, bool) {
+	for _, p := range s.Proxies {
+		if p.Active && p.Host != "" {
+			return p, true
+		}
+	}
+	return Proxy{}, false
+}
+
+// URL returns the URL of the proxy, including the credentials.
+func (p Proxy) URL() *url.URL {
+	u := &url.URL{Scheme: p.Protocol, Host: p.Host}
+	if p.Port > 0 {
+		u.Host = p.Host + ":" + strconv.Itoa(p.Port)
+	}
+	if p.Username != "" {
+		u.User = url.UserPassword(p.Username, p.Password)
+	}
+	return u
+}
+
+// ProxyFunc returns the function for http.Transport.Proxy, which sends the requests through the proxy
+// except for those to NonProxyHosts.
+func (p Proxy) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	u := p.URL()
+	return func(req *http.Request) (*url.URL, error) {
+		if p.bypassed(req.URL.Hostname()) {
+			return nil, nil
+		}
+		return u, nil
+	}
+}
+
+// bypassed tells whether the host matches NonProxyHosts, whose patterns may start or end with "*".
+// Maven also accepts "," as the separator.
+func (p Proxy) bypassed(host string) bool {
+	host = strings.ToLower(host)
+	for _, pattern := range strings.FieldsFunc(p.NonProxyHosts, func(r rune) bool { return r == '|' || r == ',' }) {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		switch {
+		case pattern == "":
+		case pattern == "*" || pattern == host:
+			return true
+		case strings.HasPrefix(pattern, "*") && strings.HasSuffix(host, pattern[1:]):
+			return true
+		case strings.HasSuffix(pattern, "*") && strings.HasPrefix(host, pattern[:len(pattern)-1]):
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package manifest
+
+import (
+	"io"
+
+	"github.com/BurntSushi/toml"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type primitiveManifest struct {
+	JuliaVersion   string `toml:"julia_version"`
+	ManifestFormat string `toml:"manifest_format"`
+}
+
+// Manifest format 2.0, generated by Julia 1.7 and later
+type manifestV2 struct {
+	JuliaVersion string                       `toml:"julia_version"`
+	Deps         map[string][]manifestPackage `toml:"deps"`
+}
+
+type manifestPackage struct {
+	UUID        string   `toml:"uuid"`
+	Version     string   `toml:"version"`
+	GitTreeSHA1 string   `toml:"git-tree-sha1"`
+	Deps        []string `toml:"deps"`
+}
+
+// Parser implements types.Parser for Manifest.toml
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Manifest.toml
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	var primitive primitiveManifest
+	if _, err = toml.Decode(string(b), &primitive); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var juliaVersion string
+	var deps map[string][]manifestPackage
+	switch primitive.ManifestFormat {
+	case "":
+		// Before Julia 1.7, packages are stored at the top level
+		if _, err = toml.Decode(string(b), &deps); err != nil {
+			return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+	case "2.0":
+		var man manifestV2
+		if _, err = toml.Decode(string(b), &man); err != nil {
+			return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		juliaVersion = man.JuliaVersion
+		deps = man.Deps
+	default:
+		return nil, &types.ErrUnsupportedLockfileVersion{File: "manifest format", Version: primitive.ManifestFormat}
+	}
+
+	var libs []types.Library
+	for name, pkgs := range deps {
+		// The same name can be used by multiple packages with different UUIDs
+		for _, pkg := range pkgs {
+			version := pkg.Version
+			if version == "" {
+				// Standard libraries are versioned with Julia itself
+				version = juliaVersion
+			}
+			if version == "" {
+				continue
+			}
+
+			var digest string
+			if pkg.GitTreeSHA1 != "" {
+				digest = "sha1:" + pkg.GitTreeSHA1
+			}
+
+			libs = append(libs, types.Library{
+				ID:      pkg.UUID,
+				Name:    name,
+				Version: version,
+				Digest:  digest,
+			})
+		}
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
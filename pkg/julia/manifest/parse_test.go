@@ -0,0 +1,51 @@
+package manifest
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/Manifest_v1.6.toml",
+			want: manifestV16,
+		},
+		{
+			file: "testdata/Manifest_v2.0.toml",
+			want: manifestV20,
+		},
+		{
+			file:    "testdata/Manifest_unsupported.toml",
+			wantErr: "unsupported manifest format",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
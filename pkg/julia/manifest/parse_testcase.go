@@ -0,0 +1,22 @@
+package manifest
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name julia --rm -it julia:1.6 bash
+	// julia -e 'using Pkg; Pkg.activate("."); Pkg.add("JSON"); Pkg.add("JLLWrappers")'
+	manifestV16 = []types.Library{
+		{ID: "692b3bcd-3c85-4b1f-b108-f13ce0eb3210", Name: "JLLWrappers", Version: "1.3.0", Digest: "sha1:642a199af8b68253517b80bd3bfd17eb4e84df6e"},
+		{ID: "682c06a0-de6a-54ab-a142-c8b1cf79cde6", Name: "JSON", Version: "0.21.3", Digest: "sha1:3c837543ddb02250ef42f4738347454f95079d4e"},
+		{ID: "69de0a69-1ddd-5017-9359-2bf0b02dc9f0", Name: "Parsers", Version: "2.3.2", Digest: "sha1:0044b23da09b5608b4ecacb4e5e6c6332f833a7e"},
+		{ID: "21216c6a-2e73-6563-6e65-726566657250", Name: "Preferences", Version: "1.3.0", Digest: "sha1:47e5f437cc0e7ef2ce8406ce1e7e24d44915f88d"},
+	}
+
+	// docker run --name julia --rm -it julia:1.8.5 bash
+	// julia -e 'using Pkg; Pkg.activate("."); Pkg.add("JSON")'
+	manifestV20 = []types.Library{
+		{ID: "ade2ca70-3891-5945-98fb-dc099432e06a", Name: "Dates", Version: "1.8.5"},
+		{ID: "682c06a0-de6a-54ab-a142-c8b1cf79cde6", Name: "JSON", Version: "0.21.3", Digest: "sha1:3c837543ddb02250ef42f4738347454f95079d4e"},
+		{ID: "69de0a69-1ddd-5017-9359-2bf0b02dc9f0", Name: "Parsers", Version: "2.5.2", Digest: "sha1:6466e524967496866901a78fca3f2e9ea445a559"},
+	}
+)
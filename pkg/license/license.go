@@ -0,0 +1,390 @@
+// Package license normalizes the license names written in manifests to SPDX license expressions.
+// See https://spdx.org/licenses/ and https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+//
+// e.g.
+//
+//	expr, confidence := license.Normalize("Apache License, Version 2.0") // Apache-2.0, 0.95
+//	expr, confidence = license.Normalize("GPLv2+")                        // GPL-2.0-or-later, 0.95
+package license
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MinConfidence is the confidence NormalizeAll requires to replace the license names.
+const MinConfidence = 0.9
+
+// SPDX license identifiers commonly found in packages
+var ids = []string{
+	"0BSD", "AFL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later", "Apache-1.1", "Apache-2.0", "APSL-2.0",
+	"Artistic-1.0", "Artistic-2.0", "BlueOak-1.0.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-3-Clause",
+	"BSD-3-Clause-Clear", "BSD-4-Clause", "BSL-1.0", "CC-BY-3.0", "CC-BY-4.0", "CC-BY-SA-3.0", "CC-BY-SA-4.0",
+	"CC0-1.0", "CDDL-1.0", "CDDL-1.1", "CPL-1.0", "EPL-1.0", "EPL-2.0", "EUPL-1.1", "EUPL-1.2",
+	"GPL-1.0-only", "GPL-1.0-or-later", "GPL-2.0-only", "GPL-2.0-or-later", "GPL-3.0-only", "GPL-3.0-or-later",
+	"ISC", "LGPL-2.0-only", "LGPL-2.0-or-later", "LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0-only",
+	"LGPL-3.0-or-later", "MIT", "MIT-0", "MPL-1.0", "MPL-1.1", "MPL-2.0", "MS-PL", "MS-RL", "NCSA", "ODbL-1.0",
+	"OFL-1.1", "OpenSSL", "PHP-3.0", "PHP-3.01", "PostgreSQL", "PSF-2.0", "Python-2.0", "Ruby",
+	"Unicode-DFS-2016", "Unlicense", "UPL-1.0", "Vim", "W3C", "WTFPL", "X11", "Zlib", "ZPL-2.1",
+}
+
+// SPDX license exceptions used with WITH
+var exceptions = []string{
+	"Classpath-exception-2.0", "GCC-exception-3.1", "LLVM-exception", "OpenJDK-assembly-exception-1.0",
+}
+
+// Deprecated identifiers of the GNU licenses are replaced with the current ones
+var deprecated = map[string]string{
+	"GPL-1.0": "GPL-1.0-only", "GPL-1.0+": "GPL-1.0-or-later",
+	"GPL-2.0": "GPL-2.0-only", "GPL-2.0+": "GPL-2.0-or-later",
+	"GPL-3.0": "GPL-3.0-only", "GPL-3.0+": "GPL-3.0-or-later",
+	"LGPL-2.0": "LGPL-2.0-only", "LGPL-2.0+": "LGPL-2.0-or-later",
+	"LGPL-2.1": "LGPL-2.1-only", "LGPL-2.1+": "LGPL-2.1-or-later",
+	"LGPL-3.0": "LGPL-3.0-only", "LGPL-3.0+": "LGPL-3.0-or-later",
+	"AGPL-3.0": "AGPL-3.0-only", "AGPL-3.0+": "AGPL-3.0-or-later",
+}
+
+type alias struct {
+	name       string
+	id         string
+	confidence float64
+}
+
+// Names written instead of the identifiers.
+// Those not telling the version or the variant have lower confidence.
+var aliases = []alias{
+	{"MIT License", "MIT", 0.95},
+	{"MIT/X11", "MIT", 0.9},
+	{"Expat", "MIT", 0.9},
+	{"Apache 2", "Apache-2.0", 0.95},
+	{"Apache License 2.0", "Apache-2.0", 0.95},
+	{"Apache Software License 2.0", "Apache-2.0", 0.95},
+	{"ASL 2.0", "Apache-2.0", 0.95},
+	{"AL2", "Apache-2.0", 0.9},
+	{"Apache", "Apache-2.0", 0.7},
+	{"Apache Software License", "Apache-2.0", 0.7},
+	{"BSD", "BSD-3-Clause", 0.5},
+	{"BSD style", "BSD-3-Clause", 0.5},
+	{"BSD like", "BSD-3-Clause", 0.5},
+	{"BSDL", "BSD-2-Clause", 0.8},
+	{"New BSD", "BSD-3-Clause", 0.95},
+	{"Modified BSD", "BSD-3-Clause", 0.95},
+	{"Revised BSD", "BSD-3-Clause", 0.95},
+	{"BSD 3", "BSD-3-Clause", 0.95},
+	{"3-Clause BSD", "BSD-3-Clause", 0.95},
+	{"Simplified BSD", "BSD-2-Clause", 0.95},
+	{"FreeBSD", "BSD-2-Clause", 0.9},
+	{"BSD 2", "BSD-2-Clause", 0.95},
+	{"2-Clause BSD", "BSD-2-Clause", 0.95},
+	{"Eclipse Distribution License 1.0", "BSD-3-Clause", 0.9},
+	{"GPL", "GPL-1.0-or-later", 0.5},
+	{"GPL 1", "GPL-1.0-only", 0.9},
+	{"GPL 2", "GPL-2.0-only", 0.9},
+	{"GPL 3", "GPL-3.0-only", 0.9},
+	{"GPL 1+", "GPL-1.0-or-later", 0.95},
+	{"GPL 2+", "GPL-2.0-or-later", 0.95},
+	{"GPL 3+", "GPL-3.0-or-later", 0.95},
+	{"LGPL", "LGPL-2.0-or-later", 0.5},
+	{"LGPL 2", "LGPL-2.0-only", 0.9},
+	{"LGPL 2.1", "LGPL-2.1-only", 0.9},
+	{"LGPL 3", "LGPL-3.0-only", 0.9},
+	{"LGPL 2+", "LGPL-2.0-or-later", 0.95},
+	{"LGPL 2.1+", "LGPL-2.1-or-later", 0.95},
+	{"LGPL 3+", "LGPL-3.0-or-later", 0.95},
+	{"AGPL", "AGPL-3.0-or-later", 0.6},
+	{"AGPL 3", "AGPL-3.0-only", 0.9},
+	{"AGPL 3+", "AGPL-3.0-or-later", 0.95},
+	{"Mozilla Public License 1.1", "MPL-1.1", 0.95},
+	{"Mozilla Public License 2.0", "MPL-2.0", 0.95},
+	{"MPL", "MPL-2.0", 0.6},
+	{"Eclipse Public License 1.0", "EPL-1.0", 0.95},
+	{"Eclipse Public License 2.0", "EPL-2.0", 0.95},
+	{"EPL", "EPL-1.0", 0.6},
+	{"Common Development and Distribution License 1.0", "CDDL-1.0", 0.95},
+	{"Common Development and Distribution License 1.1", "CDDL-1.1", 0.95},
+	{"CDDL", "CDDL-1.0", 0.7},
+	{"Common Public License 1.0", "CPL-1.0", 0.95},
+	{"Boost Software License 1.0", "BSL-1.0", 0.95},
+	{"Boost", "BSL-1.0", 0.8},
+	{"Artistic", "Artistic-2.0", 0.6},
+	{"Artistic License 2.0", "Artistic-2.0", 0.95},
+	{"Python Software Foundation License", "PSF-2.0", 0.9},
+	{"PSF", "PSF-2.0", 0.8},
+	{"PSFL", "PSF-2.0", 0.8},
+	{"ISC License", "ISC", 0.95},
+	{"CC0", "CC0-1.0", 0.9},
+	{"Public Domain CC0", "CC0-1.0", 0.9},
+	{"Zlib/libpng", "Zlib", 0.8},
+	{"Universal Permissive License 1.0", "UPL-1.0", 0.95},
+	{"Do What The F*ck You Want To Public License", "WTFPL", 0.95},
+	{"PostgreSQL License", "PostgreSQL", 0.95},
+	{"Ruby License", "Ruby", 0.95},
+}
+
+// License URLs often written as the name. e.g. the url of pom.xml
+var urls = []alias{
+	{"apache.org/licenses/license-2.0", "Apache-2.0", 0.95},
+	{"opensource.org/licenses/mit", "MIT", 0.95},
+	{"opensource.org/licenses/apache-2.0", "Apache-2.0", 0.95},
+	{"opensource.org/licenses/bsd-3-clause", "BSD-3-Clause", 0.95},
+	{"opensource.org/licenses/bsd-2-clause", "BSD-2-Clause", 0.95},
+	{"opensource.org/licenses/isc", "ISC", 0.95},
+	{"gnu.org/licenses/gpl-2.0", "GPL-2.0-only", 0.9},
+	{"gnu.org/licenses/gpl-3.0", "GPL-3.0-only", 0.9},
+	{"gnu.org/licenses/lgpl-2.1", "LGPL-2.1-only", 0.9},
+	{"gnu.org/licenses/lgpl-3.0", "LGPL-3.0-only", 0.9},
+	{"mozilla.org/mpl/2.0", "MPL-2.0", 0.95},
+	{"eclipse.org/legal/epl-v10", "EPL-1.0", 0.95},
+	{"eclipse.org/legal/epl-2.0", "EPL-2.0", 0.95},
+}
+
+var (
+	// canonical ID of the lowercased one, including deprecated and exceptions
+	idsByLower = map[string]string{}
+	// alias of the canonical name
+	aliasesByName = map[string]alias{}
+
+	// e.g. GPLv2 => GPL 2
+	versionRegexp = regexp.MustCompile(`([a-z])v?(\d)`)
+	// e.g. "GPL 2.0 or later", "LGPL-2.1-or-later" and "GPL version 2, or (at your option) any later version"
+	orLaterRegexp = regexp.MustCompile(`[\s,-]*(\(at your option\)\s*)?or[\s-]+(\(at your option\)\s*)?(any[\s-]+)?later(\s+version)?`)
+	// GNU licenses are written in many ways
+	gnuReplacer = strings.NewReplacer(
+		"gnu general public", "gpl",
+		"gnu lesser general public", "lgpl",
+		"gnu library general public", "lgpl",
+		"gnu affero general public", "agpl",
+		"gnu gpl", "gpl",
+		"gnu lgpl", "lgpl",
+		"gnu agpl", "agpl",
+	)
+	// e.g. "Apache Software License (Apache-2.0)"
+	abbreviationRegexp = regexp.MustCompile(`^(.+?)\s*\(([^()]+)\)$`)
+	// e.g. MIT AND (Apache-2.0 OR BSD-3-Clause), GPL-2.0-only WITH Classpath-exception-2.0
+	operatorRegexp = regexp.MustCompile(`(?i)\s+(AND|OR|WITH)\s+`)
+)
+
+func init() {
+	for _, id := range append(ids, exceptions...) {
+		idsByLower[strings.ToLower(id)] = id
+	}
+	for old, id := range deprecated {
+		idsByLower[strings.ToLower(old)] = id
+	}
+
+	// Identifiers written in other ways. e.g. "Apache 2.0" and "BSD 3-Clause"
+	for _, id := range ids {
+		aliasesByName[canonical(id)] = alias{name: id, id: id, confidence: MinConfidence}
+	}
+	for _, a := range aliases {
+		aliasesByName[canonical(a.name)] = a
+	}
+}
+
+// Normalize returns the SPDX license expression of the license name, and the confidence between 0 and 1.
+// Identifiers and expressions written correctly have the confidence of 1.
+// It returns an empty string and 0 when the name is not recognized.
+func Normalize(name string) (string, float64) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", 0
+	}
+
+	// Python classifiers. e.g. License :: OSI Approved :: MIT License
+	if i := strings.LastIndex(name, "::"); i != -1 {
+		name = strings.TrimSpace(name[i+2:])
+	}
+
+	if id, confidence := normalizeName(name); id != "" {
+		return id, confidence
+	}
+	if expr, confidence := normalizeExpression(name); expr != "" {
+		return expr, confidence
+	}
+
+	// Dual licenses. e.g. MIT/Apache-2.0
+	if strings.Contains(name, "/") {
+		var operands []string
+		for _, s := range strings.Split(name, "/") {
+			id, ok := lookupID(strings.TrimSpace(s))
+			if !ok {
+				return "", 0
+			}
+			operands = append(operands, id)
+		}
+		return strings.Join(operands, " OR "), MinConfidence
+	}
+	return "", 0
+}
+
+// NormalizeAll replaces the license names with the SPDX license expressions
+// if they are recognized with MinConfidence, and keeps them as they are otherwise.
+func NormalizeAll(names []string) []string {
+	if names == nil {
+		return nil
+	}
+	normalized := make([]string, 0, len(names))
+	for _, name := range names {
+		if expr, confidence := Normalize(name); confidence >= MinConfidence {
+			name = expr
+		}
+		normalized = append(normalized, name)
+	}
+	return normalized
+}
+
+// normalizeName returns the identifier of a single license.
+func normalizeName(name string) (string, float64) {
+	if id, ok := lookupID(name); ok {
+		return id, 1
+	}
+	if strings.Contains(name, "://") {
+		return lookupURL(name)
+	}
+	if a, ok := aliasesByName[canonical(name)]; ok {
+		return a.id, a.confidence
+	}
+
+	// Names followed by the abbreviations. e.g. GNU General Public License v2 or later (GPLv2+)
+	if m := abbreviationRegexp.FindStringSubmatch(name); m != nil {
+		id, confidence := normalizeName(m[1])
+		if abbrID, abbrConfidence := normalizeName(m[2]); abbrConfidence > confidence {
+			id, confidence = abbrID, abbrConfidence
+		}
+		return id, confidence
+	}
+	return "", 0
+}
+
+// lookupID returns the canonical identifier, case-insensitively.
+// The "+" operator is allowed for licenses other than the GNU ones. e.g. MPL-1.1+
+func lookupID(s string) (string, bool) {
+	lower := strings.ToLower(s)
+	if id, ok := idsByLower[lower]; ok {
+		return id, true
+	}
+	if strings.HasSuffix(lower, "+") {
+		if id, ok := idsByLower[strings.TrimSuffix(lower, "+")]; ok && !strings.HasSuffix(id, "-only") {
+			return id + "+", true
+		}
+	}
+	return "", false
+}
+
+func lookupURL(s string) (string, float64) {
+	lower := strings.ToLower(s)
+	for _, u := range urls {
+		if strings.Contains(lower, u.name) {
+			return u.id, u.confidence
+		}
+	}
+	return "", 0
+}
+
+// normalizeExpression normalizes the operands of the expression,
+// and returns the lowest confidence of them.
+func normalizeExpression(s string) (string, float64) {
+	if !operatorRegexp.MatchString(s) && !strings.ContainsAny(s, "()") {
+		return "", 0
+	}
+
+	// Parentheses are separated from the operands
+	s = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(s)
+	s = operatorRegexp.ReplaceAllStringFunc(s, func(op string) string {
+		return "\x00" + strings.ToUpper(strings.TrimSpace(op)) + "\x00"
+	})
+
+	confidence := 1.0
+	var sb strings.Builder
+	var afterWith bool
+	for _, token := range strings.Split(s, "\x00") {
+		for _, t := range []string{"AND", "OR", "WITH"} {
+			if token == t {
+				sb.WriteString(" " + t + " ")
+				afterWith = t == "WITH"
+				token = ""
+			}
+		}
+		if token == "" {
+			continue
+		}
+
+		// Operands are surrounded by parentheses. e.g. " ( MIT"
+		trimmed := strings.TrimSpace(strings.Trim(token, " ()"))
+		if trimmed == "" {
+			return "", 0
+		}
+		var expr string
+		var c float64
+		if afterWith {
+			expr, c = lookupException(trimmed)
+		} else {
+			expr, c = normalizeName(trimmed)
+		}
+		if expr == "" {
+			return "", 0
+		}
+		if c < confidence {
+			confidence = c
+		}
+		start := strings.Index(token, trimmed)
+		sb.WriteString(strings.ReplaceAll(token[:start], " ", ""))
+		sb.WriteString(expr)
+		sb.WriteString(strings.ReplaceAll(token[start+len(trimmed):], " ", ""))
+	}
+	return sb.String(), confidence
+}
+
+func lookupException(s string) (string, float64) {
+	if id, ok := idsByLower[strings.ToLower(s)]; ok {
+		return id, 1
+	}
+	if strings.EqualFold(s, "Classpath exception") {
+		return "Classpath-exception-2.0", MinConfidence
+	}
+	return "", 0
+}
+
+// canonical returns the lowercased words of the name without the noise.
+// e.g. "The Apache License, Version 2.0" => "apache 2", "GPLv2 or later" => "gpl 2+"
+func canonical(name string) string {
+	s := strings.ToLower(name)
+	s = strings.ReplaceAll(s, "licence", "license")
+	s = orLaterRegexp.ReplaceAllString(s, "+")
+	s = gnuReplacer.Replace(s)
+	s = versionRegexp.ReplaceAllString(s, "$1 $2")
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case 'a' <= r && r <= 'z', '0' <= r && r <= '9', r == '+', r == '.', r == '*':
+			return r
+		}
+		return ' '
+	}, s)
+
+	var words []string
+	for _, w := range strings.Fields(s) {
+		w = strings.Trim(w, ".")
+		switch w {
+		case "", "the", "license", "version", "v", "only", "clause":
+			continue
+		}
+		words = append(words, trimVersion(w))
+	}
+	return gnuReplacer.Replace(strings.Join(words, " "))
+}
+
+// trimVersion removes the trailing zeros of versions. e.g. 2.0 => 2, 2.0+ => 2+
+func trimVersion(w string) string {
+	if w[0] < '0' || w[0] > '9' {
+		return w
+	}
+	plus := strings.HasSuffix(w, "+")
+	w = strings.TrimSuffix(w, "+")
+	for strings.HasSuffix(w, ".0") {
+		w = strings.TrimSuffix(w, ".0")
+	}
+	if plus {
+		w += "+"
+	}
+	return w
+}
@@ -0,0 +1,53 @@
+package license_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name           string
+		want           string
+		wantConfidence float64
+	}{
+		{name: "MIT", want: "MIT", wantConfidence: 1},
+		{name: "apache-2.0", want: "Apache-2.0", wantConfidence: 1},
+		{name: "GPL-2.0", want: "GPL-2.0-only", wantConfidence: 1},
+		{name: "LGPL-2.1+", want: "LGPL-2.1-or-later", wantConfidence: 1},
+		{name: "MPL-1.1+", want: "MPL-1.1+", wantConfidence: 1},
+		{name: "Apache 2", want: "Apache-2.0", wantConfidence: 0.95},
+		{name: "The Apache Software License, Version 2.0", want: "Apache-2.0", wantConfidence: 0.95},
+		{name: "BSD 3-Clause", want: "BSD-3-Clause", wantConfidence: 0.95},
+		{name: "BSD style", want: "BSD-3-Clause", wantConfidence: 0.5},
+		{name: "GPLv2+", want: "GPL-2.0-or-later", wantConfidence: 0.95},
+		{name: "GNU General Public License v2 or later (GPLv2+)", want: "GPL-2.0-or-later", wantConfidence: 0.95},
+		{name: "GPL version 2, or (at your option) any later version", want: "GPL-2.0-or-later", wantConfidence: 0.95},
+		{name: "Eclipse Public Licence - v 1.0", want: "EPL-1.0", wantConfidence: 0.95},
+		{name: "License :: OSI Approved :: MIT License", want: "MIT", wantConfidence: 0.95},
+		{name: "http://www.apache.org/licenses/LICENSE-2.0.txt", want: "Apache-2.0", wantConfidence: 0.95},
+		{name: "(MIT AND BSD-3-Clause) or GPL-2.0+", want: "(MIT AND BSD-3-Clause) OR GPL-2.0-or-later", wantConfidence: 1},
+		{name: "GPL-2.0 with Classpath-exception-2.0", want: "GPL-2.0-only WITH Classpath-exception-2.0", wantConfidence: 1},
+		{name: "MIT or Apache 2", want: "MIT OR Apache-2.0", wantConfidence: 0.95},
+		{name: "MIT/Apache-2.0", want: "MIT OR Apache-2.0", wantConfidence: 0.9},
+		{name: "Proprietary"},
+		{name: "MIT AND Proprietary"},
+		{name: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, confidence := license.Normalize(tt.name)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantConfidence, confidence)
+		})
+	}
+}
+
+func TestNormalizeAll(t *testing.T) {
+	got := license.NormalizeAll([]string{"MIT License", "BSD", "Proprietary"})
+	assert.Equal(t, []string{"MIT", "BSD", "Proprietary"}, got)
+	assert.Nil(t, license.NormalizeAll(nil))
+}
@@ -0,0 +1,73 @@
+package luarocks
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// Lua comments, e.g. -- this is a comment
+	commentRegexp = regexp.MustCompile(`--[^\n]*`)
+
+	// The start of the dependencies table, e.g. dependencies = {
+	dependenciesRegexp = regexp.MustCompile(`dependencies\s*=\s*\{`)
+
+	// A pinned dependency
+	// e.g. ["luafilesystem"] = "1.8.0-1"
+	//      penlight = "1.13.1-1"
+	pinRegexp = regexp.MustCompile(`(?:\[\s*["']([^"']+)["']\s*\]|([A-Za-z_][A-Za-z0-9_]*))\s*=\s*["']([^"']+)["']`)
+)
+
+// Parser implements types.Parser for luarocks.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses luarocks.lock
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+	content := commentRegexp.ReplaceAllString(string(b), "")
+
+	loc := dependenciesRegexp.FindStringIndex(content)
+	if loc == nil {
+		return nil, &types.ErrMalformedInput{Err: xerrors.New("no dependencies table found")}
+	}
+	content = content[loc[1]:]
+
+	end := strings.Index(content, "}")
+	if end == -1 {
+		return nil, &types.ErrMalformedInput{Err: xerrors.New("unterminated dependencies table")}
+	}
+
+	var libs []types.Library
+	for _, m := range pinRegexp.FindAllStringSubmatch(content[:end], -1) {
+		name := m[1] + m[2]
+		if name == "lua" {
+			// "lua" is the interpreter itself
+			continue
+		}
+		libs = append(libs, types.Library{
+			Name:    name,
+			Version: m[3],
+		})
+	}
+	return libs, nil
+}
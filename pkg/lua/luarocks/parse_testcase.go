@@ -0,0 +1,13 @@
+package luarocks
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name luarocks --rm -it nickblah/luarocks:3.9-lua5.4 sh
+	// luarocks init && luarocks install --pin --deps-mode=one normal-1.0-1.rockspec
+	luarocksNormal = []types.Library{
+		{Name: "luafilesystem", Version: "1.8.0-1"},
+		{Name: "lpeg", Version: "1.0.2-1"},
+		{Name: "penlight", Version: "1.13.1-1"},
+	}
+)
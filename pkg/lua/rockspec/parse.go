@@ -0,0 +1,84 @@
+package rockspec
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// Lua comments, e.g. -- this is a comment
+	commentRegexp = regexp.MustCompile(`--[^\n]*`)
+
+	// The start of the top-level dependencies table.
+	// build_dependencies and test_dependencies are not runtime dependencies.
+	// e.g. dependencies = {
+	dependenciesRegexp = regexp.MustCompile(`(?m)^\s*dependencies\s*=\s*\{`)
+
+	// A quoted string, e.g. "luafilesystem >= 1.6.3"
+	stringRegexp = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+)
+
+// Parser implements types.Parser for *.rockspec
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses *.rockspec
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+	content := commentRegexp.ReplaceAllString(string(b), "")
+
+	loc := dependenciesRegexp.FindStringIndex(content)
+	if loc == nil {
+		return nil, nil
+	}
+	content = content[loc[1]:]
+
+	end := strings.Index(content, "}")
+	if end == -1 {
+		return nil, &types.ErrMalformedInput{Err: xerrors.New("unterminated dependencies table")}
+	}
+
+	var libs []types.Library
+	for _, m := range stringRegexp.FindAllStringSubmatch(content[:end], -1) {
+		dep := m[1] + m[2]
+
+		// e.g. "luafilesystem >= 1.6.3, < 2.0" => "luafilesystem", ">= 1.6.3, < 2.0"
+		ss := strings.SplitN(strings.TrimSpace(dep), " ", 2)
+		name := ss[0]
+		if name == "" || name == "lua" {
+			// "lua" is the interpreter itself
+			continue
+		}
+
+		var constraint string
+		if len(ss) == 2 {
+			constraint = strings.TrimSpace(ss[1])
+		}
+
+		libs = append(libs, types.Library{
+			Name:       name,
+			Version:    constraint,
+			Constraint: constraint,
+		})
+	}
+	return libs, nil
+}
@@ -0,0 +1,11 @@
+package rockspec
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	rockspecNormal = []types.Library{
+		{Name: "luafilesystem", Version: ">= 1.6.3", Constraint: ">= 1.6.3"},
+		{Name: "penlight", Version: "~> 1.13", Constraint: "~> 1.13"},
+		{Name: "lpeg"},
+	}
+)
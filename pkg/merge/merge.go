@@ -0,0 +1,278 @@
+// Package merge combines the results of parsing several files into one,
+// such as package.json of each workspace and the lock file of a monorepo.
+package merge
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Result is what a parser returned for a file.
+type Result struct {
+	FilePath     string
+	Libraries    []types.Library
+	Dependencies []types.Dependency
+}
+
+// Policy decides which versions are kept when the same package is found with different versions.
+type Policy int
+
+const (
+	// KeepAll keeps every version.
+	KeepAll Policy = iota
+	// KeepFirst keeps the version found first.
+	KeepFirst
+	// KeepHighest keeps the highest version, according to the comparison given by WithCompare.
+	KeepHighest
+)
+
+type options struct {
+	policy  Policy
+	compare func(v1, v2 string) int
+}
+
+type Option func(*options)
+
+// WithPolicy sets the policy for packages with different versions. The default is KeepAll.
+func WithPolicy(p Policy) Option {
+	return func(o *options) {
+		o.policy = p
+	}
+}
+
+// WithCompare sets the comparison of versions used by KeepHighest.
+// It returns a negative number when v1 < v2, 0 when v1 == v2 and a positive number when v1 > v2.
+// The default compares the numbers in versions numerically and the rest lexically,
+// which is good enough for most ecosystems but doesn't know about pre-releases.
+func WithCompare(compare func(v1, v2 string) int) Option {
+	return func(o *options) {
+		o.compare = compare
+	}
+}
+
+// Merge merges the results in order.
+//
+// Libraries with the same name and version are merged into the first one:
+//   - Locations are combined, with FilePath telling which file they are in
+//   - Licenses are combined
+//   - Indirect is false if the library is direct in any file
+//   - Root is true if the library is the root in any file
+//   - ID, PURL, Constraint, Qualifiers, Scope, ExternalReferences, Digest and FilePath are taken from the first library having them
+//
+// Libraries without FilePath get the one of their result.
+// The dependency graphs are combined, and edges from and to the versions dropped by the policy are removed.
+func Merge(results []Result, opts ...Option) ([]types.Library, []types.Dependency) {
+	o := options{
+		policy:  KeepAll,
+		compare: CompareVersions,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	type key struct {
+		name, version string
+	}
+	var libs []types.Library
+	index := map[key]int{}
+	for _, result := range results {
+		for _, lib := range result.Libraries {
+			if lib.FilePath == "" {
+				lib.FilePath = result.FilePath
+			}
+			lib.Locations = withFilePath(lib.Locations, lib.FilePath)
+
+			k := key{name: lib.Name, version: lib.Version}
+			if i, ok := index[k]; ok {
+				libs[i] = mergeLibrary(libs[i], lib)
+				continue
+			}
+			index[k] = len(libs)
+			libs = append(libs, lib)
+		}
+	}
+
+	libs, dropped := applyPolicy(libs, o)
+	return libs, mergeDependencies(results, dropped)
+}
+
+func withFilePath(locs []types.Location, filePath string) []types.Location {
+	if len(locs) == 0 {
+		return nil
+	}
+	merged := make([]types.Location, len(locs))
+	for i, loc := range locs {
+		if loc.FilePath == "" {
+			loc.FilePath = filePath
+		}
+		merged[i] = loc
+	}
+	return merged
+}
+
+func mergeLibrary(dst, src types.Library) types.Library {
+	if dst.ID == "" {
+		dst.ID = src.ID
+	}
+	if dst.PURL == "" {
+		dst.PURL = src.PURL
+	}
+	if dst.Constraint == "" {
+		dst.Constraint = src.Constraint
+	}
+	if len(dst.Qualifiers) == 0 {
+		dst.Qualifiers = src.Qualifiers
+	}
+	if dst.Scope == "" {
+		dst.Scope = src.Scope
+	}
+	if len(dst.ExternalReferences) == 0 {
+		dst.ExternalReferences = src.ExternalReferences
+	}
+	if dst.Digest == "" {
+		dst.Digest = src.Digest
+	}
+	if dst.FilePath == "" {
+		dst.FilePath = src.FilePath
+	}
+	dst.Indirect = dst.Indirect && src.Indirect
+	dst.Root = dst.Root || src.Root
+
+	licenses := append([]string{}, dst.Licenses...)
+	for _, l := range src.Licenses {
+		if !contains(licenses, l) {
+			licenses = append(licenses, l)
+		}
+	}
+	if len(licenses) > 0 {
+		dst.SetLicenses(licenses)
+	}
+
+	if len(src.Locations) > 0 {
+		dst.Locations = append(append([]types.Location{}, dst.Locations...), src.Locations...)
+	}
+	return dst
+}
+
+// applyPolicy returns the kept libraries in order, and the IDs of the dropped ones.
+func applyPolicy(libs []types.Library, o options) ([]types.Library, map[string]bool) {
+	if o.policy == KeepAll {
+		return libs, nil
+	}
+
+	// The index of the library kept for each name
+	kept := map[string]int{}
+	for i, lib := range libs {
+		j, ok := kept[lib.Name]
+		if !ok || (o.policy == KeepHighest && o.compare(lib.Version, libs[j].Version) > 0) {
+			kept[lib.Name] = i
+		}
+	}
+
+	var merged []types.Library
+	dropped := map[string]bool{}
+	for i, lib := range libs {
+		if kept[lib.Name] == i {
+			merged = append(merged, lib)
+		} else if lib.ID != "" {
+			dropped[lib.ID] = true
+		}
+	}
+	return merged, dropped
+}
+
+func mergeDependencies(results []Result, dropped map[string]bool) []types.Dependency {
+	var deps []types.Dependency
+	index := map[string]int{}
+	for _, result := range results {
+		for _, dep := range result.Dependencies {
+			if dropped[dep.ID] {
+				continue
+			}
+			i, ok := index[dep.ID]
+			if !ok {
+				i = len(deps)
+				index[dep.ID] = i
+				deps = append(deps, types.Dependency{ID: dep.ID})
+			}
+			for _, id := range dep.DependsOn {
+				if !dropped[id] && !contains(deps[i].DependsOn, id) {
+					deps[i].DependsOn = append(deps[i].DependsOn, id)
+				}
+			}
+		}
+	}
+	for i := range deps {
+		sort.Strings(deps[i].DependsOn)
+	}
+	return deps
+}
+
+// CompareVersions compares the numbers in versions numerically and the rest lexically, which is the default of WithCompare.
+// e.g. 1.10.0 > 1.9.0
+func CompareVersions(v1, v2 string) int {
+	p1, p2 := splitVersion(v1), splitVersion(v2)
+	for i := 0; i < len(p1) && i < len(p2); i++ {
+		n1, err1 := strconv.ParseUint(p1[i], 10, 64)
+		n2, err2 := strconv.ParseUint(p2[i], 10, 64)
+		switch {
+		case err1 == nil && err2 == nil:
+			if n1 != n2 {
+				if n1 < n2 {
+					return -1
+				}
+				return 1
+			}
+		case p1[i] != p2[i]:
+			return strings.Compare(p1[i], p2[i])
+		}
+	}
+	return len(p1) - len(p2)
+}
+
+// splitVersion splits the version into runs of digits and the others, dropping separators.
+// e.g. 1.2.0-rc1 => 1, 2, 0, rc, 1
+func splitVersion(v string) []string {
+	var parts []string
+	var current []rune
+	var digits bool
+	flush := func() {
+		if len(current) > 0 {
+			parts = append(parts, string(current))
+			current = nil
+		}
+	}
+	for _, r := range v {
+		switch {
+		case unicode.IsDigit(r):
+			if !digits {
+				flush()
+			}
+			digits = true
+			current = append(current, r)
+		case unicode.IsLetter(r):
+			if digits {
+				flush()
+			}
+			digits = false
+			current = append(current, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return parts
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package merge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestMerge(t *testing.T) {
+	results := []Result{
+		{
+			FilePath: "package-lock.json",
+			Libraries: []types.Library{
+				{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Indirect: true, Locations: []types.Location{{StartLine: 10, EndLine: 15}}},
+				{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Indirect: true},
+				{ID: "express@4.18.1", Name: "express", Version: "4.18.1", Digest: "sha1:3f3ad2c6e4b2c8ba1d9b0e2b3c35d0c1f2b0a1c2"},
+			},
+			Dependencies: []types.Dependency{
+				{ID: "express@4.18.1", DependsOn: []string{"debug@2.6.9"}},
+				{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+			},
+		},
+		{
+			FilePath: "packages/app/package-lock.json",
+			Libraries: []types.Library{
+				{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Licenses: []string{"MIT"}, License: "MIT", Locations: []types.Location{{StartLine: 3, EndLine: 8}}},
+				{ID: "ms@2.1.3", Name: "ms", Version: "2.1.3", Indirect: true},
+			},
+			Dependencies: []types.Dependency{
+				{ID: "debug@2.6.9", DependsOn: []string{"ms@2.1.3"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		want     []types.Library
+		wantDeps []types.Dependency
+	}{
+		{
+			name: "keep all",
+			want: []types.Library{
+				{
+					ID:       "debug@2.6.9",
+					Name:     "debug",
+					Version:  "2.6.9",
+					Licenses: []string{"MIT"},
+					License:  "MIT",
+					FilePath: "package-lock.json",
+					Locations: []types.Location{
+						{FilePath: "package-lock.json", StartLine: 10, EndLine: 15},
+						{FilePath: "packages/app/package-lock.json", StartLine: 3, EndLine: 8},
+					},
+				},
+				{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Indirect: true, FilePath: "package-lock.json"},
+				{ID: "express@4.18.1", Name: "express", Version: "4.18.1", Digest: "sha1:3f3ad2c6e4b2c8ba1d9b0e2b3c35d0c1f2b0a1c2", FilePath: "package-lock.json"},
+				{ID: "ms@2.1.3", Name: "ms", Version: "2.1.3", Indirect: true, FilePath: "packages/app/package-lock.json"},
+			},
+			wantDeps: []types.Dependency{
+				{ID: "express@4.18.1", DependsOn: []string{"debug@2.6.9"}},
+				{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0", "ms@2.1.3"}},
+			},
+		},
+		{
+			name: "keep highest",
+			opts: []Option{WithPolicy(KeepHighest)},
+			want: []types.Library{
+				{
+					ID:       "debug@2.6.9",
+					Name:     "debug",
+					Version:  "2.6.9",
+					Licenses: []string{"MIT"},
+					License:  "MIT",
+					FilePath: "package-lock.json",
+					Locations: []types.Location{
+						{FilePath: "package-lock.json", StartLine: 10, EndLine: 15},
+						{FilePath: "packages/app/package-lock.json", StartLine: 3, EndLine: 8},
+					},
+				},
+				{ID: "express@4.18.1", Name: "express", Version: "4.18.1", Digest: "sha1:3f3ad2c6e4b2c8ba1d9b0e2b3c35d0c1f2b0a1c2", FilePath: "package-lock.json"},
+				{ID: "ms@2.1.3", Name: "ms", Version: "2.1.3", Indirect: true, FilePath: "packages/app/package-lock.json"},
+			},
+			wantDeps: []types.Dependency{
+				{ID: "express@4.18.1", DependsOn: []string{"debug@2.6.9"}},
+				{ID: "debug@2.6.9", DependsOn: []string{"ms@2.1.3"}},
+			},
+		},
+		{
+			name: "keep first",
+			opts: []Option{WithPolicy(KeepFirst)},
+			want: []types.Library{
+				{
+					ID:       "debug@2.6.9",
+					Name:     "debug",
+					Version:  "2.6.9",
+					Licenses: []string{"MIT"},
+					License:  "MIT",
+					FilePath: "package-lock.json",
+					Locations: []types.Location{
+						{FilePath: "package-lock.json", StartLine: 10, EndLine: 15},
+						{FilePath: "packages/app/package-lock.json", StartLine: 3, EndLine: 8},
+					},
+				},
+				{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Indirect: true, FilePath: "package-lock.json"},
+				{ID: "express@4.18.1", Name: "express", Version: "4.18.1", Digest: "sha1:3f3ad2c6e4b2c8ba1d9b0e2b3c35d0c1f2b0a1c2", FilePath: "package-lock.json"},
+			},
+			wantDeps: []types.Dependency{
+				{ID: "express@4.18.1", DependsOn: []string{"debug@2.6.9"}},
+				{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotDeps := Merge(results, tt.opts...)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantDeps, gotDeps)
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		want   int
+	}{
+		{v1: "1.10.0", v2: "1.9.0", want: 1},
+		{v1: "1.0.0", v2: "1.0.0", want: 0},
+		{v1: "1.0", v2: "1.0.1", want: -1},
+		{v1: "2.0.0-rc2", v2: "2.0.0-rc10", want: -1},
+		{v1: "v1.2.3", v2: "v1.2.3", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" vs "+tt.v2, func(t *testing.T) {
+			got := CompareVersions(tt.v1, tt.v2)
+			switch {
+			case tt.want < 0:
+				assert.Negative(t, got)
+			case tt.want > 0:
+				assert.Positive(t, got)
+			default:
+				assert.Zero(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package wrap
+
+import (
+	"bufio"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// e.g. zlib-1.2.13 => zlib, 1.2.13
+var nameVersionRegexp = regexp.MustCompile(`^(.+?)-v?(\d[^-]*)$`)
+
+// Archive extensions to be removed from source_filename
+var archiveExtensions = []string{".tar.gz", ".tar.xz", ".tar.bz2", ".tgz", ".zip"}
+
+// Parser implements types.Parser for subprojects/*.wrap
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses subprojects/*.wrap
+//
+// e.g.
+//
+//	[wrap-file]
+//	directory = zlib-1.2.13
+//	source_url = http://zlib.net/fossils/zlib-1.2.13.tar.gz
+//	source_hash = b3a24de97a8fdbc835b9833169501030b8977031bcb54b3b3ac13740f846ab30
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	sections, err := parseINI(r)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to parse wrap file: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	if s, ok := sections["wrap-file"]; ok {
+		return []types.Library{parseWrapFile(s)}, nil
+	}
+	for _, vcs := range []string{"wrap-git", "wrap-hg", "wrap-svn"} {
+		if s, ok := sections[vcs]; ok {
+			return []types.Library{parseWrapVCS(s)}, nil
+		}
+	}
+
+	// e.g. [wrap-redirect] points to another wrap file
+	return nil, nil
+}
+
+func parseWrapFile(s map[string]string) types.Library {
+	// The directory usually contains the name and version
+	name, version := splitNameVersion(s["directory"])
+	if version == "" {
+		filename := s["source_filename"]
+		for _, ext := range archiveExtensions {
+			filename = strings.TrimSuffix(filename, ext)
+		}
+		name, version = splitNameVersion(filename)
+	}
+
+	var digest string
+	if s["source_hash"] != "" {
+		digest = "sha256:" + s["source_hash"]
+	}
+
+	return types.Library{
+		Name:    name,
+		Version: version,
+		Digest:  digest,
+	}
+}
+
+func parseWrapVCS(s map[string]string) types.Library {
+	name := s["directory"]
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(s["url"]), ".git")
+	}
+	return types.Library{
+		Name:    name,
+		Version: s["revision"],
+	}
+}
+
+func splitNameVersion(s string) (string, string) {
+	m := nameVersionRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return s, ""
+	}
+	return m[1], m[2]
+}
+
+func parseINI(r io.Reader) (map[string]map[string]string, error) {
+	sections := map[string]map[string]string{}
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = map[string]string{}
+			sections[strings.TrimSpace(line[1:len(line)-1])] = current
+			continue
+		}
+
+		ss := strings.SplitN(line, "=", 2)
+		if len(ss) != 2 || current == nil {
+			return nil, xerrors.Errorf("invalid line: %s", line)
+		}
+		current[strings.TrimSpace(ss[0])] = strings.TrimSpace(ss[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return sections, nil
+}
@@ -0,0 +1,15 @@
+package wrap
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name meson --rm -it mesonbuild/bionic bash
+	// meson wrap install zlib
+	wrapFile = []types.Library{
+		{Name: "zlib", Version: "1.2.13", Digest: "sha256:b3a24de97a8fdbc835b9833169501030b8977031bcb54b3b3ac13740f846ab30"},
+	}
+
+	wrapGit = []types.Library{
+		{Name: "libfuse", Version: "fuse-3.10.5"},
+	}
+)
@@ -0,0 +1,109 @@
+// Package metrics reports how long parsing takes and how many remote requests it makes,
+// so that services embedding the library can monitor scanning.
+//
+// e.g.
+//
+//	hooks := metrics.Hooks{
+//		StartParse: func(parser, filePath string) func(metrics.ParseEvent) {
+//			span := tracer.Start(parser)
+//			return func(e metrics.ParseEvent) {
+//				histogram.Observe(e.Parser, e.Duration)
+//				span.End()
+//			}
+//		},
+//	}
+//	apps, err := scanner.Scan(fsys, ".", scanner.WithHooks(hooks))
+package metrics
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+const pkgPrefix = "github.com/aquasecurity/go-dep-parser/pkg/"
+
+// ParseEvent is reported after a file is parsed.
+type ParseEvent struct {
+	// Parser is named by ParserName. e.g. "nodejs/yarn"
+	Parser    string
+	FilePath  string
+	Duration  time.Duration
+	Libraries int
+	Err       error
+}
+
+// RequestEvent is reported after a request to a remote repository.
+type RequestEvent struct {
+	Parser string
+	URL    string
+	// StatusCode is 0 if no response is received
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// CacheEvent is reported when a parser looks up cache.Cache.
+type CacheEvent struct {
+	Parser string
+	Key    string
+	Hit    bool
+}
+
+// Hooks receives the events. Nil hooks are not called, and the zero value reports nothing.
+// Hooks may be called concurrently.
+type Hooks struct {
+	// StartParse is called before a file is parsed, and the returned function after it if it's not nil.
+	// They can start and end a span of tracing.
+	StartParse func(parser, filePath string) func(ParseEvent)
+	OnRequest  func(RequestEvent)
+	OnCache    func(CacheEvent)
+}
+
+// ParseStarted calls StartParse, and returns the function to be called after parsing.
+func (h Hooks) ParseStarted(parser, filePath string) func(libraries int, err error) {
+	if h.StartParse == nil {
+		return func(int, error) {}
+	}
+	start := time.Now()
+	done := h.StartParse(parser, filePath)
+	return func(libraries int, err error) {
+		if done == nil {
+			return
+		}
+		done(ParseEvent{
+			Parser:    parser,
+			FilePath:  filePath,
+			Duration:  time.Since(start),
+			Libraries: libraries,
+			Err:       err,
+		})
+	}
+}
+
+// Requested calls OnRequest.
+func (h Hooks) Requested(e RequestEvent) {
+	if h.OnRequest != nil {
+		h.OnRequest(e)
+	}
+}
+
+// CacheLookedUp calls OnCache.
+func (h Hooks) CacheLookedUp(parser, key string, hit bool) {
+	if h.OnCache != nil {
+		h.OnCache(CacheEvent{Parser: parser, Key: key, Hit: hit})
+	}
+}
+
+// ParserName returns the package of the parser relative to pkg, which is used as the label of metrics.
+// e.g. "nodejs/yarn" and "java/jar"
+// The full package path is returned for the parsers outside this module.
+func ParserName(p types.Parser) string {
+	t := reflect.TypeOf(p)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return strings.TrimPrefix(t.PkgPath(), pkgPrefix)
+}
@@ -0,0 +1,55 @@
+package metrics_test
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/yarn"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+type fakeParser struct{}
+
+func (fakeParser) Parse(_ io.Reader) ([]types.Library, []types.Dependency, error) {
+	return nil, nil, nil
+}
+
+func TestParserName(t *testing.T) {
+	assert.Equal(t, "nodejs/yarn", metrics.ParserName(yarn.NewParser()))
+	assert.Equal(t, "metrics_test", metrics.ParserName(fakeParser{}))
+}
+
+func TestHooks_ParseStarted(t *testing.T) {
+	var started []string
+	var got []metrics.ParseEvent
+	hooks := metrics.Hooks{
+		StartParse: func(parser, filePath string) func(metrics.ParseEvent) {
+			started = append(started, filePath)
+			return func(e metrics.ParseEvent) {
+				got = append(got, e)
+			}
+		},
+	}
+
+	done := hooks.ParseStarted("nodejs/yarn", "yarn.lock")
+	assert.Equal(t, []string{"yarn.lock"}, started)
+	assert.Empty(t, got)
+
+	err := errors.New("error")
+	done(2, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "nodejs/yarn", got[0].Parser)
+	assert.Equal(t, "yarn.lock", got[0].FilePath)
+	assert.Equal(t, 2, got[0].Libraries)
+	assert.Equal(t, err, got[0].Err)
+
+	// The zero value reports nothing
+	metrics.Hooks{}.ParseStarted("nodejs/yarn", "yarn.lock")(0, nil)
+	metrics.Hooks{}.Requested(metrics.RequestEvent{})
+	metrics.Hooks{}.CacheLookedUp("java/jar", "key", true)
+}
@@ -0,0 +1,76 @@
+package nimble
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type lockFile struct {
+	Version  int
+	Packages map[string]packageInfo
+}
+
+type packageInfo struct {
+	Version      string
+	VcsRevision  string
+	URL          string
+	Dependencies []string
+	Checksums    struct {
+		SHA1 string
+	}
+}
+
+// Parser implements types.Parser for nimble.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses nimble.lock
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var lockFile lockFile
+	if err := json.NewDecoder(r).Decode(&lockFile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	if lockFile.Version != 1 && lockFile.Version != 2 {
+		return nil, &types.ErrUnsupportedLockfileVersion{File: "lock file", Version: strconv.Itoa(lockFile.Version)}
+	}
+
+	var libs []types.Library
+	for name, pkg := range lockFile.Packages {
+		version := pkg.Version
+		if version == "" || version == "#head" {
+			// Packages installed from a branch are identified by the revision
+			version = pkg.VcsRevision
+		}
+
+		var digest string
+		if pkg.Checksums.SHA1 != "" {
+			digest = "sha1:" + pkg.Checksums.SHA1
+		}
+
+		libs = append(libs, types.Library{
+			Name:    name,
+			Version: version,
+			Digest:  digest,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
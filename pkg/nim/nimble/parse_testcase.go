@@ -0,0 +1,15 @@
+package nimble
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name nim --rm -it nimlang/nim:1.6.10 bash
+	// nimble init app && cd app
+	// (add jester and karax@#head to requires in app.nimble)
+	// nimble lock
+	nimbleNormal = []types.Library{
+		{Name: "httpbeast", Version: "0.4.1", Digest: "sha1:3f1d6a4b1bb0cdaa8b0f5c2c8b2a53e0c10b41a5"},
+		{Name: "jester", Version: "0.5.0", Digest: "sha1:d3d8ab4c1b2c9d1e0a7e6f5d4c3b2a1908f7e6d5"},
+		{Name: "karax", Version: "5f21dcd631c6d8b4c8c5e2fbf2d0e29f1b3c4d5e", Digest: "sha1:0c5a0d5c1f6f2b6a5e8a7d1c9b3e4f5a6b7c8d9e"},
+	}
+)
@@ -0,0 +1,111 @@
+package flake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type lockFile struct {
+	Nodes   map[string]node
+	Root    string
+	Version int
+}
+
+type node struct {
+	// e.g. "nixpkgs": "nixpkgs", or "nixpkgs": ["nixpkgs"] to follow another input
+	Inputs map[string]interface{}
+	Locked *locked
+}
+
+type locked struct {
+	Type    string
+	Owner   string
+	Repo    string
+	Host    string
+	URL     string
+	Rev     string
+	NarHash string
+}
+
+// Parser implements types.Parser for flake.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses flake.lock
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var lockFile lockFile
+	if err := json.NewDecoder(r).Decode(&lockFile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	// The "nodes" format was introduced in version 5
+	if lockFile.Version < 5 || lockFile.Version > 7 {
+		return nil, &types.ErrUnsupportedLockfileVersion{File: "lock file", Version: strconv.Itoa(lockFile.Version)}
+	}
+
+	// Inputs of the root node are declared in flake.nix
+	direct := map[string]bool{}
+	for _, input := range lockFile.Nodes[lockFile.Root].Inputs {
+		if nodeName, ok := input.(string); ok {
+			direct[nodeName] = true
+		}
+	}
+
+	var libs []types.Library
+	for name, n := range lockFile.Nodes {
+		// The root node is the flake itself and has no locked input
+		if name == lockFile.Root || n.Locked == nil {
+			continue
+		}
+
+		libs = append(libs, types.Library{
+			Name:     n.Locked.source(name),
+			Version:  n.Locked.Rev,
+			Indirect: !direct[name],
+			Digest:   n.Locked.NarHash,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
+
+// source returns the location the input is fetched from.
+// e.g. github.com/NixOS/nixpkgs
+func (l locked) source(name string) string {
+	switch l.Type {
+	case "github", "gitlab", "sourcehut":
+		host := l.Host
+		if host == "" {
+			host = defaultHosts[l.Type]
+		}
+		return fmt.Sprintf("%s/%s/%s", host, l.Owner, l.Repo)
+	case "git", "hg", "tarball", "file":
+		return l.URL
+	}
+	// e.g. "path" inputs don't have a remote location
+	return name
+}
+
+var defaultHosts = map[string]string{
+	"github":    "github.com",
+	"gitlab":    "gitlab.com",
+	"sourcehut": "git.sr.ht",
+}
@@ -0,0 +1,15 @@
+package flake
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name nix --rm -it nixos/nix:2.11.0 sh
+	// nix --extra-experimental-features 'nix-command flakes' flake lock
+	flakeNormal = []types.Library{
+		{Name: "github.com/NixOS/nixpkgs", Version: "2da64a81275b68fdad38af669afeda43d401e94b", Digest: "sha256-oPEjHKGGVbBXqwwL+UjsveJzghWiWV0n9ogo1X6l4cw="},
+		{Name: "github.com/nix-systems/default", Version: "da67096a3b9bf56a91d16901293e51ba5b49a27e", Indirect: true, Digest: "sha256-Vy1rq5AaRuLzOxct8nz4T6wlgyUR7zLU309k9mBC768="},
+		{Name: "github.com/numtide/flake-utils", Version: "c0e246b9b83f637f4681389ecabcb2681b4f3af0", Digest: "sha256-zllb8aq3YO3h8B/U0/J1WBgAL8EX5yWf5pMj3G0NAmc="},
+		{Name: "https://github.com/hello/hello/archive/v2.12.tar.gz", Digest: "sha256-Hr7Ks8GJPcVPuwDrh0MzWmMeqh2kPO2DFZQ8vWgzPQ8="},
+		{Name: "https://github.com/numtide/nix-filter", Version: "3b821578685d661a10b563cba30b1861eec05748", Digest: "sha256-RizGJH/buaw9A2+fiBf9WnXYw4LZABB5kMAZIEE5/T8="},
+	}
+)
@@ -3,60 +3,381 @@ package npm
 import (
 	"encoding/json"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/aquasecurity/go-dep-parser/pkg/types"
 	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
+const defaultRegistry = "https://registry.npmjs.org"
+
+// lockfileVersionPackagesOnly is the version of package-lock.json from which "dependencies" is omitted.
+const lockfileVersionPackagesOnly = 3
+
 type LockFile struct {
 	Dependencies map[string]Dependency
 }
 type Dependency struct {
-	Version      string
+	Version string
+	// e.g. https://registry.npmjs.org/lodash/-/lodash-4.17.21.tgz
+	Resolved     string
 	Dev          bool
+	Optional     bool
+	Requires     map[string]string
 	Dependencies map[string]Dependency
 }
 
+// Parser implements types.Parser for package-lock.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return parse(r)
+}
+
+func (p *Parser) ParseStream(r io.Reader, fn func(types.Library) error) error {
+	return ParseStream(r, fn)
+}
+
+// Parse parses package-lock.json and returns the libraries.
+// The dependency graph between them is returned by Parser.Parse.
 func Parse(r io.Reader) ([]types.Library, error) {
-	var lockFile LockFile
-	decoder := json.NewDecoder(r)
-	err := decoder.Decode(&lockFile)
+	libs, _, err := parse(r)
+	return libs, err
+}
+
+// parse returns the libraries and the dependency graph between them.
+// Only "dependencies" is decoded, and the other sections such as "packages" are skipped token by token.
+// Lock files of version 3, which only have "packages", are refused with *types.ErrUnsupportedLockfileVersion.
+func parse(r io.Reader) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	dependencies, err := decodeDependencies(json.NewDecoder(r))
 	if err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+		return nil, nil, err
+	}
+
+	p := newLockParser(countDependencies(dependencies))
+	p.parse(dependencies, nil)
+	libs, deps := p.libs, p.deps
+
+	// Nested dependencies are decoded into maps, whose iteration order is random
+	utils.SortLibraries(libs)
+	utils.SortDependencies(deps)
+	return libs, deps, nil
+}
+
+func decodeDependencies(decoder *json.Decoder) (map[string]Dependency, error) {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+	var dependencies map[string]Dependency
+	var lockfileVersion int
+	var found bool
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		switch key {
+		case "lockfileVersion":
+			err = decodeLockfileVersion(decoder, &lockfileVersion)
+		case "dependencies":
+			found = true
+			if err = decoder.Decode(&dependencies); err != nil {
+				err = xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+			}
+		default:
+			err = skipValue(decoder)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return nil, err
+	}
+	if err := checkLockfileVersion(lockfileVersion, found); err != nil {
+		return nil, err
+	}
+	return dependencies, nil
+}
+
+func decodeLockfileVersion(decoder *json.Decoder, version *int) error {
+	if err := decoder.Decode(version); err != nil {
+		return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+	return nil
+}
+
+// checkLockfileVersion refuses lock files without "dependencies" from version 3,
+// instead of returning no libraries as if the project had no dependencies.
+func checkLockfileVersion(version int, hasDependencies bool) error {
+	if !hasDependencies && version >= lockfileVersionPackagesOnly {
+		return &types.ErrUnsupportedLockfileVersion{File: "package-lock.json", Version: strconv.Itoa(version)}
+	}
+	return nil
+}
+
+// countDependencies returns the number of the dependencies including nested ones, to preallocate the libraries.
+func countDependencies(dependencies map[string]Dependency) int {
+	n := len(dependencies)
+	for _, dependency := range dependencies {
+		n += countDependencies(dependency.Dependencies)
 	}
+	return n
+}
+
+// lockParser builds the libraries and the graph without duplicates.
+// The same packages are nested many times in large lock files, so their names, IDs and registries are interned.
+type lockParser struct {
+	libs []types.Library
+	deps []types.Dependency
+
+	strings utils.Interner
+	ids     map[[2]string]string
+	// The libraries are unique by the ID and the registry
+	seenLibs map[[2]string]struct{}
+	seenDeps map[string]struct{}
+}
+
+func newLockParser(size int) *lockParser {
+	return &lockParser{
+		libs:     make([]types.Library, 0, size),
+		ids:      map[[2]string]string{},
+		seenLibs: map[[2]string]struct{}{},
+		seenDeps: map[string]struct{}{},
+	}
+}
 
-	libs := parse(lockFile.Dependencies)
-	return unique(libs), nil
+func (p *lockParser) id(name, version string) string {
+	k := [2]string{name, version}
+	if id, ok := p.ids[k]; ok {
+		return id
+	}
+	id := utils.PackageID(name, version)
+	p.ids[[2]string{p.strings.Intern(name), p.strings.Intern(version)}] = id
+	return id
 }
 
-func parse(dependencies map[string]Dependency) []types.Library {
-	var libs []types.Library
+// parse walks the nested dependencies.
+// parents holds the enclosing "dependencies" objects, the innermost one last,
+// so that required packages are resolved in the same way as node_modules.
+func (p *lockParser) parse(dependencies map[string]Dependency, parents []map[string]Dependency) {
+	scopes := append(parents[:len(parents):len(parents)], dependencies)
+
 	for pkgName, dependency := range dependencies {
 		if dependency.Dev {
 			continue
 		}
 
-		libs = append(libs, types.Library{
-			Name:    pkgName,
-			Version: dependency.Version,
-		})
+		id := p.id(pkgName, dependency.Version)
+		registry := p.strings.Intern(dependency.registry(pkgName))
+		k := [2]string{id, registry}
+		if _, ok := p.seenLibs[k]; !ok {
+			p.seenLibs[k] = struct{}{}
+			p.libs = append(p.libs, types.Library{
+				ID:         id,
+				Name:       p.strings.Intern(pkgName),
+				Version:    p.strings.Intern(dependency.Version),
+				Qualifiers: qualifiers(registry),
+				Scope:      dependency.scope(),
+			})
+		}
+
+		if _, ok := p.seenDeps[id]; !ok {
+			dependsOn := make([]string, 0, len(dependency.Requires))
+			for name := range dependency.Requires {
+				if version, ok := resolve(name, dependency.Dependencies, scopes); ok {
+					dependsOn = append(dependsOn, p.id(name, version))
+				}
+			}
+			if len(dependsOn) > 0 {
+				p.seenDeps[id] = struct{}{}
+				sort.Strings(dependsOn)
+				p.deps = append(p.deps, types.Dependency{
+					ID:        id,
+					DependsOn: dependsOn,
+				})
+			}
+		}
 
 		if dependency.Dependencies != nil {
 			// Recursion
-			libs = append(libs, parse(dependency.Dependencies)...)
+			p.parse(dependency.Dependencies, scopes)
 		}
 	}
-	return libs
 }
 
-func unique(libs []types.Library) []types.Library {
-	var uniqLibs []types.Library
-	unique := map[types.Library]struct{}{}
-	for _, lib := range libs {
-		if _, ok := unique[lib]; !ok {
-			unique[lib] = struct{}{}
-			uniqLibs = append(uniqLibs, lib)
+// scope returns the scope of the dependency. Dev dependencies are skipped before.
+func (d Dependency) scope() types.Scope {
+	if d.Optional {
+		return types.ScopeOptional
+	}
+	return types.ScopeRuntime
+}
+
+// qualifiers returns the registry of the package if it's not the public registry.
+func qualifiers(registry string) map[string]string {
+	if registry == "" {
+		return nil
+	}
+	return map[string]string{purl.QualifierRepositoryURL: registry}
+}
+
+// registry returns the part of the resolved URL before the package name, unless it's the public registry.
+// e.g. https://npm.example.com/repo for https://npm.example.com/repo/@babel/core/-/core-7.18.6.tgz
+func (d Dependency) registry(pkgName string) string {
+	i := strings.Index(d.Resolved, "/"+pkgName+"/-/")
+	if i == -1 {
+		return ""
+	}
+	if registry := d.Resolved[:i]; registry != defaultRegistry {
+		return registry
+	}
+	return ""
+}
+
+// resolve looks for the version of the required package from the nested dependencies to the top level.
+func resolve(name string, nested map[string]Dependency, scopes []map[string]Dependency) (string, bool) {
+	if dep, ok := nested[name]; ok {
+		return dep.Version, true
+	}
+	for i := len(scopes) - 1; i >= 0; i-- {
+		if dep, ok := scopes[i][name]; ok {
+			return dep.Version, true
+		}
+	}
+	return "", false
+}
+
+// ParseStream parses package-lock.json and calls fn for each library,
+// decoding one top-level dependency at a time instead of the whole file.
+// The dependency graph is not built, as resolving requires needs all the dependencies.
+// Lock files of version 3 are refused as Parse does.
+func ParseStream(r io.Reader, fn func(types.Library) error) (err error) {
+	defer utils.RecoverPanic(&err)
+
+	decoder := json.NewDecoder(r)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	var lockfileVersion int
+	var found bool
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		switch key {
+		case "lockfileVersion":
+			err = decodeLockfileVersion(decoder, &lockfileVersion)
+		case "dependencies":
+			found = true
+			err = streamDependencies(decoder, fn)
+		default:
+			err = skipValue(decoder)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return err
+	}
+	return checkLockfileVersion(lockfileVersion, found)
+}
+
+func streamDependencies(decoder *json.Decoder, fn func(types.Library) error) error {
+	if err := expectDelim(decoder, '{'); err != nil {
+		return err
+	}
+	// Only the keys are kept to skip duplicates, which are unique by the ID and the registry as in Parse
+	seen := map[[2]string]struct{}{}
+	for decoder.More() {
+		name, err := decoder.Token()
+		if err != nil {
+			return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		pkgName, ok := name.(string)
+		if !ok {
+			return &types.ErrMalformedInput{Err: xerrors.Errorf("unexpected token: %v", name)}
+		}
+		var dependency Dependency
+		if err = decoder.Decode(&dependency); err != nil {
+			return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		if err = emit(pkgName, dependency, seen, fn); err != nil {
+			return err
+		}
+	}
+	return expectDelim(decoder, '}')
+}
+
+// emit calls fn for the dependency and the ones nested in it, skipping dev dependencies as parse does.
+func emit(pkgName string, dependency Dependency, seen map[[2]string]struct{}, fn func(types.Library) error) error {
+	if dependency.Dev {
+		return nil
+	}
+	id := utils.PackageID(pkgName, dependency.Version)
+	registry := dependency.registry(pkgName)
+	k := [2]string{id, registry}
+	if _, ok := seen[k]; !ok {
+		seen[k] = struct{}{}
+		lib := types.Library{
+			ID:         id,
+			Name:       pkgName,
+			Version:    dependency.Version,
+			Qualifiers: qualifiers(registry),
+			Scope:      dependency.scope(),
+		}
+		if err := fn(lib); err != nil {
+			return err
+		}
+	}
+	for name, nested := range dependency.Dependencies {
+		if err := emit(name, nested, seen, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	t, err := decoder.Token()
+	if err != nil {
+		return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return &types.ErrMalformedInput{Err: xerrors.Errorf("expected %s, got %v", delim, t)}
+	}
+	return nil
+}
+
+// skipValue skips the next value token by token, so that large objects such as "packages" are not held in memory.
+func skipValue(decoder *json.Decoder) error {
+	var depth int
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		switch t {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
 		}
 	}
-	return uniqLibs
 }
@@ -1,6 +1,8 @@
 package npm
 
 import (
+	"errors"
+	"io"
 	"os"
 	"path"
 	"sort"
@@ -15,45 +17,176 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
+	}{
+		{
+			file:     "testdata/package-lock_normal.json",
+			want:     npmNormal,
+			wantDeps: npmNormalDeps,
+		},
+		{
+			file:     "testdata/package-lock_react.json",
+			want:     npmReact,
+			wantDeps: npmReactDeps,
+		},
+		{
+			file:     "testdata/package-lock_with_dev.json",
+			want:     npmWithDev,
+			wantDeps: npmWithDevDeps,
+		},
+		{
+			file:     "testdata/package-lock_many.json",
+			want:     npmMany,
+			wantDeps: npmManyDeps,
+		},
+		{
+			file:     "testdata/package-lock_nested.json",
+			want:     npmNested,
+			wantDeps: npmNestedDeps,
+		},
+		{
+			file:     "testdata/package-lock_optional.json",
+			want:     npmOptional,
+			wantDeps: npmOptionalDeps,
+		},
+		{
+			file: "testdata/package-lock_registry.json",
+			want: npmRegistry,
+		},
+		{
+			file:     "testdata/package-lock_v2.json",
+			want:     npmV2,
+			wantDeps: npmV2Deps,
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+
+			got, gotDeps, err := NewParser().Parse(f)
+			require.NoError(t, err)
+
+			assert.Equal(t, v.want, got)
+			assert.Equal(t, v.wantDeps, gotDeps)
+		})
+	}
+}
+
+func TestParseStream(t *testing.T) {
+	vectors := []struct {
+		file string
 		want []types.Library
 	}{
 		{
 			file: "testdata/package-lock_normal.json",
 			want: npmNormal,
 		},
-		{
-			file: "testdata/package-lock_react.json",
-			want: npmReact,
-		},
 		{
 			file: "testdata/package-lock_with_dev.json",
 			want: npmWithDev,
 		},
-		{
-			file: "testdata/package-lock_many.json",
-			want: npmMany,
-		},
 		{
 			file: "testdata/package-lock_nested.json",
 			want: npmNested,
 		},
+		{
+			file: "testdata/package-lock_optional.json",
+			want: npmOptional,
+		},
 	}
 
 	for _, v := range vectors {
 		t.Run(path.Base(v.file), func(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
+			defer f.Close()
 
-			got, err := Parse(f)
+			var got []types.Library
+			err = ParseStream(f, func(lib types.Library) error {
+				got = append(got, lib)
+				return nil
+			})
 			require.NoError(t, err)
 
 			sortLibs(got)
 			sortLibs(v.want)
-
 			assert.Equal(t, v.want, got)
 		})
 	}
+
+	t.Run("stopped by callback", func(t *testing.T) {
+		f, err := os.Open("testdata/package-lock_normal.json")
+		require.NoError(t, err)
+		defer f.Close()
+
+		errStop := errors.New("stop")
+		var count int
+		err = ParseStream(f, func(lib types.Library) error {
+			count++
+			return errStop
+		})
+		assert.Equal(t, errStop, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("lockfileVersion 3", func(t *testing.T) {
+		f, err := os.Open("testdata/package-lock_v3.json")
+		require.NoError(t, err)
+		defer f.Close()
+
+		err = ParseStream(f, func(types.Library) error {
+			return nil
+		})
+		var unsupported *types.ErrUnsupportedLockfileVersion
+		require.True(t, errors.As(err, &unsupported), err)
+		assert.Equal(t, "3", unsupported.Version)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		err := ParseStream(strings.NewReader(`{"dependencies": {"a": `), func(types.Library) error {
+			return nil
+		})
+		var malformed *types.ErrMalformedInput
+		assert.True(t, errors.As(err, &malformed))
+	})
+}
+
+func TestParse_LockfileVersion3(t *testing.T) {
+	f, err := os.Open("testdata/package-lock_v3.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = Parse(f)
+	var unsupported *types.ErrUnsupportedLockfileVersion
+	require.True(t, errors.As(err, &unsupported), err)
+	assert.Equal(t, "unsupported package-lock.json version: 3", err.Error())
+}
+
+// Parse and ParseStream skip the same duplicates. The libraries of the same name and version are returned
+// in the random order of the registries.
+func TestParse_Registries(t *testing.T) {
+	f, err := os.Open("testdata/package-lock_registries.json")
+	require.NoError(t, err)
+	defer f.Close()
+
+	got, err := Parse(f)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, npmRegistries, got)
+
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(t, err)
+
+	var streamed []types.Library
+	err = ParseStream(f, func(lib types.Library) error {
+		streamed = append(streamed, lib)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, npmRegistries, streamed)
 }
 
 func sortLibs(libs []types.Library) {
@@ -8,9 +8,9 @@ var (
 	// npm install --save promise jquery
 	// npm ls | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmNormal = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.4.0", ""},
-		{"promise", "8.0.3", ""},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Scope: types.ScopeRuntime},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Scope: types.ScopeRuntime},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Scope: types.ScopeRuntime},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -18,18 +18,18 @@ var (
 	// npm install --save react redux
 	// npm ls | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmReact = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.4.0", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"react", "16.8.6", ""},
-		{"react-is", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"scheduler", "0.13.6", ""},
-		{"symbol-observable", "1.2.0", ""},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Scope: types.ScopeRuntime},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Scope: types.ScopeRuntime},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Scope: types.ScopeRuntime},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Scope: types.ScopeRuntime},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Scope: types.ScopeRuntime},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Scope: types.ScopeRuntime},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Scope: types.ScopeRuntime},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Scope: types.ScopeRuntime},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Scope: types.ScopeRuntime},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Scope: types.ScopeRuntime},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -38,18 +38,18 @@ var (
 	// npm install --save-dev mocha
 	// npm ls -prod | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmWithDev = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.4.0", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"react", "16.8.6", ""},
-		{"react-is", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"scheduler", "0.13.6", ""},
-		{"symbol-observable", "1.2.0", ""},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Scope: types.ScopeRuntime},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Scope: types.ScopeRuntime},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Scope: types.ScopeRuntime},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Scope: types.ScopeRuntime},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Scope: types.ScopeRuntime},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Scope: types.ScopeRuntime},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Scope: types.ScopeRuntime},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Scope: types.ScopeRuntime},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Scope: types.ScopeRuntime},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Scope: types.ScopeRuntime},
 	}
 
 	// docker run --name node --rm -it node:12-alpine sh
@@ -59,135 +59,261 @@ var (
 	// npm install --save lodash request chalk commander express async axios vue
 	// npm ls -prod | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	npmMany = []types.Library{
-		{"accepts", "1.3.6", ""},
-		{"ajv", "6.10.0", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"array-flatten", "1.1.1", ""},
-		{"asap", "2.0.6", ""},
-		{"asn1", "0.2.4", ""},
-		{"assert-plus", "1.0.0", ""},
-		{"async", "2.6.2", ""},
-		{"asynckit", "0.4.0", ""},
-		{"aws-sign2", "0.7.0", ""},
-		{"aws4", "1.8.0", ""},
-		{"axios", "0.18.0", ""},
-		{"bcrypt-pbkdf", "1.0.2", ""},
-		{"body-parser", "1.18.3", ""},
-		{"bytes", "3.0.0", ""},
-		{"caseless", "0.12.0", ""},
-		{"chalk", "2.4.2", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-name", "1.1.3", ""},
-		{"combined-stream", "1.0.7", ""},
-		{"commander", "2.20.0", ""},
-		{"content-disposition", "0.5.2", ""},
-		{"content-type", "1.0.4", ""},
-		{"cookie-signature", "1.0.6", ""},
-		{"cookie", "0.3.1", ""},
-		{"core-util-is", "1.0.2", ""},
-		{"dashdash", "1.14.1", ""},
-		{"debug", "2.6.9", ""},
-		{"debug", "3.2.6", ""},
-		{"delayed-stream", "1.0.0", ""},
-		{"depd", "1.1.2", ""},
-		{"destroy", "1.0.4", ""},
-		{"ecc-jsbn", "0.1.2", ""},
-		{"ee-first", "1.1.1", ""},
-		{"encodeurl", "1.0.2", ""},
-		{"escape-html", "1.0.3", ""},
-		{"escape-string-regexp", "1.0.5", ""},
-		{"etag", "1.8.1", ""},
-		{"express", "4.16.4", ""},
-		{"extend", "3.0.2", ""},
-		{"extsprintf", "1.3.0", ""},
-		{"fast-deep-equal", "2.0.1", ""},
-		{"fast-json-stable-stringify", "2.0.0", ""},
-		{"finalhandler", "1.1.1", ""},
-		{"follow-redirects", "1.7.0", ""},
-		{"forever-agent", "0.6.1", ""},
-		{"form-data", "2.3.3", ""},
-		{"forwarded", "0.1.2", ""},
-		{"fresh", "0.5.2", ""},
-		{"getpass", "0.1.7", ""},
-		{"har-schema", "2.0.0", ""},
-		{"har-validator", "5.1.3", ""},
-		{"has-flag", "3.0.0", ""},
-		{"http-errors", "1.6.3", ""},
-		{"http-signature", "1.2.0", ""},
-		{"iconv-lite", "0.4.23", ""},
-		{"inherits", "2.0.3", ""},
-		{"ipaddr.js", "1.9.0", ""},
-		{"is-buffer", "1.1.6", ""},
-		{"is-typedarray", "1.0.0", ""},
-		{"isstream", "0.1.2", ""},
-		{"jquery", "3.4.0", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"jsbn", "0.1.1", ""},
-		{"json-schema-traverse", "0.4.1", ""},
-		{"json-schema", "0.2.3", ""},
-		{"json-stringify-safe", "5.0.1", ""},
-		{"jsprim", "1.4.1", ""},
-		{"lodash", "4.17.11", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"media-typer", "0.3.0", ""},
-		{"merge-descriptors", "1.0.1", ""},
-		{"methods", "1.1.2", ""},
-		{"mime-db", "1.40.0", ""},
-		{"mime-types", "2.1.24", ""},
-		{"mime", "1.4.1", ""},
-		{"ms", "2.0.0", ""},
-		{"ms", "2.1.1", ""},
-		{"negotiator", "0.6.1", ""},
-		{"oauth-sign", "0.9.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"on-finished", "2.3.0", ""},
-		{"parseurl", "1.3.3", ""},
-		{"path-to-regexp", "0.1.7", ""},
-		{"performance-now", "2.1.0", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"proxy-addr", "2.0.5", ""},
-		{"psl", "1.1.31", ""},
-		{"punycode", "1.4.1", ""},
-		{"punycode", "2.1.1", ""},
-		{"qs", "6.5.2", ""},
-		{"range-parser", "1.2.0", ""},
-		{"raw-body", "2.3.3", ""},
-		{"react-is", "16.8.6", ""},
-		{"react", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"request", "2.88.0", ""},
-		{"safe-buffer", "5.1.2", ""},
-		{"safer-buffer", "2.1.2", ""},
-		{"scheduler", "0.13.6", ""},
-		{"send", "0.16.2", ""},
-		{"serve-static", "1.13.2", ""},
-		{"setprototypeof", "1.1.0", ""},
-		{"sshpk", "1.16.1", ""},
-		{"statuses", "1.4.0", ""},
-		{"supports-color", "5.5.0", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"tough-cookie", "2.4.3", ""},
-		{"tunnel-agent", "0.6.0", ""},
-		{"tweetnacl", "0.14.5", ""},
-		{"type-is", "1.6.18", ""},
-		{"unpipe", "1.0.0", ""},
-		{"uri-js", "4.2.2", ""},
-		{"utils-merge", "1.0.1", ""},
-		{"uuid", "3.3.2", ""},
-		{"vary", "1.1.2", ""},
-		{"verror", "1.10.0", ""},
-		{"vue", "2.6.10", ""},
+		{ID: "accepts@1.3.6", Name: "accepts", Version: "1.3.6", Scope: types.ScopeRuntime},
+		{ID: "ajv@6.10.0", Name: "ajv", Version: "6.10.0", Scope: types.ScopeRuntime},
+		{ID: "ansi-styles@3.2.1", Name: "ansi-styles", Version: "3.2.1", Scope: types.ScopeRuntime},
+		{ID: "array-flatten@1.1.1", Name: "array-flatten", Version: "1.1.1", Scope: types.ScopeRuntime},
+		{ID: "asap@2.0.6", Name: "asap", Version: "2.0.6", Scope: types.ScopeRuntime},
+		{ID: "asn1@0.2.4", Name: "asn1", Version: "0.2.4", Scope: types.ScopeRuntime},
+		{ID: "assert-plus@1.0.0", Name: "assert-plus", Version: "1.0.0", Scope: types.ScopeRuntime},
+		{ID: "async@2.6.2", Name: "async", Version: "2.6.2", Scope: types.ScopeRuntime},
+		{ID: "asynckit@0.4.0", Name: "asynckit", Version: "0.4.0", Scope: types.ScopeRuntime},
+		{ID: "aws-sign2@0.7.0", Name: "aws-sign2", Version: "0.7.0", Scope: types.ScopeRuntime},
+		{ID: "aws4@1.8.0", Name: "aws4", Version: "1.8.0", Scope: types.ScopeRuntime},
+		{ID: "axios@0.18.0", Name: "axios", Version: "0.18.0", Scope: types.ScopeRuntime},
+		{ID: "bcrypt-pbkdf@1.0.2", Name: "bcrypt-pbkdf", Version: "1.0.2", Scope: types.ScopeRuntime},
+		{ID: "body-parser@1.18.3", Name: "body-parser", Version: "1.18.3", Scope: types.ScopeRuntime},
+		{ID: "bytes@3.0.0", Name: "bytes", Version: "3.0.0", Scope: types.ScopeRuntime},
+		{ID: "caseless@0.12.0", Name: "caseless", Version: "0.12.0", Scope: types.ScopeRuntime},
+		{ID: "chalk@2.4.2", Name: "chalk", Version: "2.4.2", Scope: types.ScopeRuntime},
+		{ID: "color-convert@1.9.3", Name: "color-convert", Version: "1.9.3", Scope: types.ScopeRuntime},
+		{ID: "color-name@1.1.3", Name: "color-name", Version: "1.1.3", Scope: types.ScopeRuntime},
+		{ID: "combined-stream@1.0.7", Name: "combined-stream", Version: "1.0.7", Scope: types.ScopeRuntime},
+		{ID: "commander@2.20.0", Name: "commander", Version: "2.20.0", Scope: types.ScopeRuntime},
+		{ID: "content-disposition@0.5.2", Name: "content-disposition", Version: "0.5.2", Scope: types.ScopeRuntime},
+		{ID: "content-type@1.0.4", Name: "content-type", Version: "1.0.4", Scope: types.ScopeRuntime},
+		{ID: "cookie@0.3.1", Name: "cookie", Version: "0.3.1", Scope: types.ScopeRuntime},
+		{ID: "cookie-signature@1.0.6", Name: "cookie-signature", Version: "1.0.6", Scope: types.ScopeRuntime},
+		{ID: "core-util-is@1.0.2", Name: "core-util-is", Version: "1.0.2", Scope: types.ScopeRuntime},
+		{ID: "dashdash@1.14.1", Name: "dashdash", Version: "1.14.1", Scope: types.ScopeRuntime},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Scope: types.ScopeRuntime},
+		{ID: "debug@3.2.6", Name: "debug", Version: "3.2.6", Scope: types.ScopeRuntime},
+		{ID: "delayed-stream@1.0.0", Name: "delayed-stream", Version: "1.0.0", Scope: types.ScopeRuntime},
+		{ID: "depd@1.1.2", Name: "depd", Version: "1.1.2", Scope: types.ScopeRuntime},
+		{ID: "destroy@1.0.4", Name: "destroy", Version: "1.0.4", Scope: types.ScopeRuntime},
+		{ID: "ecc-jsbn@0.1.2", Name: "ecc-jsbn", Version: "0.1.2", Scope: types.ScopeRuntime},
+		{ID: "ee-first@1.1.1", Name: "ee-first", Version: "1.1.1", Scope: types.ScopeRuntime},
+		{ID: "encodeurl@1.0.2", Name: "encodeurl", Version: "1.0.2", Scope: types.ScopeRuntime},
+		{ID: "escape-html@1.0.3", Name: "escape-html", Version: "1.0.3", Scope: types.ScopeRuntime},
+		{ID: "escape-string-regexp@1.0.5", Name: "escape-string-regexp", Version: "1.0.5", Scope: types.ScopeRuntime},
+		{ID: "etag@1.8.1", Name: "etag", Version: "1.8.1", Scope: types.ScopeRuntime},
+		{ID: "express@4.16.4", Name: "express", Version: "4.16.4", Scope: types.ScopeRuntime},
+		{ID: "extend@3.0.2", Name: "extend", Version: "3.0.2", Scope: types.ScopeRuntime},
+		{ID: "extsprintf@1.3.0", Name: "extsprintf", Version: "1.3.0", Scope: types.ScopeRuntime},
+		{ID: "fast-deep-equal@2.0.1", Name: "fast-deep-equal", Version: "2.0.1", Scope: types.ScopeRuntime},
+		{ID: "fast-json-stable-stringify@2.0.0", Name: "fast-json-stable-stringify", Version: "2.0.0", Scope: types.ScopeRuntime},
+		{ID: "finalhandler@1.1.1", Name: "finalhandler", Version: "1.1.1", Scope: types.ScopeRuntime},
+		{ID: "follow-redirects@1.7.0", Name: "follow-redirects", Version: "1.7.0", Scope: types.ScopeRuntime},
+		{ID: "forever-agent@0.6.1", Name: "forever-agent", Version: "0.6.1", Scope: types.ScopeRuntime},
+		{ID: "form-data@2.3.3", Name: "form-data", Version: "2.3.3", Scope: types.ScopeRuntime},
+		{ID: "forwarded@0.1.2", Name: "forwarded", Version: "0.1.2", Scope: types.ScopeRuntime},
+		{ID: "fresh@0.5.2", Name: "fresh", Version: "0.5.2", Scope: types.ScopeRuntime},
+		{ID: "getpass@0.1.7", Name: "getpass", Version: "0.1.7", Scope: types.ScopeRuntime},
+		{ID: "har-schema@2.0.0", Name: "har-schema", Version: "2.0.0", Scope: types.ScopeRuntime},
+		{ID: "har-validator@5.1.3", Name: "har-validator", Version: "5.1.3", Scope: types.ScopeRuntime},
+		{ID: "has-flag@3.0.0", Name: "has-flag", Version: "3.0.0", Scope: types.ScopeRuntime},
+		{ID: "http-errors@1.6.3", Name: "http-errors", Version: "1.6.3", Scope: types.ScopeRuntime},
+		{ID: "http-signature@1.2.0", Name: "http-signature", Version: "1.2.0", Scope: types.ScopeRuntime},
+		{ID: "iconv-lite@0.4.23", Name: "iconv-lite", Version: "0.4.23", Scope: types.ScopeRuntime},
+		{ID: "inherits@2.0.3", Name: "inherits", Version: "2.0.3", Scope: types.ScopeRuntime},
+		{ID: "ipaddr.js@1.9.0", Name: "ipaddr.js", Version: "1.9.0", Scope: types.ScopeRuntime},
+		{ID: "is-buffer@1.1.6", Name: "is-buffer", Version: "1.1.6", Scope: types.ScopeRuntime},
+		{ID: "is-typedarray@1.0.0", Name: "is-typedarray", Version: "1.0.0", Scope: types.ScopeRuntime},
+		{ID: "isstream@0.1.2", Name: "isstream", Version: "0.1.2", Scope: types.ScopeRuntime},
+		{ID: "jquery@3.4.0", Name: "jquery", Version: "3.4.0", Scope: types.ScopeRuntime},
+		{ID: "js-tokens@4.0.0", Name: "js-tokens", Version: "4.0.0", Scope: types.ScopeRuntime},
+		{ID: "jsbn@0.1.1", Name: "jsbn", Version: "0.1.1", Scope: types.ScopeRuntime},
+		{ID: "json-schema@0.2.3", Name: "json-schema", Version: "0.2.3", Scope: types.ScopeRuntime},
+		{ID: "json-schema-traverse@0.4.1", Name: "json-schema-traverse", Version: "0.4.1", Scope: types.ScopeRuntime},
+		{ID: "json-stringify-safe@5.0.1", Name: "json-stringify-safe", Version: "5.0.1", Scope: types.ScopeRuntime},
+		{ID: "jsprim@1.4.1", Name: "jsprim", Version: "1.4.1", Scope: types.ScopeRuntime},
+		{ID: "lodash@4.17.11", Name: "lodash", Version: "4.17.11", Scope: types.ScopeRuntime},
+		{ID: "loose-envify@1.4.0", Name: "loose-envify", Version: "1.4.0", Scope: types.ScopeRuntime},
+		{ID: "media-typer@0.3.0", Name: "media-typer", Version: "0.3.0", Scope: types.ScopeRuntime},
+		{ID: "merge-descriptors@1.0.1", Name: "merge-descriptors", Version: "1.0.1", Scope: types.ScopeRuntime},
+		{ID: "methods@1.1.2", Name: "methods", Version: "1.1.2", Scope: types.ScopeRuntime},
+		{ID: "mime@1.4.1", Name: "mime", Version: "1.4.1", Scope: types.ScopeRuntime},
+		{ID: "mime-db@1.40.0", Name: "mime-db", Version: "1.40.0", Scope: types.ScopeRuntime},
+		{ID: "mime-types@2.1.24", Name: "mime-types", Version: "2.1.24", Scope: types.ScopeRuntime},
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Scope: types.ScopeRuntime},
+		{ID: "ms@2.1.1", Name: "ms", Version: "2.1.1", Scope: types.ScopeRuntime},
+		{ID: "negotiator@0.6.1", Name: "negotiator", Version: "0.6.1", Scope: types.ScopeRuntime},
+		{ID: "oauth-sign@0.9.0", Name: "oauth-sign", Version: "0.9.0", Scope: types.ScopeRuntime},
+		{ID: "object-assign@4.1.1", Name: "object-assign", Version: "4.1.1", Scope: types.ScopeRuntime},
+		{ID: "on-finished@2.3.0", Name: "on-finished", Version: "2.3.0", Scope: types.ScopeRuntime},
+		{ID: "parseurl@1.3.3", Name: "parseurl", Version: "1.3.3", Scope: types.ScopeRuntime},
+		{ID: "path-to-regexp@0.1.7", Name: "path-to-regexp", Version: "0.1.7", Scope: types.ScopeRuntime},
+		{ID: "performance-now@2.1.0", Name: "performance-now", Version: "2.1.0", Scope: types.ScopeRuntime},
+		{ID: "promise@8.0.3", Name: "promise", Version: "8.0.3", Scope: types.ScopeRuntime},
+		{ID: "prop-types@15.7.2", Name: "prop-types", Version: "15.7.2", Scope: types.ScopeRuntime},
+		{ID: "proxy-addr@2.0.5", Name: "proxy-addr", Version: "2.0.5", Scope: types.ScopeRuntime},
+		{ID: "psl@1.1.31", Name: "psl", Version: "1.1.31", Scope: types.ScopeRuntime},
+		{ID: "punycode@1.4.1", Name: "punycode", Version: "1.4.1", Scope: types.ScopeRuntime},
+		{ID: "punycode@2.1.1", Name: "punycode", Version: "2.1.1", Scope: types.ScopeRuntime},
+		{ID: "qs@6.5.2", Name: "qs", Version: "6.5.2", Scope: types.ScopeRuntime},
+		{ID: "range-parser@1.2.0", Name: "range-parser", Version: "1.2.0", Scope: types.ScopeRuntime},
+		{ID: "raw-body@2.3.3", Name: "raw-body", Version: "2.3.3", Scope: types.ScopeRuntime},
+		{ID: "react@16.8.6", Name: "react", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "react-is@16.8.6", Name: "react-is", Version: "16.8.6", Scope: types.ScopeRuntime},
+		{ID: "redux@4.0.1", Name: "redux", Version: "4.0.1", Scope: types.ScopeRuntime},
+		{ID: "request@2.88.0", Name: "request", Version: "2.88.0", Scope: types.ScopeRuntime},
+		{ID: "safe-buffer@5.1.2", Name: "safe-buffer", Version: "5.1.2", Scope: types.ScopeRuntime},
+		{ID: "safer-buffer@2.1.2", Name: "safer-buffer", Version: "2.1.2", Scope: types.ScopeRuntime},
+		{ID: "scheduler@0.13.6", Name: "scheduler", Version: "0.13.6", Scope: types.ScopeRuntime},
+		{ID: "send@0.16.2", Name: "send", Version: "0.16.2", Scope: types.ScopeRuntime},
+		{ID: "serve-static@1.13.2", Name: "serve-static", Version: "1.13.2", Scope: types.ScopeRuntime},
+		{ID: "setprototypeof@1.1.0", Name: "setprototypeof", Version: "1.1.0", Scope: types.ScopeRuntime},
+		{ID: "sshpk@1.16.1", Name: "sshpk", Version: "1.16.1", Scope: types.ScopeRuntime},
+		{ID: "statuses@1.4.0", Name: "statuses", Version: "1.4.0", Scope: types.ScopeRuntime},
+		{ID: "supports-color@5.5.0", Name: "supports-color", Version: "5.5.0", Scope: types.ScopeRuntime},
+		{ID: "symbol-observable@1.2.0", Name: "symbol-observable", Version: "1.2.0", Scope: types.ScopeRuntime},
+		{ID: "tough-cookie@2.4.3", Name: "tough-cookie", Version: "2.4.3", Scope: types.ScopeRuntime},
+		{ID: "tunnel-agent@0.6.0", Name: "tunnel-agent", Version: "0.6.0", Scope: types.ScopeRuntime},
+		{ID: "tweetnacl@0.14.5", Name: "tweetnacl", Version: "0.14.5", Scope: types.ScopeRuntime},
+		{ID: "type-is@1.6.18", Name: "type-is", Version: "1.6.18", Scope: types.ScopeRuntime},
+		{ID: "unpipe@1.0.0", Name: "unpipe", Version: "1.0.0", Scope: types.ScopeRuntime},
+		{ID: "uri-js@4.2.2", Name: "uri-js", Version: "4.2.2", Scope: types.ScopeRuntime},
+		{ID: "utils-merge@1.0.1", Name: "utils-merge", Version: "1.0.1", Scope: types.ScopeRuntime},
+		{ID: "uuid@3.3.2", Name: "uuid", Version: "3.3.2", Scope: types.ScopeRuntime},
+		{ID: "vary@1.1.2", Name: "vary", Version: "1.1.2", Scope: types.ScopeRuntime},
+		{ID: "verror@1.10.0", Name: "verror", Version: "1.10.0", Scope: types.ScopeRuntime},
+		{ID: "vue@2.6.10", Name: "vue", Version: "2.6.10", Scope: types.ScopeRuntime},
 	}
 
 	// manually created
 	npmNested = []types.Library{
-		{"debug", "2.0.0", ""},
-		{"debug", "2.6.9", ""},
-		{"ms", "0.6.2", ""},
-		{"ms", "2.0.0", ""},
-		{"ms", "2.1.0", ""},
-		{"ms", "2.1.1", ""},
-		{"send", "0.17.1", ""},
+		{ID: "debug@2.0.0", Name: "debug", Version: "2.0.0", Scope: types.ScopeRuntime},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Scope: types.ScopeRuntime},
+		{ID: "ms@0.6.2", Name: "ms", Version: "0.6.2", Scope: types.ScopeRuntime},
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Scope: types.ScopeRuntime},
+		{ID: "ms@2.1.0", Name: "ms", Version: "2.1.0", Scope: types.ScopeRuntime},
+		{ID: "ms@2.1.1", Name: "ms", Version: "2.1.1", Scope: types.ScopeRuntime},
+		{ID: "send@0.17.1", Name: "send", Version: "0.17.1", Scope: types.ScopeRuntime},
+	}
+
+	npmNormalDeps = []types.Dependency{
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+	}
+
+	npmReactDeps = []types.Dependency{
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{ID: "react@16.8.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "prop-types@15.7.2", "scheduler@0.13.6"}},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+	}
+
+	npmWithDevDeps = []types.Dependency{
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{ID: "react@16.8.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "prop-types@15.7.2", "scheduler@0.13.6"}},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+	}
+
+	npmManyDeps = []types.Dependency{
+		{ID: "accepts@1.3.6", DependsOn: []string{"mime-types@2.1.24", "negotiator@0.6.1"}},
+		{ID: "ajv@6.10.0", DependsOn: []string{"fast-deep-equal@2.0.1", "fast-json-stable-stringify@2.0.0", "json-schema-traverse@0.4.1", "uri-js@4.2.2"}},
+		{ID: "ansi-styles@3.2.1", DependsOn: []string{"color-convert@1.9.3"}},
+		{ID: "asn1@0.2.4", DependsOn: []string{"safer-buffer@2.1.2"}},
+		{ID: "async@2.6.2", DependsOn: []string{"lodash@4.17.11"}},
+		{ID: "axios@0.18.0", DependsOn: []string{"follow-redirects@1.7.0", "is-buffer@1.1.6"}},
+		{ID: "bcrypt-pbkdf@1.0.2", DependsOn: []string{"tweetnacl@0.14.5"}},
+		{ID: "body-parser@1.18.3", DependsOn: []string{"bytes@3.0.0", "content-type@1.0.4", "debug@2.6.9", "depd@1.1.2", "http-errors@1.6.3", "iconv-lite@0.4.23", "on-finished@2.3.0", "qs@6.5.2", "raw-body@2.3.3", "type-is@1.6.18"}},
+		{ID: "chalk@2.4.2", DependsOn: []string{"ansi-styles@3.2.1", "escape-string-regexp@1.0.5", "supports-color@5.5.0"}},
+		{ID: "color-convert@1.9.3", DependsOn: []string{"color-name@1.1.3"}},
+		{ID: "combined-stream@1.0.7", DependsOn: []string{"delayed-stream@1.0.0"}},
+		{ID: "dashdash@1.14.1", DependsOn: []string{"assert-plus@1.0.0"}},
+		{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+		{ID: "debug@3.2.6", DependsOn: []string{"ms@2.1.1"}},
+		{ID: "ecc-jsbn@0.1.2", DependsOn: []string{"jsbn@0.1.1", "safer-buffer@2.1.2"}},
+		{ID: "express@4.16.4", DependsOn: []string{"accepts@1.3.6", "array-flatten@1.1.1", "body-parser@1.18.3", "content-disposition@0.5.2", "content-type@1.0.4", "cookie-signature@1.0.6", "cookie@0.3.1", "debug@2.6.9", "depd@1.1.2", "encodeurl@1.0.2", "escape-html@1.0.3", "etag@1.8.1", "finalhandler@1.1.1", "fresh@0.5.2", "merge-descriptors@1.0.1", "methods@1.1.2", "on-finished@2.3.0", "parseurl@1.3.3", "path-to-regexp@0.1.7", "proxy-addr@2.0.5", "qs@6.5.2", "range-parser@1.2.0", "safe-buffer@5.1.2", "send@0.16.2", "serve-static@1.13.2", "setprototypeof@1.1.0", "statuses@1.4.0", "type-is@1.6.18", "utils-merge@1.0.1", "vary@1.1.2"}},
+		{ID: "finalhandler@1.1.1", DependsOn: []string{"debug@2.6.9", "encodeurl@1.0.2", "escape-html@1.0.3", "on-finished@2.3.0", "parseurl@1.3.3", "statuses@1.4.0", "unpipe@1.0.0"}},
+		{ID: "follow-redirects@1.7.0", DependsOn: []string{"debug@3.2.6"}},
+		{ID: "form-data@2.3.3", DependsOn: []string{"asynckit@0.4.0", "combined-stream@1.0.7", "mime-types@2.1.24"}},
+		{ID: "getpass@0.1.7", DependsOn: []string{"assert-plus@1.0.0"}},
+		{ID: "har-validator@5.1.3", DependsOn: []string{"ajv@6.10.0", "har-schema@2.0.0"}},
+		{ID: "http-errors@1.6.3", DependsOn: []string{"depd@1.1.2", "inherits@2.0.3", "setprototypeof@1.1.0", "statuses@1.4.0"}},
+		{ID: "http-signature@1.2.0", DependsOn: []string{"assert-plus@1.0.0", "jsprim@1.4.1", "sshpk@1.16.1"}},
+		{ID: "iconv-lite@0.4.23", DependsOn: []string{"safer-buffer@2.1.2"}},
+		{ID: "jsprim@1.4.1", DependsOn: []string{"assert-plus@1.0.0", "extsprintf@1.3.0", "json-schema@0.2.3", "verror@1.10.0"}},
+		{ID: "loose-envify@1.4.0", DependsOn: []string{"js-tokens@4.0.0"}},
+		{ID: "mime-types@2.1.24", DependsOn: []string{"mime-db@1.40.0"}},
+		{ID: "on-finished@2.3.0", DependsOn: []string{"ee-first@1.1.1"}},
+		{ID: "promise@8.0.3", DependsOn: []string{"asap@2.0.6"}},
+		{ID: "prop-types@15.7.2", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "react-is@16.8.6"}},
+		{ID: "proxy-addr@2.0.5", DependsOn: []string{"forwarded@0.1.2", "ipaddr.js@1.9.0"}},
+		{ID: "raw-body@2.3.3", DependsOn: []string{"bytes@3.0.0", "http-errors@1.6.3", "iconv-lite@0.4.23", "unpipe@1.0.0"}},
+		{ID: "react@16.8.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1", "prop-types@15.7.2", "scheduler@0.13.6"}},
+		{ID: "redux@4.0.1", DependsOn: []string{"loose-envify@1.4.0", "symbol-observable@1.2.0"}},
+		{ID: "request@2.88.0", DependsOn: []string{"aws-sign2@0.7.0", "aws4@1.8.0", "caseless@0.12.0", "combined-stream@1.0.7", "extend@3.0.2", "forever-agent@0.6.1", "form-data@2.3.3", "har-validator@5.1.3", "http-signature@1.2.0", "is-typedarray@1.0.0", "isstream@0.1.2", "json-stringify-safe@5.0.1", "mime-types@2.1.24", "oauth-sign@0.9.0", "performance-now@2.1.0", "qs@6.5.2", "safe-buffer@5.1.2", "tough-cookie@2.4.3", "tunnel-agent@0.6.0", "uuid@3.3.2"}},
+		{ID: "scheduler@0.13.6", DependsOn: []string{"loose-envify@1.4.0", "object-assign@4.1.1"}},
+		{ID: "send@0.16.2", DependsOn: []string{"debug@2.6.9", "depd@1.1.2", "destroy@1.0.4", "encodeurl@1.0.2", "escape-html@1.0.3", "etag@1.8.1", "fresh@0.5.2", "http-errors@1.6.3", "mime@1.4.1", "ms@2.0.0", "on-finished@2.3.0", "range-parser@1.2.0", "statuses@1.4.0"}},
+		{ID: "serve-static@1.13.2", DependsOn: []string{"encodeurl@1.0.2", "escape-html@1.0.3", "parseurl@1.3.3", "send@0.16.2"}},
+		{ID: "sshpk@1.16.1", DependsOn: []string{"asn1@0.2.4", "assert-plus@1.0.0", "bcrypt-pbkdf@1.0.2", "dashdash@1.14.1", "ecc-jsbn@0.1.2", "getpass@0.1.7", "jsbn@0.1.1", "safer-buffer@2.1.2", "tweetnacl@0.14.5"}},
+		{ID: "supports-color@5.5.0", DependsOn: []string{"has-flag@3.0.0"}},
+		{ID: "tough-cookie@2.4.3", DependsOn: []string{"psl@1.1.31", "punycode@1.4.1"}},
+		{ID: "tunnel-agent@0.6.0", DependsOn: []string{"safe-buffer@5.1.2"}},
+		{ID: "type-is@1.6.18", DependsOn: []string{"media-typer@0.3.0", "mime-types@2.1.24"}},
+		{ID: "uri-js@4.2.2", DependsOn: []string{"punycode@2.1.1"}},
+		{ID: "verror@1.10.0", DependsOn: []string{"assert-plus@1.0.0", "core-util-is@1.0.2", "extsprintf@1.3.0"}},
+	}
+
+	npmNestedDeps = []types.Dependency{
+		{ID: "debug@2.0.0", DependsOn: []string{"ms@0.6.2"}},
+		{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}},
+		{ID: "send@0.17.1", DependsOn: []string{"debug@2.6.9", "ms@2.1.1"}},
+	}
+
+	// Dev dependencies are skipped
+	npmOptional = []types.Library{
+		{ID: "chokidar@3.5.3", Name: "chokidar", Version: "3.5.3", Scope: types.ScopeRuntime},
+		{ID: "fsevents@2.3.2", Name: "fsevents", Version: "2.3.2", Scope: types.ScopeOptional},
+	}
+
+	npmOptionalDeps = []types.Dependency{
+		{ID: "chokidar@3.5.3", DependsOn: []string{"fsevents@2.3.2"}},
+	}
+
+	// Packages from private registries have the registry as a qualifier
+	npmRegistry = []types.Library{
+		{
+			ID:         "@babel/core@7.18.6",
+			Name:       "@babel/core",
+			Version:    "7.18.6",
+			Qualifiers: map[string]string{"repository_url": "https://npm.example.com/repository/npm"},
+			Scope:      types.ScopeRuntime,
+		},
+		{ID: "lodash@4.17.21", Name: "lodash", Version: "4.17.21", Scope: types.ScopeRuntime},
+	}
+
+	// "packages" of lockfileVersion 2 is skipped
+	npmV2 = []types.Library{
+		{ID: "debug@4.3.4", Name: "debug", Version: "4.3.4", Scope: types.ScopeRuntime},
+		{ID: "ms@2.1.2", Name: "ms", Version: "2.1.2", Scope: types.ScopeRuntime},
+	}
+	npmV2Deps = []types.Dependency{
+		{ID: "debug@4.3.4", DependsOn: []string{"ms@2.1.2"}},
+	}
+
+	// The same version from different registries is returned for each registry
+	npmRegistries = []types.Library{
+		{
+			ID:         "@babel/core@7.18.6",
+			Name:       "@babel/core",
+			Version:    "7.18.6",
+			Qualifiers: map[string]string{"repository_url": "https://npm.example.com/repository/npm"},
+			Scope:      types.ScopeRuntime,
+		},
+		{
+			ID:         "lodash@4.17.21",
+			Name:       "lodash",
+			Version:    "4.17.21",
+			Qualifiers: map[string]string{"repository_url": "https://npm.example.com/repository/npm"},
+			Scope:      types.ScopeRuntime,
+		},
+		{ID: "lodash@4.17.21", Name: "lodash", Version: "4.17.21", Scope: types.ScopeRuntime},
 	}
 )
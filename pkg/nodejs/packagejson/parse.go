@@ -4,32 +4,91 @@ import (
 	"encoding/json"
 	"io"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
 type packageJSON struct {
-	Name    string      `json:"name"`
-	Version string      `json:"version"`
-	License interface{} `json:"license"`
+	Name     string        `json:"name"`
+	Version  string        `json:"version"`
+	License  interface{}   `json:"license"`
+	Licenses []interface{} `json:"licenses"`
+	Homepage string        `json:"homepage"`
+	// e.g. "github:user/repo" or {"type": "git", "url": "git+https://github.com/user/repo.git"}
+	Repository interface{} `json:"repository"`
 }
 
-func Parse(r io.Reader) (types.Library, error) {
+// Parser implements types.Parser for package.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	lib, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{lib}, nil, nil
+}
+
+func Parse(r io.Reader) (_ types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var data packageJSON
-	err := json.NewDecoder(r).Decode(&data)
+	err = json.NewDecoder(r).Decode(&data)
 	if err != nil {
-		return types.Library{}, xerrors.Errorf("JSON decode error: %w", err)
+		return types.Library{}, xerrors.Errorf("JSON decode error: %w", &types.ErrMalformedInput{Err: err})
 	}
 
 	if data.Name == "" || data.Version == "" {
-		return types.Library{}, xerrors.Errorf("unable to parse package.json")
+		return types.Library{}, &types.ErrMalformedInput{Err: xerrors.Errorf("unable to parse package.json")}
+	}
+
+	lib := types.Library{
+		Name:               data.Name,
+		Version:            data.Version,
+		ExternalReferences: externalReferences(data),
 	}
+	lib.SetLicenses(license.NormalizeAll(parseLicenses(data.License, data.Licenses)))
+	return lib, nil
+}
 
-	return types.Library{
-		Name:    data.Name,
-		Version: data.Version,
-		License: parseLicense(data.License),
-	}, nil
+func externalReferences(data packageJSON) []types.ExternalReference {
+	var refs []types.ExternalReference
+	if data.Homepage != "" {
+		refs = append(refs, types.ExternalReference{Type: types.RefTypeWebsite, URL: data.Homepage})
+	}
+
+	var repo string
+	switch v := data.Repository.(type) {
+	case string:
+		repo = v
+	case map[string]interface{}:
+		repo, _ = v["url"].(string)
+	}
+	if repo != "" {
+		refs = append(refs, types.ExternalReference{Type: types.RefTypeVCS, URL: repo})
+	}
+	return refs
+}
+
+func parseLicenses(license interface{}, licenses []interface{}) []string {
+	if l := parseLicense(license); l != "" {
+		return []string{l}
+	}
+
+	// e.g. "licenses": [{"type": "MIT", "url": "..."}, {"type": "Apache-2.0", "url": "..."}]
+	var ls []string
+	for _, val := range licenses {
+		if l := parseLicense(val); l != "" {
+			ls = append(ls, l)
+		}
+	}
+	return ls
 }
 
 func parseLicense(val interface{}) string {
@@ -38,8 +97,8 @@ func parseLicense(val interface{}) string {
 	case string:
 		return v
 	case map[string]interface{}:
-		if license, ok := v["type"]; ok {
-			return license.(string)
+		if license, ok := v["type"].(string); ok {
+			return license
 		}
 	}
 	return ""
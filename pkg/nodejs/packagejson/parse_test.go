@@ -28,9 +28,14 @@ func TestParse(t *testing.T) {
 			// npm install --save promise jquery
 			// npm ls | grep -E -o "\S+@\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\"},\n")}'
 			want: types.Library{
-				Name:    "bootstrap",
-				Version: "5.0.2",
-				License: "MIT",
+				Name:     "bootstrap",
+				Version:  "5.0.2",
+				Licenses: []string{"MIT"},
+				License:  "MIT",
+				ExternalReferences: []types.ExternalReference{
+					{Type: types.RefTypeWebsite, URL: "https://getbootstrap.com/"},
+					{Type: types.RefTypeVCS, URL: "git+https://github.com/twbs/bootstrap.git"},
+				},
 			},
 			wantErr: "",
 		},
@@ -38,9 +43,25 @@ func TestParse(t *testing.T) {
 			name:      "happy path - legacy license",
 			inputFile: "testdata/legacy_package.json",
 			want: types.Library{
-				Name:    "angular",
-				Version: "4.1.2",
-				License: "ISC",
+				Name:     "angular",
+				Version:  "4.1.2",
+				Licenses: []string{"ISC"},
+				License:  "ISC",
+				ExternalReferences: []types.ExternalReference{
+					{Type: types.RefTypeWebsite, URL: "https://getbootstrap.com/"},
+					{Type: types.RefTypeVCS, URL: "git+https://github.com/twbs/bootstrap.git"},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name:      "happy path - legacy licenses",
+			inputFile: "testdata/legacy_licenses_package.json",
+			want: types.Library{
+				Name:     "dual-licensed",
+				Version:  "1.0.0",
+				Licenses: []string{"MIT", "Apache-2.0"},
+				License:  "MIT, Apache-2.0",
 			},
 			wantErr: "",
 		},
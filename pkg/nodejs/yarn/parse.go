@@ -2,19 +2,17 @@ package yarn
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
-	"regexp"
 	"strings"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
-var (
-	yarnLocatorRegexp = regexp.MustCompile(`"?(?P<package>.+?)@(?:(?P<protocol>.+?):)?.+`)
-	yarnVersionRegexp = regexp.MustCompile(`\s+"?version:?"?\s+"?(?P<version>[^"]+)"?`)
-)
+var versionField = []byte("version")
 
 type LockFile struct {
 	Dependencies map[string]Dependency
@@ -27,28 +25,98 @@ type Dependency struct {
 	Dependencies map[string]Dependency
 }
 
+// parsePackageLocator returns the name and the protocol of the first locator of the package line.
+// Lines are scanned without regular expressions, which dominated the time to parse large lock files.
+// e.g. "@babel/code-frame@npm:^7.10.4", "@babel/code-frame@^7.0.0": => @babel/code-frame, npm
 func parsePackageLocator(target string) (packagename, protocol string, err error) {
-	capture := yarnLocatorRegexp.FindStringSubmatch(target)
-	if len(capture) < 2 {
+	locator := target
+	if i := strings.Index(locator, ", "); i != -1 {
+		locator = locator[:i]
+	}
+	locator = strings.TrimSuffix(strings.TrimSpace(locator), ":")
+	locator = strings.Trim(locator, `"`)
+
+	// The name of scoped packages starts with "@"
+	var start int
+	if strings.HasPrefix(locator, "@") {
+		start = 1
+	}
+	i := strings.IndexByte(locator[start:], '@')
+	if i == -1 {
 		return "", "", xerrors.New("not package format")
 	}
-	for i, group := range yarnLocatorRegexp.SubexpNames() {
-		switch group {
-		case "package":
-			packagename = capture[i]
-		case "protocol":
-			protocol = capture[i]
-		}
+	i += start
+	packagename, rest := locator[:i], locator[i+1:]
+
+	// e.g. npm:^7.10.4, git+https://github.com/...
+	if j := strings.IndexByte(rest, ':'); j > 0 {
+		protocol = rest[:j]
 	}
-	return
+	return packagename, protocol, nil
 }
 
+// getVersion returns the version of the "version" line.
+// e.g. v1: `  version "1.2.3"`, v2: `  version: 1.2.3`
 func getVersion(target string) (version string, err error) {
-	capture := yarnVersionRegexp.FindStringSubmatch(target)
-	if len(capture) < 2 {
+	line := strings.TrimLeft(target, " \t")
+	if len(line) == len(target) {
 		return "", xerrors.New("not version")
 	}
-	return capture[len(capture)-1], nil
+	line = strings.TrimPrefix(line, `"`)
+	if !strings.HasPrefix(line, "version") {
+		return "", xerrors.New("not version")
+	}
+	line = strings.TrimPrefix(line[len("version"):], ":")
+	line = strings.TrimPrefix(line, `"`)
+
+	value := strings.TrimLeft(line, " \t")
+	if len(value) == len(line) {
+		return "", xerrors.New("not version")
+	}
+	value = strings.TrimPrefix(value, `"`)
+	if i := strings.IndexByte(value, '"'); i != -1 {
+		value = value[:i]
+	}
+	if value == "" {
+		return "", xerrors.New("not version")
+	}
+	return value, nil
+}
+
+// parseConstraints returns the ranges the package is requested with, joined with ", ".
+// e.g. "@babel/code-frame@^7.0.0", "@babel/code-frame@npm:^7.10.4": => ^7.0.0, ^7.10.4
+func parseConstraints(line string) string {
+	var constraints string
+	for _, locator := range strings.Split(strings.TrimSuffix(line, ":"), ", ") {
+		locator = strings.Trim(strings.TrimSpace(locator), `"`)
+		// The name of scoped packages starts with "@"
+		i := strings.Index(strings.TrimPrefix(locator, "@"), "@")
+		if i == -1 {
+			continue
+		}
+		if strings.HasPrefix(locator, "@") {
+			i++
+		}
+		constraints = joinConstraints(constraints, strings.TrimPrefix(locator[i+1:], "npm:"))
+	}
+	return constraints
+}
+
+// joinConstraints adds the constraints which are not yet in constraints.
+func joinConstraints(constraints, added string) string {
+	for _, c := range strings.Split(added, ", ") {
+		if c == "" {
+			continue
+		}
+		if constraints == "" {
+			constraints = c
+			continue
+		}
+		if !strings.Contains(", "+constraints+", ", ", "+c+", ") {
+			constraints += ", " + c
+		}
+	}
+	return constraints
 }
 
 func validProtocol(protocol string) (valid bool) {
@@ -60,16 +128,58 @@ func validProtocol(protocol string) (valid bool) {
 	return false
 }
 
-func Parse(r io.Reader) (libs []types.Library, err error) {
+// Parser implements types.Parser for yarn.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// ParseWithWarnings reports malformed entries to fn with their lines as soon as they are found.
+func (p *Parser) ParseWithWarnings(r io.Reader, fn func(types.Warning)) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	libs, err := parse(r, fn)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	return parse(r, nil)
+}
+
+// parse skips malformed entries, and reports them to fn if it is given, or returns them as *types.ErrPartialResult.
+func parse(r io.Reader, fn func(types.Warning)) (libs []types.Library, err error) {
 	scanner := bufio.NewScanner(r)
-	unique := map[string]struct{}{}
+	unique := map[string]int{}
 	var lib types.Library
 	var skipPackage bool
+	var lineNum, startLine int
+	var errs []error
+	// The index of the library the current block belongs to, or -1
+	current := -1
 	for scanner.Scan() {
-		line := scanner.Text()
-		if len(line) < 1 {
+		lineNum++
+		b := scanner.Bytes()
+		if len(b) < 1 {
 			continue
 		}
+		// Most lines are the fields of the blocks, which only extend the current block.
+		// They are not converted into strings, so that large lock files don't produce garbage for each line.
+		if b[0] == ' ' && !bytes.Contains(b, versionField) {
+			if current >= 0 {
+				locs := libs[current].Locations
+				locs[len(locs)-1].EndLine = lineNum
+			}
+			continue
+		}
+		line := string(b)
 
 		// parse version
 		var version string
@@ -78,27 +188,41 @@ func Parse(r io.Reader) (libs []types.Library, err error) {
 				continue
 			}
 			if lib.Name == "" {
-				return nil, xerrors.New("Invalid yarn.lock format")
+				err = &types.ErrMalformedInput{Err: xerrors.Errorf("Invalid yarn.lock format at line %d", lineNum)}
+				if fn != nil {
+					fn(types.Warning{Kind: types.WarningSkippedEntry, Message: err.Error(), Line: lineNum})
+					continue
+				}
+				errs = append(errs, err)
+				continue
 			}
 			// fetch between version prefix and last double-quote
 			symbol := fmt.Sprintf("%s@%s", lib.Name, version)
-			if _, ok := unique[symbol]; ok {
+			loc := types.Location{StartLine: startLine, EndLine: lineNum}
+			if i, ok := unique[symbol]; ok {
+				libs[i].Locations = append(libs[i].Locations, loc)
+				libs[i].Constraint = joinConstraints(libs[i].Constraint, lib.Constraint)
+				current = i
 				lib = types.Library{}
 				continue
 			}
 
 			lib.Version = version
+			lib.Locations = []types.Location{loc}
 			libs = append(libs, lib)
 			lib = types.Library{}
-			unique[symbol] = struct{}{}
+			current = len(libs) - 1
+			unique[symbol] = current
 			continue
 		}
 		// skip __metadata block
 		if skipPackage = strings.HasPrefix(line, "__metadata"); skipPackage {
+			current = -1
 			continue
 		}
 		// packagename line start 1 char
 		if line[:1] != " " && line[:1] != "#" {
+			current = -1
 			var name string
 			var protocol string
 			if name, protocol, err = parsePackageLocator(line); err != nil {
@@ -108,7 +232,16 @@ func Parse(r io.Reader) (libs []types.Library, err error) {
 				continue
 			}
 			lib.Name = name
+			lib.Constraint = parseConstraints(line)
+			startLine = lineNum
+			continue
+		}
+
+		// The block continues until the next package
+		if current >= 0 && line[:1] == " " {
+			locs := libs[current].Locations
+			locs[len(locs)-1].EndLine = lineNum
 		}
 	}
-	return libs, nil
+	return libs, types.NewErrPartialResult(errs)
 }
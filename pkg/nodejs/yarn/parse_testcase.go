@@ -8,2312 +8,2312 @@ var (
 	// yarn add promise jquery
 	// yarn list | grep -E -o "\S+@[^\^~]\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	yarnNormal = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.4.1", ""},
-		{"promise", "8.0.3", ""},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 5, EndLine: 8}}},
+		{Name: "jquery", Version: "3.4.1", Constraint: "^3.4.1", Locations: []types.Location{{StartLine: 10, EndLine: 13}}},
+		{Name: "promise", Version: "8.0.3", Constraint: "^8.0.3", Locations: []types.Location{{StartLine: 15, EndLine: 20}}},
 	}
 
 	// ... and
 	// yarn add react redux
 	// yarn list | grep -E -o "\S+@[^\^~]\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}'
 	yarnReact = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.4.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"react-is", "16.8.6", ""},
-		{"react", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"scheduler", "0.13.6", ""},
-		{"symbol-observable", "1.2.0", ""},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 5, EndLine: 8}}},
+		{Name: "jquery", Version: "3.4.1", Constraint: "^3.4.1", Locations: []types.Location{{StartLine: 10, EndLine: 13}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 15, EndLine: 18}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 20, EndLine: 25}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 27, EndLine: 30}}},
+		{Name: "promise", Version: "8.0.3", Constraint: "^8.0.3", Locations: []types.Location{{StartLine: 32, EndLine: 37}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 39, EndLine: 46}}},
+		{Name: "react-is", Version: "16.8.6", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 48, EndLine: 51}}},
+		{Name: "react", Version: "16.8.6", Constraint: "^16.8.6", Locations: []types.Location{{StartLine: 53, EndLine: 61}}},
+		{Name: "redux", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 63, EndLine: 69}}},
+		{Name: "scheduler", Version: "0.13.6", Constraint: "^0.13.6", Locations: []types.Location{{StartLine: 71, EndLine: 77}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 79, EndLine: 82}}},
 	}
 
 	// ... and
 	// yarn add -D mocha
 	// yarn list | grep -E -o "\S+@[^\^~]\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}' | sort | uniq
 	yarnWithDev = []types.Library{
-		{"ansi-colors", "3.2.3", ""},
-		{"ansi-regex", "2.1.1", ""},
-		{"ansi-regex", "3.0.0", ""},
-		{"ansi-regex", "4.1.0", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"argparse", "1.0.10", ""},
-		{"asap", "2.0.6", ""},
-		{"balanced-match", "1.0.0", ""},
-		{"brace-expansion", "1.1.11", ""},
-		{"browser-stdout", "1.3.1", ""},
-		{"camelcase", "5.3.1", ""},
-		{"chalk", "2.4.2", ""},
-		{"cliui", "4.1.0", ""},
-		{"code-point-at", "1.1.0", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-name", "1.1.3", ""},
-		{"concat-map", "0.0.1", ""},
-		{"cross-spawn", "6.0.5", ""},
-		{"debug", "3.2.6", ""},
-		{"decamelize", "1.2.0", ""},
-		{"define-properties", "1.1.3", ""},
-		{"diff", "3.5.0", ""},
-		{"emoji-regex", "7.0.3", ""},
-		{"end-of-stream", "1.4.1", ""},
-		{"es-abstract", "1.13.0", ""},
-		{"es-to-primitive", "1.2.0", ""},
-		{"escape-string-regexp", "1.0.5", ""},
-		{"esprima", "4.0.1", ""},
-		{"execa", "1.0.0", ""},
-		{"find-up", "3.0.0", ""},
-		{"flat", "4.1.0", ""},
-		{"fs.realpath", "1.0.0", ""},
-		{"function-bind", "1.1.1", ""},
-		{"get-caller-file", "1.0.3", ""},
-		{"get-caller-file", "2.0.5", ""},
-		{"get-stream", "4.1.0", ""},
-		{"glob", "7.1.3", ""},
-		{"growl", "1.10.5", ""},
-		{"has", "1.0.3", ""},
-		{"has-flag", "3.0.0", ""},
-		{"has-symbols", "1.0.0", ""},
-		{"he", "1.2.0", ""},
-		{"inflight", "1.0.6", ""},
-		{"inherits", "2.0.3", ""},
-		{"invert-kv", "2.0.0", ""},
-		{"is-buffer", "2.0.3", ""},
-		{"is-callable", "1.1.4", ""},
-		{"is-date-object", "1.0.1", ""},
-		{"is-fullwidth-code-point", "1.0.0", ""},
-		{"is-fullwidth-code-point", "2.0.0", ""},
-		{"is-regex", "1.0.4", ""},
-		{"is-stream", "1.1.0", ""},
-		{"is-symbol", "1.0.2", ""},
-		{"isexe", "2.0.0", ""},
-		{"jquery", "3.4.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"js-yaml", "3.13.1", ""},
-		{"lcid", "2.0.0", ""},
-		{"locate-path", "3.0.0", ""},
-		{"lodash", "4.17.11", ""},
-		{"log-symbols", "2.2.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"map-age-cleaner", "0.1.3", ""},
-		{"mem", "4.3.0", ""},
-		{"mimic-fn", "2.1.0", ""},
-		{"minimatch", "3.0.4", ""},
-		{"minimist", "0.0.8", ""},
-		{"mkdirp", "0.5.1", ""},
-		{"mocha", "6.1.4", ""},
-		{"ms", "2.1.1", ""},
-		{"nice-try", "1.0.5", ""},
-		{"node-environment-flags", "1.0.5", ""},
-		{"npm-run-path", "2.0.2", ""},
-		{"number-is-nan", "1.0.1", ""},
-		{"object-assign", "4.1.1", ""},
-		{"object-keys", "1.1.1", ""},
-		{"object.assign", "4.1.0", ""},
-		{"object.getownpropertydescriptors", "2.0.3", ""},
-		{"once", "1.4.0", ""},
-		{"os-locale", "3.1.0", ""},
-		{"p-defer", "1.0.0", ""},
-		{"p-finally", "1.0.0", ""},
-		{"p-is-promise", "2.1.0", ""},
-		{"p-limit", "2.2.0", ""},
-		{"p-locate", "3.0.0", ""},
-		{"p-try", "2.2.0", ""},
-		{"path-exists", "3.0.0", ""},
-		{"path-is-absolute", "1.0.1", ""},
-		{"path-key", "2.0.1", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"pump", "3.0.0", ""},
-		{"react", "16.8.6", ""},
-		{"react-is", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"require-directory", "2.1.1", ""},
-		{"require-main-filename", "1.0.1", ""},
-		{"require-main-filename", "2.0.0", ""},
-		{"scheduler", "0.13.6", ""},
-		{"semver", "5.7.0", ""},
-		{"set-blocking", "2.0.0", ""},
-		{"shebang-command", "1.2.0", ""},
-		{"shebang-regex", "1.0.0", ""},
-		{"signal-exit", "3.0.2", ""},
-		{"sprintf-js", "1.0.3", ""},
-		{"string-width", "1.0.2", ""},
-		{"string-width", "2.1.1", ""},
-		{"string-width", "3.1.0", ""},
-		{"strip-ansi", "3.0.1", ""},
-		{"strip-ansi", "4.0.0", ""},
-		{"strip-ansi", "5.2.0", ""},
-		{"strip-eof", "1.0.0", ""},
-		{"strip-json-comments", "2.0.1", ""},
-		{"supports-color", "5.5.0", ""},
-		{"supports-color", "6.0.0", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"which", "1.3.1", ""},
-		{"which-module", "2.0.0", ""},
-		{"wide-align", "1.1.3", ""},
-		{"wrap-ansi", "2.1.0", ""},
-		{"wrappy", "1.0.2", ""},
-		{"y18n", "4.0.0", ""},
-		{"yargs", "12.0.5", ""},
-		{"yargs", "13.2.2", ""},
-		{"yargs-parser", "11.1.1", ""},
-		{"yargs-parser", "13.0.0", ""},
-		{"yargs-parser", "13.1.0", ""},
-		{"yargs-unparser", "1.5.0", ""},
+		{Name: "ansi-colors", Version: "3.2.3", Constraint: "3.2.3", Locations: []types.Location{{StartLine: 5, EndLine: 8}}},
+		{Name: "ansi-regex", Version: "2.1.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10, EndLine: 13}}},
+		{Name: "ansi-regex", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 15, EndLine: 18}}},
+		{Name: "ansi-regex", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 20, EndLine: 23}}},
+		{Name: "ansi-styles", Version: "3.2.1", Constraint: "^3.2.1", Locations: []types.Location{{StartLine: 25, EndLine: 30}}},
+		{Name: "argparse", Version: "1.0.10", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 32, EndLine: 37}}},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 39, EndLine: 42}}},
+		{Name: "balanced-match", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 44, EndLine: 47}}},
+		{Name: "brace-expansion", Version: "1.1.11", Constraint: "^1.1.7", Locations: []types.Location{{StartLine: 49, EndLine: 55}}},
+		{Name: "browser-stdout", Version: "1.3.1", Constraint: "1.3.1", Locations: []types.Location{{StartLine: 57, EndLine: 60}}},
+		{Name: "camelcase", Version: "5.3.1", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 62, EndLine: 65}}},
+		{Name: "chalk", Version: "2.4.2", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 67, EndLine: 74}}},
+		{Name: "cliui", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 76, EndLine: 83}}},
+		{Name: "code-point-at", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 85, EndLine: 88}}},
+		{Name: "color-convert", Version: "1.9.3", Constraint: "^1.9.0", Locations: []types.Location{{StartLine: 90, EndLine: 95}}},
+		{Name: "color-name", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 97, EndLine: 100}}},
+		{Name: "concat-map", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 102, EndLine: 105}}},
+		{Name: "cross-spawn", Version: "6.0.5", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 107, EndLine: 116}}},
+		{Name: "debug", Version: "3.2.6", Constraint: "3.2.6", Locations: []types.Location{{StartLine: 118, EndLine: 123}}},
+		{Name: "decamelize", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 125, EndLine: 128}}},
+		{Name: "define-properties", Version: "1.1.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 130, EndLine: 135}}},
+		{Name: "diff", Version: "3.5.0", Constraint: "3.5.0", Locations: []types.Location{{StartLine: 137, EndLine: 140}}},
+		{Name: "emoji-regex", Version: "7.0.3", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 142, EndLine: 145}}},
+		{Name: "end-of-stream", Version: "1.4.1", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 147, EndLine: 152}}},
+		{Name: "es-abstract", Version: "1.13.0", Constraint: "^1.5.1", Locations: []types.Location{{StartLine: 154, EndLine: 164}}},
+		{Name: "es-to-primitive", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 166, EndLine: 173}}},
+		{Name: "escape-string-regexp", Version: "1.0.5", Constraint: "1.0.5, ^1.0.5", Locations: []types.Location{{StartLine: 175, EndLine: 178}}},
+		{Name: "esprima", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 180, EndLine: 183}}},
+		{Name: "execa", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 185, EndLine: 196}}},
+		{Name: "find-up", Version: "3.0.0", Constraint: "3.0.0, ^3.0.0", Locations: []types.Location{{StartLine: 198, EndLine: 203}}},
+		{Name: "flat", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 205, EndLine: 210}}},
+		{Name: "fs.realpath", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 212, EndLine: 215}}},
+		{Name: "function-bind", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 217, EndLine: 220}}},
+		{Name: "get-caller-file", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 222, EndLine: 225}}},
+		{Name: "get-caller-file", Version: "2.0.5", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 227, EndLine: 230}}},
+		{Name: "get-stream", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 232, EndLine: 237}}},
+		{Name: "glob", Version: "7.1.3", Constraint: "7.1.3", Locations: []types.Location{{StartLine: 239, EndLine: 249}}},
+		{Name: "growl", Version: "1.10.5", Constraint: "1.10.5", Locations: []types.Location{{StartLine: 251, EndLine: 254}}},
+		{Name: "has", Version: "1.0.3", Constraint: "^1.0.1, ^1.0.3", Locations: []types.Location{{StartLine: 266, EndLine: 271}}},
+		{Name: "has-flag", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 256, EndLine: 259}}},
+		{Name: "has-symbols", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 261, EndLine: 264}}},
+		{Name: "he", Version: "1.2.0", Constraint: "1.2.0", Locations: []types.Location{{StartLine: 273, EndLine: 276}}},
+		{Name: "inflight", Version: "1.0.6", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 278, EndLine: 284}}},
+		{Name: "inherits", Version: "2.0.3", Constraint: "2", Locations: []types.Location{{StartLine: 286, EndLine: 289}}},
+		{Name: "invert-kv", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 291, EndLine: 294}}},
+		{Name: "is-buffer", Version: "2.0.3", Constraint: "~2.0.3", Locations: []types.Location{{StartLine: 296, EndLine: 299}}},
+		{Name: "is-callable", Version: "1.1.4", Constraint: "^1.1.4", Locations: []types.Location{{StartLine: 301, EndLine: 304}}},
+		{Name: "is-date-object", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 306, EndLine: 309}}},
+		{Name: "is-fullwidth-code-point", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 311, EndLine: 316}}},
+		{Name: "is-fullwidth-code-point", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 318, EndLine: 321}}},
+		{Name: "is-regex", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 323, EndLine: 328}}},
+		{Name: "is-stream", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 330, EndLine: 333}}},
+		{Name: "is-symbol", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 335, EndLine: 340}}},
+		{Name: "isexe", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 342, EndLine: 345}}},
+		{Name: "jquery", Version: "3.4.1", Constraint: "^3.4.1", Locations: []types.Location{{StartLine: 347, EndLine: 350}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 352, EndLine: 355}}},
+		{Name: "js-yaml", Version: "3.13.1", Constraint: "3.13.1", Locations: []types.Location{{StartLine: 357, EndLine: 363}}},
+		{Name: "lcid", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 365, EndLine: 370}}},
+		{Name: "locate-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 372, EndLine: 378}}},
+		{Name: "lodash", Version: "4.17.11", Constraint: "^4.17.11", Locations: []types.Location{{StartLine: 380, EndLine: 383}}},
+		{Name: "log-symbols", Version: "2.2.0", Constraint: "2.2.0", Locations: []types.Location{{StartLine: 385, EndLine: 390}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 392, EndLine: 397}}},
+		{Name: "map-age-cleaner", Version: "0.1.3", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 399, EndLine: 404}}},
+		{Name: "mem", Version: "4.3.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 406, EndLine: 413}}},
+		{Name: "mimic-fn", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 415, EndLine: 418}}},
+		{Name: "minimatch", Version: "3.0.4", Constraint: "3.0.4, ^3.0.4", Locations: []types.Location{{StartLine: 420, EndLine: 425}}},
+		{Name: "minimist", Version: "0.0.8", Constraint: "0.0.8", Locations: []types.Location{{StartLine: 427, EndLine: 430}}},
+		{Name: "mkdirp", Version: "0.5.1", Constraint: "0.5.1", Locations: []types.Location{{StartLine: 432, EndLine: 437}}},
+		{Name: "mocha", Version: "6.1.4", Constraint: "^6.1.4", Locations: []types.Location{{StartLine: 439, EndLine: 466}}},
+		{Name: "ms", Version: "2.1.1", Constraint: "2.1.1, ^2.1.1", Locations: []types.Location{{StartLine: 468, EndLine: 471}}},
+		{Name: "nice-try", Version: "1.0.5", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 473, EndLine: 476}}},
+		{Name: "node-environment-flags", Version: "1.0.5", Constraint: "1.0.5", Locations: []types.Location{{StartLine: 478, EndLine: 484}}},
+		{Name: "npm-run-path", Version: "2.0.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 486, EndLine: 491}}},
+		{Name: "number-is-nan", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 493, EndLine: 496}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 498, EndLine: 501}}},
+		{Name: "object-keys", Version: "1.1.1", Constraint: "^1.0.11, ^1.0.12", Locations: []types.Location{{StartLine: 503, EndLine: 506}}},
+		{Name: "object.assign", Version: "4.1.0", Constraint: "4.1.0", Locations: []types.Location{{StartLine: 508, EndLine: 516}}},
+		{Name: "object.getownpropertydescriptors", Version: "2.0.3", Constraint: "^2.0.3", Locations: []types.Location{{StartLine: 518, EndLine: 524}}},
+		{Name: "once", Version: "1.4.0", Constraint: "^1.3.0, ^1.3.1, ^1.4.0", Locations: []types.Location{{StartLine: 526, EndLine: 531}}},
+		{Name: "os-locale", Version: "3.1.0", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 533, EndLine: 540}}},
+		{Name: "p-defer", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 542, EndLine: 545}}},
+		{Name: "p-finally", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 547, EndLine: 550}}},
+		{Name: "p-is-promise", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 552, EndLine: 555}}},
+		{Name: "p-limit", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 557, EndLine: 562}}},
+		{Name: "p-locate", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 564, EndLine: 569}}},
+		{Name: "p-try", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 571, EndLine: 574}}},
+		{Name: "path-exists", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 576, EndLine: 579}}},
+		{Name: "path-is-absolute", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 581, EndLine: 584}}},
+		{Name: "path-key", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 586, EndLine: 589}}},
+		{Name: "promise", Version: "8.0.3", Constraint: "^8.0.3", Locations: []types.Location{{StartLine: 591, EndLine: 596}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 598, EndLine: 605}}},
+		{Name: "pump", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 607, EndLine: 613}}},
+		{Name: "react", Version: "16.8.6", Constraint: "^16.8.6", Locations: []types.Location{{StartLine: 620, EndLine: 628}}},
+		{Name: "react-is", Version: "16.8.6", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 615, EndLine: 618}}},
+		{Name: "redux", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 630, EndLine: 636}}},
+		{Name: "require-directory", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 638, EndLine: 641}}},
+		{Name: "require-main-filename", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 643, EndLine: 646}}},
+		{Name: "require-main-filename", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 648, EndLine: 651}}},
+		{Name: "scheduler", Version: "0.13.6", Constraint: "^0.13.6", Locations: []types.Location{{StartLine: 653, EndLine: 659}}},
+		{Name: "semver", Version: "5.7.0", Constraint: "^5.5.0, ^5.7.0", Locations: []types.Location{{StartLine: 661, EndLine: 664}}},
+		{Name: "set-blocking", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 666, EndLine: 669}}},
+		{Name: "shebang-command", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 671, EndLine: 676}}},
+		{Name: "shebang-regex", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 678, EndLine: 681}}},
+		{Name: "signal-exit", Version: "3.0.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 683, EndLine: 686}}},
+		{Name: "sprintf-js", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 688, EndLine: 691}}},
+		{Name: "string-width", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 693, EndLine: 700}}},
+		{Name: "string-width", Version: "2.1.1", Constraint: "^1.0.2 || 2, ^2.0.0, ^2.1.1", Locations: []types.Location{{StartLine: 702, EndLine: 708}}},
+		{Name: "string-width", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 710, EndLine: 717}}},
+		{Name: "strip-ansi", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 719, EndLine: 724}}},
+		{Name: "strip-ansi", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 726, EndLine: 731}}},
+		{Name: "strip-ansi", Version: "5.2.0", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 733, EndLine: 738}}},
+		{Name: "strip-eof", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 740, EndLine: 743}}},
+		{Name: "strip-json-comments", Version: "2.0.1", Constraint: "2.0.1", Locations: []types.Location{{StartLine: 745, EndLine: 748}}},
+		{Name: "supports-color", Version: "5.5.0", Constraint: "^5.3.0", Locations: []types.Location{{StartLine: 757, EndLine: 762}}},
+		{Name: "supports-color", Version: "6.0.0", Constraint: "6.0.0", Locations: []types.Location{{StartLine: 750, EndLine: 755}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 764, EndLine: 767}}},
+		{Name: "which", Version: "1.3.1", Constraint: "1.3.1, ^1.2.9", Locations: []types.Location{{StartLine: 774, EndLine: 779}}},
+		{Name: "which-module", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 769, EndLine: 772}}},
+		{Name: "wide-align", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 781, EndLine: 786}}},
+		{Name: "wrap-ansi", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 788, EndLine: 794}}},
+		{Name: "wrappy", Version: "1.0.2", Constraint: "1", Locations: []types.Location{{StartLine: 796, EndLine: 799}}},
+		{Name: "y18n", Version: "4.0.0", Constraint: "^3.2.1 || ^4.0.0, ^4.0.0", Locations: []types.Location{{StartLine: 801, EndLine: 804}}},
+		{Name: "yargs", Version: "12.0.5", Constraint: "^12.0.5", Locations: []types.Location{{StartLine: 856, EndLine: 872}}},
+		{Name: "yargs", Version: "13.2.2", Constraint: "13.2.2", Locations: []types.Location{{StartLine: 839, EndLine: 854}}},
+		{Name: "yargs-parser", Version: "11.1.1", Constraint: "^11.1.1", Locations: []types.Location{{StartLine: 814, EndLine: 820}}},
+		{Name: "yargs-parser", Version: "13.0.0", Constraint: "13.0.0", Locations: []types.Location{{StartLine: 806, EndLine: 812}}},
+		{Name: "yargs-parser", Version: "13.1.0", Constraint: "^13.0.0", Locations: []types.Location{{StartLine: 822, EndLine: 828}}},
+		{Name: "yargs-unparser", Version: "1.5.0", Constraint: "1.5.0", Locations: []types.Location{{StartLine: 830, EndLine: 837}}},
 	}
 
 	// ... and
 	// yarn add lodash request chalk commander express async axios vue
 	// yarn list | grep -E -o "\S+@[^\^~]\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}' | sort | uniq
 	yarnMany = []types.Library{
-		{"accepts", "1.3.7", ""},
-		{"ajv", "6.10.0", ""},
-		{"ansi-colors", "3.2.3", ""},
-		{"ansi-regex", "2.1.1", ""},
-		{"ansi-regex", "3.0.0", ""},
-		{"ansi-regex", "4.1.0", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"argparse", "1.0.10", ""},
-		{"array-flatten", "1.1.1", ""},
-		{"asap", "2.0.6", ""},
-		{"asn1", "0.2.4", ""},
-		{"assert-plus", "1.0.0", ""},
-		{"async", "2.6.2", ""},
-		{"asynckit", "0.4.0", ""},
-		{"aws-sign2", "0.7.0", ""},
-		{"aws4", "1.8.0", ""},
-		{"axios", "0.18.0", ""},
-		{"balanced-match", "1.0.0", ""},
-		{"bcrypt-pbkdf", "1.0.2", ""},
-		{"body-parser", "1.18.3", ""},
-		{"brace-expansion", "1.1.11", ""},
-		{"browser-stdout", "1.3.1", ""},
-		{"bytes", "3.0.0", ""},
-		{"camelcase", "5.3.1", ""},
-		{"caseless", "0.12.0", ""},
-		{"chalk", "2.4.2", ""},
-		{"cliui", "4.1.0", ""},
-		{"code-point-at", "1.1.0", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-name", "1.1.3", ""},
-		{"combined-stream", "1.0.8", ""},
-		{"commander", "2.20.0", ""},
-		{"concat-map", "0.0.1", ""},
-		{"content-disposition", "0.5.2", ""},
-		{"content-type", "1.0.4", ""},
-		{"cookie", "0.3.1", ""},
-		{"cookie-signature", "1.0.6", ""},
-		{"core-util-is", "1.0.2", ""},
-		{"cross-spawn", "6.0.5", ""},
-		{"dashdash", "1.14.1", ""},
-		{"debug", "2.6.9", ""},
-		{"debug", "3.2.6", ""},
-		{"decamelize", "1.2.0", ""},
-		{"define-properties", "1.1.3", ""},
-		{"delayed-stream", "1.0.0", ""},
-		{"depd", "1.1.2", ""},
-		{"destroy", "1.0.4", ""},
-		{"diff", "3.5.0", ""},
-		{"ecc-jsbn", "0.1.2", ""},
-		{"ee-first", "1.1.1", ""},
-		{"emoji-regex", "7.0.3", ""},
-		{"encodeurl", "1.0.2", ""},
-		{"end-of-stream", "1.4.1", ""},
-		{"es-abstract", "1.13.0", ""},
-		{"es-to-primitive", "1.2.0", ""},
-		{"escape-html", "1.0.3", ""},
-		{"escape-string-regexp", "1.0.5", ""},
-		{"esprima", "4.0.1", ""},
-		{"etag", "1.8.1", ""},
-		{"execa", "1.0.0", ""},
-		{"express", "4.16.4", ""},
-		{"extend", "3.0.2", ""},
-		{"extsprintf", "1.3.0", ""},
-		{"extsprintf", "1.4.0", ""},
-		{"fast-deep-equal", "2.0.1", ""},
-		{"fast-json-stable-stringify", "2.0.0", ""},
-		{"finalhandler", "1.1.1", ""},
-		{"find-up", "3.0.0", ""},
-		{"flat", "4.1.0", ""},
-		{"follow-redirects", "1.7.0", ""},
-		{"forever-agent", "0.6.1", ""},
-		{"form-data", "2.3.3", ""},
-		{"forwarded", "0.1.2", ""},
-		{"fresh", "0.5.2", ""},
-		{"fs.realpath", "1.0.0", ""},
-		{"function-bind", "1.1.1", ""},
-		{"get-caller-file", "1.0.3", ""},
-		{"get-caller-file", "2.0.5", ""},
-		{"get-stream", "4.1.0", ""},
-		{"getpass", "0.1.7", ""},
-		{"glob", "7.1.3", ""},
-		{"growl", "1.10.5", ""},
-		{"har-schema", "2.0.0", ""},
-		{"har-validator", "5.1.3", ""},
-		{"has", "1.0.3", ""},
-		{"has-flag", "3.0.0", ""},
-		{"has-symbols", "1.0.0", ""},
-		{"he", "1.2.0", ""},
-		{"http-errors", "1.6.3", ""},
-		{"http-signature", "1.2.0", ""},
-		{"iconv-lite", "0.4.23", ""},
-		{"inflight", "1.0.6", ""},
-		{"inherits", "2.0.3", ""},
-		{"invert-kv", "2.0.0", ""},
-		{"ipaddr.js", "1.9.0", ""},
-		{"is-buffer", "1.1.6", ""},
-		{"is-buffer", "2.0.3", ""},
-		{"is-callable", "1.1.4", ""},
-		{"is-date-object", "1.0.1", ""},
-		{"is-fullwidth-code-point", "1.0.0", ""},
-		{"is-fullwidth-code-point", "2.0.0", ""},
-		{"is-regex", "1.0.4", ""},
-		{"is-stream", "1.1.0", ""},
-		{"is-symbol", "1.0.2", ""},
-		{"is-typedarray", "1.0.0", ""},
-		{"isexe", "2.0.0", ""},
-		{"isstream", "0.1.2", ""},
-		{"jquery", "3.4.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"js-yaml", "3.13.1", ""},
-		{"jsbn", "0.1.1", ""},
-		{"json-schema", "0.2.3", ""},
-		{"json-schema-traverse", "0.4.1", ""},
-		{"json-stringify-safe", "5.0.1", ""},
-		{"jsprim", "1.4.1", ""},
-		{"lcid", "2.0.0", ""},
-		{"locate-path", "3.0.0", ""},
-		{"lodash", "4.17.11", ""},
-		{"log-symbols", "2.2.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"map-age-cleaner", "0.1.3", ""},
-		{"media-typer", "0.3.0", ""},
-		{"mem", "4.3.0", ""},
-		{"merge-descriptors", "1.0.1", ""},
-		{"methods", "1.1.2", ""},
-		{"mime", "1.4.1", ""},
-		{"mime-db", "1.40.0", ""},
-		{"mime-types", "2.1.24", ""},
-		{"mimic-fn", "2.1.0", ""},
-		{"minimatch", "3.0.4", ""},
-		{"minimist", "0.0.8", ""},
-		{"mkdirp", "0.5.1", ""},
-		{"mocha", "6.1.4", ""},
-		{"ms", "2.0.0", ""},
-		{"ms", "2.1.1", ""},
-		{"negotiator", "0.6.2", ""},
-		{"nice-try", "1.0.5", ""},
-		{"node-environment-flags", "1.0.5", ""},
-		{"npm-run-path", "2.0.2", ""},
-		{"number-is-nan", "1.0.1", ""},
-		{"oauth-sign", "0.9.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"object-keys", "1.1.1", ""},
-		{"object.assign", "4.1.0", ""},
-		{"object.getownpropertydescriptors", "2.0.3", ""},
-		{"on-finished", "2.3.0", ""},
-		{"once", "1.4.0", ""},
-		{"os-locale", "3.1.0", ""},
-		{"p-defer", "1.0.0", ""},
-		{"p-finally", "1.0.0", ""},
-		{"p-is-promise", "2.1.0", ""},
-		{"p-limit", "2.2.0", ""},
-		{"p-locate", "3.0.0", ""},
-		{"p-try", "2.2.0", ""},
-		{"parseurl", "1.3.3", ""},
-		{"path-exists", "3.0.0", ""},
-		{"path-is-absolute", "1.0.1", ""},
-		{"path-key", "2.0.1", ""},
-		{"path-to-regexp", "0.1.7", ""},
-		{"performance-now", "2.1.0", ""},
-		{"promise", "8.0.3", ""},
-		{"prop-types", "15.7.2", ""},
-		{"proxy-addr", "2.0.5", ""},
-		{"psl", "1.1.31", ""},
-		{"pump", "3.0.0", ""},
-		{"punycode", "1.4.1", ""},
-		{"punycode", "2.1.1", ""},
-		{"qs", "6.5.2", ""},
-		{"range-parser", "1.2.1", ""},
-		{"raw-body", "2.3.3", ""},
-		{"react", "16.8.6", ""},
-		{"react-is", "16.8.6", ""},
-		{"redux", "4.0.1", ""},
-		{"request", "2.88.0", ""},
-		{"require-directory", "2.1.1", ""},
-		{"require-main-filename", "1.0.1", ""},
-		{"require-main-filename", "2.0.0", ""},
-		{"safe-buffer", "5.1.2", ""},
-		{"safer-buffer", "2.1.2", ""},
-		{"scheduler", "0.13.6", ""},
-		{"semver", "5.7.0", ""},
-		{"send", "0.16.2", ""},
-		{"serve-static", "1.13.2", ""},
-		{"set-blocking", "2.0.0", ""},
-		{"setprototypeof", "1.1.0", ""},
-		{"shebang-command", "1.2.0", ""},
-		{"shebang-regex", "1.0.0", ""},
-		{"signal-exit", "3.0.2", ""},
-		{"sprintf-js", "1.0.3", ""},
-		{"sshpk", "1.16.1", ""},
-		{"statuses", "1.4.0", ""},
-		{"statuses", "1.5.0", ""},
-		{"string-width", "1.0.2", ""},
-		{"string-width", "2.1.1", ""},
-		{"string-width", "3.1.0", ""},
-		{"strip-ansi", "3.0.1", ""},
-		{"strip-ansi", "4.0.0", ""},
-		{"strip-ansi", "5.2.0", ""},
-		{"strip-eof", "1.0.0", ""},
-		{"strip-json-comments", "2.0.1", ""},
-		{"supports-color", "5.5.0", ""},
-		{"supports-color", "6.0.0", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"tough-cookie", "2.4.3", ""},
-		{"tunnel-agent", "0.6.0", ""},
-		{"tweetnacl", "0.14.5", ""},
-		{"type-is", "1.6.18", ""},
-		{"unpipe", "1.0.0", ""},
-		{"uri-js", "4.2.2", ""},
-		{"utils-merge", "1.0.1", ""},
-		{"uuid", "3.3.2", ""},
-		{"vary", "1.1.2", ""},
-		{"verror", "1.10.0", ""},
-		{"vue", "2.6.10", ""},
-		{"which", "1.3.1", ""},
-		{"which-module", "2.0.0", ""},
-		{"wide-align", "1.1.3", ""},
-		{"wrap-ansi", "2.1.0", ""},
-		{"wrappy", "1.0.2", ""},
-		{"y18n", "4.0.0", ""},
-		{"yargs", "12.0.5", ""},
-		{"yargs", "13.2.2", ""},
-		{"yargs-parser", "11.1.1", ""},
-		{"yargs-parser", "13.0.0", ""},
-		{"yargs-parser", "13.1.0", ""},
-		{"yargs-unparser", "1.5.0", ""},
+		{Name: "accepts", Version: "1.3.7", Constraint: "~1.3.5", Locations: []types.Location{{StartLine: 5, EndLine: 11}}},
+		{Name: "ajv", Version: "6.10.0", Constraint: "^6.5.5", Locations: []types.Location{{StartLine: 13, EndLine: 21}}},
+		{Name: "ansi-colors", Version: "3.2.3", Constraint: "3.2.3", Locations: []types.Location{{StartLine: 23, EndLine: 26}}},
+		{Name: "ansi-regex", Version: "2.1.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 28, EndLine: 31}}},
+		{Name: "ansi-regex", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 33, EndLine: 36}}},
+		{Name: "ansi-regex", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 38, EndLine: 41}}},
+		{Name: "ansi-styles", Version: "3.2.1", Constraint: "^3.2.1", Locations: []types.Location{{StartLine: 43, EndLine: 48}}},
+		{Name: "argparse", Version: "1.0.10", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 50, EndLine: 55}}},
+		{Name: "array-flatten", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 57, EndLine: 60}}},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 62, EndLine: 65}}},
+		{Name: "asn1", Version: "0.2.4", Constraint: "~0.2.3", Locations: []types.Location{{StartLine: 67, EndLine: 72}}},
+		{Name: "assert-plus", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0", Locations: []types.Location{{StartLine: 74, EndLine: 77}}},
+		{Name: "async", Version: "2.6.2", Constraint: "^2.6.2", Locations: []types.Location{{StartLine: 79, EndLine: 84}}},
+		{Name: "asynckit", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 86, EndLine: 89}}},
+		{Name: "aws-sign2", Version: "0.7.0", Constraint: "~0.7.0", Locations: []types.Location{{StartLine: 91, EndLine: 94}}},
+		{Name: "aws4", Version: "1.8.0", Constraint: "^1.8.0", Locations: []types.Location{{StartLine: 96, EndLine: 99}}},
+		{Name: "axios", Version: "0.18.0", Constraint: "^0.18.0", Locations: []types.Location{{StartLine: 101, EndLine: 107}}},
+		{Name: "balanced-match", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 109, EndLine: 112}}},
+		{Name: "bcrypt-pbkdf", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 114, EndLine: 119}}},
+		{Name: "body-parser", Version: "1.18.3", Constraint: "1.18.3", Locations: []types.Location{{StartLine: 121, EndLine: 135}}},
+		{Name: "brace-expansion", Version: "1.1.11", Constraint: "^1.1.7", Locations: []types.Location{{StartLine: 137, EndLine: 143}}},
+		{Name: "browser-stdout", Version: "1.3.1", Constraint: "1.3.1", Locations: []types.Location{{StartLine: 145, EndLine: 148}}},
+		{Name: "bytes", Version: "3.0.0", Constraint: "3.0.0", Locations: []types.Location{{StartLine: 150, EndLine: 153}}},
+		{Name: "camelcase", Version: "5.3.1", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 155, EndLine: 158}}},
+		{Name: "caseless", Version: "0.12.0", Constraint: "~0.12.0", Locations: []types.Location{{StartLine: 160, EndLine: 163}}},
+		{Name: "chalk", Version: "2.4.2", Constraint: "^2.0.1, ^2.4.2", Locations: []types.Location{{StartLine: 165, EndLine: 172}}},
+		{Name: "cliui", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 174, EndLine: 181}}},
+		{Name: "code-point-at", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 183, EndLine: 186}}},
+		{Name: "color-convert", Version: "1.9.3", Constraint: "^1.9.0", Locations: []types.Location{{StartLine: 188, EndLine: 193}}},
+		{Name: "color-name", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 195, EndLine: 198}}},
+		{Name: "combined-stream", Version: "1.0.8", Constraint: "^1.0.6, ~1.0.6", Locations: []types.Location{{StartLine: 200, EndLine: 205}}},
+		{Name: "commander", Version: "2.20.0", Constraint: "^2.20.0", Locations: []types.Location{{StartLine: 207, EndLine: 210}}},
+		{Name: "concat-map", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 212, EndLine: 215}}},
+		{Name: "content-disposition", Version: "0.5.2", Constraint: "0.5.2", Locations: []types.Location{{StartLine: 217, EndLine: 220}}},
+		{Name: "content-type", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 222, EndLine: 225}}},
+		{Name: "cookie", Version: "0.3.1", Constraint: "0.3.1", Locations: []types.Location{{StartLine: 232, EndLine: 235}}},
+		{Name: "cookie-signature", Version: "1.0.6", Constraint: "1.0.6", Locations: []types.Location{{StartLine: 227, EndLine: 230}}},
+		{Name: "core-util-is", Version: "1.0.2", Constraint: "1.0.2", Locations: []types.Location{{StartLine: 237, EndLine: 240}}},
+		{Name: "cross-spawn", Version: "6.0.5", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 242, EndLine: 251}}},
+		{Name: "dashdash", Version: "1.14.1", Constraint: "^1.12.0", Locations: []types.Location{{StartLine: 253, EndLine: 258}}},
+		{Name: "debug", Version: "2.6.9", Constraint: "2.6.9", Locations: []types.Location{{StartLine: 260, EndLine: 265}}},
+		{Name: "debug", Version: "3.2.6", Constraint: "3.2.6, ^3.2.6", Locations: []types.Location{{StartLine: 267, EndLine: 272}}},
+		{Name: "decamelize", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 274, EndLine: 277}}},
+		{Name: "define-properties", Version: "1.1.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 279, EndLine: 284}}},
+		{Name: "delayed-stream", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 286, EndLine: 289}}},
+		{Name: "depd", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 291, EndLine: 294}}},
+		{Name: "destroy", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 296, EndLine: 299}}},
+		{Name: "diff", Version: "3.5.0", Constraint: "3.5.0", Locations: []types.Location{{StartLine: 301, EndLine: 304}}},
+		{Name: "ecc-jsbn", Version: "0.1.2", Constraint: "~0.1.1", Locations: []types.Location{{StartLine: 306, EndLine: 312}}},
+		{Name: "ee-first", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 314, EndLine: 317}}},
+		{Name: "emoji-regex", Version: "7.0.3", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 319, EndLine: 322}}},
+		{Name: "encodeurl", Version: "1.0.2", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 324, EndLine: 327}}},
+		{Name: "end-of-stream", Version: "1.4.1", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 329, EndLine: 334}}},
+		{Name: "es-abstract", Version: "1.13.0", Constraint: "^1.5.1", Locations: []types.Location{{StartLine: 336, EndLine: 346}}},
+		{Name: "es-to-primitive", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 348, EndLine: 355}}},
+		{Name: "escape-html", Version: "1.0.3", Constraint: "~1.0.3", Locations: []types.Location{{StartLine: 357, EndLine: 360}}},
+		{Name: "escape-string-regexp", Version: "1.0.5", Constraint: "1.0.5, ^1.0.5", Locations: []types.Location{{StartLine: 362, EndLine: 365}}},
+		{Name: "esprima", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 367, EndLine: 370}}},
+		{Name: "etag", Version: "1.8.1", Constraint: "~1.8.1", Locations: []types.Location{{StartLine: 372, EndLine: 375}}},
+		{Name: "execa", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 377, EndLine: 388}}},
+		{Name: "express", Version: "4.16.4", Constraint: "^4.16.4", Locations: []types.Location{{StartLine: 390, EndLine: 424}}},
+		{Name: "extend", Version: "3.0.2", Constraint: "~3.0.2", Locations: []types.Location{{StartLine: 426, EndLine: 429}}},
+		{Name: "extsprintf", Version: "1.3.0", Constraint: "1.3.0", Locations: []types.Location{{StartLine: 431, EndLine: 434}}},
+		{Name: "extsprintf", Version: "1.4.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 436, EndLine: 439}}},
+		{Name: "fast-deep-equal", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 441, EndLine: 444}}},
+		{Name: "fast-json-stable-stringify", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 446, EndLine: 449}}},
+		{Name: "finalhandler", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 451, EndLine: 462}}},
+		{Name: "find-up", Version: "3.0.0", Constraint: "3.0.0, ^3.0.0", Locations: []types.Location{{StartLine: 464, EndLine: 469}}},
+		{Name: "flat", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 471, EndLine: 476}}},
+		{Name: "follow-redirects", Version: "1.7.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 478, EndLine: 483}}},
+		{Name: "forever-agent", Version: "0.6.1", Constraint: "~0.6.1", Locations: []types.Location{{StartLine: 485, EndLine: 488}}},
+		{Name: "form-data", Version: "2.3.3", Constraint: "~2.3.2", Locations: []types.Location{{StartLine: 490, EndLine: 497}}},
+		{Name: "forwarded", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 499, EndLine: 502}}},
+		{Name: "fresh", Version: "0.5.2", Constraint: "0.5.2", Locations: []types.Location{{StartLine: 504, EndLine: 507}}},
+		{Name: "fs.realpath", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 509, EndLine: 512}}},
+		{Name: "function-bind", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 514, EndLine: 517}}},
+		{Name: "get-caller-file", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 519, EndLine: 522}}},
+		{Name: "get-caller-file", Version: "2.0.5", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 524, EndLine: 527}}},
+		{Name: "get-stream", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 529, EndLine: 534}}},
+		{Name: "getpass", Version: "0.1.7", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 536, EndLine: 541}}},
+		{Name: "glob", Version: "7.1.3", Constraint: "7.1.3", Locations: []types.Location{{StartLine: 543, EndLine: 553}}},
+		{Name: "growl", Version: "1.10.5", Constraint: "1.10.5", Locations: []types.Location{{StartLine: 555, EndLine: 558}}},
+		{Name: "har-schema", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 560, EndLine: 563}}},
+		{Name: "har-validator", Version: "5.1.3", Constraint: "~5.1.0", Locations: []types.Location{{StartLine: 565, EndLine: 571}}},
+		{Name: "has", Version: "1.0.3", Constraint: "^1.0.1, ^1.0.3", Locations: []types.Location{{StartLine: 583, EndLine: 588}}},
+		{Name: "has-flag", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 573, EndLine: 576}}},
+		{Name: "has-symbols", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 578, EndLine: 581}}},
+		{Name: "he", Version: "1.2.0", Constraint: "1.2.0", Locations: []types.Location{{StartLine: 590, EndLine: 593}}},
+		{Name: "http-errors", Version: "1.6.3", Constraint: "1.6.3, ~1.6.2, ~1.6.3", Locations: []types.Location{{StartLine: 595, EndLine: 603}}},
+		{Name: "http-signature", Version: "1.2.0", Constraint: "~1.2.0", Locations: []types.Location{{StartLine: 605, EndLine: 612}}},
+		{Name: "iconv-lite", Version: "0.4.23", Constraint: "0.4.23", Locations: []types.Location{{StartLine: 614, EndLine: 619}}},
+		{Name: "inflight", Version: "1.0.6", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 621, EndLine: 627}}},
+		{Name: "inherits", Version: "2.0.3", Constraint: "2, 2.0.3", Locations: []types.Location{{StartLine: 629, EndLine: 632}}},
+		{Name: "invert-kv", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 634, EndLine: 637}}},
+		{Name: "ipaddr.js", Version: "1.9.0", Constraint: "1.9.0", Locations: []types.Location{{StartLine: 639, EndLine: 642}}},
+		{Name: "is-buffer", Version: "1.1.6", Constraint: "^1.1.5", Locations: []types.Location{{StartLine: 644, EndLine: 647}}},
+		{Name: "is-buffer", Version: "2.0.3", Constraint: "~2.0.3", Locations: []types.Location{{StartLine: 649, EndLine: 652}}},
+		{Name: "is-callable", Version: "1.1.4", Constraint: "^1.1.4", Locations: []types.Location{{StartLine: 654, EndLine: 657}}},
+		{Name: "is-date-object", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 659, EndLine: 662}}},
+		{Name: "is-fullwidth-code-point", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 664, EndLine: 669}}},
+		{Name: "is-fullwidth-code-point", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 671, EndLine: 674}}},
+		{Name: "is-regex", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 676, EndLine: 681}}},
+		{Name: "is-stream", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 683, EndLine: 686}}},
+		{Name: "is-symbol", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 688, EndLine: 693}}},
+		{Name: "is-typedarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 695, EndLine: 698}}},
+		{Name: "isexe", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 700, EndLine: 703}}},
+		{Name: "isstream", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 705, EndLine: 708}}},
+		{Name: "jquery", Version: "3.4.1", Constraint: "^3.4.1", Locations: []types.Location{{StartLine: 710, EndLine: 713}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 715, EndLine: 718}}},
+		{Name: "js-yaml", Version: "3.13.1", Constraint: "3.13.1", Locations: []types.Location{{StartLine: 720, EndLine: 726}}},
+		{Name: "jsbn", Version: "0.1.1", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 728, EndLine: 731}}},
+		{Name: "json-schema", Version: "0.2.3", Constraint: "0.2.3", Locations: []types.Location{{StartLine: 738, EndLine: 741}}},
+		{Name: "json-schema-traverse", Version: "0.4.1", Constraint: "^0.4.1", Locations: []types.Location{{StartLine: 733, EndLine: 736}}},
+		{Name: "json-stringify-safe", Version: "5.0.1", Constraint: "~5.0.1", Locations: []types.Location{{StartLine: 743, EndLine: 746}}},
+		{Name: "jsprim", Version: "1.4.1", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 748, EndLine: 756}}},
+		{Name: "lcid", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 758, EndLine: 763}}},
+		{Name: "locate-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 765, EndLine: 771}}},
+		{Name: "lodash", Version: "4.17.11", Constraint: "^4.17.11", Locations: []types.Location{{StartLine: 773, EndLine: 776}}},
+		{Name: "log-symbols", Version: "2.2.0", Constraint: "2.2.0", Locations: []types.Location{{StartLine: 778, EndLine: 783}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 785, EndLine: 790}}},
+		{Name: "map-age-cleaner", Version: "0.1.3", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 792, EndLine: 797}}},
+		{Name: "media-typer", Version: "0.3.0", Constraint: "0.3.0", Locations: []types.Location{{StartLine: 799, EndLine: 802}}},
+		{Name: "mem", Version: "4.3.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 804, EndLine: 811}}},
+		{Name: "merge-descriptors", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 813, EndLine: 816}}},
+		{Name: "methods", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 818, EndLine: 821}}},
+		{Name: "mime", Version: "1.4.1", Constraint: "1.4.1", Locations: []types.Location{{StartLine: 835, EndLine: 838}}},
+		{Name: "mime-db", Version: "1.40.0", Constraint: "1.40.0", Locations: []types.Location{{StartLine: 823, EndLine: 826}}},
+		{Name: "mime-types", Version: "2.1.24", Constraint: "^2.1.12, ~2.1.19, ~2.1.24", Locations: []types.Location{{StartLine: 828, EndLine: 833}}},
+		{Name: "mimic-fn", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 840, EndLine: 843}}},
+		{Name: "minimatch", Version: "3.0.4", Constraint: "3.0.4, ^3.0.4", Locations: []types.Location{{StartLine: 845, EndLine: 850}}},
+		{Name: "minimist", Version: "0.0.8", Constraint: "0.0.8", Locations: []types.Location{{StartLine: 852, EndLine: 855}}},
+		{Name: "mkdirp", Version: "0.5.1", Constraint: "0.5.1", Locations: []types.Location{{StartLine: 857, EndLine: 862}}},
+		{Name: "mocha", Version: "6.1.4", Constraint: "^6.1.4", Locations: []types.Location{{StartLine: 864, EndLine: 891}}},
+		{Name: "ms", Version: "2.0.0", Constraint: "2.0.0", Locations: []types.Location{{StartLine: 893, EndLine: 896}}},
+		{Name: "ms", Version: "2.1.1", Constraint: "2.1.1, ^2.1.1", Locations: []types.Location{{StartLine: 898, EndLine: 901}}},
+		{Name: "negotiator", Version: "0.6.2", Constraint: "0.6.2", Locations: []types.Location{{StartLine: 903, EndLine: 906}}},
+		{Name: "nice-try", Version: "1.0.5", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 908, EndLine: 911}}},
+		{Name: "node-environment-flags", Version: "1.0.5", Constraint: "1.0.5", Locations: []types.Location{{StartLine: 913, EndLine: 919}}},
+		{Name: "npm-run-path", Version: "2.0.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 921, EndLine: 926}}},
+		{Name: "number-is-nan", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 928, EndLine: 931}}},
+		{Name: "oauth-sign", Version: "0.9.0", Constraint: "~0.9.0", Locations: []types.Location{{StartLine: 933, EndLine: 936}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 938, EndLine: 941}}},
+		{Name: "object-keys", Version: "1.1.1", Constraint: "^1.0.11, ^1.0.12", Locations: []types.Location{{StartLine: 943, EndLine: 946}}},
+		{Name: "object.assign", Version: "4.1.0", Constraint: "4.1.0", Locations: []types.Location{{StartLine: 948, EndLine: 956}}},
+		{Name: "object.getownpropertydescriptors", Version: "2.0.3", Constraint: "^2.0.3", Locations: []types.Location{{StartLine: 958, EndLine: 964}}},
+		{Name: "on-finished", Version: "2.3.0", Constraint: "~2.3.0", Locations: []types.Location{{StartLine: 966, EndLine: 971}}},
+		{Name: "once", Version: "1.4.0", Constraint: "^1.3.0, ^1.3.1, ^1.4.0", Locations: []types.Location{{StartLine: 973, EndLine: 978}}},
+		{Name: "os-locale", Version: "3.1.0", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 980, EndLine: 987}}},
+		{Name: "p-defer", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 989, EndLine: 992}}},
+		{Name: "p-finally", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 994, EndLine: 997}}},
+		{Name: "p-is-promise", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 999, EndLine: 1002}}},
+		{Name: "p-limit", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1004, EndLine: 1009}}},
+		{Name: "p-locate", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1011, EndLine: 1016}}},
+		{Name: "p-try", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1018, EndLine: 1021}}},
+		{Name: "parseurl", Version: "1.3.3", Constraint: "~1.3.2", Locations: []types.Location{{StartLine: 1023, EndLine: 1026}}},
+		{Name: "path-exists", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1028, EndLine: 1031}}},
+		{Name: "path-is-absolute", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1033, EndLine: 1036}}},
+		{Name: "path-key", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 1038, EndLine: 1041}}},
+		{Name: "path-to-regexp", Version: "0.1.7", Constraint: "0.1.7", Locations: []types.Location{{StartLine: 1043, EndLine: 1046}}},
+		{Name: "performance-now", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1048, EndLine: 1051}}},
+		{Name: "promise", Version: "8.0.3", Constraint: "^8.0.3", Locations: []types.Location{{StartLine: 1053, EndLine: 1058}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 1060, EndLine: 1067}}},
+		{Name: "proxy-addr", Version: "2.0.5", Constraint: "~2.0.4", Locations: []types.Location{{StartLine: 1069, EndLine: 1075}}},
+		{Name: "psl", Version: "1.1.31", Constraint: "^1.1.24", Locations: []types.Location{{StartLine: 1077, EndLine: 1080}}},
+		{Name: "pump", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1082, EndLine: 1088}}},
+		{Name: "punycode", Version: "1.4.1", Constraint: "^1.4.1", Locations: []types.Location{{StartLine: 1090, EndLine: 1093}}},
+		{Name: "punycode", Version: "2.1.1", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1095, EndLine: 1098}}},
+		{Name: "qs", Version: "6.5.2", Constraint: "6.5.2, ~6.5.2", Locations: []types.Location{{StartLine: 1100, EndLine: 1103}}},
+		{Name: "range-parser", Version: "1.2.1", Constraint: "~1.2.0", Locations: []types.Location{{StartLine: 1105, EndLine: 1108}}},
+		{Name: "raw-body", Version: "2.3.3", Constraint: "2.3.3", Locations: []types.Location{{StartLine: 1110, EndLine: 1118}}},
+		{Name: "react", Version: "16.8.6", Constraint: "^16.8.6", Locations: []types.Location{{StartLine: 1125, EndLine: 1133}}},
+		{Name: "react-is", Version: "16.8.6", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 1120, EndLine: 1123}}},
+		{Name: "redux", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 1135, EndLine: 1141}}},
+		{Name: "request", Version: "2.88.0", Constraint: "^2.88.0", Locations: []types.Location{{StartLine: 1143, EndLine: 1167}}},
+		{Name: "require-directory", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1169, EndLine: 1172}}},
+		{Name: "require-main-filename", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1174, EndLine: 1177}}},
+		{Name: "require-main-filename", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1179, EndLine: 1182}}},
+		{Name: "safe-buffer", Version: "5.1.2", Constraint: "5.1.2, ^5.0.1, ^5.1.2", Locations: []types.Location{{StartLine: 1184, EndLine: 1187}}},
+		{Name: "safer-buffer", Version: "2.1.2", Constraint: ">= 2.1.2 < 3, ^2.0.2, ^2.1.0, ~2.1.0", Locations: []types.Location{{StartLine: 1189, EndLine: 1192}}},
+		{Name: "scheduler", Version: "0.13.6", Constraint: "^0.13.6", Locations: []types.Location{{StartLine: 1194, EndLine: 1200}}},
+		{Name: "semver", Version: "5.7.0", Constraint: "^5.5.0, ^5.7.0", Locations: []types.Location{{StartLine: 1202, EndLine: 1205}}},
+		{Name: "send", Version: "0.16.2", Constraint: "0.16.2", Locations: []types.Location{{StartLine: 1207, EndLine: 1224}}},
+		{Name: "serve-static", Version: "1.13.2", Constraint: "1.13.2", Locations: []types.Location{{StartLine: 1226, EndLine: 1234}}},
+		{Name: "set-blocking", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1236, EndLine: 1239}}},
+		{Name: "setprototypeof", Version: "1.1.0", Constraint: "1.1.0", Locations: []types.Location{{StartLine: 1241, EndLine: 1244}}},
+		{Name: "shebang-command", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 1246, EndLine: 1251}}},
+		{Name: "shebang-regex", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1253, EndLine: 1256}}},
+		{Name: "signal-exit", Version: "3.0.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1258, EndLine: 1261}}},
+		{Name: "sprintf-js", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 1263, EndLine: 1266}}},
+		{Name: "sshpk", Version: "1.16.1", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 1268, EndLine: 1281}}},
+		{Name: "statuses", Version: "1.4.0", Constraint: "~1.4.0", Locations: []types.Location{{StartLine: 1288, EndLine: 1291}}},
+		{Name: "statuses", Version: "1.5.0", Constraint: ">= 1.4.0 < 2", Locations: []types.Location{{StartLine: 1283, EndLine: 1286}}},
+		{Name: "string-width", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1293, EndLine: 1300}}},
+		{Name: "string-width", Version: "2.1.1", Constraint: "^1.0.2 || 2, ^2.0.0, ^2.1.1", Locations: []types.Location{{StartLine: 1302, EndLine: 1308}}},
+		{Name: "string-width", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1310, EndLine: 1317}}},
+		{Name: "strip-ansi", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 1319, EndLine: 1324}}},
+		{Name: "strip-ansi", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1326, EndLine: 1331}}},
+		{Name: "strip-ansi", Version: "5.2.0", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 1333, EndLine: 1338}}},
+		{Name: "strip-eof", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1340, EndLine: 1343}}},
+		{Name: "strip-json-comments", Version: "2.0.1", Constraint: "2.0.1", Locations: []types.Location{{StartLine: 1345, EndLine: 1348}}},
+		{Name: "supports-color", Version: "5.5.0", Constraint: "^5.3.0", Locations: []types.Location{{StartLine: 1357, EndLine: 1362}}},
+		{Name: "supports-color", Version: "6.0.0", Constraint: "6.0.0", Locations: []types.Location{{StartLine: 1350, EndLine: 1355}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 1364, EndLine: 1367}}},
+		{Name: "tough-cookie", Version: "2.4.3", Constraint: "~2.4.3", Locations: []types.Location{{StartLine: 1369, EndLine: 1375}}},
+		{Name: "tunnel-agent", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 1377, EndLine: 1382}}},
+		{Name: "tweetnacl", Version: "0.14.5", Constraint: "^0.14.3, ~0.14.0", Locations: []types.Location{{StartLine: 1384, EndLine: 1387}}},
+		{Name: "type-is", Version: "1.6.18", Constraint: "~1.6.16", Locations: []types.Location{{StartLine: 1389, EndLine: 1395}}},
+		{Name: "unpipe", Version: "1.0.0", Constraint: "1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 1397, EndLine: 1400}}},
+		{Name: "uri-js", Version: "4.2.2", Constraint: "^4.2.2", Locations: []types.Location{{StartLine: 1402, EndLine: 1407}}},
+		{Name: "utils-merge", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 1409, EndLine: 1412}}},
+		{Name: "uuid", Version: "3.3.2", Constraint: "^3.3.2", Locations: []types.Location{{StartLine: 1414, EndLine: 1417}}},
+		{Name: "vary", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 1419, EndLine: 1422}}},
+		{Name: "verror", Version: "1.10.0", Constraint: "1.10.0", Locations: []types.Location{{StartLine: 1424, EndLine: 1431}}},
+		{Name: "vue", Version: "2.6.10", Constraint: "^2.6.10", Locations: []types.Location{{StartLine: 1433, EndLine: 1436}}},
+		{Name: "which", Version: "1.3.1", Constraint: "1.3.1, ^1.2.9", Locations: []types.Location{{StartLine: 1443, EndLine: 1448}}},
+		{Name: "which-module", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1438, EndLine: 1441}}},
+		{Name: "wide-align", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 1450, EndLine: 1455}}},
+		{Name: "wrap-ansi", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1457, EndLine: 1463}}},
+		{Name: "wrappy", Version: "1.0.2", Constraint: "1", Locations: []types.Location{{StartLine: 1465, EndLine: 1468}}},
+		{Name: "y18n", Version: "4.0.0", Constraint: "^3.2.1 || ^4.0.0, ^4.0.0", Locations: []types.Location{{StartLine: 1470, EndLine: 1473}}},
+		{Name: "yargs", Version: "12.0.5", Constraint: "^12.0.5", Locations: []types.Location{{StartLine: 1525, EndLine: 1541}}},
+		{Name: "yargs", Version: "13.2.2", Constraint: "13.2.2", Locations: []types.Location{{StartLine: 1508, EndLine: 1523}}},
+		{Name: "yargs-parser", Version: "11.1.1", Constraint: "^11.1.1", Locations: []types.Location{{StartLine: 1483, EndLine: 1489}}},
+		{Name: "yargs-parser", Version: "13.0.0", Constraint: "13.0.0", Locations: []types.Location{{StartLine: 1475, EndLine: 1481}}},
+		{Name: "yargs-parser", Version: "13.1.0", Constraint: "^13.0.0", Locations: []types.Location{{StartLine: 1491, EndLine: 1497}}},
+		{Name: "yargs-unparser", Version: "1.5.0", Constraint: "1.5.0", Locations: []types.Location{{StartLine: 1499, EndLine: 1506}}},
 	}
 
 	// yarn list | grep -E -o "\S+@[^\^~]\S+" | awk -F@ 'NR>0 {printf("{\""$1"\", \""$2"\", \"\"},\n")}' | sort | uniq
 	yarnRealWorld = []types.Library{
-		{"@babel/code-frame", "7.0.0", ""},
-		{"@babel/code-frame", "7.0.0-beta.44", ""},
-		{"@babel/core", "7.1.0", ""},
-		{"@babel/core", "7.4.4", ""},
-		{"@babel/generator", "7.0.0-beta.44", ""},
-		{"@babel/generator", "7.4.4", ""},
-		{"@babel/helper-annotate-as-pure", "7.0.0", ""},
-		{"@babel/helper-builder-binary-assignment-operator-visitor", "7.1.0", ""},
-		{"@babel/helper-builder-react-jsx", "7.3.0", ""},
-		{"@babel/helper-call-delegate", "7.4.4", ""},
-		{"@babel/helper-create-class-features-plugin", "7.4.4", ""},
-		{"@babel/helper-define-map", "7.4.4", ""},
-		{"@babel/helper-explode-assignable-expression", "7.1.0", ""},
-		{"@babel/helper-function-name", "7.0.0-beta.44", ""},
-		{"@babel/helper-function-name", "7.1.0", ""},
-		{"@babel/helper-get-function-arity", "7.0.0", ""},
-		{"@babel/helper-get-function-arity", "7.0.0-beta.44", ""},
-		{"@babel/helper-hoist-variables", "7.4.4", ""},
-		{"@babel/helper-member-expression-to-functions", "7.0.0", ""},
-		{"@babel/helper-module-imports", "7.0.0", ""},
-		{"@babel/helper-module-transforms", "7.4.4", ""},
-		{"@babel/helper-optimise-call-expression", "7.0.0", ""},
-		{"@babel/helper-plugin-utils", "7.0.0", ""},
-		{"@babel/helper-regex", "7.4.4", ""},
-		{"@babel/helper-remap-async-to-generator", "7.1.0", ""},
-		{"@babel/helper-replace-supers", "7.4.4", ""},
-		{"@babel/helper-simple-access", "7.1.0", ""},
-		{"@babel/helper-split-export-declaration", "7.0.0-beta.44", ""},
-		{"@babel/helper-split-export-declaration", "7.4.4", ""},
-		{"@babel/helper-wrap-function", "7.2.0", ""},
-		{"@babel/helpers", "7.4.4", ""},
-		{"@babel/highlight", "7.0.0", ""},
-		{"@babel/highlight", "7.0.0-beta.44", ""},
-		{"@babel/parser", "7.4.4", ""},
-		{"@babel/plugin-proposal-async-generator-functions", "7.2.0", ""},
-		{"@babel/plugin-proposal-class-properties", "7.1.0", ""},
-		{"@babel/plugin-proposal-class-properties", "7.4.4", ""},
-		{"@babel/plugin-proposal-decorators", "7.1.2", ""},
-		{"@babel/plugin-proposal-json-strings", "7.2.0", ""},
-		{"@babel/plugin-proposal-object-rest-spread", "7.0.0", ""},
-		{"@babel/plugin-proposal-object-rest-spread", "7.4.4", ""},
-		{"@babel/plugin-proposal-optional-catch-binding", "7.2.0", ""},
-		{"@babel/plugin-proposal-unicode-property-regex", "7.4.4", ""},
-		{"@babel/plugin-syntax-async-generators", "7.2.0", ""},
-		{"@babel/plugin-syntax-class-properties", "7.2.0", ""},
-		{"@babel/plugin-syntax-decorators", "7.2.0", ""},
-		{"@babel/plugin-syntax-dynamic-import", "7.0.0", ""},
-		{"@babel/plugin-syntax-dynamic-import", "7.2.0", ""},
-		{"@babel/plugin-syntax-flow", "7.2.0", ""},
-		{"@babel/plugin-syntax-json-strings", "7.2.0", ""},
-		{"@babel/plugin-syntax-jsx", "7.2.0", ""},
-		{"@babel/plugin-syntax-object-rest-spread", "7.2.0", ""},
-		{"@babel/plugin-syntax-optional-catch-binding", "7.2.0", ""},
-		{"@babel/plugin-syntax-typescript", "7.3.3", ""},
-		{"@babel/plugin-transform-arrow-functions", "7.2.0", ""},
-		{"@babel/plugin-transform-async-to-generator", "7.4.4", ""},
-		{"@babel/plugin-transform-block-scoped-functions", "7.2.0", ""},
-		{"@babel/plugin-transform-block-scoping", "7.4.4", ""},
-		{"@babel/plugin-transform-classes", "7.1.0", ""},
-		{"@babel/plugin-transform-classes", "7.4.4", ""},
-		{"@babel/plugin-transform-computed-properties", "7.2.0", ""},
-		{"@babel/plugin-transform-destructuring", "7.0.0", ""},
-		{"@babel/plugin-transform-destructuring", "7.4.4", ""},
-		{"@babel/plugin-transform-dotall-regex", "7.4.4", ""},
-		{"@babel/plugin-transform-duplicate-keys", "7.2.0", ""},
-		{"@babel/plugin-transform-exponentiation-operator", "7.2.0", ""},
-		{"@babel/plugin-transform-flow-strip-types", "7.0.0", ""},
-		{"@babel/plugin-transform-flow-strip-types", "7.4.4", ""},
-		{"@babel/plugin-transform-for-of", "7.4.4", ""},
-		{"@babel/plugin-transform-function-name", "7.4.4", ""},
-		{"@babel/plugin-transform-literals", "7.2.0", ""},
-		{"@babel/plugin-transform-member-expression-literals", "7.2.0", ""},
-		{"@babel/plugin-transform-modules-amd", "7.2.0", ""},
-		{"@babel/plugin-transform-modules-commonjs", "7.4.4", ""},
-		{"@babel/plugin-transform-modules-systemjs", "7.4.4", ""},
-		{"@babel/plugin-transform-modules-umd", "7.2.0", ""},
-		{"@babel/plugin-transform-named-capturing-groups-regex", "7.4.4", ""},
-		{"@babel/plugin-transform-new-target", "7.4.4", ""},
-		{"@babel/plugin-transform-object-super", "7.2.0", ""},
-		{"@babel/plugin-transform-parameters", "7.4.4", ""},
-		{"@babel/plugin-transform-property-literals", "7.2.0", ""},
-		{"@babel/plugin-transform-react-constant-elements", "7.0.0", ""},
-		{"@babel/plugin-transform-react-constant-elements", "7.2.0", ""},
-		{"@babel/plugin-transform-react-display-name", "7.0.0", ""},
-		{"@babel/plugin-transform-react-display-name", "7.2.0", ""},
-		{"@babel/plugin-transform-react-jsx", "7.3.0", ""},
-		{"@babel/plugin-transform-react-jsx-self", "7.2.0", ""},
-		{"@babel/plugin-transform-react-jsx-source", "7.2.0", ""},
-		{"@babel/plugin-transform-regenerator", "7.4.4", ""},
-		{"@babel/plugin-transform-reserved-words", "7.2.0", ""},
-		{"@babel/plugin-transform-runtime", "7.1.0", ""},
-		{"@babel/plugin-transform-shorthand-properties", "7.2.0", ""},
-		{"@babel/plugin-transform-spread", "7.2.2", ""},
-		{"@babel/plugin-transform-sticky-regex", "7.2.0", ""},
-		{"@babel/plugin-transform-template-literals", "7.4.4", ""},
-		{"@babel/plugin-transform-typeof-symbol", "7.2.0", ""},
-		{"@babel/plugin-transform-typescript", "7.4.4", ""},
-		{"@babel/plugin-transform-unicode-regex", "7.4.4", ""},
-		{"@babel/preset-env", "7.1.0", ""},
-		{"@babel/preset-env", "7.4.4", ""},
-		{"@babel/preset-flow", "7.0.0", ""},
-		{"@babel/preset-react", "7.0.0", ""},
-		{"@babel/preset-typescript", "7.1.0", ""},
-		{"@babel/register", "7.4.4", ""},
-		{"@babel/runtime", "7.0.0", ""},
-		{"@babel/runtime", "7.4.4", ""},
-		{"@babel/template", "7.0.0-beta.44", ""},
-		{"@babel/template", "7.4.4", ""},
-		{"@babel/traverse", "7.0.0-beta.44", ""},
-		{"@babel/traverse", "7.4.4", ""},
-		{"@babel/types", "7.0.0-beta.44", ""},
-		{"@babel/types", "7.4.4", ""},
-		{"@emotion/cache", "0.8.8", ""},
-		{"@emotion/core", "0.13.1", ""},
-		{"@emotion/css", "0.9.8", ""},
-		{"@emotion/hash", "0.6.6", ""},
-		{"@emotion/is-prop-valid", "0.6.8", ""},
-		{"@emotion/is-prop-valid", "0.7.3", ""},
-		{"@emotion/memoize", "0.6.6", ""},
-		{"@emotion/memoize", "0.7.1", ""},
-		{"@emotion/provider", "0.11.2", ""},
-		{"@emotion/serialize", "0.9.1", ""},
-		{"@emotion/sheet", "0.8.1", ""},
-		{"@emotion/styled", "0.10.6", ""},
-		{"@emotion/styled-base", "0.10.6", ""},
-		{"@emotion/stylis", "0.7.1", ""},
-		{"@emotion/unitless", "0.6.7", ""},
-		{"@emotion/unitless", "0.7.3", ""},
-		{"@emotion/utils", "0.8.2", ""},
-		{"@emotion/weak-memoize", "0.1.3", ""},
-		{"@icons/material", "0.2.4", ""},
-		{"@loadable/component", "5.10.1", ""},
-		{"@material-ui/core", "3.9.3", ""},
-		{"@material-ui/icons", "3.0.2", ""},
-		{"@material-ui/system", "3.0.0-alpha.2", ""},
-		{"@material-ui/utils", "3.0.0-alpha.3", ""},
-		{"@mrmlnc/readdir-enhanced", "2.2.1", ""},
-		{"@nodelib/fs.stat", "1.1.3", ""},
-		{"@octokit/rest", "15.18.1", ""},
-		{"@samverschueren/stream-to-observable", "0.3.0", ""},
-		{"@storybook/addon-actions", "4.1.18", ""},
-		{"@storybook/addon-info", "4.1.18", ""},
-		{"@storybook/addon-knobs", "4.1.18", ""},
-		{"@storybook/addons", "4.1.18", ""},
-		{"@storybook/channel-postmessage", "4.1.18", ""},
-		{"@storybook/channels", "4.1.18", ""},
-		{"@storybook/cli", "4.1.18", ""},
-		{"@storybook/client-logger", "4.1.18", ""},
-		{"@storybook/codemod", "4.1.18", ""},
-		{"@storybook/components", "4.1.18", ""},
-		{"@storybook/core", "4.1.18", ""},
-		{"@storybook/core-events", "4.1.18", ""},
-		{"@storybook/mantra-core", "1.7.2", ""},
-		{"@storybook/node-logger", "4.1.18", ""},
-		{"@storybook/podda", "1.2.3", ""},
-		{"@storybook/react", "4.1.18", ""},
-		{"@storybook/react-komposer", "2.0.5", ""},
-		{"@storybook/react-simple-di", "1.3.0", ""},
-		{"@storybook/react-stubber", "1.0.1", ""},
-		{"@storybook/ui", "4.1.18", ""},
-		{"@svgr/babel-plugin-add-jsx-attribute", "4.2.0", ""},
-		{"@svgr/babel-plugin-remove-jsx-attribute", "4.2.0", ""},
-		{"@svgr/babel-plugin-remove-jsx-empty-expression", "4.2.0", ""},
-		{"@svgr/babel-plugin-replace-jsx-attribute-value", "4.2.0", ""},
-		{"@svgr/babel-plugin-svg-dynamic-title", "4.2.0", ""},
-		{"@svgr/babel-plugin-svg-em-dimensions", "4.2.0", ""},
-		{"@svgr/babel-plugin-transform-react-native-svg", "4.2.0", ""},
-		{"@svgr/babel-plugin-transform-svg-component", "4.2.0", ""},
-		{"@svgr/babel-preset", "4.2.0", ""},
-		{"@svgr/core", "4.2.0", ""},
-		{"@svgr/hast-util-to-babel-ast", "4.2.0", ""},
-		{"@svgr/plugin-jsx", "4.2.0", ""},
-		{"@svgr/plugin-svgo", "4.2.0", ""},
-		{"@svgr/webpack", "4.2.0", ""},
-		{"@types/events", "3.0.0", ""},
-		{"@types/glob", "7.1.1", ""},
-		{"@types/jss", "9.5.8", ""},
-		{"@types/minimatch", "3.0.3", ""},
-		{"@types/node", "12.0.2", ""},
-		{"@types/prop-types", "15.7.1", ""},
-		{"@types/q", "1.5.2", ""},
-		{"@types/react", "16.8.17", ""},
-		{"@types/react-transition-group", "2.9.1", ""},
-		{"@types/unist", "2.0.3", ""},
-		{"@types/vfile", "3.0.2", ""},
-		{"@types/vfile-message", "1.0.1", ""},
-		{"@webassemblyjs/ast", "1.8.5", ""},
-		{"@webassemblyjs/floating-point-hex-parser", "1.8.5", ""},
-		{"@webassemblyjs/helper-api-error", "1.8.5", ""},
-		{"@webassemblyjs/helper-buffer", "1.8.5", ""},
-		{"@webassemblyjs/helper-code-frame", "1.8.5", ""},
-		{"@webassemblyjs/helper-fsm", "1.8.5", ""},
-		{"@webassemblyjs/helper-module-context", "1.8.5", ""},
-		{"@webassemblyjs/helper-wasm-bytecode", "1.8.5", ""},
-		{"@webassemblyjs/helper-wasm-section", "1.8.5", ""},
-		{"@webassemblyjs/ieee754", "1.8.5", ""},
-		{"@webassemblyjs/leb128", "1.8.5", ""},
-		{"@webassemblyjs/utf8", "1.8.5", ""},
-		{"@webassemblyjs/wasm-edit", "1.8.5", ""},
-		{"@webassemblyjs/wasm-gen", "1.8.5", ""},
-		{"@webassemblyjs/wasm-opt", "1.8.5", ""},
-		{"@webassemblyjs/wasm-parser", "1.8.5", ""},
-		{"@webassemblyjs/wast-parser", "1.8.5", ""},
-		{"@webassemblyjs/wast-printer", "1.8.5", ""},
-		{"@xtuc/ieee754", "1.2.0", ""},
-		{"@xtuc/long", "4.2.2", ""},
+		{Name: "@babel/code-frame", Version: "7.0.0", Constraint: "7.0.0, ^7.0.0, ^7.0.0-beta.35", Locations: []types.Location{{StartLine: 5, EndLine: 10}}},
+		{Name: "@babel/code-frame", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 12, EndLine: 17}}},
+		{Name: "@babel/core", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 19, EndLine: 37}}},
+		{Name: "@babel/core", Version: "7.4.4", Constraint: "^7.2.0, ^7.4.3", Locations: []types.Location{{StartLine: 39, EndLine: 57}}},
+		{Name: "@babel/generator", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 59, EndLine: 68}}},
+		{Name: "@babel/generator", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 70, EndLine: 79}}},
+		{Name: "@babel/helper-annotate-as-pure", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 81, EndLine: 86}}},
+		{Name: "@babel/helper-builder-binary-assignment-operator-visitor", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 88, EndLine: 94}}},
+		{Name: "@babel/helper-builder-react-jsx", Version: "7.3.0", Constraint: "^7.3.0", Locations: []types.Location{{StartLine: 96, EndLine: 102}}},
+		{Name: "@babel/helper-call-delegate", Version: "7.4.4", Constraint: "^7.4.4", Locations: []types.Location{{StartLine: 104, EndLine: 111}}},
+		{Name: "@babel/helper-create-class-features-plugin", Version: "7.4.4", Constraint: "^7.4.4", Locations: []types.Location{{StartLine: 113, EndLine: 123}}},
+		{Name: "@babel/helper-define-map", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 125, EndLine: 132}}},
+		{Name: "@babel/helper-explode-assignable-expression", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 134, EndLine: 140}}},
+		{Name: "@babel/helper-function-name", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 142, EndLine: 149}}},
+		{Name: "@babel/helper-function-name", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 151, EndLine: 158}}},
+		{Name: "@babel/helper-get-function-arity", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 167, EndLine: 172}}},
+		{Name: "@babel/helper-get-function-arity", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 160, EndLine: 165}}},
+		{Name: "@babel/helper-hoist-variables", Version: "7.4.4", Constraint: "^7.4.4", Locations: []types.Location{{StartLine: 174, EndLine: 179}}},
+		{Name: "@babel/helper-member-expression-to-functions", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 181, EndLine: 186}}},
+		{Name: "@babel/helper-module-imports", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 188, EndLine: 193}}},
+		{Name: "@babel/helper-module-transforms", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 195, EndLine: 205}}},
+		{Name: "@babel/helper-optimise-call-expression", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 207, EndLine: 212}}},
+		{Name: "@babel/helper-plugin-utils", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 214, EndLine: 217}}},
+		{Name: "@babel/helper-regex", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 219, EndLine: 224}}},
+		{Name: "@babel/helper-remap-async-to-generator", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 226, EndLine: 235}}},
+		{Name: "@babel/helper-replace-supers", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 237, EndLine: 245}}},
+		{Name: "@babel/helper-simple-access", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 247, EndLine: 253}}},
+		{Name: "@babel/helper-split-export-declaration", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 255, EndLine: 260}}},
+		{Name: "@babel/helper-split-export-declaration", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 262, EndLine: 267}}},
+		{Name: "@babel/helper-wrap-function", Version: "7.2.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 269, EndLine: 277}}},
+		{Name: "@babel/helpers", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 279, EndLine: 286}}},
+		{Name: "@babel/highlight", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 297, EndLine: 304}}},
+		{Name: "@babel/highlight", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 288, EndLine: 295}}},
+		{Name: "@babel/parser", Version: "7.4.4", Constraint: "^7.1.0, ^7.1.3, ^7.4.4", Locations: []types.Location{{StartLine: 306, EndLine: 309}}},
+		{Name: "@babel/plugin-proposal-async-generator-functions", Version: "7.2.0", Constraint: "^7.1.0, ^7.2.0", Locations: []types.Location{{StartLine: 311, EndLine: 318}}},
+		{Name: "@babel/plugin-proposal-class-properties", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 320, EndLine: 330}}},
+		{Name: "@babel/plugin-proposal-class-properties", Version: "7.4.4", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 332, EndLine: 338}}},
+		{Name: "@babel/plugin-proposal-decorators", Version: "7.1.2", Constraint: "7.1.2", Locations: []types.Location{{StartLine: 340, EndLine: 348}}},
+		{Name: "@babel/plugin-proposal-json-strings", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 350, EndLine: 356}}},
+		{Name: "@babel/plugin-proposal-object-rest-spread", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 358, EndLine: 364}}},
+		{Name: "@babel/plugin-proposal-object-rest-spread", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 366, EndLine: 372}}},
+		{Name: "@babel/plugin-proposal-optional-catch-binding", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 374, EndLine: 380}}},
+		{Name: "@babel/plugin-proposal-unicode-property-regex", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 382, EndLine: 389}}},
+		{Name: "@babel/plugin-syntax-async-generators", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 391, EndLine: 396}}},
+		{Name: "@babel/plugin-syntax-class-properties", Version: "7.2.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 398, EndLine: 403}}},
+		{Name: "@babel/plugin-syntax-decorators", Version: "7.2.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 405, EndLine: 410}}},
+		{Name: "@babel/plugin-syntax-dynamic-import", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 412, EndLine: 417}}},
+		{Name: "@babel/plugin-syntax-dynamic-import", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 419, EndLine: 424}}},
+		{Name: "@babel/plugin-syntax-flow", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 426, EndLine: 431}}},
+		{Name: "@babel/plugin-syntax-json-strings", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 433, EndLine: 438}}},
+		{Name: "@babel/plugin-syntax-jsx", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 440, EndLine: 445}}},
+		{Name: "@babel/plugin-syntax-object-rest-spread", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 447, EndLine: 452}}},
+		{Name: "@babel/plugin-syntax-optional-catch-binding", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 454, EndLine: 459}}},
+		{Name: "@babel/plugin-syntax-typescript", Version: "7.3.3", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 461, EndLine: 466}}},
+		{Name: "@babel/plugin-transform-arrow-functions", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 468, EndLine: 473}}},
+		{Name: "@babel/plugin-transform-async-to-generator", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 475, EndLine: 482}}},
+		{Name: "@babel/plugin-transform-block-scoped-functions", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 484, EndLine: 489}}},
+		{Name: "@babel/plugin-transform-block-scoping", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 491, EndLine: 497}}},
+		{Name: "@babel/plugin-transform-classes", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 499, EndLine: 511}}},
+		{Name: "@babel/plugin-transform-classes", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 513, EndLine: 525}}},
+		{Name: "@babel/plugin-transform-computed-properties", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 527, EndLine: 532}}},
+		{Name: "@babel/plugin-transform-destructuring", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 534, EndLine: 539}}},
+		{Name: "@babel/plugin-transform-destructuring", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 541, EndLine: 546}}},
+		{Name: "@babel/plugin-transform-dotall-regex", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 548, EndLine: 555}}},
+		{Name: "@babel/plugin-transform-duplicate-keys", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 557, EndLine: 562}}},
+		{Name: "@babel/plugin-transform-exponentiation-operator", Version: "7.2.0", Constraint: "^7.1.0, ^7.2.0", Locations: []types.Location{{StartLine: 564, EndLine: 570}}},
+		{Name: "@babel/plugin-transform-flow-strip-types", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 572, EndLine: 578}}},
+		{Name: "@babel/plugin-transform-flow-strip-types", Version: "7.4.4", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 580, EndLine: 586}}},
+		{Name: "@babel/plugin-transform-for-of", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 588, EndLine: 593}}},
+		{Name: "@babel/plugin-transform-function-name", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 595, EndLine: 601}}},
+		{Name: "@babel/plugin-transform-literals", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 603, EndLine: 608}}},
+		{Name: "@babel/plugin-transform-member-expression-literals", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 610, EndLine: 615}}},
+		{Name: "@babel/plugin-transform-modules-amd", Version: "7.2.0", Constraint: "^7.1.0, ^7.2.0", Locations: []types.Location{{StartLine: 617, EndLine: 623}}},
+		{Name: "@babel/plugin-transform-modules-commonjs", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 625, EndLine: 632}}},
+		{Name: "@babel/plugin-transform-modules-systemjs", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 634, EndLine: 640}}},
+		{Name: "@babel/plugin-transform-modules-umd", Version: "7.2.0", Constraint: "^7.1.0, ^7.2.0", Locations: []types.Location{{StartLine: 642, EndLine: 648}}},
+		{Name: "@babel/plugin-transform-named-capturing-groups-regex", Version: "7.4.4", Constraint: "^7.4.4", Locations: []types.Location{{StartLine: 650, EndLine: 655}}},
+		{Name: "@babel/plugin-transform-new-target", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 657, EndLine: 662}}},
+		{Name: "@babel/plugin-transform-object-super", Version: "7.2.0", Constraint: "^7.1.0, ^7.2.0", Locations: []types.Location{{StartLine: 664, EndLine: 670}}},
+		{Name: "@babel/plugin-transform-parameters", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 672, EndLine: 679}}},
+		{Name: "@babel/plugin-transform-property-literals", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 681, EndLine: 686}}},
+		{Name: "@babel/plugin-transform-react-constant-elements", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 688, EndLine: 694}}},
+		{Name: "@babel/plugin-transform-react-constant-elements", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 696, EndLine: 702}}},
+		{Name: "@babel/plugin-transform-react-display-name", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 704, EndLine: 709}}},
+		{Name: "@babel/plugin-transform-react-display-name", Version: "7.2.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 711, EndLine: 716}}},
+		{Name: "@babel/plugin-transform-react-jsx", Version: "7.3.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 734, EndLine: 741}}},
+		{Name: "@babel/plugin-transform-react-jsx-self", Version: "7.2.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 718, EndLine: 724}}},
+		{Name: "@babel/plugin-transform-react-jsx-source", Version: "7.2.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 726, EndLine: 732}}},
+		{Name: "@babel/plugin-transform-regenerator", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 743, EndLine: 748}}},
+		{Name: "@babel/plugin-transform-reserved-words", Version: "7.2.0", Constraint: "^7.2.0", Locations: []types.Location{{StartLine: 750, EndLine: 755}}},
+		{Name: "@babel/plugin-transform-runtime", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 757, EndLine: 765}}},
+		{Name: "@babel/plugin-transform-shorthand-properties", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 767, EndLine: 772}}},
+		{Name: "@babel/plugin-transform-spread", Version: "7.2.2", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 774, EndLine: 779}}},
+		{Name: "@babel/plugin-transform-sticky-regex", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 781, EndLine: 787}}},
+		{Name: "@babel/plugin-transform-template-literals", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 789, EndLine: 795}}},
+		{Name: "@babel/plugin-transform-typeof-symbol", Version: "7.2.0", Constraint: "^7.0.0, ^7.2.0", Locations: []types.Location{{StartLine: 797, EndLine: 802}}},
+		{Name: "@babel/plugin-transform-typescript", Version: "7.4.4", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 804, EndLine: 810}}},
+		{Name: "@babel/plugin-transform-unicode-regex", Version: "7.4.4", Constraint: "^7.0.0, ^7.4.4", Locations: []types.Location{{StartLine: 812, EndLine: 819}}},
+		{Name: "@babel/preset-env", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 821, EndLine: 866}}},
+		{Name: "@babel/preset-env", Version: "7.4.4", Constraint: "^7.2.0, ^7.4.3", Locations: []types.Location{{StartLine: 868, EndLine: 920}}},
+		{Name: "@babel/preset-flow", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 922, EndLine: 928}}},
+		{Name: "@babel/preset-react", Version: "7.0.0", Constraint: "7.0.0, ^7.0.0", Locations: []types.Location{{StartLine: 930, EndLine: 939}}},
+		{Name: "@babel/preset-typescript", Version: "7.1.0", Constraint: "7.1.0", Locations: []types.Location{{StartLine: 941, EndLine: 947}}},
+		{Name: "@babel/register", Version: "7.4.4", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 949, EndLine: 959}}},
+		{Name: "@babel/runtime", Version: "7.0.0", Constraint: "7.0.0", Locations: []types.Location{{StartLine: 961, EndLine: 966}}},
+		{Name: "@babel/runtime", Version: "7.4.4", Constraint: "^7.0.0, ^7.1.2, ^7.2.0, ^7.3.1, ^7.4.2, ^7.4.4", Locations: []types.Location{{StartLine: 968, EndLine: 973}}},
+		{Name: "@babel/template", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 975, EndLine: 983}}},
+		{Name: "@babel/template", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 985, EndLine: 992}}},
+		{Name: "@babel/traverse", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 994, EndLine: 1008}}},
+		{Name: "@babel/traverse", Version: "7.4.4", Constraint: "^7.1.0, ^7.4.4", Locations: []types.Location{{StartLine: 1010, EndLine: 1023}}},
+		{Name: "@babel/types", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 1025, EndLine: 1032}}},
+		{Name: "@babel/types", Version: "7.4.4", Constraint: "^7.0.0, ^7.2.0, ^7.3.0, ^7.4.0, ^7.4.4", Locations: []types.Location{{StartLine: 1034, EndLine: 1041}}},
+		{Name: "@emotion/cache", Version: "0.8.8", Constraint: "^0.8.8", Locations: []types.Location{{StartLine: 1043, EndLine: 1050}}},
+		{Name: "@emotion/core", Version: "0.13.1", Constraint: "^0.13.1", Locations: []types.Location{{StartLine: 1052, EndLine: 1061}}},
+		{Name: "@emotion/css", Version: "0.9.8", Constraint: "^0.9.8", Locations: []types.Location{{StartLine: 1063, EndLine: 1069}}},
+		{Name: "@emotion/hash", Version: "0.6.6", Constraint: "^0.6.6", Locations: []types.Location{{StartLine: 1071, EndLine: 1074}}},
+		{Name: "@emotion/is-prop-valid", Version: "0.6.8", Constraint: "^0.6.8", Locations: []types.Location{{StartLine: 1076, EndLine: 1081}}},
+		{Name: "@emotion/is-prop-valid", Version: "0.7.3", Constraint: "^0.7.3", Locations: []types.Location{{StartLine: 1083, EndLine: 1088}}},
+		{Name: "@emotion/memoize", Version: "0.6.6", Constraint: "^0.6.6", Locations: []types.Location{{StartLine: 1095, EndLine: 1098}}},
+		{Name: "@emotion/memoize", Version: "0.7.1", Constraint: "0.7.1", Locations: []types.Location{{StartLine: 1090, EndLine: 1093}}},
+		{Name: "@emotion/provider", Version: "0.11.2", Constraint: "^0.11.2", Locations: []types.Location{{StartLine: 1100, EndLine: 1106}}},
+		{Name: "@emotion/serialize", Version: "0.9.1", Constraint: "^0.9.1", Locations: []types.Location{{StartLine: 1108, EndLine: 1116}}},
+		{Name: "@emotion/sheet", Version: "0.8.1", Constraint: "^0.8.1", Locations: []types.Location{{StartLine: 1118, EndLine: 1121}}},
+		{Name: "@emotion/styled", Version: "0.10.6", Constraint: "^0.10.6", Locations: []types.Location{{StartLine: 1132, EndLine: 1137}}},
+		{Name: "@emotion/styled-base", Version: "0.10.6", Constraint: "^0.10.6", Locations: []types.Location{{StartLine: 1123, EndLine: 1130}}},
+		{Name: "@emotion/stylis", Version: "0.7.1", Constraint: "^0.7.1", Locations: []types.Location{{StartLine: 1139, EndLine: 1142}}},
+		{Name: "@emotion/unitless", Version: "0.6.7", Constraint: "^0.6.7", Locations: []types.Location{{StartLine: 1144, EndLine: 1147}}},
+		{Name: "@emotion/unitless", Version: "0.7.3", Constraint: "^0.7.0", Locations: []types.Location{{StartLine: 1149, EndLine: 1152}}},
+		{Name: "@emotion/utils", Version: "0.8.2", Constraint: "^0.8.2", Locations: []types.Location{{StartLine: 1154, EndLine: 1157}}},
+		{Name: "@emotion/weak-memoize", Version: "0.1.3", Constraint: "^0.1.3", Locations: []types.Location{{StartLine: 1159, EndLine: 1162}}},
+		{Name: "@icons/material", Version: "0.2.4", Constraint: "^0.2.4", Locations: []types.Location{{StartLine: 1164, EndLine: 1167}}},
+		{Name: "@loadable/component", Version: "5.10.1", Constraint: "^5.6.0", Locations: []types.Location{{StartLine: 1169, EndLine: 1175}}},
+		{Name: "@material-ui/core", Version: "3.9.3", Constraint: "^3.9.2", Locations: []types.Location{{StartLine: 1177, EndLine: 1208}}},
+		{Name: "@material-ui/icons", Version: "3.0.2", Constraint: "^3.0.2", Locations: []types.Location{{StartLine: 1210, EndLine: 1216}}},
+		{Name: "@material-ui/system", Version: "3.0.0-alpha.2", Constraint: "^3.0.0-alpha.0", Locations: []types.Location{{StartLine: 1218, EndLine: 1226}}},
+		{Name: "@material-ui/utils", Version: "3.0.0-alpha.3", Constraint: "^3.0.0-alpha.2", Locations: []types.Location{{StartLine: 1228, EndLine: 1235}}},
+		{Name: "@mrmlnc/readdir-enhanced", Version: "2.2.1", Constraint: "^2.2.1", Locations: []types.Location{{StartLine: 1237, EndLine: 1243}}},
+		{Name: "@nodelib/fs.stat", Version: "1.1.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 1245, EndLine: 1248}}},
+		{Name: "@octokit/rest", Version: "15.18.1", Constraint: "^15.2.6", Locations: []types.Location{{StartLine: 1250, EndLine: 1263}}},
+		{Name: "@samverschueren/stream-to-observable", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 1265, EndLine: 1270}}},
+		{Name: "@storybook/addon-actions", Version: "4.1.18", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 1272, EndLine: 1290}}},
+		{Name: "@storybook/addon-info", Version: "4.1.18", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 1292, EndLine: 1307}}},
+		{Name: "@storybook/addon-knobs", Version: "4.1.18", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 1309, EndLine: 1327}}},
+		{Name: "@storybook/addons", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1329, EndLine: 1337}}},
+		{Name: "@storybook/channel-postmessage", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1339, EndLine: 1346}}},
+		{Name: "@storybook/channels", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1348, EndLine: 1351}}},
+		{Name: "@storybook/cli", Version: "4.1.18", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 1353, EndLine: 1372}}},
+		{Name: "@storybook/client-logger", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1374, EndLine: 1377}}},
+		{Name: "@storybook/codemod", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1379, EndLine: 1386}}},
+		{Name: "@storybook/components", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1388, EndLine: 1402}}},
+		{Name: "@storybook/core", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1409, EndLine: 1477}}},
+		{Name: "@storybook/core-events", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1404, EndLine: 1407}}},
+		{Name: "@storybook/mantra-core", Version: "1.7.2", Constraint: "^1.7.2", Locations: []types.Location{{StartLine: 1479, EndLine: 1486}}},
+		{Name: "@storybook/node-logger", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1488, EndLine: 1497}}},
+		{Name: "@storybook/podda", Version: "1.2.3", Constraint: "^1.2.3", Locations: []types.Location{{StartLine: 1499, EndLine: 1505}}},
+		{Name: "@storybook/react", Version: "4.1.18", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 1535, EndLine: 1559}}},
+		{Name: "@storybook/react-komposer", Version: "2.0.5", Constraint: "^2.0.1, ^2.0.5", Locations: []types.Location{{StartLine: 1507, EndLine: 1516}}},
+		{Name: "@storybook/react-simple-di", Version: "1.3.0", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 1518, EndLine: 1526}}},
+		{Name: "@storybook/react-stubber", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1528, EndLine: 1533}}},
+		{Name: "@storybook/ui", Version: "4.1.18", Constraint: "4.1.18", Locations: []types.Location{{StartLine: 1561, EndLine: 1587}}},
+		{Name: "@svgr/babel-plugin-add-jsx-attribute", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1589, EndLine: 1592}}},
+		{Name: "@svgr/babel-plugin-remove-jsx-attribute", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1594, EndLine: 1597}}},
+		{Name: "@svgr/babel-plugin-remove-jsx-empty-expression", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1599, EndLine: 1602}}},
+		{Name: "@svgr/babel-plugin-replace-jsx-attribute-value", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1604, EndLine: 1607}}},
+		{Name: "@svgr/babel-plugin-svg-dynamic-title", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1609, EndLine: 1612}}},
+		{Name: "@svgr/babel-plugin-svg-em-dimensions", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1614, EndLine: 1617}}},
+		{Name: "@svgr/babel-plugin-transform-react-native-svg", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1619, EndLine: 1622}}},
+		{Name: "@svgr/babel-plugin-transform-svg-component", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1624, EndLine: 1627}}},
+		{Name: "@svgr/babel-preset", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1629, EndLine: 1641}}},
+		{Name: "@svgr/core", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1643, EndLine: 1650}}},
+		{Name: "@svgr/hast-util-to-babel-ast", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1652, EndLine: 1657}}},
+		{Name: "@svgr/plugin-jsx", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1659, EndLine: 1669}}},
+		{Name: "@svgr/plugin-svgo", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 1671, EndLine: 1678}}},
+		{Name: "@svgr/webpack", Version: "4.2.0", Constraint: "^4.0.3", Locations: []types.Location{{StartLine: 1680, EndLine: 1692}}},
+		{Name: "@types/events", Version: "3.0.0", Constraint: "*", Locations: []types.Location{{StartLine: 1694, EndLine: 1697}}},
+		{Name: "@types/glob", Version: "7.1.1", Constraint: "^7.1.1", Locations: []types.Location{{StartLine: 1699, EndLine: 1706}}},
+		{Name: "@types/jss", Version: "9.5.8", Constraint: "^9.5.6", Locations: []types.Location{{StartLine: 1708, EndLine: 1714}}},
+		{Name: "@types/minimatch", Version: "3.0.3", Constraint: "*", Locations: []types.Location{{StartLine: 1716, EndLine: 1719}}},
+		{Name: "@types/node", Version: "12.0.2", Constraint: "*", Locations: []types.Location{{StartLine: 1721, EndLine: 1724}}},
+		{Name: "@types/prop-types", Version: "15.7.1", Constraint: "*", Locations: []types.Location{{StartLine: 1726, EndLine: 1729}}},
+		{Name: "@types/q", Version: "1.5.2", Constraint: "^1.5.1", Locations: []types.Location{{StartLine: 1731, EndLine: 1734}}},
+		{Name: "@types/react", Version: "16.8.17", Constraint: "*", Locations: []types.Location{{StartLine: 1743, EndLine: 1749}}},
+		{Name: "@types/react-transition-group", Version: "2.9.1", Constraint: "^2.0.8", Locations: []types.Location{{StartLine: 1736, EndLine: 1741}}},
+		{Name: "@types/unist", Version: "2.0.3", Constraint: "*, ^2.0.0, ^2.0.2", Locations: []types.Location{{StartLine: 1751, EndLine: 1754}}},
+		{Name: "@types/vfile", Version: "3.0.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1764, EndLine: 1771}}},
+		{Name: "@types/vfile-message", Version: "1.0.1", Constraint: "*", Locations: []types.Location{{StartLine: 1756, EndLine: 1762}}},
+		{Name: "@webassemblyjs/ast", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1773, EndLine: 1780}}},
+		{Name: "@webassemblyjs/floating-point-hex-parser", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1782, EndLine: 1785}}},
+		{Name: "@webassemblyjs/helper-api-error", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1787, EndLine: 1790}}},
+		{Name: "@webassemblyjs/helper-buffer", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1792, EndLine: 1795}}},
+		{Name: "@webassemblyjs/helper-code-frame", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1797, EndLine: 1802}}},
+		{Name: "@webassemblyjs/helper-fsm", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1804, EndLine: 1807}}},
+		{Name: "@webassemblyjs/helper-module-context", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1809, EndLine: 1815}}},
+		{Name: "@webassemblyjs/helper-wasm-bytecode", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1817, EndLine: 1820}}},
+		{Name: "@webassemblyjs/helper-wasm-section", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1822, EndLine: 1830}}},
+		{Name: "@webassemblyjs/ieee754", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1832, EndLine: 1837}}},
+		{Name: "@webassemblyjs/leb128", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1839, EndLine: 1844}}},
+		{Name: "@webassemblyjs/utf8", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1846, EndLine: 1849}}},
+		{Name: "@webassemblyjs/wasm-edit", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1851, EndLine: 1863}}},
+		{Name: "@webassemblyjs/wasm-gen", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1865, EndLine: 1874}}},
+		{Name: "@webassemblyjs/wasm-opt", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1876, EndLine: 1884}}},
+		{Name: "@webassemblyjs/wasm-parser", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1886, EndLine: 1896}}},
+		{Name: "@webassemblyjs/wast-parser", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1898, EndLine: 1908}}},
+		{Name: "@webassemblyjs/wast-printer", Version: "1.8.5", Constraint: "1.8.5", Locations: []types.Location{{StartLine: 1910, EndLine: 1917}}},
+		{Name: "@xtuc/ieee754", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 1919, EndLine: 1922}}},
+		{Name: "@xtuc/long", Version: "4.2.2", Constraint: "4.2.2", Locations: []types.Location{{StartLine: 1924, EndLine: 1927}}},
 
-		{"JSONStream", "1.3.5", ""},
-		{"abab", "2.0.0", ""},
-		{"abbrev", "1.1.1", ""},
-		{"accepts", "1.3.7", ""},
-		{"acorn", "5.7.3", ""},
-		{"acorn", "6.1.1", ""},
-		{"acorn-dynamic-import", "4.0.0", ""},
-		{"acorn-globals", "4.3.2", ""},
-		{"acorn-jsx", "5.0.1", ""},
-		{"acorn-walk", "6.1.1", ""},
-		{"address", "1.0.3", ""},
-		{"address", "1.1.0", ""},
-		{"after", "0.8.2", ""},
-		{"agent-base", "4.2.1", ""},
-		{"agentkeepalive", "3.5.2", ""},
-		{"airbnb-js-shims", "2.2.0", ""},
-		{"airbnb-prop-types", "2.13.2", ""},
-		{"ajv", "6.10.0", ""},
-		{"ajv-errors", "1.0.1", ""},
-		{"ajv-keywords", "3.4.0", ""},
-		{"ansi-align", "2.0.0", ""},
-		{"ansi-align", "3.0.0", ""},
-		{"ansi-colors", "3.2.4", ""},
-		{"ansi-escapes", "1.4.0", ""},
-		{"ansi-escapes", "3.2.0", ""},
-		{"ansi-html", "0.0.7", ""},
-		{"ansi-regex", "2.1.1", ""},
-		{"ansi-regex", "3.0.0", ""},
-		{"ansi-regex", "4.1.0", ""},
-		{"ansi-styles", "1.0.0", ""},
-		{"ansi-styles", "2.2.1", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"ansicolors", "0.3.2", ""},
-		{"ansistyles", "0.1.3", ""},
-		{"any-observable", "0.3.0", ""},
-		{"anymatch", "1.3.2", ""},
-		{"anymatch", "2.0.0", ""},
-		{"app-root-dir", "1.0.2", ""},
-		{"append-transform", "0.4.0", ""},
-		{"aproba", "1.2.0", ""},
-		{"aproba", "2.0.0", ""},
-		{"archy", "1.0.0", ""},
-		{"are-we-there-yet", "1.1.5", ""},
-		{"argparse", "1.0.10", ""},
-		{"aria-query", "3.0.0", ""},
-		{"arr-diff", "2.0.0", ""},
-		{"arr-diff", "4.0.0", ""},
-		{"arr-flatten", "1.1.0", ""},
-		{"arr-union", "3.1.0", ""},
-		{"array-equal", "1.0.0", ""},
-		{"array-filter", "0.0.1", ""},
-		{"array-filter", "1.0.0", ""},
-		{"array-flatten", "1.1.1", ""},
-		{"array-flatten", "2.1.2", ""},
-		{"array-includes", "3.0.3", ""},
-		{"array-map", "0.0.0", ""},
-		{"array-reduce", "0.0.0", ""},
-		{"array-union", "1.0.2", ""},
-		{"array-uniq", "1.0.3", ""},
-		{"array-unique", "0.2.1", ""},
-		{"array-unique", "0.3.2", ""},
-		{"array.prototype.find", "2.0.4", ""},
-		{"array.prototype.flat", "1.2.1", ""},
-		{"array.prototype.flatmap", "1.2.1", ""},
-		{"arraybuffer.slice", "0.0.7", ""},
-		{"arrify", "1.0.1", ""},
-		{"asap", "2.0.6", ""},
-		{"asn1", "0.2.4", ""},
-		{"asn1.js", "4.10.1", ""},
-		{"assert", "1.5.0", ""},
-		{"assert-plus", "1.0.0", ""},
-		{"assign-symbols", "1.0.0", ""},
-		{"ast-types", "0.11.3", ""},
-		{"ast-types", "0.11.5", ""},
-		{"ast-types", "0.11.7", ""},
-		{"ast-types-flow", "0.0.7", ""},
-		{"astral-regex", "1.0.0", ""},
-		{"async", "1.5.2", ""},
-		{"async", "2.6.2", ""},
-		{"async-each", "1.0.3", ""},
-		{"async-limiter", "1.0.0", ""},
-		{"asynckit", "0.4.0", ""},
-		{"atob", "2.1.2", ""},
-		{"attr-accept", "1.1.3", ""},
-		{"autodll-webpack-plugin", "0.4.2", ""},
-		{"autoprefixer", "8.6.5", ""},
-		{"autoprefixer", "9.5.1", ""},
-		{"aws-sign2", "0.7.0", ""},
-		{"aws4", "1.8.0", ""},
-		{"axios", "0.18.0", ""},
-		{"axobject-query", "2.0.2", ""},
-		{"babel-cli", "6.26.0", ""},
-		{"babel-code-frame", "6.26.0", ""},
-		{"babel-core", "6.26.3", ""},
-		{"babel-eslint", "8.2.6", ""},
-		{"babel-generator", "6.26.1", ""},
-		{"babel-helper-bindify-decorators", "6.24.1", ""},
-		{"babel-helper-builder-binary-assignment-operator-visitor", "6.24.1", ""},
-		{"babel-helper-builder-react-jsx", "6.26.0", ""},
-		{"babel-helper-call-delegate", "6.24.1", ""},
-		{"babel-helper-define-map", "6.26.0", ""},
-		{"babel-helper-evaluate-path", "0.5.0", ""},
-		{"babel-helper-explode-assignable-expression", "6.24.1", ""},
-		{"babel-helper-explode-class", "6.24.1", ""},
-		{"babel-helper-flip-expressions", "0.4.3", ""},
-		{"babel-helper-function-name", "6.24.1", ""},
-		{"babel-helper-get-function-arity", "6.24.1", ""},
-		{"babel-helper-hoist-variables", "6.24.1", ""},
-		{"babel-helper-is-nodes-equiv", "0.0.1", ""},
-		{"babel-helper-is-void-0", "0.4.3", ""},
-		{"babel-helper-mark-eval-scopes", "0.4.3", ""},
-		{"babel-helper-optimise-call-expression", "6.24.1", ""},
-		{"babel-helper-regex", "6.26.0", ""},
-		{"babel-helper-remap-async-to-generator", "6.24.1", ""},
-		{"babel-helper-remove-or-void", "0.4.3", ""},
-		{"babel-helper-replace-supers", "6.24.1", ""},
-		{"babel-helper-to-multiple-sequence-expressions", "0.5.0", ""},
-		{"babel-helpers", "6.24.1", ""},
-		{"babel-jest", "23.6.0", ""},
-		{"babel-loader", "7.1.5", ""},
-		{"babel-loader", "8.0.4", ""},
-		{"babel-messages", "6.23.0", ""},
-		{"babel-plugin-check-es2015-constants", "6.22.0", ""},
-		{"babel-plugin-dynamic-import-node", "2.2.0", ""},
-		{"babel-plugin-istanbul", "4.1.6", ""},
-		{"babel-plugin-jest-hoist", "23.2.0", ""},
-		{"babel-plugin-macros", "2.4.2", ""},
-		{"babel-plugin-macros", "2.5.1", ""},
-		{"babel-plugin-minify-builtins", "0.5.0", ""},
-		{"babel-plugin-minify-constant-folding", "0.5.0", ""},
-		{"babel-plugin-minify-dead-code-elimination", "0.5.0", ""},
-		{"babel-plugin-minify-flip-comparisons", "0.4.3", ""},
-		{"babel-plugin-minify-guarded-expressions", "0.4.3", ""},
-		{"babel-plugin-minify-infinity", "0.4.3", ""},
-		{"babel-plugin-minify-mangle-names", "0.5.0", ""},
-		{"babel-plugin-minify-numeric-literals", "0.4.3", ""},
-		{"babel-plugin-minify-replace", "0.5.0", ""},
-		{"babel-plugin-minify-simplify", "0.5.0", ""},
-		{"babel-plugin-minify-type-constructors", "0.4.3", ""},
-		{"babel-plugin-named-asset-import", "0.2.3", ""},
-		{"babel-plugin-react-docgen", "2.0.2", ""},
-		{"babel-plugin-react-html-attrs", "2.1.0", ""},
-		{"babel-plugin-styled-components", "1.10.0", ""},
-		{"babel-plugin-syntax-async-functions", "6.13.0", ""},
-		{"babel-plugin-syntax-async-generators", "6.13.0", ""},
-		{"babel-plugin-syntax-class-constructor-call", "6.18.0", ""},
-		{"babel-plugin-syntax-class-properties", "6.13.0", ""},
-		{"babel-plugin-syntax-decorators", "6.13.0", ""},
-		{"babel-plugin-syntax-dynamic-import", "6.18.0", ""},
-		{"babel-plugin-syntax-exponentiation-operator", "6.13.0", ""},
-		{"babel-plugin-syntax-export-extensions", "6.13.0", ""},
-		{"babel-plugin-syntax-flow", "6.18.0", ""},
-		{"babel-plugin-syntax-jsx", "6.18.0", ""},
-		{"babel-plugin-syntax-object-rest-spread", "6.13.0", ""},
-		{"babel-plugin-syntax-trailing-function-commas", "6.22.0", ""},
-		{"babel-plugin-transform-async-generator-functions", "6.24.1", ""},
-		{"babel-plugin-transform-async-to-generator", "6.24.1", ""},
-		{"babel-plugin-transform-class-constructor-call", "6.24.1", ""},
-		{"babel-plugin-transform-class-properties", "6.24.1", ""},
-		{"babel-plugin-transform-decorators", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-arrow-functions", "6.22.0", ""},
-		{"babel-plugin-transform-es2015-block-scoped-functions", "6.22.0", ""},
-		{"babel-plugin-transform-es2015-block-scoping", "6.26.0", ""},
-		{"babel-plugin-transform-es2015-classes", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-computed-properties", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-destructuring", "6.23.0", ""},
-		{"babel-plugin-transform-es2015-duplicate-keys", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-for-of", "6.23.0", ""},
-		{"babel-plugin-transform-es2015-function-name", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-literals", "6.22.0", ""},
-		{"babel-plugin-transform-es2015-modules-amd", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-modules-commonjs", "6.26.2", ""},
-		{"babel-plugin-transform-es2015-modules-systemjs", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-modules-umd", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-object-super", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-parameters", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-shorthand-properties", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-spread", "6.22.0", ""},
-		{"babel-plugin-transform-es2015-sticky-regex", "6.24.1", ""},
-		{"babel-plugin-transform-es2015-template-literals", "6.22.0", ""},
-		{"babel-plugin-transform-es2015-typeof-symbol", "6.23.0", ""},
-		{"babel-plugin-transform-es2015-unicode-regex", "6.24.1", ""},
-		{"babel-plugin-transform-exponentiation-operator", "6.24.1", ""},
-		{"babel-plugin-transform-export-extensions", "6.22.0", ""},
-		{"babel-plugin-transform-flow-strip-types", "6.22.0", ""},
-		{"babel-plugin-transform-inline-consecutive-adds", "0.4.3", ""},
-		{"babel-plugin-transform-member-expression-literals", "6.9.4", ""},
-		{"babel-plugin-transform-merge-sibling-variables", "6.9.4", ""},
-		{"babel-plugin-transform-minify-booleans", "6.9.4", ""},
-		{"babel-plugin-transform-object-rest-spread", "6.26.0", ""},
-		{"babel-plugin-transform-property-literals", "6.9.4", ""},
-		{"babel-plugin-transform-react-display-name", "6.25.0", ""},
-		{"babel-plugin-transform-react-jsx", "6.24.1", ""},
-		{"babel-plugin-transform-react-jsx-self", "6.22.0", ""},
-		{"babel-plugin-transform-react-jsx-source", "6.22.0", ""},
-		{"babel-plugin-transform-react-remove-prop-types", "0.4.18", ""},
-		{"babel-plugin-transform-regenerator", "6.26.0", ""},
-		{"babel-plugin-transform-regexp-constructors", "0.4.3", ""},
-		{"babel-plugin-transform-remove-console", "6.9.4", ""},
-		{"babel-plugin-transform-remove-debugger", "6.9.4", ""},
-		{"babel-plugin-transform-remove-undefined", "0.5.0", ""},
-		{"babel-plugin-transform-runtime", "6.23.0", ""},
-		{"babel-plugin-transform-simplify-comparison-operators", "6.9.4", ""},
-		{"babel-plugin-transform-strict-mode", "6.24.1", ""},
-		{"babel-plugin-transform-undefined-to-void", "6.9.4", ""},
-		{"babel-polyfill", "6.26.0", ""},
-		{"babel-preset-env", "1.7.0", ""},
-		{"babel-preset-es2015", "6.24.1", ""},
-		{"babel-preset-flow", "6.23.0", ""},
-		{"babel-preset-jest", "23.2.0", ""},
-		{"babel-preset-minify", "0.5.0", ""},
-		{"babel-preset-react", "6.24.1", ""},
-		{"babel-preset-react-app", "6.1.0", ""},
-		{"babel-preset-stage-1", "6.24.1", ""},
-		{"babel-preset-stage-2", "6.24.1", ""},
-		{"babel-preset-stage-3", "6.24.1", ""},
-		{"babel-register", "6.26.0", ""},
-		{"babel-runtime", "6.26.0", ""},
-		{"babel-standalone", "6.26.0", ""},
-		{"babel-template", "6.26.0", ""},
-		{"babel-traverse", "6.26.0", ""},
-		{"babel-types", "6.26.0", ""},
-		{"babylon", "6.18.0", ""},
-		{"babylon", "7.0.0-beta.44", ""},
-		{"babylon", "7.0.0-beta.47", ""},
-		{"backo2", "1.0.2", ""},
-		{"bail", "1.0.4", ""},
-		{"balanced-match", "1.0.0", ""},
-		{"base", "0.11.2", ""},
-		{"base64-arraybuffer", "0.1.5", ""},
-		{"base64-js", "1.3.0", ""},
-		{"base64id", "1.0.0", ""},
-		{"batch", "0.6.1", ""},
-		{"bcrypt-pbkdf", "1.0.2", ""},
-		{"before-after-hook", "1.4.0", ""},
-		{"better-assert", "1.0.2", ""},
-		{"bfj", "6.1.1", ""},
-		{"big-integer", "1.6.43", ""},
-		{"big.js", "3.2.0", ""},
-		{"big.js", "5.2.2", ""},
-		{"bin-links", "1.1.2", ""},
-		{"binary", "0.3.0", ""},
-		{"binary-extensions", "1.13.1", ""},
-		{"blob", "0.0.5", ""},
-		{"block-stream", "0.0.9", ""},
-		{"bluebird", "3.4.7", ""},
-		{"bluebird", "3.5.4", ""},
-		{"bn.js", "4.11.8", ""},
-		{"body-parser", "1.18.3", ""},
-		{"bonjour", "3.5.0", ""},
-		{"boolbase", "1.0.0", ""},
-		{"boxen", "1.3.0", ""},
-		{"boxen", "2.1.0", ""},
-		{"brace-expansion", "1.1.11", ""},
-		{"braces", "1.8.5", ""},
-		{"braces", "2.3.2", ""},
-		{"brcast", "3.0.1", ""},
-		{"brorand", "1.1.0", ""},
-		{"browser-process-hrtime", "0.1.3", ""},
-		{"browser-resolve", "1.11.3", ""},
-		{"browserify-aes", "1.2.0", ""},
-		{"browserify-cipher", "1.0.1", ""},
-		{"browserify-des", "1.0.2", ""},
-		{"browserify-rsa", "4.0.1", ""},
-		{"browserify-sign", "4.0.4", ""},
-		{"browserify-zlib", "0.2.0", ""},
-		{"browserslist", "3.2.8", ""},
-		{"browserslist", "4.1.1", ""},
-		{"browserslist", "4.6.0", ""},
-		{"bser", "2.0.0", ""},
-		{"btoa-lite", "1.0.0", ""},
-		{"buffer", "4.9.1", ""},
-		{"buffer-from", "1.1.1", ""},
-		{"buffer-indexof", "1.1.1", ""},
-		{"buffer-indexof-polyfill", "1.0.1", ""},
-		{"buffer-shims", "1.0.0", ""},
-		{"buffer-xor", "1.0.3", ""},
-		{"buffers", "0.1.1", ""},
-		{"builtin-status-codes", "3.0.0", ""},
-		{"builtins", "1.0.3", ""},
-		{"byline", "5.0.0", ""},
-		{"byte-size", "5.0.1", ""},
-		{"bytes", "3.0.0", ""},
-		{"cacache", "10.0.4", ""},
-		{"cacache", "11.3.2", ""},
-		{"cache-base", "1.0.1", ""},
-		{"cache-loader", "1.2.5", ""},
-		{"call-limit", "1.1.0", ""},
-		{"call-me-maybe", "1.0.1", ""},
-		{"caller-callsite", "2.0.0", ""},
-		{"caller-path", "2.0.0", ""},
-		{"callsite", "1.0.0", ""},
-		{"callsites", "2.0.0", ""},
-		{"callsites", "3.1.0", ""},
-		{"camel-case", "3.0.0", ""},
-		{"camelcase", "3.0.0", ""},
-		{"camelcase", "4.1.0", ""},
-		{"camelcase", "5.3.1", ""},
-		{"camelize", "1.0.0", ""},
-		{"caniuse-lite", "1.0.30000967", ""},
-		{"capture-exit", "1.2.0", ""},
-		{"capture-stack-trace", "1.0.1", ""},
-		{"case-sensitive-paths-webpack-plugin", "2.2.0", ""},
-		{"caseless", "0.12.0", ""},
-		{"ccount", "1.0.4", ""},
-		{"chainsaw", "0.1.0", ""},
-		{"chalk", "0.4.0", ""},
-		{"chalk", "1.1.3", ""},
-		{"chalk", "2.4.1", ""},
-		{"chalk", "2.4.2", ""},
-		{"change-emitter", "0.1.6", ""},
-		{"chardet", "0.7.0", ""},
-		{"charenc", "0.0.2", ""},
-		{"check-types", "7.4.0", ""},
-		{"cheerio", "1.0.0-rc.3", ""},
-		{"child-process-promise", "2.2.1", ""},
-		{"chokidar", "1.7.0", ""},
-		{"chokidar", "2.1.5", ""},
-		{"chownr", "1.1.1", ""},
-		{"chrome-trace-event", "1.0.0", ""},
-		{"ci-info", "1.6.0", ""},
-		{"ci-info", "2.0.0", ""},
-		{"cidr-regex", "2.0.10", ""},
-		{"cipher-base", "1.0.4", ""},
-		{"class-utils", "0.3.6", ""},
-		{"classnames", "2.2.6", ""},
-		{"clean-css", "4.2.1", ""},
-		{"clean-webpack-plugin", "0.1.19", ""},
-		{"cli-boxes", "1.0.0", ""},
-		{"cli-columns", "3.1.2", ""},
-		{"cli-cursor", "1.0.2", ""},
-		{"cli-cursor", "2.1.0", ""},
-		{"cli-table3", "0.5.1", ""},
-		{"cli-truncate", "0.2.1", ""},
-		{"cli-width", "1.1.1", ""},
-		{"cli-width", "2.2.0", ""},
-		{"cliui", "3.2.0", ""},
-		{"cliui", "4.1.0", ""},
-		{"clone", "1.0.4", ""},
-		{"clone-deep", "0.2.4", ""},
-		{"clsx", "1.0.4", ""},
-		{"cmd-shim", "2.0.2", ""},
-		{"co", "4.6.0", ""},
-		{"coa", "2.0.2", ""},
-		{"code-point-at", "1.1.0", ""},
-		{"collection-visit", "1.0.0", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-name", "1.1.3", ""},
-		{"colors", "1.3.3", ""},
-		{"columnify", "1.5.4", ""},
-		{"combined-stream", "1.0.8", ""},
-		{"comma-separated-tokens", "1.0.7", ""},
-		{"commander", "2.17.1", ""},
-		{"commander", "2.19.0", ""},
-		{"commander", "2.20.0", ""},
-		{"common-tags", "1.8.0", ""},
-		{"commondir", "1.0.1", ""},
-		{"component-bind", "1.0.0", ""},
-		{"component-emitter", "1.2.1", ""},
-		{"component-emitter", "1.3.0", ""},
-		{"component-inherit", "0.0.3", ""},
-		{"compressible", "2.0.17", ""},
-		{"compression", "1.7.4", ""},
-		{"concat-map", "0.0.1", ""},
-		{"concat-stream", "1.6.2", ""},
-		{"config-chain", "1.1.12", ""},
-		{"configstore", "3.1.2", ""},
-		{"connect-history-api-fallback", "1.6.0", ""},
-		{"console-browserify", "1.1.0", ""},
-		{"console-control-strings", "1.1.0", ""},
-		{"console-polyfill", "0.3.0", ""},
-		{"constants-browserify", "1.0.0", ""},
-		{"contains-path", "0.1.0", ""},
-		{"content-disposition", "0.5.2", ""},
-		{"content-type", "1.0.4", ""},
-		{"convert-source-map", "1.6.0", ""},
-		{"cookie", "0.3.1", ""},
-		{"cookie-signature", "1.0.6", ""},
-		{"copy-concurrently", "1.0.5", ""},
-		{"copy-descriptor", "0.1.1", ""},
-		{"copy-to-clipboard", "3.2.0", ""},
-		{"copy-webpack-plugin", "4.6.0", ""},
-		{"core-js", "1.2.7", ""},
-		{"core-js", "2.6.5", ""},
-		{"core-js", "3.0.1", ""},
-		{"core-js-compat", "3.0.1", ""},
-		{"core-js-pure", "3.0.1", ""},
-		{"core-util-is", "1.0.2", ""},
-		{"cosmiconfig", "4.0.0", ""},
-		{"cosmiconfig", "5.2.1", ""},
-		{"create-ecdh", "4.0.3", ""},
-		{"create-error-class", "3.0.2", ""},
-		{"create-hash", "1.2.0", ""},
-		{"create-hmac", "1.1.7", ""},
-		{"create-react-class", "15.6.3", ""},
-		{"create-react-context", "0.2.2", ""},
-		{"create-react-context", "0.2.3", ""},
-		{"cross-spawn", "4.0.2", ""},
-		{"cross-spawn", "5.1.0", ""},
-		{"cross-spawn", "6.0.5", ""},
-		{"crypt", "0.0.2", ""},
-		{"crypto-browserify", "3.12.0", ""},
-		{"crypto-random-string", "1.0.0", ""},
-		{"css-color-keywords", "1.0.0", ""},
-		{"css-loader", "1.0.1", ""},
-		{"css-select", "1.2.0", ""},
-		{"css-select", "2.0.2", ""},
-		{"css-select-base-adapter", "0.1.1", ""},
-		{"css-selector-tokenizer", "0.7.1", ""},
-		{"css-to-react-native", "2.3.1", ""},
-		{"css-tree", "1.0.0-alpha.28", ""},
-		{"css-tree", "1.0.0-alpha.29", ""},
-		{"css-url-regex", "1.1.0", ""},
-		{"css-vendor", "0.3.8", ""},
-		{"css-what", "2.1.3", ""},
-		{"cssesc", "0.1.0", ""},
-		{"csso", "3.5.1", ""},
-		{"cssom", "0.3.6", ""},
-		{"cssstyle", "1.2.2", ""},
-		{"csstype", "2.6.4", ""},
-		{"cyclist", "0.2.2", ""},
-		{"damerau-levenshtein", "1.0.5", ""},
-		{"dashdash", "1.14.1", ""},
-		{"data-urls", "1.1.0", ""},
-		{"date-fns", "1.30.1", ""},
-		{"date-fns", "2.0.0-alpha.27", ""},
-		{"date-now", "0.1.4", ""},
-		{"debounce", "1.2.0", ""},
-		{"debug", "2.6.9", ""},
-		{"debug", "3.1.0", ""},
-		{"debug", "3.2.6", ""},
-		{"debug", "4.1.1", ""},
-		{"debuglog", "1.0.1", ""},
-		{"decamelize", "1.2.0", ""},
-		{"decode-uri-component", "0.2.0", ""},
-		{"decompress-response", "3.3.0", ""},
-		{"dedent", "0.7.0", ""},
-		{"deep-equal", "1.0.1", ""},
-		{"deep-extend", "0.6.0", ""},
-		{"deep-is", "0.1.3", ""},
-		{"deepmerge", "2.2.1", ""},
-		{"deepmerge", "3.2.0", ""},
-		{"default-gateway", "4.2.0", ""},
-		{"default-require-extensions", "1.0.0", ""},
-		{"defaults", "1.0.3", ""},
-		{"define-properties", "1.1.3", ""},
-		{"define-property", "0.2.5", ""},
-		{"define-property", "1.0.0", ""},
-		{"define-property", "2.0.2", ""},
-		{"del", "3.0.0", ""},
-		{"del", "4.1.1", ""},
-		{"delayed-stream", "1.0.0", ""},
-		{"delegates", "1.0.0", ""},
-		{"depd", "1.1.2", ""},
-		{"des.js", "1.0.0", ""},
-		{"destroy", "1.0.4", ""},
-		{"detect-file", "1.0.0", ""},
-		{"detect-indent", "4.0.0", ""},
-		{"detect-indent", "5.0.0", ""},
-		{"detect-libc", "1.0.3", ""},
-		{"detect-newline", "2.1.0", ""},
-		{"detect-node", "2.0.4", ""},
-		{"detect-port", "1.3.0", ""},
-		{"detect-port-alt", "1.1.6", ""},
-		{"dezalgo", "1.0.3", ""},
-		{"diff", "3.5.0", ""},
-		{"diffie-hellman", "5.0.3", ""},
-		{"dir-glob", "2.2.2", ""},
-		{"discontinuous-range", "1.0.0", ""},
-		{"dns-equal", "1.0.0", ""},
-		{"dns-packet", "1.3.1", ""},
-		{"dns-txt", "2.0.2", ""},
-		{"doctrine", "1.5.0", ""},
-		{"doctrine", "2.1.0", ""},
-		{"doctrine", "3.0.0", ""},
-		{"dom-converter", "0.2.0", ""},
-		{"dom-helpers", "3.4.0", ""},
-		{"dom-serializer", "0.1.1", ""},
-		{"dom-walk", "0.1.1", ""},
-		{"domain-browser", "1.2.0", ""},
-		{"domelementtype", "1.3.1", ""},
-		{"domexception", "1.0.1", ""},
-		{"domhandler", "2.4.2", ""},
-		{"domutils", "1.5.1", ""},
-		{"domutils", "1.7.0", ""},
-		{"dot-prop", "4.2.0", ""},
-		{"dotenv", "5.0.1", ""},
-		{"dotenv", "6.2.0", ""},
-		{"dotenv-defaults", "1.0.2", ""},
-		{"dotenv-expand", "4.2.0", ""},
-		{"dotenv-webpack", "1.7.0", ""},
-		{"duplexer", "0.1.1", ""},
-		{"duplexer2", "0.1.4", ""},
-		{"duplexer3", "0.1.4", ""},
-		{"duplexify", "3.7.1", ""},
-		{"ecc-jsbn", "0.1.2", ""},
-		{"editor", "1.0.0", ""},
-		{"ee-first", "1.1.1", ""},
-		{"ejs", "2.6.1", ""},
-		{"electron-to-chromium", "1.3.134", ""},
-		{"elegant-spinner", "1.0.1", ""},
-		{"elliptic", "6.4.1", ""},
-		{"emoji-regex", "7.0.3", ""},
-		{"emojis-list", "2.1.0", ""},
-		{"encodeurl", "1.0.2", ""},
-		{"encoding", "0.1.12", ""},
-		{"end-of-stream", "1.4.1", ""},
-		{"engine.io", "3.3.2", ""},
-		{"engine.io-client", "3.3.2", ""},
-		{"engine.io-parser", "2.1.3", ""},
-		{"enhanced-resolve", "4.1.0", ""},
-		{"entities", "1.1.2", ""},
-		{"enzyme", "3.9.0", ""},
-		{"enzyme-adapter-react-16", "1.13.0", ""},
-		{"enzyme-adapter-utils", "1.12.0", ""},
-		{"err-code", "1.1.2", ""},
-		{"errno", "0.1.7", ""},
-		{"error-ex", "1.3.2", ""},
-		{"es-abstract", "1.13.0", ""},
-		{"es-to-primitive", "1.2.0", ""},
-		{"es5-shim", "4.5.13", ""},
-		{"es6-promise", "3.3.1", ""},
-		{"es6-promise", "4.2.6", ""},
-		{"es6-promise-promise", "1.0.0", ""},
-		{"es6-promisify", "5.0.0", ""},
-		{"es6-shim", "0.35.5", ""},
-		{"escape-html", "1.0.3", ""},
-		{"escape-string-regexp", "1.0.5", ""},
-		{"escodegen", "1.11.1", ""},
-		{"eslint", "5.16.0", ""},
-		{"eslint-config-airbnb", "17.1.0", ""},
-		{"eslint-config-airbnb-base", "13.1.0", ""},
-		{"eslint-import-resolver-node", "0.3.2", ""},
-		{"eslint-loader", "2.1.2", ""},
-		{"eslint-module-utils", "2.4.0", ""},
-		{"eslint-plugin-import", "2.17.2", ""},
-		{"eslint-plugin-jsx-a11y", "6.2.1", ""},
-		{"eslint-plugin-react", "7.13.0", ""},
-		{"eslint-restricted-globals", "0.1.1", ""},
-		{"eslint-scope", "3.7.1", ""},
-		{"eslint-scope", "4.0.3", ""},
-		{"eslint-utils", "1.3.1", ""},
-		{"eslint-visitor-keys", "1.0.0", ""},
-		{"espree", "5.0.1", ""},
-		{"esprima", "3.1.3", ""},
-		{"esprima", "4.0.1", ""},
-		{"esquery", "1.0.1", ""},
-		{"esrecurse", "4.2.1", ""},
-		{"estraverse", "4.2.0", ""},
-		{"esutils", "2.0.2", ""},
-		{"etag", "1.8.1", ""},
-		{"eventemitter3", "3.1.2", ""},
-		{"events", "3.0.0", ""},
-		{"eventsource", "0.1.6", ""},
-		{"eventsource", "1.0.7", ""},
-		{"evp_bytestokey", "1.0.3", ""},
-		{"exec-sh", "0.2.2", ""},
-		{"execa", "0.7.0", ""},
-		{"execa", "0.9.0", ""},
-		{"execa", "1.0.0", ""},
-		{"exenv", "1.2.2", ""},
-		{"exit", "0.1.2", ""},
-		{"exit-hook", "1.1.1", ""},
-		{"expand-brackets", "0.1.5", ""},
-		{"expand-brackets", "2.1.4", ""},
-		{"expand-range", "1.8.2", ""},
-		{"expand-tilde", "2.0.2", ""},
-		{"expect", "23.6.0", ""},
-		{"express", "4.16.4", ""},
-		{"extend", "3.0.2", ""},
-		{"extend-shallow", "2.0.1", ""},
-		{"extend-shallow", "3.0.2", ""},
-		{"external-editor", "3.0.3", ""},
-		{"extglob", "0.3.2", ""},
-		{"extglob", "2.0.4", ""},
-		{"extract-text-webpack-plugin", "4.0.0-beta.0", ""},
-		{"extsprintf", "1.3.0", ""},
-		{"extsprintf", "1.4.0", ""},
-		{"faker", "4.1.0", ""},
-		{"fast-deep-equal", "2.0.1", ""},
-		{"fast-glob", "2.2.6", ""},
-		{"fast-json-stable-stringify", "2.0.0", ""},
-		{"fast-levenshtein", "2.0.6", ""},
-		{"fastparse", "1.1.2", ""},
-		{"faye-websocket", "0.10.0", ""},
-		{"faye-websocket", "0.11.1", ""},
-		{"fb-watchman", "2.0.0", ""},
-		{"fbjs", "0.8.17", ""},
-		{"figgy-pudding", "3.5.1", ""},
-		{"figures", "1.7.0", ""},
-		{"figures", "2.0.0", ""},
-		{"file-entry-cache", "5.0.1", ""},
-		{"file-loader", "1.1.11", ""},
-		{"file-loader", "2.0.0", ""},
-		{"file-selector", "0.1.11", ""},
-		{"file-system-cache", "1.0.5", ""},
-		{"filename-regex", "2.0.1", ""},
-		{"fileset", "2.0.3", ""},
-		{"filesize", "3.6.1", ""},
-		{"fill-range", "2.2.4", ""},
-		{"fill-range", "4.0.0", ""},
-		{"finalhandler", "1.1.1", ""},
-		{"find-cache-dir", "0.1.1", ""},
-		{"find-cache-dir", "1.0.0", ""},
-		{"find-cache-dir", "2.1.0", ""},
-		{"find-npm-prefix", "1.0.2", ""},
-		{"find-parent-dir", "0.3.0", ""},
-		{"find-up", "1.1.2", ""},
-		{"find-up", "2.1.0", ""},
-		{"find-up", "3.0.0", ""},
-		{"findup-sync", "2.0.0", ""},
-		{"flat-cache", "2.0.1", ""},
-		{"flatted", "2.0.0", ""},
-		{"flow-bin", "0.89.0", ""},
-		{"flow-parser", "0.98.1", ""},
-		{"flow-typed", "2.5.1", ""},
-		{"flush-write-stream", "1.1.1", ""},
-		{"follow-redirects", "1.7.0", ""},
-		{"for-in", "0.1.8", ""},
-		{"for-in", "1.0.2", ""},
-		{"for-own", "0.1.5", ""},
-		{"forever-agent", "0.6.1", ""},
-		{"form-data", "2.3.3", ""},
-		{"formik", "1.5.1", ""},
-		{"forwarded", "0.1.2", ""},
-		{"fragment-cache", "0.2.1", ""},
-		{"fresh", "0.5.2", ""},
-		{"from2", "1.3.0", ""},
-		{"from2", "2.3.0", ""},
-		{"fs-extra", "0.30.0", ""},
-		{"fs-extra", "5.0.0", ""},
-		{"fs-extra", "7.0.1", ""},
-		{"fs-minipass", "1.2.5", ""},
-		{"fs-readdir-recursive", "1.1.0", ""},
-		{"fs-vacuum", "1.2.10", ""},
-		{"fs-write-stream-atomic", "1.0.10", ""},
-		{"fs.realpath", "1.0.0", ""},
-		{"fsevents", "1.2.9", ""},
-		{"fstream", "1.0.12", ""},
-		{"function-bind", "1.1.1", ""},
-		{"function.prototype.name", "1.1.0", ""},
-		{"functional-red-black-tree", "1.0.1", ""},
-		{"fuse.js", "3.4.4", ""},
-		{"gauge", "2.7.4", ""},
-		{"genfun", "5.0.0", ""},
-		{"gentle-fs", "2.0.1", ""},
-		{"get-caller-file", "1.0.3", ""},
-		{"get-own-enumerable-property-symbols", "3.0.0", ""},
-		{"get-stdin", "6.0.0", ""},
-		{"get-stream", "3.0.0", ""},
-		{"get-stream", "4.1.0", ""},
-		{"get-value", "2.0.6", ""},
-		{"getpass", "0.1.7", ""},
-		{"glob", "7.1.4", ""},
-		{"glob-base", "0.3.0", ""},
-		{"glob-parent", "2.0.0", ""},
-		{"glob-parent", "3.1.0", ""},
-		{"glob-to-regexp", "0.3.0", ""},
-		{"global", "4.3.2", ""},
-		{"global-dirs", "0.1.1", ""},
-		{"global-modules", "1.0.0", ""},
-		{"global-prefix", "1.0.2", ""},
-		{"globals", "11.12.0", ""},
-		{"globals", "9.18.0", ""},
-		{"globalthis", "1.0.0", ""},
-		{"globby", "6.1.0", ""},
-		{"globby", "7.1.1", ""},
-		{"globby", "8.0.1", ""},
-		{"got", "6.7.1", ""},
-		{"got", "7.1.0", ""},
-		{"graceful-fs", "4.1.15", ""},
-		{"growly", "1.3.0", ""},
-		{"gud", "1.0.0", ""},
-		{"gzip-size", "5.0.0", ""},
-		{"gzip-size", "5.1.0", ""},
-		{"handle-thing", "2.0.0", ""},
-		{"handlebars", "4.1.2", ""},
-		{"har-schema", "2.0.0", ""},
-		{"har-validator", "5.1.3", ""},
-		{"hard-source-webpack-plugin", "0.13.1", ""},
-		{"has", "1.0.3", ""},
-		{"has-ansi", "2.0.0", ""},
-		{"has-binary2", "1.0.3", ""},
-		{"has-color", "0.1.7", ""},
-		{"has-cors", "1.1.0", ""},
-		{"has-flag", "1.0.0", ""},
-		{"has-flag", "3.0.0", ""},
-		{"has-symbol-support-x", "1.4.2", ""},
-		{"has-symbols", "1.0.0", ""},
-		{"has-to-string-tag-x", "1.4.1", ""},
-		{"has-unicode", "2.0.1", ""},
-		{"has-value", "0.3.1", ""},
-		{"has-value", "1.0.0", ""},
-		{"has-values", "0.1.4", ""},
-		{"has-values", "1.0.0", ""},
-		{"hash-base", "3.0.4", ""},
-		{"hash.js", "1.1.7", ""},
-		{"hast-util-from-parse5", "5.0.0", ""},
-		{"hast-util-parse-selector", "2.2.1", ""},
-		{"hastscript", "5.0.0", ""},
-		{"he", "1.2.0", ""},
-		{"history", "4.9.0", ""},
-		{"hmac-drbg", "1.0.1", ""},
-		{"hoist-non-react-statics", "1.2.0", ""},
-		{"hoist-non-react-statics", "2.5.5", ""},
-		{"hoist-non-react-statics", "3.3.0", ""},
-		{"home-or-tmp", "2.0.0", ""},
-		{"homedir-polyfill", "1.0.3", ""},
-		{"hoopy", "0.1.4", ""},
-		{"hosted-git-info", "2.7.1", ""},
-		{"hpack.js", "2.1.6", ""},
-		{"html-element-map", "1.0.1", ""},
-		{"html-encoding-sniffer", "1.0.2", ""},
-		{"html-entities", "1.2.1", ""},
-		{"html-minifier", "3.5.21", ""},
-		{"html-webpack-harddisk-plugin", "1.0.1", ""},
-		{"html-webpack-plugin", "3.2.0", ""},
-		{"html-webpack-plugin", "4.0.0-beta.5", ""},
-		{"htmlparser2", "3.10.1", ""},
-		{"http-cache-semantics", "3.8.1", ""},
-		{"http-deceiver", "1.2.7", ""},
-		{"http-errors", "1.6.3", ""},
-		{"http-parser-js", "0.5.0", ""},
-		{"http-proxy", "1.17.0", ""},
-		{"http-proxy-agent", "2.1.0", ""},
-		{"http-proxy-middleware", "0.19.1", ""},
-		{"http-signature", "1.2.0", ""},
-		{"https-browserify", "1.0.0", ""},
-		{"https-proxy-agent", "2.2.1", ""},
-		{"humanize-ms", "1.2.1", ""},
-		{"husky", "1.3.1", ""},
-		{"hyphenate-style-name", "1.0.3", ""},
-		{"i", "0.3.6", ""},
-		{"iconv-lite", "0.4.23", ""},
-		{"iconv-lite", "0.4.24", ""},
-		{"icss-replace-symbols", "1.1.0", ""},
-		{"icss-utils", "2.1.0", ""},
-		{"ieee754", "1.1.13", ""},
-		{"iferr", "0.1.5", ""},
-		{"iferr", "1.0.2", ""},
-		{"ignore", "3.3.10", ""},
-		{"ignore", "4.0.6", ""},
-		{"ignore-walk", "3.0.1", ""},
-		{"immer", "1.7.2", ""},
-		{"immutable", "3.8.2", ""},
-		{"import-cwd", "2.1.0", ""},
-		{"import-fresh", "2.0.0", ""},
-		{"import-fresh", "3.0.0", ""},
-		{"import-from", "2.1.0", ""},
-		{"import-lazy", "2.1.0", ""},
-		{"import-local", "1.0.0", ""},
-		{"import-local", "2.0.0", ""},
-		{"imurmurhash", "0.1.4", ""},
-		{"indefinite-observable", "1.0.2", ""},
-		{"indent-string", "3.2.0", ""},
-		{"indexof", "0.0.1", ""},
-		{"inflight", "1.0.6", ""},
-		{"inherits", "2.0.1", ""},
-		{"inherits", "2.0.3", ""},
-		{"ini", "1.3.5", ""},
-		{"init-package-json", "1.10.3", ""},
-		{"inquirer", "0.11.4", ""},
-		{"inquirer", "6.2.0", ""},
-		{"inquirer", "6.3.1", ""},
-		{"internal-ip", "4.3.0", ""},
-		{"interpret", "1.2.0", ""},
-		{"intl", "1.2.5", ""},
-		{"intl-messageformat", "2.2.0", ""},
-		{"intl-messageformat-parser", "1.4.0", ""},
-		{"invariant", "2.2.4", ""},
-		{"invert-kv", "1.0.0", ""},
-		{"invert-kv", "2.0.0", ""},
-		{"ip", "1.1.5", ""},
-		{"ip-regex", "2.1.0", ""},
-		{"ipaddr.js", "1.9.0", ""},
-		{"is-accessor-descriptor", "0.1.6", ""},
-		{"is-accessor-descriptor", "1.0.0", ""},
-		{"is-arrayish", "0.2.1", ""},
-		{"is-binary-path", "1.0.1", ""},
-		{"is-boolean-object", "1.0.0", ""},
-		{"is-buffer", "1.1.6", ""},
-		{"is-buffer", "2.0.3", ""},
-		{"is-callable", "1.1.4", ""},
-		{"is-ci", "1.2.1", ""},
-		{"is-ci", "2.0.0", ""},
-		{"is-cidr", "3.0.0", ""},
-		{"is-data-descriptor", "0.1.4", ""},
-		{"is-data-descriptor", "1.0.0", ""},
-		{"is-date-object", "1.0.1", ""},
-		{"is-descriptor", "0.1.6", ""},
-		{"is-descriptor", "1.0.2", ""},
-		{"is-directory", "0.3.1", ""},
-		{"is-dom", "1.0.9", ""},
-		{"is-dotfile", "1.0.3", ""},
-		{"is-electron", "2.2.0", ""},
-		{"is-equal-shallow", "0.1.3", ""},
-		{"is-extendable", "0.1.1", ""},
-		{"is-extendable", "1.0.1", ""},
-		{"is-extglob", "1.0.0", ""},
-		{"is-extglob", "2.1.1", ""},
-		{"is-finite", "1.0.2", ""},
-		{"is-fullwidth-code-point", "1.0.0", ""},
-		{"is-fullwidth-code-point", "2.0.0", ""},
-		{"is-generator-fn", "1.0.0", ""},
-		{"is-glob", "2.0.1", ""},
-		{"is-glob", "3.1.0", ""},
-		{"is-glob", "4.0.1", ""},
-		{"is-in-browser", "1.1.3", ""},
-		{"is-installed-globally", "0.1.0", ""},
-		{"is-npm", "1.0.0", ""},
-		{"is-number", "2.1.0", ""},
-		{"is-number", "3.0.0", ""},
-		{"is-number", "4.0.0", ""},
-		{"is-number-object", "1.0.3", ""},
-		{"is-obj", "1.0.1", ""},
-		{"is-object", "1.0.1", ""},
-		{"is-observable", "1.1.0", ""},
-		{"is-path-cwd", "1.0.0", ""},
-		{"is-path-cwd", "2.1.0", ""},
-		{"is-path-in-cwd", "1.0.1", ""},
-		{"is-path-in-cwd", "2.1.0", ""},
-		{"is-path-inside", "1.0.1", ""},
-		{"is-path-inside", "2.1.0", ""},
-		{"is-plain-obj", "1.1.0", ""},
-		{"is-plain-object", "2.0.4", ""},
-		{"is-posix-bracket", "0.1.1", ""},
-		{"is-primitive", "2.0.0", ""},
-		{"is-promise", "2.1.0", ""},
-		{"is-redirect", "1.0.0", ""},
-		{"is-regex", "1.0.4", ""},
-		{"is-regexp", "1.0.0", ""},
-		{"is-retry-allowed", "1.1.0", ""},
-		{"is-root", "2.0.0", ""},
-		{"is-stream", "1.1.0", ""},
-		{"is-string", "1.0.4", ""},
-		{"is-subset", "0.1.1", ""},
-		{"is-symbol", "1.0.2", ""},
-		{"is-typedarray", "1.0.0", ""},
-		{"is-utf8", "0.2.1", ""},
-		{"is-windows", "1.0.2", ""},
-		{"is-wsl", "1.1.0", ""},
-		{"isarray", "0.0.1", ""},
-		{"isarray", "1.0.0", ""},
-		{"isarray", "2.0.1", ""},
-		{"isexe", "2.0.0", ""},
-		{"isobject", "2.1.0", ""},
-		{"isobject", "3.0.1", ""},
-		{"isomorphic-fetch", "2.2.1", ""},
-		{"isstream", "0.1.2", ""},
-		{"istanbul-api", "1.3.7", ""},
-		{"istanbul-lib-coverage", "1.2.1", ""},
-		{"istanbul-lib-hook", "1.2.2", ""},
-		{"istanbul-lib-instrument", "1.10.2", ""},
-		{"istanbul-lib-report", "1.1.5", ""},
-		{"istanbul-lib-source-maps", "1.2.6", ""},
-		{"istanbul-reports", "1.5.1", ""},
-		{"isurl", "1.0.0", ""},
-		{"jest", "23.6.0", ""},
-		{"jest-changed-files", "23.4.2", ""},
-		{"jest-cli", "23.6.0", ""},
-		{"jest-config", "23.6.0", ""},
-		{"jest-diff", "23.6.0", ""},
-		{"jest-docblock", "23.2.0", ""},
-		{"jest-each", "23.6.0", ""},
-		{"jest-environment-jsdom", "23.4.0", ""},
-		{"jest-environment-node", "23.4.0", ""},
-		{"jest-get-type", "22.4.3", ""},
-		{"jest-haste-map", "23.6.0", ""},
-		{"jest-jasmine2", "23.6.0", ""},
-		{"jest-leak-detector", "23.6.0", ""},
-		{"jest-matcher-utils", "23.6.0", ""},
-		{"jest-message-util", "23.4.0", ""},
-		{"jest-mock", "23.2.0", ""},
-		{"jest-regex-util", "23.3.0", ""},
-		{"jest-resolve", "23.6.0", ""},
-		{"jest-resolve-dependencies", "23.6.0", ""},
-		{"jest-runner", "23.6.0", ""},
-		{"jest-runtime", "23.6.0", ""},
-		{"jest-serializer", "23.0.1", ""},
-		{"jest-snapshot", "23.6.0", ""},
-		{"jest-util", "23.4.0", ""},
-		{"jest-validate", "23.6.0", ""},
-		{"jest-watcher", "23.4.0", ""},
-		{"jest-worker", "23.2.0", ""},
-		{"js-file-download", "0.4.5", ""},
-		{"js-levenshtein", "1.1.6", ""},
-		{"js-tokens", "3.0.2", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"js-yaml", "3.13.1", ""},
-		{"jsbn", "0.1.1", ""},
-		{"jscodeshift", "0.5.1", ""},
-		{"jsdom", "11.12.0", ""},
-		{"jsesc", "0.5.0", ""},
-		{"jsesc", "1.3.0", ""},
-		{"jsesc", "2.5.2", ""},
-		{"json-parse-better-errors", "1.0.2", ""},
-		{"json-schema", "0.2.3", ""},
-		{"json-schema-traverse", "0.4.1", ""},
-		{"json-stable-stringify-without-jsonify", "1.0.1", ""},
-		{"json-stringify-safe", "5.0.1", ""},
-		{"json3", "3.3.2", ""},
-		{"json5", "0.5.1", ""},
-		{"json5", "1.0.1", ""},
-		{"json5", "2.1.0", ""},
-		{"jsonfile", "2.4.0", ""},
-		{"jsonfile", "4.0.0", ""},
-		{"jsonify", "0.0.0", ""},
-		{"jsonparse", "1.3.1", ""},
-		{"jsprim", "1.4.1", ""},
-		{"jss", "9.8.7", ""},
-		{"jss-camel-case", "6.1.0", ""},
-		{"jss-default-unit", "8.0.2", ""},
-		{"jss-global", "3.0.0", ""},
-		{"jss-nested", "6.0.1", ""},
-		{"jss-props-sort", "6.0.0", ""},
-		{"jss-vendor-prefixer", "7.0.0", ""},
-		{"jsx-ast-utils", "2.1.0", ""},
-		{"keycode", "2.2.0", ""},
-		{"killable", "1.0.1", ""},
-		{"kind-of", "2.0.1", ""},
-		{"kind-of", "3.2.2", ""},
-		{"kind-of", "4.0.0", ""},
-		{"kind-of", "5.1.0", ""},
-		{"kind-of", "6.0.2", ""},
-		{"klaw", "1.3.1", ""},
-		{"kleur", "2.0.2", ""},
-		{"latest-version", "3.1.0", ""},
-		{"lazy-cache", "0.2.7", ""},
-		{"lazy-cache", "1.0.4", ""},
-		{"lazy-property", "1.0.0", ""},
-		{"lazy-universal-dotenv", "2.0.0", ""},
-		{"lcid", "1.0.0", ""},
-		{"lcid", "2.0.0", ""},
-		{"left-pad", "1.3.0", ""},
-		{"leven", "2.1.0", ""},
-		{"levn", "0.3.0", ""},
-		{"libcipm", "3.0.3", ""},
-		{"libnpm", "2.0.1", ""},
-		{"libnpmaccess", "3.0.1", ""},
-		{"libnpmconfig", "1.2.1", ""},
-		{"libnpmhook", "5.0.2", ""},
-		{"libnpmorg", "1.0.0", ""},
-		{"libnpmpublish", "1.1.1", ""},
-		{"libnpmsearch", "2.0.0", ""},
-		{"libnpmteam", "1.0.1", ""},
-		{"libnpx", "10.2.0", ""},
-		{"linear-layout-vector", "0.0.1", ""},
-		{"lint-staged", "7.3.0", ""},
-		{"listenercount", "1.0.1", ""},
-		{"listr", "0.14.3", ""},
-		{"listr-silent-renderer", "1.1.1", ""},
-		{"listr-update-renderer", "0.5.0", ""},
-		{"listr-verbose-renderer", "0.5.0", ""},
-		{"load-json-file", "1.1.0", ""},
-		{"load-json-file", "2.0.0", ""},
-		{"load-json-file", "4.0.0", ""},
-		{"load-script", "1.0.0", ""},
-		{"loader-fs-cache", "1.0.2", ""},
-		{"loader-runner", "2.4.0", ""},
-		{"loader-utils", "0.2.17", ""},
-		{"loader-utils", "1.1.0", ""},
-		{"loader-utils", "1.2.3", ""},
-		{"locate-path", "2.0.0", ""},
-		{"locate-path", "3.0.0", ""},
-		{"lock-verify", "2.1.0", ""},
-		{"lockfile", "1.0.4", ""},
-		{"lodash", "3.10.1", ""},
-		{"lodash", "4.17.11", ""},
-		{"lodash-es", "4.17.11", ""},
-		{"lodash._baseuniq", "4.6.0", ""},
-		{"lodash._createset", "4.0.3", ""},
-		{"lodash._root", "3.0.1", ""},
-		{"lodash.assign", "4.2.0", ""},
-		{"lodash.clonedeep", "4.5.0", ""},
-		{"lodash.escape", "4.0.1", ""},
-		{"lodash.flattendeep", "4.4.0", ""},
-		{"lodash.isequal", "4.5.0", ""},
-		{"lodash.isplainobject", "4.0.6", ""},
-		{"lodash.merge", "4.6.1", ""},
-		{"lodash.some", "4.6.0", ""},
-		{"lodash.sortby", "4.7.0", ""},
-		{"lodash.union", "4.6.0", ""},
-		{"lodash.uniq", "4.5.0", ""},
-		{"lodash.without", "4.4.0", ""},
-		{"log-symbols", "1.0.2", ""},
-		{"log-symbols", "2.2.0", ""},
-		{"log-update", "2.3.0", ""},
-		{"loglevel", "1.6.1", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"lower-case", "1.1.4", ""},
-		{"lowercase-keys", "1.0.1", ""},
-		{"lru-cache", "4.1.5", ""},
-		{"lru-cache", "5.1.1", ""},
-		{"macos-release", "2.2.0", ""},
-		{"make-dir", "1.3.0", ""},
-		{"make-dir", "2.1.0", ""},
-		{"make-error", "1.3.5", ""},
-		{"make-fetch-happen", "4.0.1", ""},
-		{"makeerror", "1.0.11", ""},
-		{"mamacro", "0.0.3", ""},
-		{"map-age-cleaner", "0.1.3", ""},
-		{"map-cache", "0.2.2", ""},
-		{"map-visit", "1.0.0", ""},
-		{"marked", "0.3.19", ""},
-		{"marksy", "6.1.0", ""},
-		{"material-colors", "1.2.6", ""},
-		{"math-random", "1.0.4", ""},
-		{"md5", "2.2.1", ""},
-		{"md5.js", "1.3.5", ""},
-		{"mdn-data", "1.1.4", ""},
-		{"meant", "1.0.1", ""},
-		{"media-typer", "0.3.0", ""},
-		{"mem", "1.1.0", ""},
-		{"mem", "4.3.0", ""},
-		{"memoize-one", "4.1.0", ""},
-		{"memory-fs", "0.4.1", ""},
-		{"memorystream", "0.3.1", ""},
-		{"merge", "1.2.1", ""},
-		{"merge-deep", "3.0.2", ""},
-		{"merge-descriptors", "1.0.1", ""},
-		{"merge-dirs", "0.2.1", ""},
-		{"merge-stream", "1.0.1", ""},
-		{"merge2", "1.2.3", ""},
-		{"methods", "1.1.2", ""},
-		{"micromatch", "2.3.11", ""},
-		{"micromatch", "3.1.10", ""},
-		{"miller-rabin", "4.0.1", ""},
-		{"mime", "1.4.1", ""},
-		{"mime", "2.4.2", ""},
-		{"mime-db", "1.40.0", ""},
-		{"mime-types", "2.1.24", ""},
-		{"mimic-fn", "1.2.0", ""},
-		{"mimic-fn", "2.1.0", ""},
-		{"mimic-response", "1.0.1", ""},
-		{"min-document", "2.19.0", ""},
-		{"mini-css-extract-plugin", "0.4.5", ""},
-		{"minimalistic-assert", "1.0.1", ""},
-		{"minimalistic-crypto-utils", "1.0.1", ""},
-		{"minimatch", "3.0.4", ""},
-		{"minimist", "0.0.10", ""},
-		{"minimist", "0.0.8", ""},
-		{"minimist", "1.2.0", ""},
-		{"minipass", "2.3.5", ""},
-		{"minizlib", "1.2.1", ""},
-		{"mississippi", "2.0.0", ""},
-		{"mississippi", "3.0.0", ""},
-		{"mixin-deep", "1.3.1", ""},
-		{"mixin-object", "2.0.1", ""},
-		{"mkdirp", "0.5.1", ""},
-		{"moment", "2.23.0", ""},
-		{"moment", "2.24.0", ""},
-		{"moment-timezone", "0.5.23", ""},
-		{"moo", "0.4.3", ""},
-		{"move-concurrently", "1.0.1", ""},
-		{"ms", "2.0.0", ""},
-		{"ms", "2.1.1", ""},
-		{"multicast-dns", "6.2.3", ""},
-		{"multicast-dns-service-types", "1.1.0", ""},
-		{"mute-stream", "0.0.5", ""},
-		{"mute-stream", "0.0.7", ""},
-		{"mute-stream", "0.0.8", ""},
-		{"nan", "2.13.2", ""},
-		{"nanomatch", "1.2.13", ""},
-		{"natural-compare", "1.4.0", ""},
-		{"nearley", "2.16.0", ""},
-		{"needle", "2.4.0", ""},
-		{"negotiator", "0.6.2", ""},
-		{"neo-async", "2.6.1", ""},
-		{"nested-object-assign", "1.0.3", ""},
-		{"nice-try", "1.0.5", ""},
-		{"no-case", "2.3.2", ""},
-		{"node-dir", "0.1.17", ""},
-		{"node-dir", "0.1.8", ""},
-		{"node-fetch", "1.7.3", ""},
-		{"node-fetch", "2.5.0", ""},
-		{"node-fetch-npm", "2.0.2", ""},
-		{"node-forge", "0.7.5", ""},
-		{"node-fs", "0.1.7", ""},
-		{"node-gyp", "3.8.0", ""},
-		{"node-gyp", "4.0.0", ""},
-		{"node-int64", "0.4.0", ""},
-		{"node-libs-browser", "2.2.0", ""},
-		{"node-modules-regexp", "1.0.0", ""},
-		{"node-notifier", "5.4.0", ""},
-		{"node-object-hash", "1.4.2", ""},
-		{"node-pre-gyp", "0.12.0", ""},
-		{"node-releases", "1.1.19", ""},
-		{"node-version", "1.2.0", ""},
-		{"nomnom", "1.8.1", ""},
-		{"nopt", "3.0.6", ""},
-		{"nopt", "4.0.1", ""},
-		{"normalize-package-data", "2.5.0", ""},
-		{"normalize-path", "2.1.1", ""},
-		{"normalize-path", "3.0.0", ""},
-		{"normalize-range", "0.1.2", ""},
-		{"normalize-scroll-left", "0.1.2", ""},
-		{"npm", "6.9.0", ""},
-		{"npm-audit-report", "1.3.2", ""},
-		{"npm-bundled", "1.0.6", ""},
-		{"npm-cache-filename", "1.0.2", ""},
-		{"npm-install-checks", "3.0.0", ""},
-		{"npm-lifecycle", "2.1.1", ""},
-		{"npm-logical-tree", "1.2.1", ""},
-		{"npm-package-arg", "6.1.0", ""},
-		{"npm-packlist", "1.4.1", ""},
-		{"npm-path", "2.0.4", ""},
-		{"npm-pick-manifest", "2.2.3", ""},
-		{"npm-profile", "4.0.1", ""},
-		{"npm-registry-fetch", "3.9.0", ""},
-		{"npm-run-all", "4.1.5", ""},
-		{"npm-run-path", "2.0.2", ""},
-		{"npm-user-validate", "1.0.0", ""},
-		{"npm-which", "3.0.1", ""},
-		{"npmlog", "4.1.2", ""},
-		{"nth-check", "1.0.2", ""},
-		{"num2fraction", "1.2.2", ""},
-		{"number-is-nan", "1.0.1", ""},
-		{"nwsapi", "2.1.4", ""},
-		{"oauth-sign", "0.9.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"object-component", "0.0.3", ""},
-		{"object-copy", "0.1.0", ""},
-		{"object-hash", "1.3.1", ""},
-		{"object-inspect", "1.6.0", ""},
-		{"object-is", "1.0.1", ""},
-		{"object-keys", "1.1.1", ""},
-		{"object-visit", "1.0.1", ""},
-		{"object.assign", "4.1.0", ""},
-		{"object.entries", "1.1.0", ""},
-		{"object.fromentries", "2.0.0", ""},
-		{"object.getownpropertydescriptors", "2.0.3", ""},
-		{"object.omit", "2.0.1", ""},
-		{"object.pick", "1.3.0", ""},
-		{"object.values", "1.1.0", ""},
-		{"obuf", "1.1.2", ""},
-		{"on-finished", "2.3.0", ""},
-		{"on-headers", "1.0.2", ""},
-		{"once", "1.4.0", ""},
-		{"onetime", "1.1.0", ""},
-		{"onetime", "2.0.1", ""},
-		{"opener", "1.5.1", ""},
-		{"opn", "5.4.0", ""},
-		{"opn", "5.5.0", ""},
-		{"optimist", "0.6.1", ""},
-		{"optionator", "0.8.2", ""},
-		{"original", "1.0.2", ""},
-		{"os-browserify", "0.3.0", ""},
-		{"os-homedir", "1.0.2", ""},
-		{"os-locale", "1.4.0", ""},
-		{"os-locale", "2.1.0", ""},
-		{"os-locale", "3.1.0", ""},
-		{"os-name", "3.1.0", ""},
-		{"os-tmpdir", "1.0.2", ""},
-		{"osenv", "0.1.5", ""},
-		{"output-file-sync", "1.1.2", ""},
-		{"p-cancelable", "0.3.0", ""},
-		{"p-defer", "1.0.0", ""},
-		{"p-finally", "1.0.0", ""},
-		{"p-is-promise", "2.1.0", ""},
-		{"p-limit", "1.3.0", ""},
-		{"p-limit", "2.2.0", ""},
-		{"p-locate", "2.0.0", ""},
-		{"p-locate", "3.0.0", ""},
-		{"p-map", "1.2.0", ""},
-		{"p-map", "2.1.0", ""},
-		{"p-timeout", "1.2.1", ""},
-		{"p-try", "1.0.0", ""},
-		{"p-try", "2.2.0", ""},
-		{"package-json", "4.0.1", ""},
-		{"pacote", "9.5.0", ""},
-		{"pako", "1.0.10", ""},
-		{"parallel-transform", "1.1.0", ""},
-		{"param-case", "2.1.1", ""},
-		{"parent-module", "1.0.1", ""},
-		{"parse-asn1", "5.1.4", ""},
-		{"parse-glob", "3.0.4", ""},
-		{"parse-json", "2.2.0", ""},
-		{"parse-json", "4.0.0", ""},
-		{"parse-passwd", "1.0.0", ""},
-		{"parse5", "3.0.3", ""},
-		{"parse5", "4.0.0", ""},
-		{"parse5", "5.1.0", ""},
-		{"parseqs", "0.0.5", ""},
-		{"parseuri", "0.0.5", ""},
-		{"parseurl", "1.3.3", ""},
-		{"pascalcase", "0.1.1", ""},
-		{"path", "0.12.7", ""},
-		{"path-browserify", "0.0.0", ""},
-		{"path-dirname", "1.0.2", ""},
-		{"path-exists", "2.1.0", ""},
-		{"path-exists", "3.0.0", ""},
-		{"path-is-absolute", "1.0.1", ""},
-		{"path-is-inside", "1.0.2", ""},
-		{"path-key", "2.0.1", ""},
-		{"path-parse", "1.0.6", ""},
-		{"path-to-regexp", "0.1.7", ""},
-		{"path-to-regexp", "1.7.0", ""},
-		{"path-type", "1.1.0", ""},
-		{"path-type", "2.0.0", ""},
-		{"path-type", "3.0.0", ""},
-		{"pbkdf2", "3.0.17", ""},
-		{"performance-now", "2.1.0", ""},
-		{"pidtree", "0.3.0", ""},
-		{"pify", "2.3.0", ""},
-		{"pify", "3.0.0", ""},
-		{"pify", "4.0.1", ""},
-		{"pinkie", "2.0.4", ""},
-		{"pinkie-promise", "2.0.1", ""},
-		{"pirates", "4.0.1", ""},
-		{"pkg-dir", "1.0.0", ""},
-		{"pkg-dir", "2.0.0", ""},
-		{"pkg-dir", "3.0.0", ""},
-		{"pkg-up", "2.0.0", ""},
-		{"please-upgrade-node", "3.1.1", ""},
-		{"pn", "1.1.0", ""},
-		{"popper.js", "1.15.0", ""},
-		{"portfinder", "1.0.20", ""},
-		{"posix-character-classes", "0.1.1", ""},
-		{"postcss", "6.0.23", ""},
-		{"postcss", "7.0.16", ""},
-		{"postcss-flexbugs-fixes", "4.1.0", ""},
-		{"postcss-load-config", "2.0.0", ""},
-		{"postcss-loader", "3.0.0", ""},
-		{"postcss-modules-extract-imports", "1.2.1", ""},
-		{"postcss-modules-local-by-default", "1.2.0", ""},
-		{"postcss-modules-scope", "1.1.0", ""},
-		{"postcss-modules-values", "1.3.0", ""},
-		{"postcss-value-parser", "3.3.1", ""},
-		{"prelude-ls", "1.1.2", ""},
-		{"prepend-http", "1.0.4", ""},
-		{"preserve", "0.2.0", ""},
-		{"pretty-error", "2.1.1", ""},
-		{"pretty-format", "23.6.0", ""},
-		{"pretty-hrtime", "1.0.3", ""},
-		{"private", "0.1.8", ""},
-		{"process", "0.11.10", ""},
-		{"process", "0.5.2", ""},
-		{"process-nextick-args", "1.0.7", ""},
-		{"process-nextick-args", "2.0.0", ""},
-		{"progress", "2.0.3", ""},
-		{"promise", "7.3.1", ""},
-		{"promise-inflight", "1.0.1", ""},
-		{"promise-polyfill", "6.1.0", ""},
-		{"promise-retry", "1.1.1", ""},
-		{"promise.allsettled", "1.0.1", ""},
-		{"promise.prototype.finally", "3.1.0", ""},
-		{"prompts", "0.1.14", ""},
-		{"promzard", "0.3.0", ""},
-		{"prop-types", "15.7.2", ""},
-		{"prop-types-exact", "1.2.0", ""},
-		{"property-information", "5.1.0", ""},
-		{"proto-list", "1.2.4", ""},
-		{"protoduck", "5.0.1", ""},
-		{"proxy-addr", "2.0.5", ""},
-		{"prr", "1.0.1", ""},
-		{"pseudomap", "1.0.2", ""},
-		{"psl", "1.1.31", ""},
-		{"public-encrypt", "4.0.3", ""},
-		{"pump", "2.0.1", ""},
-		{"pump", "3.0.0", ""},
-		{"pumpify", "1.5.1", ""},
-		{"punycode", "1.3.2", ""},
-		{"punycode", "1.4.1", ""},
-		{"punycode", "2.1.1", ""},
-		{"q", "1.5.1", ""},
-		{"qrcode-terminal", "0.12.0", ""},
-		{"qs", "6.5.2", ""},
-		{"qs", "6.7.0", ""},
-		{"query-string", "6.5.0", ""},
-		{"querystring", "0.2.0", ""},
-		{"querystring-es3", "0.2.1", ""},
-		{"querystringify", "2.1.1", ""},
-		{"qw", "1.0.1", ""},
-		{"raf", "3.4.1", ""},
-		{"railroad-diagrams", "1.0.0", ""},
-		{"ramda", "0.21.0", ""},
-		{"randexp", "0.4.6", ""},
-		{"randomatic", "3.1.1", ""},
-		{"randombytes", "2.1.0", ""},
-		{"randomfill", "1.0.4", ""},
-		{"range-parser", "1.2.1", ""},
-		{"raven-for-redux", "1.4.0", ""},
-		{"raven-js", "3.27.0", ""},
-		{"raw-body", "2.3.3", ""},
-		{"raw-loader", "0.5.1", ""},
-		{"rc", "1.2.8", ""},
-		{"react", "16.8.3", ""},
-		{"react", "16.8.6", ""},
-		{"react-addons-create-fragment", "15.6.2", ""},
-		{"react-color", "2.17.3", ""},
-		{"react-datepicker", "2.5.0", ""},
-		{"react-dev-utils", "6.1.1", ""},
-		{"react-docgen", "3.0.0", ""},
-		{"react-dom", "16.8.3", ""},
-		{"react-dom", "16.8.6", ""},
-		{"react-dropzone", "10.1.4", ""},
-		{"react-error-overlay", "5.1.6", ""},
-		{"react-event-listener", "0.6.6", ""},
-		{"react-fast-compare", "2.0.4", ""},
-		{"react-fuzzy", "0.5.2", ""},
-		{"react-ga", "2.5.7", ""},
-		{"react-gateway", "3.0.0", ""},
-		{"react-inspector", "2.3.1", ""},
-		{"react-intl-universal", "1.16.2", ""},
-		{"react-is", "16.8.6", ""},
-		{"react-lifecycles-compat", "3.0.4", ""},
-		{"react-modal", "3.8.1", ""},
-		{"react-onclickoutside", "6.8.0", ""},
-		{"react-popper", "1.3.3", ""},
-		{"react-prop-types", "0.4.0", ""},
-		{"react-redux", "6.0.1", ""},
-		{"react-router", "4.3.1", ""},
-		{"react-router-dom", "4.3.1", ""},
-		{"react-split-pane", "0.1.87", ""},
-		{"react-style-proptype", "3.2.2", ""},
-		{"react-test-renderer", "16.8.6", ""},
-		{"react-textarea-autosize", "7.1.0", ""},
-		{"react-transition-group", "2.9.0", ""},
-		{"react-treebeard", "3.1.0", ""},
-		{"react-virtualized", "9.21.1", ""},
-		{"reactcss", "1.2.3", ""},
-		{"read", "1.0.7", ""},
-		{"read-cmd-shim", "1.0.1", ""},
-		{"read-installed", "4.0.3", ""},
-		{"read-package-json", "2.0.13", ""},
-		{"read-package-tree", "5.2.2", ""},
-		{"read-pkg", "1.1.0", ""},
-		{"read-pkg", "2.0.0", ""},
-		{"read-pkg", "3.0.0", ""},
-		{"read-pkg", "4.0.1", ""},
-		{"read-pkg-up", "1.0.1", ""},
-		{"read-pkg-up", "2.0.0", ""},
-		{"readable-stream", "1.1.14", ""},
-		{"readable-stream", "2.1.5", ""},
-		{"readable-stream", "2.3.6", ""},
-		{"readable-stream", "3.3.0", ""},
-		{"readdir-scoped-modules", "1.0.2", ""},
-		{"readdirp", "2.2.1", ""},
-		{"readline2", "1.0.1", ""},
-		{"realpath-native", "1.1.0", ""},
-		{"recast", "0.14.7", ""},
-		{"recast", "0.15.5", ""},
-		{"recast", "0.16.2", ""},
-		{"rechoir", "0.6.2", ""},
-		{"recompose", "0.30.0", ""},
-		{"recursive-readdir", "2.2.2", ""},
-		{"redux", "4.0.1", ""},
-		{"redux-thunk", "2.3.0", ""},
-		{"reflect.ownkeys", "0.2.0", ""},
-		{"regenerate", "1.4.0", ""},
-		{"regenerate-unicode-properties", "8.1.0", ""},
-		{"regenerator-runtime", "0.10.5", ""},
-		{"regenerator-runtime", "0.11.1", ""},
-		{"regenerator-runtime", "0.12.1", ""},
-		{"regenerator-runtime", "0.13.2", ""},
-		{"regenerator-transform", "0.10.1", ""},
-		{"regenerator-transform", "0.13.4", ""},
-		{"regex-cache", "0.4.4", ""},
-		{"regex-not", "1.0.2", ""},
-		{"regexp-tree", "0.1.6", ""},
-		{"regexp.prototype.flags", "1.2.0", ""},
-		{"regexpp", "2.0.1", ""},
-		{"regexpu-core", "1.0.0", ""},
-		{"regexpu-core", "2.0.0", ""},
-		{"regexpu-core", "4.5.4", ""},
-		{"registry-auth-token", "3.4.0", ""},
-		{"registry-url", "3.1.0", ""},
-		{"regjsgen", "0.2.0", ""},
-		{"regjsgen", "0.5.0", ""},
-		{"regjsparser", "0.1.5", ""},
-		{"regjsparser", "0.6.0", ""},
-		{"rehype-parse", "6.0.0", ""},
-		{"relateurl", "0.2.7", ""},
-		{"remove-trailing-separator", "1.1.0", ""},
-		{"render-fragment", "0.1.1", ""},
-		{"renderkid", "2.0.3", ""},
-		{"repeat-element", "1.1.3", ""},
-		{"repeat-string", "1.6.1", ""},
-		{"repeating", "2.0.1", ""},
-		{"replace-ext", "1.0.0", ""},
-		{"request", "2.88.0", ""},
-		{"request-promise-core", "1.1.2", ""},
-		{"request-promise-native", "1.0.7", ""},
-		{"require-directory", "2.1.1", ""},
-		{"require-from-string", "2.0.2", ""},
-		{"require-main-filename", "1.0.1", ""},
-		{"requires-port", "1.0.0", ""},
-		{"resolve", "1.1.7", ""},
-		{"resolve", "1.10.1", ""},
-		{"resolve-cwd", "2.0.0", ""},
-		{"resolve-dir", "1.0.1", ""},
-		{"resolve-from", "3.0.0", ""},
-		{"resolve-from", "4.0.0", ""},
-		{"resolve-pathname", "2.2.0", ""},
-		{"resolve-url", "0.2.1", ""},
-		{"restore-cursor", "1.0.1", ""},
-		{"restore-cursor", "2.0.0", ""},
-		{"ret", "0.1.15", ""},
-		{"retry", "0.10.1", ""},
-		{"retry", "0.12.0", ""},
-		{"rimraf", "2.2.8", ""},
-		{"rimraf", "2.6.3", ""},
-		{"ripemd160", "2.0.2", ""},
-		{"rst-selector-parser", "2.2.3", ""},
-		{"rsvp", "3.6.2", ""},
-		{"run-async", "0.1.0", ""},
-		{"run-async", "2.3.0", ""},
-		{"run-node", "1.0.0", ""},
-		{"run-queue", "1.0.3", ""},
-		{"rx-lite", "3.1.2", ""},
-		{"rxjs", "6.5.2", ""},
-		{"safe-buffer", "5.1.1", ""},
-		{"safe-buffer", "5.1.2", ""},
-		{"safe-regex", "1.1.0", ""},
-		{"safer-buffer", "2.1.2", ""},
-		{"sane", "2.5.2", ""},
-		{"sax", "1.2.4", ""},
-		{"scheduler", "0.13.6", ""},
-		{"schema-utils", "0.4.7", ""},
-		{"schema-utils", "1.0.0", ""},
-		{"select-hose", "2.0.0", ""},
-		{"selfsigned", "1.10.4", ""},
-		{"semver", "5.3.0", ""},
-		{"semver", "5.7.0", ""},
-		{"semver", "6.0.0", ""},
-		{"semver-compare", "1.0.0", ""},
-		{"semver-diff", "2.1.0", ""},
-		{"send", "0.16.2", ""},
-		{"serialize-javascript", "1.7.0", ""},
-		{"serve-favicon", "2.5.0", ""},
-		{"serve-index", "1.9.1", ""},
-		{"serve-static", "1.13.2", ""},
-		{"set-blocking", "2.0.0", ""},
-		{"set-value", "0.4.3", ""},
-		{"set-value", "2.0.0", ""},
-		{"setimmediate", "1.0.5", ""},
-		{"setprototypeof", "1.1.0", ""},
-		{"sha", "2.0.1", ""},
-		{"sha.js", "2.4.11", ""},
-		{"shallow-clone", "0.1.2", ""},
-		{"shallowequal", "1.1.0", ""},
-		{"shebang-command", "1.2.0", ""},
-		{"shebang-regex", "1.0.0", ""},
-		{"shell-quote", "1.6.1", ""},
-		{"shelljs", "0.8.3", ""},
-		{"shellwords", "0.1.1", ""},
-		{"signal-exit", "3.0.2", ""},
-		{"sisteransi", "0.1.1", ""},
-		{"slash", "1.0.0", ""},
-		{"slash", "2.0.0", ""},
-		{"slice-ansi", "0.0.4", ""},
-		{"slice-ansi", "1.0.0", ""},
-		{"slice-ansi", "2.1.0", ""},
-		{"slide", "1.1.6", ""},
-		{"smart-buffer", "4.0.2", ""},
-		{"snapdragon", "0.8.2", ""},
-		{"snapdragon-node", "2.1.1", ""},
-		{"snapdragon-util", "3.0.1", ""},
-		{"socket.io", "2.2.0", ""},
-		{"socket.io-adapter", "1.1.1", ""},
-		{"socket.io-client", "2.2.0", ""},
-		{"socket.io-parser", "3.3.0", ""},
-		{"sockjs", "0.3.19", ""},
-		{"sockjs-client", "1.1.5", ""},
-		{"sockjs-client", "1.3.0", ""},
-		{"socks", "2.3.2", ""},
-		{"socks-proxy-agent", "4.0.2", ""},
-		{"sort-keys", "2.0.0", ""},
-		{"sorted-object", "2.0.1", ""},
-		{"sorted-union-stream", "2.1.3", ""},
-		{"source-list-map", "2.0.1", ""},
-		{"source-map", "0.5.7", ""},
-		{"source-map", "0.6.1", ""},
-		{"source-map-resolve", "0.5.2", ""},
-		{"source-map-support", "0.4.18", ""},
-		{"source-map-support", "0.5.12", ""},
-		{"source-map-url", "0.4.0", ""},
-		{"space-separated-tokens", "1.1.4", ""},
-		{"spawn-promise", "0.1.8", ""},
-		{"spdx-correct", "3.1.0", ""},
-		{"spdx-exceptions", "2.2.0", ""},
-		{"spdx-expression-parse", "3.0.0", ""},
-		{"spdx-license-ids", "3.0.4", ""},
-		{"spdy", "4.0.0", ""},
-		{"spdy-transport", "3.0.0", ""},
-		{"split-on-first", "1.1.0", ""},
-		{"split-string", "3.1.0", ""},
-		{"sprintf-js", "1.0.3", ""},
-		{"sshpk", "1.16.1", ""},
-		{"ssri", "5.3.0", ""},
-		{"ssri", "6.0.1", ""},
-		{"stable", "0.1.8", ""},
-		{"stack-utils", "1.0.2", ""},
-		{"staged-git-files", "1.1.1", ""},
-		{"static-extend", "0.1.2", ""},
-		{"statuses", "1.4.0", ""},
-		{"statuses", "1.5.0", ""},
-		{"stealthy-require", "1.1.1", ""},
-		{"stream-browserify", "2.0.2", ""},
-		{"stream-each", "1.2.3", ""},
-		{"stream-http", "2.8.3", ""},
-		{"stream-iterate", "1.2.0", ""},
-		{"stream-shift", "1.0.0", ""},
-		{"strict-uri-encode", "2.0.0", ""},
-		{"string-argv", "0.0.2", ""},
-		{"string-length", "2.0.0", ""},
-		{"string-width", "1.0.2", ""},
-		{"string-width", "2.1.1", ""},
-		{"string-width", "3.1.0", ""},
-		{"string.prototype.matchall", "3.0.1", ""},
-		{"string.prototype.padend", "3.0.0", ""},
-		{"string.prototype.padstart", "3.0.0", ""},
-		{"string.prototype.trim", "1.1.2", ""},
-		{"string_decoder", "0.10.31", ""},
-		{"string_decoder", "1.1.1", ""},
-		{"string_decoder", "1.2.0", ""},
-		{"stringify-object", "3.3.0", ""},
-		{"stringify-package", "1.0.0", ""},
-		{"strip-ansi", "0.1.1", ""},
-		{"strip-ansi", "3.0.1", ""},
-		{"strip-ansi", "4.0.0", ""},
-		{"strip-ansi", "5.2.0", ""},
-		{"strip-bom", "2.0.0", ""},
-		{"strip-bom", "3.0.0", ""},
-		{"strip-eof", "1.0.0", ""},
-		{"strip-json-comments", "2.0.1", ""},
-		{"style-loader", "0.23.1", ""},
-		{"styled-components", "4.1.3", ""},
-		{"stylis", "3.5.4", ""},
-		{"stylis-rule-sheet", "0.0.10", ""},
-		{"supports-color", "2.0.0", ""},
-		{"supports-color", "3.2.3", ""},
-		{"supports-color", "5.5.0", ""},
-		{"supports-color", "6.1.0", ""},
-		{"svg-url-loader", "2.3.2", ""},
-		{"svgo", "1.2.2", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"symbol-tree", "3.2.2", ""},
-		{"symbol.prototype.description", "1.0.0", ""},
-		{"table", "4.0.3", ""},
-		{"table", "5.3.3", ""},
-		{"tapable", "1.1.3", ""},
-		{"tar", "2.2.2", ""},
-		{"tar", "4.4.8", ""},
-		{"temp", "0.8.3", ""},
-		{"term-size", "1.2.0", ""},
-		{"terser", "3.17.0", ""},
-		{"terser-webpack-plugin", "1.2.4", ""},
-		{"test-exclude", "4.2.3", ""},
-		{"text-table", "0.2.0", ""},
-		{"throat", "4.1.0", ""},
-		{"through", "2.3.8", ""},
-		{"through2", "2.0.5", ""},
-		{"thunky", "1.0.3", ""},
-		{"timed-out", "4.0.1", ""},
-		{"timers-browserify", "2.0.10", ""},
-		{"tiny-invariant", "1.0.4", ""},
-		{"tiny-relative-date", "1.3.0", ""},
-		{"tiny-warning", "1.0.2", ""},
-		{"tinycolor2", "1.4.1", ""},
-		{"tmp", "0.0.33", ""},
-		{"tmpl", "1.0.4", ""},
-		{"to-array", "0.1.4", ""},
-		{"to-arraybuffer", "1.0.1", ""},
-		{"to-fast-properties", "1.0.3", ""},
-		{"to-fast-properties", "2.0.0", ""},
-		{"to-object-path", "0.3.0", ""},
-		{"to-regex", "3.0.2", ""},
-		{"to-regex-range", "2.1.1", ""},
-		{"toggle-selection", "1.0.6", ""},
-		{"toposort", "1.0.7", ""},
-		{"tough-cookie", "2.4.3", ""},
-		{"tough-cookie", "2.5.0", ""},
-		{"tr46", "1.0.1", ""},
-		{"traverse", "0.3.9", ""},
-		{"trim-right", "1.0.1", ""},
-		{"trough", "1.0.4", ""},
-		{"tryer", "1.0.1", ""},
-		{"tslib", "1.9.3", ""},
-		{"tty-browserify", "0.0.0", ""},
-		{"tunnel-agent", "0.6.0", ""},
-		{"tweetnacl", "0.14.5", ""},
-		{"type-check", "0.3.2", ""},
-		{"type-is", "1.6.18", ""},
-		{"typed-styles", "0.0.7", ""},
-		{"typedarray", "0.0.6", ""},
-		{"ua-parser-js", "0.7.19", ""},
-		{"uglify-js", "3.4.10", ""},
-		{"uglify-js", "3.5.12", ""},
-		{"uid-number", "0.0.6", ""},
-		{"umask", "1.1.0", ""},
-		{"underscore", "1.6.0", ""},
-		{"unicode-canonical-property-names-ecmascript", "1.0.4", ""},
-		{"unicode-match-property-ecmascript", "1.0.4", ""},
-		{"unicode-match-property-value-ecmascript", "1.1.0", ""},
-		{"unicode-property-aliases-ecmascript", "1.0.5", ""},
-		{"unified", "7.1.0", ""},
-		{"union-value", "1.0.0", ""},
-		{"unique-filename", "1.1.1", ""},
-		{"unique-slug", "2.0.1", ""},
-		{"unique-string", "1.0.0", ""},
-		{"unist-util-stringify-position", "1.1.2", ""},
-		{"unist-util-stringify-position", "2.0.0", ""},
-		{"universal-user-agent", "2.1.0", ""},
-		{"universalify", "0.1.2", ""},
-		{"unpipe", "1.0.0", ""},
-		{"unquote", "1.1.1", ""},
-		{"unset-value", "1.0.0", ""},
-		{"unzip-response", "2.0.1", ""},
-		{"unzipper", "0.8.14", ""},
-		{"upath", "1.1.2", ""},
-		{"update-notifier", "2.5.0", ""},
-		{"upper-case", "1.1.3", ""},
-		{"uri-js", "4.2.2", ""},
-		{"urix", "0.1.0", ""},
-		{"url", "0.11.0", ""},
-		{"url-loader", "1.1.2", ""},
-		{"url-parse", "1.4.7", ""},
-		{"url-parse-lax", "1.0.0", ""},
-		{"url-template", "2.0.8", ""},
-		{"url-to-options", "1.0.1", ""},
-		{"use", "3.1.1", ""},
-		{"user-home", "1.1.1", ""},
-		{"util", "0.10.3", ""},
-		{"util", "0.10.4", ""},
-		{"util", "0.11.1", ""},
-		{"util-deprecate", "1.0.2", ""},
-		{"util-extend", "1.0.3", ""},
-		{"util.promisify", "1.0.0", ""},
-		{"utila", "0.4.0", ""},
-		{"utils-merge", "1.0.1", ""},
-		{"uuid", "3.3.2", ""},
-		{"v8-compile-cache", "2.0.3", ""},
-		{"v8flags", "2.1.1", ""},
-		{"validate-npm-package-license", "3.0.4", ""},
-		{"validate-npm-package-name", "3.0.0", ""},
-		{"value-equal", "0.4.0", ""},
-		{"vary", "1.1.2", ""},
-		{"velocity-animate", "1.5.2", ""},
-		{"velocity-react", "1.4.3", ""},
-		{"verror", "1.10.0", ""},
-		{"vfile", "3.0.1", ""},
-		{"vfile", "4.0.0", ""},
-		{"vfile-message", "1.1.1", ""},
-		{"vfile-message", "2.0.0", ""},
-		{"vm-browserify", "0.0.4", ""},
-		{"w3c-hr-time", "1.0.1", ""},
-		{"walker", "1.0.7", ""},
-		{"warning", "3.0.0", ""},
-		{"warning", "4.0.3", ""},
-		{"watch", "0.18.0", ""},
-		{"watchpack", "1.6.0", ""},
-		{"wbuf", "1.7.3", ""},
-		{"wcwidth", "1.0.1", ""},
-		{"web-namespaces", "1.1.3", ""},
-		{"webidl-conversions", "4.0.2", ""},
-		{"webpack", "4.31.0", ""},
-		{"webpack-bundle-analyzer", "3.3.2", ""},
-		{"webpack-cli", "3.3.2", ""},
-		{"webpack-dev-middleware", "3.7.0", ""},
-		{"webpack-dev-server", "3.3.1", ""},
-		{"webpack-hot-middleware", "2.25.0", ""},
-		{"webpack-log", "2.0.0", ""},
-		{"webpack-merge", "4.2.1", ""},
-		{"webpack-sources", "1.3.0", ""},
-		{"websocket-driver", "0.7.0", ""},
-		{"websocket-extensions", "0.1.3", ""},
-		{"whatwg-encoding", "1.0.5", ""},
-		{"whatwg-fetch", "3.0.0", ""},
-		{"whatwg-mimetype", "2.3.0", ""},
-		{"whatwg-url", "6.5.0", ""},
-		{"whatwg-url", "7.0.0", ""},
-		{"which", "1.3.1", ""},
-		{"which-module", "1.0.0", ""},
-		{"which-module", "2.0.0", ""},
-		{"wide-align", "1.1.3", ""},
-		{"widest-line", "2.0.1", ""},
-		{"window-size", "0.2.0", ""},
-		{"windows-release", "3.2.0", ""},
-		{"wordwrap", "0.0.3", ""},
-		{"wordwrap", "1.0.0", ""},
-		{"worker-farm", "1.7.0", ""},
-		{"wrap-ansi", "2.1.0", ""},
-		{"wrap-ansi", "3.0.1", ""},
-		{"wrappy", "1.0.2", ""},
-		{"write", "1.0.3", ""},
-		{"write-file-atomic", "1.3.4", ""},
-		{"write-file-atomic", "2.4.2", ""},
-		{"write-json-file", "2.3.0", ""},
-		{"ws", "5.2.2", ""},
-		{"ws", "6.1.4", ""},
-		{"ws", "6.2.1", ""},
-		{"x-is-string", "0.1.0", ""},
-		{"xdg-basedir", "3.0.0", ""},
-		{"xml-name-validator", "3.0.0", ""},
-		{"xmlhttprequest-ssl", "1.5.5", ""},
-		{"xtend", "4.0.1", ""},
-		{"y18n", "3.2.1", ""},
-		{"y18n", "4.0.0", ""},
-		{"yallist", "2.1.2", ""},
-		{"yallist", "3.0.3", ""},
-		{"yargs", "11.1.0", ""},
-		{"yargs", "12.0.5", ""},
-		{"yargs", "4.8.1", ""},
-		{"yargs-parser", "11.1.1", ""},
-		{"yargs-parser", "2.4.1", ""},
-		{"yargs-parser", "9.0.2", ""},
-		{"yeast", "0.1.2", ""},
+		{Name: "JSONStream", Version: "1.3.5", Constraint: "^1.3.4, ^1.3.5", Locations: []types.Location{{StartLine: 1929, EndLine: 1935}}},
+		{Name: "abab", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1937, EndLine: 1940}}},
+		{Name: "abbrev", Version: "1.1.1", Constraint: "1, ~1.1.1", Locations: []types.Location{{StartLine: 1942, EndLine: 1945}}},
+		{Name: "accepts", Version: "1.3.7", Constraint: "~1.3.4, ~1.3.5", Locations: []types.Location{{StartLine: 1947, EndLine: 1953}}},
+		{Name: "acorn", Version: "5.7.3", Constraint: "^5.5.3", Locations: []types.Location{{StartLine: 1978, EndLine: 1981}}},
+		{Name: "acorn", Version: "6.1.1", Constraint: "^6.0.1, ^6.0.5, ^6.0.7", Locations: []types.Location{{StartLine: 1983, EndLine: 1986}}},
+		{Name: "acorn-dynamic-import", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1955, EndLine: 1958}}},
+		{Name: "acorn-globals", Version: "4.3.2", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 1960, EndLine: 1966}}},
+		{Name: "acorn-jsx", Version: "5.0.1", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 1968, EndLine: 1971}}},
+		{Name: "acorn-walk", Version: "6.1.1", Constraint: "^6.0.1, ^6.1.1", Locations: []types.Location{{StartLine: 1973, EndLine: 1976}}},
+		{Name: "address", Version: "1.0.3", Constraint: "1.0.3", Locations: []types.Location{{StartLine: 1988, EndLine: 1991}}},
+		{Name: "address", Version: "1.1.0", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1993, EndLine: 1996}}},
+		{Name: "after", Version: "0.8.2", Constraint: "0.8.2", Locations: []types.Location{{StartLine: 1998, EndLine: 2001}}},
+		{Name: "agent-base", Version: "4.2.1", Constraint: "4, ^4.1.0, ~4.2.1", Locations: []types.Location{{StartLine: 2003, EndLine: 2008}}},
+		{Name: "agentkeepalive", Version: "3.5.2", Constraint: "^3.4.1", Locations: []types.Location{{StartLine: 2010, EndLine: 2015}}},
+		{Name: "airbnb-js-shims", Version: "2.2.0", Constraint: "^1 || ^2", Locations: []types.Location{{StartLine: 2017, EndLine: 2038}}},
+		{Name: "airbnb-prop-types", Version: "2.13.2", Constraint: "^2.13.2", Locations: []types.Location{{StartLine: 2040, EndLine: 2054}}},
+		{Name: "ajv", Version: "6.10.0", Constraint: "^6.0.1, ^6.1.0, ^6.5.5, ^6.9.1", Locations: []types.Location{{StartLine: 2066, EndLine: 2074}}},
+		{Name: "ajv-errors", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 2056, EndLine: 2059}}},
+		{Name: "ajv-keywords", Version: "3.4.0", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 2061, EndLine: 2064}}},
+		{Name: "ansi-align", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2076, EndLine: 2081}}},
+		{Name: "ansi-align", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 2083, EndLine: 2088}}},
+		{Name: "ansi-colors", Version: "3.2.4", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 2090, EndLine: 2093}}},
+		{Name: "ansi-escapes", Version: "1.4.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 2095, EndLine: 2098}}},
+		{Name: "ansi-escapes", Version: "3.2.0", Constraint: "^3.0.0, ^3.2.0", Locations: []types.Location{{StartLine: 2100, EndLine: 2103}}},
+		{Name: "ansi-html", Version: "0.0.7", Constraint: "0.0.7", Locations: []types.Location{{StartLine: 2105, EndLine: 2108}}},
+		{Name: "ansi-regex", Version: "2.1.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2110, EndLine: 2113}}},
+		{Name: "ansi-regex", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 2115, EndLine: 2118}}},
+		{Name: "ansi-regex", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 2120, EndLine: 2123}}},
+		{Name: "ansi-styles", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 2137, EndLine: 2140}}},
+		{Name: "ansi-styles", Version: "2.2.1", Constraint: "^2.2.1", Locations: []types.Location{{StartLine: 2125, EndLine: 2128}}},
+		{Name: "ansi-styles", Version: "3.2.1", Constraint: "^3.2.0, ^3.2.1", Locations: []types.Location{{StartLine: 2130, EndLine: 2135}}},
+		{Name: "ansicolors", Version: "0.3.2", Constraint: "~0.3.2", Locations: []types.Location{{StartLine: 2142, EndLine: 2145}}},
+		{Name: "ansistyles", Version: "0.1.3", Constraint: "~0.1.3", Locations: []types.Location{{StartLine: 2147, EndLine: 2150}}},
+		{Name: "any-observable", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 2152, EndLine: 2155}}},
+		{Name: "anymatch", Version: "1.3.2", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 2157, EndLine: 2163}}},
+		{Name: "anymatch", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2165, EndLine: 2171}}},
+		{Name: "app-root-dir", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 2173, EndLine: 2176}}},
+		{Name: "append-transform", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 2178, EndLine: 2183}}},
+		{Name: "aproba", Version: "1.2.0", Constraint: "^1.0.3, ^1.1.1, ^1.1.2", Locations: []types.Location{{StartLine: 2185, EndLine: 2188}}},
+		{Name: "aproba", Version: "2.0.0", Constraint: "^1.1.2 || 2, ^2.0.0", Locations: []types.Location{{StartLine: 2190, EndLine: 2193}}},
+		{Name: "archy", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 2195, EndLine: 2198}}},
+		{Name: "are-we-there-yet", Version: "1.1.5", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 2200, EndLine: 2206}}},
+		{Name: "argparse", Version: "1.0.10", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 2208, EndLine: 2213}}},
+		{Name: "aria-query", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 2215, EndLine: 2221}}},
+		{Name: "arr-diff", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2223, EndLine: 2228}}},
+		{Name: "arr-diff", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 2230, EndLine: 2233}}},
+		{Name: "arr-flatten", Version: "1.1.0", Constraint: "^1.0.1, ^1.1.0", Locations: []types.Location{{StartLine: 2235, EndLine: 2238}}},
+		{Name: "arr-union", Version: "3.1.0", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 2240, EndLine: 2243}}},
+		{Name: "array-equal", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 2245, EndLine: 2248}}},
+		{Name: "array-filter", Version: "0.0.1", Constraint: "~0.0.0", Locations: []types.Location{{StartLine: 2255, EndLine: 2258}}},
+		{Name: "array-filter", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 2250, EndLine: 2253}}},
+		{Name: "array-flatten", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 2260, EndLine: 2263}}},
+		{Name: "array-flatten", Version: "2.1.2", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 2265, EndLine: 2268}}},
+		{Name: "array-includes", Version: "3.0.3", Constraint: "^3.0.3", Locations: []types.Location{{StartLine: 2270, EndLine: 2276}}},
+		{Name: "array-map", Version: "0.0.0", Constraint: "~0.0.0", Locations: []types.Location{{StartLine: 2278, EndLine: 2281}}},
+		{Name: "array-reduce", Version: "0.0.0", Constraint: "~0.0.0", Locations: []types.Location{{StartLine: 2283, EndLine: 2286}}},
+		{Name: "array-union", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2288, EndLine: 2293}}},
+		{Name: "array-uniq", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2295, EndLine: 2298}}},
+		{Name: "array-unique", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 2300, EndLine: 2303}}},
+		{Name: "array-unique", Version: "0.3.2", Constraint: "^0.3.2", Locations: []types.Location{{StartLine: 2305, EndLine: 2308}}},
+		{Name: "array.prototype.find", Version: "2.0.4", Constraint: "^2.0.4", Locations: []types.Location{{StartLine: 2310, EndLine: 2316}}},
+		{Name: "array.prototype.flat", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 2318, EndLine: 2325}}},
+		{Name: "array.prototype.flatmap", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 2327, EndLine: 2334}}},
+		{Name: "arraybuffer.slice", Version: "0.0.7", Constraint: "~0.0.7", Locations: []types.Location{{StartLine: 2336, EndLine: 2339}}},
+		{Name: "arrify", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2341, EndLine: 2344}}},
+		{Name: "asap", Version: "2.0.6", Constraint: "^2.0.0, ~2.0.3", Locations: []types.Location{{StartLine: 2346, EndLine: 2349}}},
+		{Name: "asn1", Version: "0.2.4", Constraint: "~0.2.3", Locations: []types.Location{{StartLine: 2360, EndLine: 2365}}},
+		{Name: "asn1.js", Version: "4.10.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 2351, EndLine: 2358}}},
+		{Name: "assert", Version: "1.5.0", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 2372, EndLine: 2378}}},
+		{Name: "assert-plus", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0", Locations: []types.Location{{StartLine: 2367, EndLine: 2370}}},
+		{Name: "assign-symbols", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 2380, EndLine: 2383}}},
+		{Name: "ast-types", Version: "0.11.3", Constraint: "0.11.3", Locations: []types.Location{{StartLine: 2390, EndLine: 2393}}},
+		{Name: "ast-types", Version: "0.11.5", Constraint: "0.11.5", Locations: []types.Location{{StartLine: 2395, EndLine: 2398}}},
+		{Name: "ast-types", Version: "0.11.7", Constraint: "0.11.7", Locations: []types.Location{{StartLine: 2400, EndLine: 2403}}},
+		{Name: "ast-types-flow", Version: "0.0.7", Constraint: "0.0.7, ^0.0.7", Locations: []types.Location{{StartLine: 2385, EndLine: 2388}}},
+		{Name: "astral-regex", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 2405, EndLine: 2408}}},
+		{Name: "async", Version: "1.5.2", Constraint: "^1.5.2", Locations: []types.Location{{StartLine: 2420, EndLine: 2423}}},
+		{Name: "async", Version: "2.6.2", Constraint: "^2.1.4, ^2.4.1", Locations: []types.Location{{StartLine: 2425, EndLine: 2430}}},
+		{Name: "async-each", Version: "1.0.3", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 2410, EndLine: 2413}}},
+		{Name: "async-limiter", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 2415, EndLine: 2418}}},
+		{Name: "asynckit", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 2432, EndLine: 2435}}},
+		{Name: "atob", Version: "2.1.2", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 2437, EndLine: 2440}}},
+		{Name: "attr-accept", Version: "1.1.3", Constraint: "^1.1.3", Locations: []types.Location{{StartLine: 2442, EndLine: 2447}}},
+		{Name: "autodll-webpack-plugin", Version: "0.4.2", Constraint: "^0.4.2", Locations: []types.Location{{StartLine: 2449, EndLine: 2463}}},
+		{Name: "autoprefixer", Version: "8.6.5", Constraint: "^8.6.0", Locations: []types.Location{{StartLine: 2465, EndLine: 2475}}},
+		{Name: "autoprefixer", Version: "9.5.1", Constraint: "^9.3.1", Locations: []types.Location{{StartLine: 2477, EndLine: 2487}}},
+		{Name: "aws-sign2", Version: "0.7.0", Constraint: "~0.7.0", Locations: []types.Location{{StartLine: 2489, EndLine: 2492}}},
+		{Name: "aws4", Version: "1.8.0", Constraint: "^1.8.0", Locations: []types.Location{{StartLine: 2494, EndLine: 2497}}},
+		{Name: "axios", Version: "0.18.0", Constraint: "^0.18.0", Locations: []types.Location{{StartLine: 2499, EndLine: 2505}}},
+		{Name: "axobject-query", Version: "2.0.2", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 2507, EndLine: 2512}}},
+		{Name: "babel-cli", Version: "6.26.0", Constraint: "^6.26.0", Locations: []types.Location{{StartLine: 2514, EndLine: 2534}}},
+		{Name: "babel-code-frame", Version: "6.26.0", Constraint: "^6.26.0", Locations: []types.Location{{StartLine: 2536, EndLine: 2543}}},
+		{Name: "babel-core", Version: "6.26.3", Constraint: "^6.0.0, ^6.26.0, ^6.26.3", Locations: []types.Location{{StartLine: 2545, EndLine: 2568}}},
+		{Name: "babel-eslint", Version: "8.2.6", Constraint: "^8.2.3", Locations: []types.Location{{StartLine: 2570, EndLine: 2580}}},
+		{Name: "babel-generator", Version: "6.26.1", Constraint: "^6.18.0, ^6.26.0", Locations: []types.Location{{StartLine: 2582, EndLine: 2594}}},
+		{Name: "babel-helper-bindify-decorators", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2596, EndLine: 2603}}},
+		{Name: "babel-helper-builder-binary-assignment-operator-visitor", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2605, EndLine: 2612}}},
+		{Name: "babel-helper-builder-react-jsx", Version: "6.26.0", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2614, EndLine: 2621}}},
+		{Name: "babel-helper-call-delegate", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2623, EndLine: 2631}}},
+		{Name: "babel-helper-define-map", Version: "6.26.0", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2633, EndLine: 2641}}},
+		{Name: "babel-helper-evaluate-path", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2643, EndLine: 2646}}},
+		{Name: "babel-helper-explode-assignable-expression", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2648, EndLine: 2655}}},
+		{Name: "babel-helper-explode-class", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2657, EndLine: 2665}}},
+		{Name: "babel-helper-flip-expressions", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2667, EndLine: 2670}}},
+		{Name: "babel-helper-function-name", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2672, EndLine: 2681}}},
+		{Name: "babel-helper-get-function-arity", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2683, EndLine: 2689}}},
+		{Name: "babel-helper-hoist-variables", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2691, EndLine: 2697}}},
+		{Name: "babel-helper-is-nodes-equiv", Version: "0.0.1", Constraint: "^0.0.1", Locations: []types.Location{{StartLine: 2699, EndLine: 2702}}},
+		{Name: "babel-helper-is-void-0", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2704, EndLine: 2707}}},
+		{Name: "babel-helper-mark-eval-scopes", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2709, EndLine: 2712}}},
+		{Name: "babel-helper-optimise-call-expression", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2714, EndLine: 2720}}},
+		{Name: "babel-helper-regex", Version: "6.26.0", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2722, EndLine: 2729}}},
+		{Name: "babel-helper-remap-async-to-generator", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2731, EndLine: 2740}}},
+		{Name: "babel-helper-remove-or-void", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2742, EndLine: 2745}}},
+		{Name: "babel-helper-replace-supers", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2747, EndLine: 2757}}},
+		{Name: "babel-helper-to-multiple-sequence-expressions", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2759, EndLine: 2762}}},
+		{Name: "babel-helpers", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 2764, EndLine: 2770}}},
+		{Name: "babel-jest", Version: "23.6.0", Constraint: "^23.0.1, ^23.6.0", Locations: []types.Location{{StartLine: 2772, EndLine: 2778}}},
+		{Name: "babel-loader", Version: "7.1.5", Constraint: "^7.1.4", Locations: []types.Location{{StartLine: 2790, EndLine: 2797}}},
+		{Name: "babel-loader", Version: "8.0.4", Constraint: "8.0.4", Locations: []types.Location{{StartLine: 2780, EndLine: 2788}}},
+		{Name: "babel-messages", Version: "6.23.0", Constraint: "^6.23.0", Locations: []types.Location{{StartLine: 2799, EndLine: 2804}}},
+		{Name: "babel-plugin-check-es2015-constants", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 2806, EndLine: 2811}}},
+		{Name: "babel-plugin-dynamic-import-node", Version: "2.2.0", Constraint: "2.2.0", Locations: []types.Location{{StartLine: 2813, EndLine: 2818}}},
+		{Name: "babel-plugin-istanbul", Version: "4.1.6", Constraint: "^4.1.6", Locations: []types.Location{{StartLine: 2820, EndLine: 2828}}},
+		{Name: "babel-plugin-jest-hoist", Version: "23.2.0", Constraint: "^23.2.0", Locations: []types.Location{{StartLine: 2830, EndLine: 2833}}},
+		{Name: "babel-plugin-macros", Version: "2.4.2", Constraint: "2.4.2", Locations: []types.Location{{StartLine: 2835, EndLine: 2841}}},
+		{Name: "babel-plugin-macros", Version: "2.5.1", Constraint: "^2.4.2", Locations: []types.Location{{StartLine: 2843, EndLine: 2850}}},
+		{Name: "babel-plugin-minify-builtins", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2852, EndLine: 2855}}},
+		{Name: "babel-plugin-minify-constant-folding", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2857, EndLine: 2862}}},
+		{Name: "babel-plugin-minify-dead-code-elimination", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2864, EndLine: 2872}}},
+		{Name: "babel-plugin-minify-flip-comparisons", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2874, EndLine: 2879}}},
+		{Name: "babel-plugin-minify-guarded-expressions", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2881, EndLine: 2886}}},
+		{Name: "babel-plugin-minify-infinity", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2888, EndLine: 2891}}},
+		{Name: "babel-plugin-minify-mangle-names", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2893, EndLine: 2898}}},
+		{Name: "babel-plugin-minify-numeric-literals", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2900, EndLine: 2903}}},
+		{Name: "babel-plugin-minify-replace", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2905, EndLine: 2908}}},
+		{Name: "babel-plugin-minify-simplify", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 2910, EndLine: 2917}}},
+		{Name: "babel-plugin-minify-type-constructors", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 2919, EndLine: 2924}}},
+		{Name: "babel-plugin-named-asset-import", Version: "0.2.3", Constraint: "^0.2.3", Locations: []types.Location{{StartLine: 2926, EndLine: 2929}}},
+		{Name: "babel-plugin-react-docgen", Version: "2.0.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2931, EndLine: 2938}}},
+		{Name: "babel-plugin-react-html-attrs", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 2940, EndLine: 2943}}},
+		{Name: "babel-plugin-styled-components", Version: "1.10.0", Constraint: ">= 1", Locations: []types.Location{{StartLine: 2945, EndLine: 2953}}},
+		{Name: "babel-plugin-syntax-async-functions", Version: "6.13.0", Constraint: "^6.8.0", Locations: []types.Location{{StartLine: 2955, EndLine: 2958}}},
+		{Name: "babel-plugin-syntax-async-generators", Version: "6.13.0", Constraint: "^6.5.0", Locations: []types.Location{{StartLine: 2960, EndLine: 2963}}},
+		{Name: "babel-plugin-syntax-class-constructor-call", Version: "6.18.0", Constraint: "^6.18.0", Locations: []types.Location{{StartLine: 2965, EndLine: 2968}}},
+		{Name: "babel-plugin-syntax-class-properties", Version: "6.13.0", Constraint: "^6.8.0", Locations: []types.Location{{StartLine: 2970, EndLine: 2973}}},
+		{Name: "babel-plugin-syntax-decorators", Version: "6.13.0", Constraint: "^6.13.0", Locations: []types.Location{{StartLine: 2975, EndLine: 2978}}},
+		{Name: "babel-plugin-syntax-dynamic-import", Version: "6.18.0", Constraint: "^6.18.0", Locations: []types.Location{{StartLine: 2980, EndLine: 2983}}},
+		{Name: "babel-plugin-syntax-exponentiation-operator", Version: "6.13.0", Constraint: "^6.8.0", Locations: []types.Location{{StartLine: 2985, EndLine: 2988}}},
+		{Name: "babel-plugin-syntax-export-extensions", Version: "6.13.0", Constraint: "^6.8.0", Locations: []types.Location{{StartLine: 2990, EndLine: 2993}}},
+		{Name: "babel-plugin-syntax-flow", Version: "6.18.0", Constraint: "^6.18.0", Locations: []types.Location{{StartLine: 2995, EndLine: 2998}}},
+		{Name: "babel-plugin-syntax-jsx", Version: "6.18.0", Constraint: "^6.18.0, ^6.3.13, ^6.8.0", Locations: []types.Location{{StartLine: 3000, EndLine: 3003}}},
+		{Name: "babel-plugin-syntax-object-rest-spread", Version: "6.13.0", Constraint: "^6.13.0, ^6.8.0", Locations: []types.Location{{StartLine: 3005, EndLine: 3008}}},
+		{Name: "babel-plugin-syntax-trailing-function-commas", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3010, EndLine: 3013}}},
+		{Name: "babel-plugin-transform-async-generator-functions", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3015, EndLine: 3022}}},
+		{Name: "babel-plugin-transform-async-to-generator", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3024, EndLine: 3031}}},
+		{Name: "babel-plugin-transform-class-constructor-call", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3033, EndLine: 3040}}},
+		{Name: "babel-plugin-transform-class-properties", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3042, EndLine: 3050}}},
+		{Name: "babel-plugin-transform-decorators", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3052, EndLine: 3061}}},
+		{Name: "babel-plugin-transform-es2015-arrow-functions", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3063, EndLine: 3068}}},
+		{Name: "babel-plugin-transform-es2015-block-scoped-functions", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3070, EndLine: 3075}}},
+		{Name: "babel-plugin-transform-es2015-block-scoping", Version: "6.26.0", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3077, EndLine: 3086}}},
+		{Name: "babel-plugin-transform-es2015-classes", Version: "6.24.1", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3088, EndLine: 3101}}},
+		{Name: "babel-plugin-transform-es2015-computed-properties", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3103, EndLine: 3109}}},
+		{Name: "babel-plugin-transform-es2015-destructuring", Version: "6.23.0", Constraint: "^6.22.0, ^6.23.0", Locations: []types.Location{{StartLine: 3111, EndLine: 3116}}},
+		{Name: "babel-plugin-transform-es2015-duplicate-keys", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3118, EndLine: 3124}}},
+		{Name: "babel-plugin-transform-es2015-for-of", Version: "6.23.0", Constraint: "^6.22.0, ^6.23.0", Locations: []types.Location{{StartLine: 3126, EndLine: 3131}}},
+		{Name: "babel-plugin-transform-es2015-function-name", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3133, EndLine: 3140}}},
+		{Name: "babel-plugin-transform-es2015-literals", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3142, EndLine: 3147}}},
+		{Name: "babel-plugin-transform-es2015-modules-amd", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3149, EndLine: 3156}}},
+		{Name: "babel-plugin-transform-es2015-modules-commonjs", Version: "6.26.2", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3158, EndLine: 3166}}},
+		{Name: "babel-plugin-transform-es2015-modules-systemjs", Version: "6.24.1", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3168, EndLine: 3175}}},
+		{Name: "babel-plugin-transform-es2015-modules-umd", Version: "6.24.1", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3177, EndLine: 3184}}},
+		{Name: "babel-plugin-transform-es2015-object-super", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3186, EndLine: 3192}}},
+		{Name: "babel-plugin-transform-es2015-parameters", Version: "6.24.1", Constraint: "^6.23.0, ^6.24.1", Locations: []types.Location{{StartLine: 3194, EndLine: 3204}}},
+		{Name: "babel-plugin-transform-es2015-shorthand-properties", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3206, EndLine: 3212}}},
+		{Name: "babel-plugin-transform-es2015-spread", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3214, EndLine: 3219}}},
+		{Name: "babel-plugin-transform-es2015-sticky-regex", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3221, EndLine: 3228}}},
+		{Name: "babel-plugin-transform-es2015-template-literals", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3230, EndLine: 3235}}},
+		{Name: "babel-plugin-transform-es2015-typeof-symbol", Version: "6.23.0", Constraint: "^6.22.0, ^6.23.0", Locations: []types.Location{{StartLine: 3237, EndLine: 3242}}},
+		{Name: "babel-plugin-transform-es2015-unicode-regex", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3244, EndLine: 3251}}},
+		{Name: "babel-plugin-transform-exponentiation-operator", Version: "6.24.1", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3253, EndLine: 3260}}},
+		{Name: "babel-plugin-transform-export-extensions", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3262, EndLine: 3268}}},
+		{Name: "babel-plugin-transform-flow-strip-types", Version: "6.22.0", Constraint: "^6.22.0, ^6.8.0", Locations: []types.Location{{StartLine: 3270, EndLine: 3276}}},
+		{Name: "babel-plugin-transform-inline-consecutive-adds", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 3278, EndLine: 3281}}},
+		{Name: "babel-plugin-transform-member-expression-literals", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3283, EndLine: 3286}}},
+		{Name: "babel-plugin-transform-merge-sibling-variables", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3288, EndLine: 3291}}},
+		{Name: "babel-plugin-transform-minify-booleans", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3293, EndLine: 3296}}},
+		{Name: "babel-plugin-transform-object-rest-spread", Version: "6.26.0", Constraint: "^6.22.0, ^6.26.0", Locations: []types.Location{{StartLine: 3298, EndLine: 3304}}},
+		{Name: "babel-plugin-transform-property-literals", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3306, EndLine: 3311}}},
+		{Name: "babel-plugin-transform-react-display-name", Version: "6.25.0", Constraint: "^6.23.0", Locations: []types.Location{{StartLine: 3313, EndLine: 3318}}},
+		{Name: "babel-plugin-transform-react-jsx", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3336, EndLine: 3343}}},
+		{Name: "babel-plugin-transform-react-jsx-self", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3320, EndLine: 3326}}},
+		{Name: "babel-plugin-transform-react-jsx-source", Version: "6.22.0", Constraint: "^6.22.0", Locations: []types.Location{{StartLine: 3328, EndLine: 3334}}},
+		{Name: "babel-plugin-transform-react-remove-prop-types", Version: "0.4.18", Constraint: "0.4.18", Locations: []types.Location{{StartLine: 3345, EndLine: 3348}}},
+		{Name: "babel-plugin-transform-regenerator", Version: "6.26.0", Constraint: "^6.22.0, ^6.24.1", Locations: []types.Location{{StartLine: 3350, EndLine: 3355}}},
+		{Name: "babel-plugin-transform-regexp-constructors", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 3357, EndLine: 3360}}},
+		{Name: "babel-plugin-transform-remove-console", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3362, EndLine: 3365}}},
+		{Name: "babel-plugin-transform-remove-debugger", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3367, EndLine: 3370}}},
+		{Name: "babel-plugin-transform-remove-undefined", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 3372, EndLine: 3377}}},
+		{Name: "babel-plugin-transform-runtime", Version: "6.23.0", Constraint: "^6.23.0", Locations: []types.Location{{StartLine: 3379, EndLine: 3384}}},
+		{Name: "babel-plugin-transform-simplify-comparison-operators", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3386, EndLine: 3389}}},
+		{Name: "babel-plugin-transform-strict-mode", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3391, EndLine: 3397}}},
+		{Name: "babel-plugin-transform-undefined-to-void", Version: "6.9.4", Constraint: "^6.9.4", Locations: []types.Location{{StartLine: 3399, EndLine: 3402}}},
+		{Name: "babel-polyfill", Version: "6.26.0", Constraint: "^6.26.0", Locations: []types.Location{{StartLine: 3404, EndLine: 3411}}},
+		{Name: "babel-preset-env", Version: "1.7.0", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 3413, EndLine: 3447}}},
+		{Name: "babel-preset-es2015", Version: "6.24.1", Constraint: "^6.9.0", Locations: []types.Location{{StartLine: 3449, EndLine: 3477}}},
+		{Name: "babel-preset-flow", Version: "6.23.0", Constraint: "^6.23.0", Locations: []types.Location{{StartLine: 3479, EndLine: 3484}}},
+		{Name: "babel-preset-jest", Version: "23.2.0", Constraint: "^23.2.0", Locations: []types.Location{{StartLine: 3486, EndLine: 3492}}},
+		{Name: "babel-preset-minify", Version: "0.5.0", Constraint: "^0.5.0 || 0.6.0-alpha.5", Locations: []types.Location{{StartLine: 3494, EndLine: 3521}}},
+		{Name: "babel-preset-react", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3548, EndLine: 3558}}},
+		{Name: "babel-preset-react-app", Version: "6.1.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 3523, EndLine: 3546}}},
+		{Name: "babel-preset-stage-1", Version: "6.24.1", Constraint: "^6.5.0", Locations: []types.Location{{StartLine: 3560, EndLine: 3567}}},
+		{Name: "babel-preset-stage-2", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3569, EndLine: 3577}}},
+		{Name: "babel-preset-stage-3", Version: "6.24.1", Constraint: "^6.24.1", Locations: []types.Location{{StartLine: 3579, EndLine: 3588}}},
+		{Name: "babel-register", Version: "6.26.0", Constraint: "^6.26.0, ^6.9.0", Locations: []types.Location{{StartLine: 3590, EndLine: 3601}}},
+		{Name: "babel-runtime", Version: "6.26.0", Constraint: "6.x.x, ^6.11.6, ^6.18.0, ^6.22.0, ^6.23.0, ^6.26.0, ^6.5.0", Locations: []types.Location{{StartLine: 3603, EndLine: 3609}}},
+		{Name: "babel-standalone", Version: "6.26.0", Constraint: "^6.26.0", Locations: []types.Location{{StartLine: 3611, EndLine: 3614}}},
+		{Name: "babel-template", Version: "6.26.0", Constraint: "^6.16.0, ^6.24.1, ^6.26.0", Locations: []types.Location{{StartLine: 3616, EndLine: 3625}}},
+		{Name: "babel-traverse", Version: "6.26.0", Constraint: "^6.0.0, ^6.18.0, ^6.24.1, ^6.26.0", Locations: []types.Location{{StartLine: 3627, EndLine: 3640}}},
+		{Name: "babel-types", Version: "6.26.0", Constraint: "^6.0.0, ^6.18.0, ^6.19.0, ^6.24.1, ^6.26.0", Locations: []types.Location{{StartLine: 3642, EndLine: 3650}}},
+		{Name: "babylon", Version: "6.18.0", Constraint: "^6.18.0", Locations: []types.Location{{StartLine: 3657, EndLine: 3660}}},
+		{Name: "babylon", Version: "7.0.0-beta.44", Constraint: "7.0.0-beta.44", Locations: []types.Location{{StartLine: 3652, EndLine: 3655}}},
+		{Name: "babylon", Version: "7.0.0-beta.47", Constraint: "^7.0.0-beta.47", Locations: []types.Location{{StartLine: 3662, EndLine: 3665}}},
+		{Name: "backo2", Version: "1.0.2", Constraint: "1.0.2", Locations: []types.Location{{StartLine: 3667, EndLine: 3670}}},
+		{Name: "bail", Version: "1.0.4", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3672, EndLine: 3675}}},
+		{Name: "balanced-match", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3677, EndLine: 3680}}},
+		{Name: "base", Version: "0.11.2", Constraint: "^0.11.1", Locations: []types.Location{{StartLine: 3697, EndLine: 3708}}},
+		{Name: "base64-arraybuffer", Version: "0.1.5", Constraint: "0.1.5", Locations: []types.Location{{StartLine: 3682, EndLine: 3685}}},
+		{Name: "base64-js", Version: "1.3.0", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 3687, EndLine: 3690}}},
+		{Name: "base64id", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 3692, EndLine: 3695}}},
+		{Name: "batch", Version: "0.6.1", Constraint: "0.6.1", Locations: []types.Location{{StartLine: 3710, EndLine: 3713}}},
+		{Name: "bcrypt-pbkdf", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3715, EndLine: 3720}}},
+		{Name: "before-after-hook", Version: "1.4.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 3722, EndLine: 3725}}},
+		{Name: "better-assert", Version: "1.0.2", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 3727, EndLine: 3732}}},
+		{Name: "bfj", Version: "6.1.1", Constraint: "^6.1.1", Locations: []types.Location{{StartLine: 3734, EndLine: 3742}}},
+		{Name: "big-integer", Version: "1.6.43", Constraint: "^1.6.17", Locations: []types.Location{{StartLine: 3744, EndLine: 3747}}},
+		{Name: "big.js", Version: "3.2.0", Constraint: "^3.1.3", Locations: []types.Location{{StartLine: 3749, EndLine: 3752}}},
+		{Name: "big.js", Version: "5.2.2", Constraint: "^5.2.2", Locations: []types.Location{{StartLine: 3754, EndLine: 3757}}},
+		{Name: "bin-links", Version: "1.1.2", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 3759, EndLine: 3768}}},
+		{Name: "binary", Version: "0.3.0", Constraint: "~0.3.0", Locations: []types.Location{{StartLine: 3775, EndLine: 3781}}},
+		{Name: "binary-extensions", Version: "1.13.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3770, EndLine: 3773}}},
+		{Name: "blob", Version: "0.0.5", Constraint: "0.0.5", Locations: []types.Location{{StartLine: 3783, EndLine: 3786}}},
+		{Name: "block-stream", Version: "0.0.9", Constraint: "*", Locations: []types.Location{{StartLine: 3788, EndLine: 3793}}},
+		{Name: "bluebird", Version: "3.4.7", Constraint: "~3.4.1", Locations: []types.Location{{StartLine: 3800, EndLine: 3803}}},
+		{Name: "bluebird", Version: "3.5.4", Constraint: "^3.3.5, ^3.5.0, ^3.5.1, ^3.5.3", Locations: []types.Location{{StartLine: 3795, EndLine: 3798}}},
+		{Name: "bn.js", Version: "4.11.8", Constraint: "^4.0.0, ^4.1.0, ^4.1.1, ^4.4.0", Locations: []types.Location{{StartLine: 3805, EndLine: 3808}}},
+		{Name: "body-parser", Version: "1.18.3", Constraint: "1.18.3", Locations: []types.Location{{StartLine: 3810, EndLine: 3824}}},
+		{Name: "bonjour", Version: "3.5.0", Constraint: "^3.5.0", Locations: []types.Location{{StartLine: 3826, EndLine: 3836}}},
+		{Name: "boolbase", Version: "1.0.0", Constraint: "^1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 3838, EndLine: 3841}}},
+		{Name: "boxen", Version: "1.3.0", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 3843, EndLine: 3854}}},
+		{Name: "boxen", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 3856, EndLine: 3867}}},
+		{Name: "brace-expansion", Version: "1.1.11", Constraint: "^1.1.7", Locations: []types.Location{{StartLine: 3869, EndLine: 3875}}},
+		{Name: "braces", Version: "1.8.5", Constraint: "^1.8.2", Locations: []types.Location{{StartLine: 3877, EndLine: 3884}}},
+		{Name: "braces", Version: "2.3.2", Constraint: "^2.3.1, ^2.3.2", Locations: []types.Location{{StartLine: 3886, EndLine: 3900}}},
+		{Name: "brcast", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 3902, EndLine: 3905}}},
+		{Name: "brorand", Version: "1.1.0", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 3907, EndLine: 3910}}},
+		{Name: "browser-process-hrtime", Version: "0.1.3", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 3912, EndLine: 3915}}},
+		{Name: "browser-resolve", Version: "1.11.3", Constraint: "^1.11.3", Locations: []types.Location{{StartLine: 3917, EndLine: 3922}}},
+		{Name: "browserify-aes", Version: "1.2.0", Constraint: "^1.0.0, ^1.0.4", Locations: []types.Location{{StartLine: 3924, EndLine: 3934}}},
+		{Name: "browserify-cipher", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3936, EndLine: 3943}}},
+		{Name: "browserify-des", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 3945, EndLine: 3953}}},
+		{Name: "browserify-rsa", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 3955, EndLine: 3961}}},
+		{Name: "browserify-sign", Version: "4.0.4", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 3963, EndLine: 3974}}},
+		{Name: "browserify-zlib", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 3976, EndLine: 3981}}},
+		{Name: "browserslist", Version: "3.2.8", Constraint: "^3.2.6, ^3.2.8", Locations: []types.Location{{StartLine: 3992, EndLine: 3998}}},
+		{Name: "browserslist", Version: "4.1.1", Constraint: "4.1.1", Locations: []types.Location{{StartLine: 3983, EndLine: 3990}}},
+		{Name: "browserslist", Version: "4.6.0", Constraint: "^4.1.0, ^4.5.2, ^4.5.4", Locations: []types.Location{{StartLine: 4000, EndLine: 4007}}},
+		{Name: "bser", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4009, EndLine: 4014}}},
+		{Name: "btoa-lite", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4016, EndLine: 4019}}},
+		{Name: "buffer", Version: "4.9.1", Constraint: "^4.3.0", Locations: []types.Location{{StartLine: 4046, EndLine: 4053}}},
+		{Name: "buffer-from", Version: "1.1.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4021, EndLine: 4024}}},
+		{Name: "buffer-indexof", Version: "1.1.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4031, EndLine: 4034}}},
+		{Name: "buffer-indexof-polyfill", Version: "1.0.1", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 4026, EndLine: 4029}}},
+		{Name: "buffer-shims", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4036, EndLine: 4039}}},
+		{Name: "buffer-xor", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 4041, EndLine: 4044}}},
+		{Name: "buffers", Version: "0.1.1", Constraint: "~0.1.1", Locations: []types.Location{{StartLine: 4055, EndLine: 4058}}},
+		{Name: "builtin-status-codes", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4060, EndLine: 4063}}},
+		{Name: "builtins", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 4065, EndLine: 4068}}},
+		{Name: "byline", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 4070, EndLine: 4073}}},
+		{Name: "byte-size", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 4075, EndLine: 4078}}},
+		{Name: "bytes", Version: "3.0.0", Constraint: "3.0.0", Locations: []types.Location{{StartLine: 4080, EndLine: 4083}}},
+		{Name: "cacache", Version: "10.0.4", Constraint: "^10.0.4", Locations: []types.Location{{StartLine: 4085, EndLine: 4102}}},
+		{Name: "cacache", Version: "11.3.2", Constraint: "^11.0.1, ^11.3.2", Locations: []types.Location{{StartLine: 4104, EndLine: 4122}}},
+		{Name: "cache-base", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4124, EndLine: 4137}}},
+		{Name: "cache-loader", Version: "1.2.5", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 4139, EndLine: 4147}}},
+		{Name: "call-limit", Version: "1.1.0", Constraint: "~1.1.0", Locations: []types.Location{{StartLine: 4149, EndLine: 4152}}},
+		{Name: "call-me-maybe", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4154, EndLine: 4157}}},
+		{Name: "caller-callsite", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4159, EndLine: 4164}}},
+		{Name: "caller-path", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4166, EndLine: 4171}}},
+		{Name: "callsite", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 4173, EndLine: 4176}}},
+		{Name: "callsites", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4178, EndLine: 4181}}},
+		{Name: "callsites", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4183, EndLine: 4186}}},
+		{Name: "camel-case", Version: "3.0.0", Constraint: "3.0.x", Locations: []types.Location{{StartLine: 4188, EndLine: 4194}}},
+		{Name: "camelcase", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4196, EndLine: 4199}}},
+		{Name: "camelcase", Version: "4.1.0", Constraint: "^4.0.0, ^4.1.0", Locations: []types.Location{{StartLine: 4201, EndLine: 4204}}},
+		{Name: "camelcase", Version: "5.3.1", Constraint: "^5.0.0, ^5.3.1", Locations: []types.Location{{StartLine: 4206, EndLine: 4209}}},
+		{Name: "camelize", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4211, EndLine: 4214}}},
+		{Name: "caniuse-lite", Version: "1.0.30000967", Constraint: "^1.0.30000844, ^1.0.30000864, ^1.0.30000884, ^1.0.30000957, ^1.0.30000967", Locations: []types.Location{{StartLine: 4216, EndLine: 4219}}},
+		{Name: "capture-exit", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 4221, EndLine: 4226}}},
+		{Name: "capture-stack-trace", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4228, EndLine: 4231}}},
+		{Name: "case-sensitive-paths-webpack-plugin", Version: "2.2.0", Constraint: "^2.1.2", Locations: []types.Location{{StartLine: 4233, EndLine: 4236}}},
+		{Name: "caseless", Version: "0.12.0", Constraint: "~0.12.0", Locations: []types.Location{{StartLine: 4238, EndLine: 4241}}},
+		{Name: "ccount", Version: "1.0.4", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 4243, EndLine: 4246}}},
+		{Name: "chainsaw", Version: "0.1.0", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 4248, EndLine: 4253}}},
+		{Name: "chalk", Version: "0.4.0", Constraint: "~0.4.0", Locations: []types.Location{{StartLine: 4284, EndLine: 4291}}},
+		{Name: "chalk", Version: "1.1.3", Constraint: "^1.0.0, ^1.1.3", Locations: []types.Location{{StartLine: 4264, EndLine: 4273}}},
+		{Name: "chalk", Version: "2.4.1", Constraint: "2.4.1", Locations: []types.Location{{StartLine: 4255, EndLine: 4262}}},
+		{Name: "chalk", Version: "2.4.2", Constraint: "^2.0.0, ^2.0.1, ^2.1.0, ^2.3.1, ^2.4.1, ^2.4.2", Locations: []types.Location{{StartLine: 4275, EndLine: 4282}}},
+		{Name: "change-emitter", Version: "0.1.6", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 4293, EndLine: 4296}}},
+		{Name: "chardet", Version: "0.7.0", Constraint: "^0.7.0", Locations: []types.Location{{StartLine: 4298, EndLine: 4301}}},
+		{Name: "charenc", Version: "0.0.2", Constraint: "~0.0.1", Locations: []types.Location{{StartLine: 4303, EndLine: 4306}}},
+		{Name: "check-types", Version: "7.4.0", Constraint: "^7.3.0", Locations: []types.Location{{StartLine: 4308, EndLine: 4311}}},
+		{Name: "cheerio", Version: "1.0.0-rc.3", Constraint: "^1.0.0-rc.2", Locations: []types.Location{{StartLine: 4313, EndLine: 4323}}},
+		{Name: "child-process-promise", Version: "2.2.1", Constraint: "^2.2.1", Locations: []types.Location{{StartLine: 4325, EndLine: 4332}}},
+		{Name: "chokidar", Version: "1.7.0", Constraint: "^1.6.1", Locations: []types.Location{{StartLine: 4334, EndLine: 4348}}},
+		{Name: "chokidar", Version: "2.1.5", Constraint: "^2.0.2, ^2.1.5", Locations: []types.Location{{StartLine: 4350, EndLine: 4367}}},
+		{Name: "chownr", Version: "1.1.1", Constraint: "^1.0.1, ^1.1.1", Locations: []types.Location{{StartLine: 4369, EndLine: 4372}}},
+		{Name: "chrome-trace-event", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4374, EndLine: 4379}}},
+		{Name: "ci-info", Version: "1.6.0", Constraint: "^1.5.0", Locations: []types.Location{{StartLine: 4381, EndLine: 4384}}},
+		{Name: "ci-info", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4386, EndLine: 4389}}},
+		{Name: "cidr-regex", Version: "2.0.10", Constraint: "^2.0.10", Locations: []types.Location{{StartLine: 4391, EndLine: 4396}}},
+		{Name: "cipher-base", Version: "1.0.4", Constraint: "^1.0.0, ^1.0.1, ^1.0.3", Locations: []types.Location{{StartLine: 4398, EndLine: 4404}}},
+		{Name: "class-utils", Version: "0.3.6", Constraint: "^0.3.5", Locations: []types.Location{{StartLine: 4406, EndLine: 4414}}},
+		{Name: "classnames", Version: "2.2.6", Constraint: "^2.2.5", Locations: []types.Location{{StartLine: 4416, EndLine: 4419}}},
+		{Name: "clean-css", Version: "4.2.1", Constraint: "4.2.x", Locations: []types.Location{{StartLine: 4421, EndLine: 4426}}},
+		{Name: "clean-webpack-plugin", Version: "0.1.19", Constraint: "^0.1.19", Locations: []types.Location{{StartLine: 4428, EndLine: 4433}}},
+		{Name: "cli-boxes", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4435, EndLine: 4438}}},
+		{Name: "cli-columns", Version: "3.1.2", Constraint: "^3.1.2", Locations: []types.Location{{StartLine: 4440, EndLine: 4446}}},
+		{Name: "cli-cursor", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4448, EndLine: 4453}}},
+		{Name: "cli-cursor", Version: "2.1.0", Constraint: "^2.0.0, ^2.1.0", Locations: []types.Location{{StartLine: 4455, EndLine: 4460}}},
+		{Name: "cli-table3", Version: "0.5.1", Constraint: "0.5.1, ^0.5.0, ^0.5.1", Locations: []types.Location{{StartLine: 4462, EndLine: 4470}}},
+		{Name: "cli-truncate", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 4472, EndLine: 4478}}},
+		{Name: "cli-width", Version: "1.1.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4480, EndLine: 4483}}},
+		{Name: "cli-width", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 4485, EndLine: 4488}}},
+		{Name: "cliui", Version: "3.2.0", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 4490, EndLine: 4497}}},
+		{Name: "cliui", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 4499, EndLine: 4506}}},
+		{Name: "clone", Version: "1.0.4", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 4519, EndLine: 4522}}},
+		{Name: "clone-deep", Version: "0.2.4", Constraint: "^0.2.4", Locations: []types.Location{{StartLine: 4508, EndLine: 4517}}},
+		{Name: "clsx", Version: "1.0.4", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4524, EndLine: 4527}}},
+		{Name: "cmd-shim", Version: "2.0.2", Constraint: "^2.0.2, ~2.0.2", Locations: []types.Location{{StartLine: 4529, EndLine: 4535}}},
+		{Name: "co", Version: "4.6.0", Constraint: "^4.6.0", Locations: []types.Location{{StartLine: 4537, EndLine: 4540}}},
+		{Name: "coa", Version: "2.0.2", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 4542, EndLine: 4549}}},
+		{Name: "code-point-at", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4551, EndLine: 4554}}},
+		{Name: "collection-visit", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4556, EndLine: 4562}}},
+		{Name: "color-convert", Version: "1.9.3", Constraint: "^1.9.0", Locations: []types.Location{{StartLine: 4564, EndLine: 4569}}},
+		{Name: "color-name", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 4571, EndLine: 4574}}},
+		{Name: "colors", Version: "1.3.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 4576, EndLine: 4579}}},
+		{Name: "columnify", Version: "1.5.4", Constraint: "~1.5.4", Locations: []types.Location{{StartLine: 4581, EndLine: 4587}}},
+		{Name: "combined-stream", Version: "1.0.8", Constraint: "^1.0.6, ~1.0.6", Locations: []types.Location{{StartLine: 4589, EndLine: 4594}}},
+		{Name: "comma-separated-tokens", Version: "1.0.7", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4596, EndLine: 4599}}},
+		{Name: "commander", Version: "2.17.1", Constraint: "2.17.x", Locations: []types.Location{{StartLine: 4601, EndLine: 4604}}},
+		{Name: "commander", Version: "2.19.0", Constraint: "~2.19.0", Locations: []types.Location{{StartLine: 4611, EndLine: 4614}}},
+		{Name: "commander", Version: "2.20.0", Constraint: "^2.11.0, ^2.14.1, ^2.18.0, ^2.19.0, ^2.9.0, ~2.20.0", Locations: []types.Location{{StartLine: 4606, EndLine: 4609}}},
+		{Name: "common-tags", Version: "1.8.0", Constraint: "^1.8.0", Locations: []types.Location{{StartLine: 4616, EndLine: 4619}}},
+		{Name: "commondir", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4621, EndLine: 4624}}},
+		{Name: "component-bind", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 4626, EndLine: 4629}}},
+		{Name: "component-emitter", Version: "1.2.1", Constraint: "1.2.1", Locations: []types.Location{{StartLine: 4631, EndLine: 4634}}},
+		{Name: "component-emitter", Version: "1.3.0", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 4636, EndLine: 4639}}},
+		{Name: "component-inherit", Version: "0.0.3", Constraint: "0.0.3", Locations: []types.Location{{StartLine: 4641, EndLine: 4644}}},
+		{Name: "compressible", Version: "2.0.17", Constraint: "~2.0.16", Locations: []types.Location{{StartLine: 4646, EndLine: 4651}}},
+		{Name: "compression", Version: "1.7.4", Constraint: "^1.7.4", Locations: []types.Location{{StartLine: 4653, EndLine: 4664}}},
+		{Name: "concat-map", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 4666, EndLine: 4669}}},
+		{Name: "concat-stream", Version: "1.6.2", Constraint: "^1.5.0", Locations: []types.Location{{StartLine: 4671, EndLine: 4679}}},
+		{Name: "config-chain", Version: "1.1.12", Constraint: "^1.1.12", Locations: []types.Location{{StartLine: 4681, EndLine: 4687}}},
+		{Name: "configstore", Version: "3.1.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4689, EndLine: 4699}}},
+		{Name: "connect-history-api-fallback", Version: "1.6.0", Constraint: "^1.6.0", Locations: []types.Location{{StartLine: 4701, EndLine: 4704}}},
+		{Name: "console-browserify", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 4706, EndLine: 4711}}},
+		{Name: "console-control-strings", Version: "1.1.0", Constraint: "^1.0.0, ^1.1.0, ~1.1.0", Locations: []types.Location{{StartLine: 4713, EndLine: 4716}}},
+		{Name: "console-polyfill", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 4718, EndLine: 4721}}},
+		{Name: "constants-browserify", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4723, EndLine: 4726}}},
+		{Name: "contains-path", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 4728, EndLine: 4731}}},
+		{Name: "content-disposition", Version: "0.5.2", Constraint: "0.5.2", Locations: []types.Location{{StartLine: 4733, EndLine: 4736}}},
+		{Name: "content-type", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 4738, EndLine: 4741}}},
+		{Name: "convert-source-map", Version: "1.6.0", Constraint: "^1.1.0, ^1.4.0, ^1.5.0, ^1.5.1", Locations: []types.Location{{StartLine: 4743, EndLine: 4748}}},
+		{Name: "cookie", Version: "0.3.1", Constraint: "0.3.1, ^0.3.1", Locations: []types.Location{{StartLine: 4755, EndLine: 4758}}},
+		{Name: "cookie-signature", Version: "1.0.6", Constraint: "1.0.6", Locations: []types.Location{{StartLine: 4750, EndLine: 4753}}},
+		{Name: "copy-concurrently", Version: "1.0.5", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4760, EndLine: 4770}}},
+		{Name: "copy-descriptor", Version: "0.1.1", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 4772, EndLine: 4775}}},
+		{Name: "copy-to-clipboard", Version: "3.2.0", Constraint: "^3.0.8", Locations: []types.Location{{StartLine: 4777, EndLine: 4782}}},
+		{Name: "copy-webpack-plugin", Version: "4.6.0", Constraint: "^4.5.1", Locations: []types.Location{{StartLine: 4784, EndLine: 4796}}},
+		{Name: "core-js", Version: "1.2.7", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4818, EndLine: 4821}}},
+		{Name: "core-js", Version: "2.6.5", Constraint: "^2.4.0, ^2.5.0, ^2.5.7", Locations: []types.Location{{StartLine: 4823, EndLine: 4826}}},
+		{Name: "core-js", Version: "3.0.1", Constraint: "3.0.1, ^3.0.0", Locations: []types.Location{{StartLine: 4813, EndLine: 4816}}},
+		{Name: "core-js-compat", Version: "3.0.1", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4798, EndLine: 4806}}},
+		{Name: "core-js-pure", Version: "3.0.1", Constraint: "3.0.1", Locations: []types.Location{{StartLine: 4808, EndLine: 4811}}},
+		{Name: "core-util-is", Version: "1.0.2", Constraint: "1.0.2, ~1.0.0", Locations: []types.Location{{StartLine: 4828, EndLine: 4831}}},
+		{Name: "cosmiconfig", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 4833, EndLine: 4841}}},
+		{Name: "cosmiconfig", Version: "5.2.1", Constraint: "^5.0.2, ^5.0.5, ^5.0.7, ^5.2.0", Locations: []types.Location{{StartLine: 4843, EndLine: 4851}}},
+		{Name: "create-ecdh", Version: "4.0.3", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 4853, EndLine: 4859}}},
+		{Name: "create-error-class", Version: "3.0.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 4861, EndLine: 4866}}},
+		{Name: "create-hash", Version: "1.2.0", Constraint: "^1.1.0, ^1.1.2", Locations: []types.Location{{StartLine: 4868, EndLine: 4877}}},
+		{Name: "create-hmac", Version: "1.1.7", Constraint: "^1.1.0, ^1.1.2, ^1.1.4", Locations: []types.Location{{StartLine: 4879, EndLine: 4889}}},
+		{Name: "create-react-class", Version: "15.6.3", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 4891, EndLine: 4898}}},
+		{Name: "create-react-context", Version: "0.2.2", Constraint: "<=0.2.2", Locations: []types.Location{{StartLine: 4900, EndLine: 4906}}},
+		{Name: "create-react-context", Version: "0.2.3", Constraint: "^0.2.2", Locations: []types.Location{{StartLine: 4908, EndLine: 4914}}},
+		{Name: "cross-spawn", Version: "4.0.2", Constraint: "^4.0.2", Locations: []types.Location{{StartLine: 4927, EndLine: 4933}}},
+		{Name: "cross-spawn", Version: "5.1.0", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 4935, EndLine: 4942}}},
+		{Name: "cross-spawn", Version: "6.0.5", Constraint: "6.0.5, ^6.0.0, ^6.0.5", Locations: []types.Location{{StartLine: 4916, EndLine: 4925}}},
+		{Name: "crypt", Version: "0.0.2", Constraint: "~0.0.1", Locations: []types.Location{{StartLine: 4944, EndLine: 4947}}},
+		{Name: "crypto-browserify", Version: "3.12.0", Constraint: "^3.11.0", Locations: []types.Location{{StartLine: 4949, EndLine: 4964}}},
+		{Name: "crypto-random-string", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4966, EndLine: 4969}}},
+		{Name: "css-color-keywords", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 4971, EndLine: 4974}}},
+		{Name: "css-loader", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 4976, EndLine: 4992}}},
+		{Name: "css-select", Version: "1.2.0", Constraint: "^1.1.0, ~1.2.0", Locations: []types.Location{{StartLine: 4999, EndLine: 5007}}},
+		{Name: "css-select", Version: "2.0.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 5009, EndLine: 5017}}},
+		{Name: "css-select-base-adapter", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 4994, EndLine: 4997}}},
+		{Name: "css-selector-tokenizer", Version: "0.7.1", Constraint: "^0.7.0", Locations: []types.Location{{StartLine: 5019, EndLine: 5026}}},
+		{Name: "css-to-react-native", Version: "2.3.1", Constraint: "^2.2.2", Locations: []types.Location{{StartLine: 5028, EndLine: 5035}}},
+		{Name: "css-tree", Version: "1.0.0-alpha.28", Constraint: "1.0.0-alpha.28", Locations: []types.Location{{StartLine: 5037, EndLine: 5043}}},
+		{Name: "css-tree", Version: "1.0.0-alpha.29", Constraint: "1.0.0-alpha.29", Locations: []types.Location{{StartLine: 5045, EndLine: 5051}}},
+		{Name: "css-url-regex", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 5053, EndLine: 5056}}},
+		{Name: "css-vendor", Version: "0.3.8", Constraint: "^0.3.8", Locations: []types.Location{{StartLine: 5058, EndLine: 5063}}},
+		{Name: "css-what", Version: "2.1.3", Constraint: "2.1, ^2.1.2", Locations: []types.Location{{StartLine: 5065, EndLine: 5068}}},
+		{Name: "cssesc", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 5070, EndLine: 5073}}},
+		{Name: "csso", Version: "3.5.1", Constraint: "^3.5.1", Locations: []types.Location{{StartLine: 5075, EndLine: 5080}}},
+		{Name: "cssom", Version: "0.3.6", Constraint: "0.3.x, >= 0.3.2 < 0.4.0", Locations: []types.Location{{StartLine: 5082, EndLine: 5085}}},
+		{Name: "cssstyle", Version: "1.2.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5087, EndLine: 5092}}},
+		{Name: "csstype", Version: "2.6.4", Constraint: "^2.0.0, ^2.2.0, ^2.5.2", Locations: []types.Location{{StartLine: 5094, EndLine: 5097}}},
+		{Name: "cyclist", Version: "0.2.2", Constraint: "~0.2.2", Locations: []types.Location{{StartLine: 5099, EndLine: 5102}}},
+		{Name: "damerau-levenshtein", Version: "1.0.5", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 5104, EndLine: 5107}}},
+		{Name: "dashdash", Version: "1.14.1", Constraint: "^1.12.0", Locations: []types.Location{{StartLine: 5109, EndLine: 5114}}},
+		{Name: "data-urls", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5116, EndLine: 5123}}},
+		{Name: "date-fns", Version: "1.30.1", Constraint: "^1.27.2", Locations: []types.Location{{StartLine: 5125, EndLine: 5128}}},
+		{Name: "date-fns", Version: "2.0.0-alpha.27", Constraint: "^2.0.0-alpha.23", Locations: []types.Location{{StartLine: 5130, EndLine: 5133}}},
+		{Name: "date-now", Version: "0.1.4", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 5135, EndLine: 5138}}},
+		{Name: "debounce", Version: "1.2.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 5140, EndLine: 5143}}},
+		{Name: "debug", Version: "2.6.9", Constraint: "2.6.9, ^2.2.0, ^2.3.3, ^2.6.0, ^2.6.6, ^2.6.8, ^2.6.9", Locations: []types.Location{{StartLine: 5145, EndLine: 5150}}},
+		{Name: "debug", Version: "3.1.0", Constraint: "3.1.0, ~3.1.0", Locations: []types.Location{{StartLine: 5152, EndLine: 5157}}},
+		{Name: "debug", Version: "3.2.6", Constraint: "^3.1.0, ^3.2.5, ^3.2.6", Locations: []types.Location{{StartLine: 5159, EndLine: 5164}}},
+		{Name: "debug", Version: "4.1.1", Constraint: "^4.0.1, ^4.1.0, ^4.1.1, ~4.1.0", Locations: []types.Location{{StartLine: 5166, EndLine: 5171}}},
+		{Name: "debuglog", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 5173, EndLine: 5176}}},
+		{Name: "decamelize", Version: "1.2.0", Constraint: "^1.1.1, ^1.2.0", Locations: []types.Location{{StartLine: 5178, EndLine: 5181}}},
+		{Name: "decode-uri-component", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 5183, EndLine: 5186}}},
+		{Name: "decompress-response", Version: "3.3.0", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 5188, EndLine: 5193}}},
+		{Name: "dedent", Version: "0.7.0", Constraint: "^0.7.0", Locations: []types.Location{{StartLine: 5195, EndLine: 5198}}},
+		{Name: "deep-equal", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 5200, EndLine: 5203}}},
+		{Name: "deep-extend", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 5205, EndLine: 5208}}},
+		{Name: "deep-is", Version: "0.1.3", Constraint: "~0.1.3", Locations: []types.Location{{StartLine: 5210, EndLine: 5213}}},
+		{Name: "deepmerge", Version: "2.2.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 5215, EndLine: 5218}}},
+		{Name: "deepmerge", Version: "3.2.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 5220, EndLine: 5223}}},
+		{Name: "default-gateway", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 5225, EndLine: 5231}}},
+		{Name: "default-require-extensions", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5233, EndLine: 5238}}},
+		{Name: "defaults", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 5240, EndLine: 5245}}},
+		{Name: "define-properties", Version: "1.1.3", Constraint: "^1.1.2, ^1.1.3", Locations: []types.Location{{StartLine: 5247, EndLine: 5252}}},
+		{Name: "define-property", Version: "0.2.5", Constraint: "^0.2.5", Locations: []types.Location{{StartLine: 5254, EndLine: 5259}}},
+		{Name: "define-property", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5261, EndLine: 5266}}},
+		{Name: "define-property", Version: "2.0.2", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 5268, EndLine: 5274}}},
+		{Name: "del", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 5276, EndLine: 5286}}},
+		{Name: "del", Version: "4.1.1", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 5288, EndLine: 5299}}},
+		{Name: "delayed-stream", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 5301, EndLine: 5304}}},
+		{Name: "delegates", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5306, EndLine: 5309}}},
+		{Name: "depd", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 5311, EndLine: 5314}}},
+		{Name: "des.js", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5316, EndLine: 5322}}},
+		{Name: "destroy", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 5324, EndLine: 5327}}},
+		{Name: "detect-file", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5329, EndLine: 5332}}},
+		{Name: "detect-indent", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 5334, EndLine: 5339}}},
+		{Name: "detect-indent", Version: "5.0.0", Constraint: "^5.0.0, ~5.0.0", Locations: []types.Location{{StartLine: 5341, EndLine: 5344}}},
+		{Name: "detect-libc", Version: "1.0.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 5346, EndLine: 5349}}},
+		{Name: "detect-newline", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 5351, EndLine: 5354}}},
+		{Name: "detect-node", Version: "2.0.4", Constraint: "^2.0.4", Locations: []types.Location{{StartLine: 5356, EndLine: 5359}}},
+		{Name: "detect-port", Version: "1.3.0", Constraint: "^1.2.3", Locations: []types.Location{{StartLine: 5369, EndLine: 5375}}},
+		{Name: "detect-port-alt", Version: "1.1.6", Constraint: "1.1.6", Locations: []types.Location{{StartLine: 5361, EndLine: 5367}}},
+		{Name: "dezalgo", Version: "1.0.3", Constraint: "^1.0.0, ~1.0.3", Locations: []types.Location{{StartLine: 5377, EndLine: 5383}}},
+		{Name: "diff", Version: "3.5.0", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 5385, EndLine: 5388}}},
+		{Name: "diffie-hellman", Version: "5.0.3", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 5390, EndLine: 5397}}},
+		{Name: "dir-glob", Version: "2.2.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 5399, EndLine: 5404}}},
+		{Name: "discontinuous-range", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 5406, EndLine: 5409}}},
+		{Name: "dns-equal", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5411, EndLine: 5414}}},
+		{Name: "dns-packet", Version: "1.3.1", Constraint: "^1.3.1", Locations: []types.Location{{StartLine: 5416, EndLine: 5422}}},
+		{Name: "dns-txt", Version: "2.0.2", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 5424, EndLine: 5429}}},
+		{Name: "doctrine", Version: "1.5.0", Constraint: "1.5.0", Locations: []types.Location{{StartLine: 5431, EndLine: 5437}}},
+		{Name: "doctrine", Version: "2.1.0", Constraint: "^2.0.0, ^2.1.0", Locations: []types.Location{{StartLine: 5439, EndLine: 5444}}},
+		{Name: "doctrine", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 5446, EndLine: 5451}}},
+		{Name: "dom-converter", Version: "0.2.0", Constraint: "^0.2", Locations: []types.Location{{StartLine: 5453, EndLine: 5458}}},
+		{Name: "dom-helpers", Version: "3.4.0", Constraint: "^2.4.0 || ^3.0.0, ^3.2.1, ^3.4.0", Locations: []types.Location{{StartLine: 5460, EndLine: 5465}}},
+		{Name: "dom-serializer", Version: "0.1.1", Constraint: "0, ~0.1.1", Locations: []types.Location{{StartLine: 5467, EndLine: 5473}}},
+		{Name: "dom-walk", Version: "0.1.1", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 5475, EndLine: 5478}}},
+		{Name: "domain-browser", Version: "1.2.0", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 5480, EndLine: 5483}}},
+		{Name: "domelementtype", Version: "1.3.1", Constraint: "1, ^1.3.0, ^1.3.1", Locations: []types.Location{{StartLine: 5485, EndLine: 5488}}},
+		{Name: "domexception", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 5490, EndLine: 5495}}},
+		{Name: "domhandler", Version: "2.4.2", Constraint: "^2.3.0", Locations: []types.Location{{StartLine: 5497, EndLine: 5502}}},
+		{Name: "domutils", Version: "1.5.1", Constraint: "1.5.1", Locations: []types.Location{{StartLine: 5504, EndLine: 5510}}},
+		{Name: "domutils", Version: "1.7.0", Constraint: "^1.5.1, ^1.7.0", Locations: []types.Location{{StartLine: 5512, EndLine: 5518}}},
+		{Name: "dot-prop", Version: "4.2.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 5520, EndLine: 5525}}},
+		{Name: "dotenv", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 5546, EndLine: 5549}}},
+		{Name: "dotenv", Version: "6.2.0", Constraint: "^6.0.0, ^6.2.0", Locations: []types.Location{{StartLine: 5551, EndLine: 5554}}},
+		{Name: "dotenv-defaults", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 5527, EndLine: 5532}}},
+		{Name: "dotenv-expand", Version: "4.2.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 5534, EndLine: 5537}}},
+		{Name: "dotenv-webpack", Version: "1.7.0", Constraint: "^1.5.7", Locations: []types.Location{{StartLine: 5539, EndLine: 5544}}},
+		{Name: "duplexer", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 5568, EndLine: 5571}}},
+		{Name: "duplexer2", Version: "0.1.4", Constraint: "~0.1.4", Locations: []types.Location{{StartLine: 5556, EndLine: 5561}}},
+		{Name: "duplexer3", Version: "0.1.4", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 5563, EndLine: 5566}}},
+		{Name: "duplexify", Version: "3.7.1", Constraint: "^3.4.2, ^3.6.0", Locations: []types.Location{{StartLine: 5573, EndLine: 5581}}},
+		{Name: "ecc-jsbn", Version: "0.1.2", Constraint: "~0.1.1", Locations: []types.Location{{StartLine: 5583, EndLine: 5589}}},
+		{Name: "editor", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 5591, EndLine: 5594}}},
+		{Name: "ee-first", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 5596, EndLine: 5599}}},
+		{Name: "ejs", Version: "2.6.1", Constraint: "^2.6.1", Locations: []types.Location{{StartLine: 5601, EndLine: 5604}}},
+		{Name: "electron-to-chromium", Version: "1.3.134", Constraint: "^1.3.133, ^1.3.47, ^1.3.62", Locations: []types.Location{{StartLine: 5606, EndLine: 5609}}},
+		{Name: "elegant-spinner", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 5611, EndLine: 5614}}},
+		{Name: "elliptic", Version: "6.4.1", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 5616, EndLine: 5627}}},
+		{Name: "emoji-regex", Version: "7.0.3", Constraint: "^7.0.1, ^7.0.2", Locations: []types.Location{{StartLine: 5629, EndLine: 5632}}},
+		{Name: "emojis-list", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 5634, EndLine: 5637}}},
+		{Name: "encodeurl", Version: "1.0.2", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 5639, EndLine: 5642}}},
+		{Name: "encoding", Version: "0.1.12", Constraint: "^0.1.11", Locations: []types.Location{{StartLine: 5644, EndLine: 5649}}},
+		{Name: "end-of-stream", Version: "1.4.1", Constraint: "^1.0.0, ^1.1.0", Locations: []types.Location{{StartLine: 5651, EndLine: 5656}}},
+		{Name: "engine.io", Version: "3.3.2", Constraint: "~3.3.1", Locations: []types.Location{{StartLine: 5686, EndLine: 5696}}},
+		{Name: "engine.io-client", Version: "3.3.2", Constraint: "~3.3.1", Locations: []types.Location{{StartLine: 5658, EndLine: 5673}}},
+		{Name: "engine.io-parser", Version: "2.1.3", Constraint: "~2.1.0, ~2.1.1", Locations: []types.Location{{StartLine: 5675, EndLine: 5684}}},
+		{Name: "enhanced-resolve", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 5698, EndLine: 5705}}},
+		{Name: "entities", Version: "1.1.2", Constraint: "^1.1.1, ~1.1.1", Locations: []types.Location{{StartLine: 5707, EndLine: 5710}}},
+		{Name: "enzyme", Version: "3.9.0", Constraint: "^3.8.0", Locations: []types.Location{{StartLine: 5737, EndLine: 5762}}},
+		{Name: "enzyme-adapter-react-16", Version: "1.13.0", Constraint: "^1.7.1", Locations: []types.Location{{StartLine: 5712, EndLine: 5723}}},
+		{Name: "enzyme-adapter-utils", Version: "1.12.0", Constraint: "^1.12.0", Locations: []types.Location{{StartLine: 5725, EndLine: 5735}}},
+		{Name: "err-code", Version: "1.1.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5764, EndLine: 5767}}},
+		{Name: "errno", Version: "0.1.7", Constraint: "^0.1.3, ~0.1.7", Locations: []types.Location{{StartLine: 5769, EndLine: 5774}}},
+		{Name: "error-ex", Version: "1.3.2", Constraint: "^1.2.0, ^1.3.1", Locations: []types.Location{{StartLine: 5776, EndLine: 5781}}},
+		{Name: "es-abstract", Version: "1.13.0", Constraint: "^1.10.0, ^1.11.0, ^1.12.0, ^1.13.0, ^1.4.3, ^1.5.0, ^1.5.1, ^1.7.0, ^1.9.0", Locations: []types.Location{{StartLine: 5783, EndLine: 5793}}},
+		{Name: "es-to-primitive", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 5795, EndLine: 5802}}},
+		{Name: "es5-shim", Version: "4.5.13", Constraint: "^4.5.13", Locations: []types.Location{{StartLine: 5804, EndLine: 5807}}},
+		{Name: "es6-promise", Version: "3.3.1", Constraint: "^3.2.1", Locations: []types.Location{{StartLine: 5816, EndLine: 5819}}},
+		{Name: "es6-promise", Version: "4.2.6", Constraint: "^4.0.3", Locations: []types.Location{{StartLine: 5821, EndLine: 5824}}},
+		{Name: "es6-promise-promise", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5809, EndLine: 5814}}},
+		{Name: "es6-promisify", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 5826, EndLine: 5831}}},
+		{Name: "es6-shim", Version: "0.35.5", Constraint: "^0.35.5", Locations: []types.Location{{StartLine: 5833, EndLine: 5836}}},
+		{Name: "escape-html", Version: "1.0.3", Constraint: "^1.0.3, ~1.0.3", Locations: []types.Location{{StartLine: 5838, EndLine: 5841}}},
+		{Name: "escape-string-regexp", Version: "1.0.5", Constraint: "1.0.5, ^1.0.2, ^1.0.5", Locations: []types.Location{{StartLine: 5843, EndLine: 5846}}},
+		{Name: "escodegen", Version: "1.11.1", Constraint: "^1.9.1", Locations: []types.Location{{StartLine: 5848, EndLine: 5858}}},
+		{Name: "eslint", Version: "5.16.0", Constraint: "^5.10.0", Locations: []types.Location{{StartLine: 5980, EndLine: 6020}}},
+		{Name: "eslint-config-airbnb", Version: "17.1.0", Constraint: "^17.1.0", Locations: []types.Location{{StartLine: 5869, EndLine: 5876}}},
+		{Name: "eslint-config-airbnb-base", Version: "13.1.0", Constraint: "^13.1.0", Locations: []types.Location{{StartLine: 5860, EndLine: 5867}}},
+		{Name: "eslint-import-resolver-node", Version: "0.3.2", Constraint: "^0.3.2", Locations: []types.Location{{StartLine: 5878, EndLine: 5884}}},
+		{Name: "eslint-loader", Version: "2.1.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 5886, EndLine: 5895}}},
+		{Name: "eslint-module-utils", Version: "2.4.0", Constraint: "^2.4.0", Locations: []types.Location{{StartLine: 5897, EndLine: 5903}}},
+		{Name: "eslint-plugin-import", Version: "2.17.2", Constraint: "^2.11.0", Locations: []types.Location{{StartLine: 5905, EndLine: 5920}}},
+		{Name: "eslint-plugin-jsx-a11y", Version: "6.2.1", Constraint: "^6.0.3", Locations: []types.Location{{StartLine: 5922, EndLine: 5934}}},
+		{Name: "eslint-plugin-react", Version: "7.13.0", Constraint: "^7.11.1", Locations: []types.Location{{StartLine: 5936, EndLine: 5947}}},
+		{Name: "eslint-restricted-globals", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 5949, EndLine: 5952}}},
+		{Name: "eslint-scope", Version: "3.7.1", Constraint: "3.7.1", Locations: []types.Location{{StartLine: 5954, EndLine: 5960}}},
+		{Name: "eslint-scope", Version: "4.0.3", Constraint: "^4.0.0, ^4.0.3", Locations: []types.Location{{StartLine: 5962, EndLine: 5968}}},
+		{Name: "eslint-utils", Version: "1.3.1", Constraint: "^1.3.1", Locations: []types.Location{{StartLine: 5970, EndLine: 5973}}},
+		{Name: "eslint-visitor-keys", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 5975, EndLine: 5978}}},
+		{Name: "espree", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 6022, EndLine: 6029}}},
+		{Name: "esprima", Version: "3.1.3", Constraint: "^3.1.3", Locations: []types.Location{{StartLine: 6031, EndLine: 6034}}},
+		{Name: "esprima", Version: "4.0.1", Constraint: "^4.0.0, ~4.0.0", Locations: []types.Location{{StartLine: 6036, EndLine: 6039}}},
+		{Name: "esquery", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 6041, EndLine: 6046}}},
+		{Name: "esrecurse", Version: "4.2.1", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 6048, EndLine: 6053}}},
+		{Name: "estraverse", Version: "4.2.0", Constraint: "^4.0.0, ^4.1.0, ^4.1.1, ^4.2.0", Locations: []types.Location{{StartLine: 6055, EndLine: 6058}}},
+		{Name: "esutils", Version: "2.0.2", Constraint: "^2.0.0, ^2.0.2", Locations: []types.Location{{StartLine: 6060, EndLine: 6063}}},
+		{Name: "etag", Version: "1.8.1", Constraint: "~1.8.1", Locations: []types.Location{{StartLine: 6065, EndLine: 6068}}},
+		{Name: "eventemitter3", Version: "3.1.2", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 6070, EndLine: 6073}}},
+		{Name: "events", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 6075, EndLine: 6078}}},
+		{Name: "eventsource", Version: "0.1.6", Constraint: "0.1.6", Locations: []types.Location{{StartLine: 6080, EndLine: 6085}}},
+		{Name: "eventsource", Version: "1.0.7", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 6087, EndLine: 6092}}},
+		{Name: "evp_bytestokey", Version: "1.0.3", Constraint: "^1.0.0, ^1.0.3", Locations: []types.Location{{StartLine: 6094, EndLine: 6100}}},
+		{Name: "exec-sh", Version: "0.2.2", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 6102, EndLine: 6107}}},
+		{Name: "execa", Version: "0.7.0", Constraint: "^0.7.0", Locations: []types.Location{{StartLine: 6109, EndLine: 6120}}},
+		{Name: "execa", Version: "0.9.0", Constraint: "^0.9.0", Locations: []types.Location{{StartLine: 6122, EndLine: 6133}}},
+		{Name: "execa", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6135, EndLine: 6146}}},
+		{Name: "exenv", Version: "1.2.2", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 6148, EndLine: 6151}}},
+		{Name: "exit", Version: "0.1.2", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 6158, EndLine: 6161}}},
+		{Name: "exit-hook", Version: "1.1.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6153, EndLine: 6156}}},
+		{Name: "expand-brackets", Version: "0.1.5", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 6163, EndLine: 6168}}},
+		{Name: "expand-brackets", Version: "2.1.4", Constraint: "^2.1.4", Locations: []types.Location{{StartLine: 6170, EndLine: 6181}}},
+		{Name: "expand-range", Version: "1.8.2", Constraint: "^1.8.1", Locations: []types.Location{{StartLine: 6183, EndLine: 6188}}},
+		{Name: "expand-tilde", Version: "2.0.2", Constraint: "^2.0.0, ^2.0.2", Locations: []types.Location{{StartLine: 6190, EndLine: 6195}}},
+		{Name: "expect", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 6197, EndLine: 6207}}},
+		{Name: "express", Version: "4.16.4", Constraint: "^4.16.3, ^4.16.4", Locations: []types.Location{{StartLine: 6209, EndLine: 6243}}},
+		{Name: "extend", Version: "3.0.2", Constraint: "^3.0.0, ~3.0.2", Locations: []types.Location{{StartLine: 6260, EndLine: 6263}}},
+		{Name: "extend-shallow", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 6245, EndLine: 6250}}},
+		{Name: "extend-shallow", Version: "3.0.2", Constraint: "^3.0.0, ^3.0.2", Locations: []types.Location{{StartLine: 6252, EndLine: 6258}}},
+		{Name: "external-editor", Version: "3.0.3", Constraint: "^3.0.0, ^3.0.3", Locations: []types.Location{{StartLine: 6265, EndLine: 6272}}},
+		{Name: "extglob", Version: "0.3.2", Constraint: "^0.3.1", Locations: []types.Location{{StartLine: 6274, EndLine: 6279}}},
+		{Name: "extglob", Version: "2.0.4", Constraint: "^2.0.4", Locations: []types.Location{{StartLine: 6281, EndLine: 6293}}},
+		{Name: "extract-text-webpack-plugin", Version: "4.0.0-beta.0", Constraint: "^4.0.0-alpha.0", Locations: []types.Location{{StartLine: 6295, EndLine: 6303}}},
+		{Name: "extsprintf", Version: "1.3.0", Constraint: "1.3.0", Locations: []types.Location{{StartLine: 6305, EndLine: 6308}}},
+		{Name: "extsprintf", Version: "1.4.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 6310, EndLine: 6313}}},
+		{Name: "faker", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 6315, EndLine: 6318}}},
+		{Name: "fast-deep-equal", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 6320, EndLine: 6323}}},
+		{Name: "fast-glob", Version: "2.2.6", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 6325, EndLine: 6335}}},
+		{Name: "fast-json-stable-stringify", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6337, EndLine: 6340}}},
+		{Name: "fast-levenshtein", Version: "2.0.6", Constraint: "~2.0.4", Locations: []types.Location{{StartLine: 6342, EndLine: 6345}}},
+		{Name: "fastparse", Version: "1.1.2", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 6347, EndLine: 6350}}},
+		{Name: "faye-websocket", Version: "0.10.0", Constraint: "^0.10.0", Locations: []types.Location{{StartLine: 6352, EndLine: 6357}}},
+		{Name: "faye-websocket", Version: "0.11.1", Constraint: "~0.11.0, ~0.11.1", Locations: []types.Location{{StartLine: 6359, EndLine: 6364}}},
+		{Name: "fb-watchman", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6366, EndLine: 6371}}},
+		{Name: "fbjs", Version: "0.8.17", Constraint: "^0.8.0, ^0.8.1, ^0.8.4, ^0.8.9", Locations: []types.Location{{StartLine: 6373, EndLine: 6384}}},
+		{Name: "figgy-pudding", Version: "3.5.1", Constraint: "^3.4.1, ^3.5.1", Locations: []types.Location{{StartLine: 6386, EndLine: 6389}}},
+		{Name: "figures", Version: "1.7.0", Constraint: "^1.3.5, ^1.7.0", Locations: []types.Location{{StartLine: 6391, EndLine: 6397}}},
+		{Name: "figures", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6399, EndLine: 6404}}},
+		{Name: "file-entry-cache", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 6406, EndLine: 6411}}},
+		{Name: "file-loader", Version: "1.1.11", Constraint: "1.1.11", Locations: []types.Location{{StartLine: 6413, EndLine: 6419}}},
+		{Name: "file-loader", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6421, EndLine: 6427}}},
+		{Name: "file-selector", Version: "0.1.11", Constraint: "^0.1.11", Locations: []types.Location{{StartLine: 6429, EndLine: 6434}}},
+		{Name: "file-system-cache", Version: "1.0.5", Constraint: "^1.0.5", Locations: []types.Location{{StartLine: 6436, EndLine: 6443}}},
+		{Name: "filename-regex", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6445, EndLine: 6448}}},
+		{Name: "fileset", Version: "2.0.3", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 6450, EndLine: 6456}}},
+		{Name: "filesize", Version: "3.6.1", Constraint: "3.6.1, ^3.6.1", Locations: []types.Location{{StartLine: 6458, EndLine: 6461}}},
+		{Name: "fill-range", Version: "2.2.4", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 6463, EndLine: 6472}}},
+		{Name: "fill-range", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 6474, EndLine: 6482}}},
+		{Name: "finalhandler", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 6484, EndLine: 6495}}},
+		{Name: "find-cache-dir", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 6497, EndLine: 6504}}},
+		{Name: "find-cache-dir", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6506, EndLine: 6513}}},
+		{Name: "find-cache-dir", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6515, EndLine: 6522}}},
+		{Name: "find-npm-prefix", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 6524, EndLine: 6527}}},
+		{Name: "find-parent-dir", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 6529, EndLine: 6532}}},
+		{Name: "find-up", Version: "1.1.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6541, EndLine: 6547}}},
+		{Name: "find-up", Version: "2.1.0", Constraint: "^2.0.0, ^2.1.0", Locations: []types.Location{{StartLine: 6549, EndLine: 6554}}},
+		{Name: "find-up", Version: "3.0.0", Constraint: "3.0.0, ^3.0.0", Locations: []types.Location{{StartLine: 6534, EndLine: 6539}}},
+		{Name: "findup-sync", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6556, EndLine: 6564}}},
+		{Name: "flat-cache", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 6566, EndLine: 6573}}},
+		{Name: "flatted", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6575, EndLine: 6578}}},
+		{Name: "flow-bin", Version: "0.89.0", Constraint: "^0.89.0", Locations: []types.Location{{StartLine: 6580, EndLine: 6583}}},
+		{Name: "flow-parser", Version: "0.98.1", Constraint: "^0.*", Locations: []types.Location{{StartLine: 6585, EndLine: 6588}}},
+		{Name: "flow-typed", Version: "2.5.1", Constraint: "^2.5.1", Locations: []types.Location{{StartLine: 6590, EndLine: 6609}}},
+		{Name: "flush-write-stream", Version: "1.1.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6611, EndLine: 6617}}},
+		{Name: "follow-redirects", Version: "1.7.0", Constraint: "^1.0.0, ^1.3.0", Locations: []types.Location{{StartLine: 6619, EndLine: 6624}}},
+		{Name: "for-in", Version: "0.1.8", Constraint: "^0.1.3", Locations: []types.Location{{StartLine: 6626, EndLine: 6629}}},
+		{Name: "for-in", Version: "1.0.2", Constraint: "^1.0.1, ^1.0.2", Locations: []types.Location{{StartLine: 6631, EndLine: 6634}}},
+		{Name: "for-own", Version: "0.1.5", Constraint: "^0.1.3, ^0.1.4", Locations: []types.Location{{StartLine: 6636, EndLine: 6641}}},
+		{Name: "forever-agent", Version: "0.6.1", Constraint: "~0.6.1", Locations: []types.Location{{StartLine: 6643, EndLine: 6646}}},
+		{Name: "form-data", Version: "2.3.3", Constraint: "~2.3.2", Locations: []types.Location{{StartLine: 6648, EndLine: 6655}}},
+		{Name: "formik", Version: "1.5.1", Constraint: "1.5.1", Locations: []types.Location{{StartLine: 6657, EndLine: 6670}}},
+		{Name: "forwarded", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 6672, EndLine: 6675}}},
+		{Name: "fragment-cache", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 6677, EndLine: 6682}}},
+		{Name: "fresh", Version: "0.5.2", Constraint: "0.5.2", Locations: []types.Location{{StartLine: 6684, EndLine: 6687}}},
+		{Name: "from2", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 6689, EndLine: 6695}}},
+		{Name: "from2", Version: "2.3.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 6697, EndLine: 6703}}},
+		{Name: "fs-extra", Version: "0.30.0", Constraint: "^0.30.0", Locations: []types.Location{{StartLine: 6705, EndLine: 6714}}},
+		{Name: "fs-extra", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 6716, EndLine: 6723}}},
+		{Name: "fs-extra", Version: "7.0.1", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 6725, EndLine: 6732}}},
+		{Name: "fs-minipass", Version: "1.2.5", Constraint: "^1.2.5", Locations: []types.Location{{StartLine: 6734, EndLine: 6739}}},
+		{Name: "fs-readdir-recursive", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6741, EndLine: 6744}}},
+		{Name: "fs-vacuum", Version: "1.2.10", Constraint: "^1.2.10, ~1.2.10", Locations: []types.Location{{StartLine: 6746, EndLine: 6753}}},
+		{Name: "fs-write-stream-atomic", Version: "1.0.10", Constraint: "^1.0.8, ~1.0.10", Locations: []types.Location{{StartLine: 6755, EndLine: 6763}}},
+		{Name: "fs.realpath", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6765, EndLine: 6768}}},
+		{Name: "fsevents", Version: "1.2.9", Constraint: "^1.0.0, ^1.2.3, ^1.2.7", Locations: []types.Location{{StartLine: 6770, EndLine: 6776}}},
+		{Name: "fstream", Version: "1.0.12", Constraint: "^1.0.0, ^1.0.12, ~1.0.10", Locations: []types.Location{{StartLine: 6778, EndLine: 6786}}},
+		{Name: "function-bind", Version: "1.1.1", Constraint: "^1.0.2, ^1.1.1", Locations: []types.Location{{StartLine: 6788, EndLine: 6791}}},
+		{Name: "function.prototype.name", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 6793, EndLine: 6800}}},
+		{Name: "functional-red-black-tree", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 6802, EndLine: 6805}}},
+		{Name: "fuse.js", Version: "3.4.4", Constraint: "^3.0.1, ^3.3.0", Locations: []types.Location{{StartLine: 6807, EndLine: 6810}}},
+		{Name: "gauge", Version: "2.7.4", Constraint: "~2.7.3", Locations: []types.Location{{StartLine: 6812, EndLine: 6824}}},
+		{Name: "genfun", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 6826, EndLine: 6829}}},
+		{Name: "gentle-fs", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 6831, EndLine: 6843}}},
+		{Name: "get-caller-file", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 6845, EndLine: 6848}}},
+		{Name: "get-own-enumerable-property-symbols", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 6850, EndLine: 6853}}},
+		{Name: "get-stdin", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 6855, EndLine: 6858}}},
+		{Name: "get-stream", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 6860, EndLine: 6863}}},
+		{Name: "get-stream", Version: "4.1.0", Constraint: "^4.0.0, ^4.1.0", Locations: []types.Location{{StartLine: 6865, EndLine: 6870}}},
+		{Name: "get-value", Version: "2.0.6", Constraint: "^2.0.3, ^2.0.6", Locations: []types.Location{{StartLine: 6872, EndLine: 6875}}},
+		{Name: "getpass", Version: "0.1.7", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 6877, EndLine: 6882}}},
+		{Name: "glob", Version: "7.1.4", Constraint: "^7.0.0, ^7.0.3, ^7.1.1, ^7.1.2, ^7.1.3", Locations: []types.Location{{StartLine: 6912, EndLine: 6922}}},
+		{Name: "glob-base", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 6884, EndLine: 6890}}},
+		{Name: "glob-parent", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 6892, EndLine: 6897}}},
+		{Name: "glob-parent", Version: "3.1.0", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 6899, EndLine: 6905}}},
+		{Name: "glob-to-regexp", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 6907, EndLine: 6910}}},
+		{Name: "global", Version: "4.3.2", Constraint: "^4.3.2", Locations: []types.Location{{StartLine: 6951, EndLine: 6957}}},
+		{Name: "global-dirs", Version: "0.1.1", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 6924, EndLine: 6929}}},
+		{Name: "global-modules", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0", Locations: []types.Location{{StartLine: 6931, EndLine: 6938}}},
+		{Name: "global-prefix", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 6940, EndLine: 6949}}},
+		{Name: "globals", Version: "11.12.0", Constraint: "^11.1.0, ^11.7.0", Locations: []types.Location{{StartLine: 6959, EndLine: 6962}}},
+		{Name: "globals", Version: "9.18.0", Constraint: "^9.18.0", Locations: []types.Location{{StartLine: 6964, EndLine: 6967}}},
+		{Name: "globalthis", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 6969, EndLine: 6976}}},
+		{Name: "globby", Version: "6.1.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 6991, EndLine: 7000}}},
+		{Name: "globby", Version: "7.1.1", Constraint: "^7.1.1", Locations: []types.Location{{StartLine: 7002, EndLine: 7012}}},
+		{Name: "globby", Version: "8.0.1", Constraint: "8.0.1", Locations: []types.Location{{StartLine: 6978, EndLine: 6989}}},
+		{Name: "got", Version: "6.7.1", Constraint: "^6.7.1", Locations: []types.Location{{StartLine: 7014, EndLine: 7029}}},
+		{Name: "got", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 7031, EndLine: 7049}}},
+		{Name: "graceful-fs", Version: "4.1.15", Constraint: "^4.1.11, ^4.1.15, ^4.1.2, ^4.1.4, ^4.1.6, ^4.1.9", Locations: []types.Location{{StartLine: 7051, EndLine: 7054}}},
+		{Name: "growly", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 7056, EndLine: 7059}}},
+		{Name: "gud", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7061, EndLine: 7064}}},
+		{Name: "gzip-size", Version: "5.0.0", Constraint: "5.0.0", Locations: []types.Location{{StartLine: 7066, EndLine: 7072}}},
+		{Name: "gzip-size", Version: "5.1.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 7074, EndLine: 7080}}},
+		{Name: "handle-thing", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7082, EndLine: 7085}}},
+		{Name: "handlebars", Version: "4.1.2", Constraint: "^4.0.3", Locations: []types.Location{{StartLine: 7087, EndLine: 7096}}},
+		{Name: "har-schema", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7098, EndLine: 7101}}},
+		{Name: "har-validator", Version: "5.1.3", Constraint: "~5.1.0", Locations: []types.Location{{StartLine: 7103, EndLine: 7109}}},
+		{Name: "hard-source-webpack-plugin", Version: "0.13.1", Constraint: "^0.13.1", Locations: []types.Location{{StartLine: 7111, EndLine: 7128}}},
+		{Name: "has", Version: "1.0.3", Constraint: "^1.0.1, ^1.0.3", Locations: []types.Location{{StartLine: 7217, EndLine: 7222}}},
+		{Name: "has-ansi", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7130, EndLine: 7135}}},
+		{Name: "has-binary2", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 7137, EndLine: 7142}}},
+		{Name: "has-color", Version: "0.1.7", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 7144, EndLine: 7147}}},
+		{Name: "has-cors", Version: "1.1.0", Constraint: "1.1.0", Locations: []types.Location{{StartLine: 7149, EndLine: 7152}}},
+		{Name: "has-flag", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7154, EndLine: 7157}}},
+		{Name: "has-flag", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 7159, EndLine: 7162}}},
+		{Name: "has-symbol-support-x", Version: "1.4.2", Constraint: "^1.4.1", Locations: []types.Location{{StartLine: 7164, EndLine: 7167}}},
+		{Name: "has-symbols", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7169, EndLine: 7172}}},
+		{Name: "has-to-string-tag-x", Version: "1.4.1", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 7174, EndLine: 7179}}},
+		{Name: "has-unicode", Version: "2.0.1", Constraint: "^2.0.0, ~2.0.1", Locations: []types.Location{{StartLine: 7181, EndLine: 7184}}},
+		{Name: "has-value", Version: "0.3.1", Constraint: "^0.3.1", Locations: []types.Location{{StartLine: 7186, EndLine: 7193}}},
+		{Name: "has-value", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7195, EndLine: 7202}}},
+		{Name: "has-values", Version: "0.1.4", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 7204, EndLine: 7207}}},
+		{Name: "has-values", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7209, EndLine: 7215}}},
+		{Name: "hash-base", Version: "3.0.4", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 7224, EndLine: 7230}}},
+		{Name: "hash.js", Version: "1.1.7", Constraint: "^1.0.0, ^1.0.3", Locations: []types.Location{{StartLine: 7232, EndLine: 7238}}},
+		{Name: "hast-util-from-parse5", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 7240, EndLine: 7249}}},
+		{Name: "hast-util-parse-selector", Version: "2.2.1", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 7251, EndLine: 7254}}},
+		{Name: "hastscript", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 7256, EndLine: 7264}}},
+		{Name: "he", Version: "1.2.0", Constraint: "1.2.x, ^1.1.1", Locations: []types.Location{{StartLine: 7266, EndLine: 7269}}},
+		{Name: "history", Version: "4.9.0", Constraint: "^4.7.2", Locations: []types.Location{{StartLine: 7271, EndLine: 7281}}},
+		{Name: "hmac-drbg", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7283, EndLine: 7290}}},
+		{Name: "hoist-non-react-statics", Version: "1.2.0", Constraint: "1.x.x, ^1.2.0", Locations: []types.Location{{StartLine: 7292, EndLine: 7295}}},
+		{Name: "hoist-non-react-statics", Version: "2.5.5", Constraint: "^2.3.1, ^2.5.0, ^2.5.5", Locations: []types.Location{{StartLine: 7297, EndLine: 7300}}},
+		{Name: "hoist-non-react-statics", Version: "3.3.0", Constraint: "^3.2.1, ^3.3.0", Locations: []types.Location{{StartLine: 7302, EndLine: 7307}}},
+		{Name: "home-or-tmp", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7309, EndLine: 7315}}},
+		{Name: "homedir-polyfill", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 7317, EndLine: 7322}}},
+		{Name: "hoopy", Version: "0.1.4", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 7324, EndLine: 7327}}},
+		{Name: "hosted-git-info", Version: "2.7.1", Constraint: "^2.1.4, ^2.6.0, ^2.7.1", Locations: []types.Location{{StartLine: 7329, EndLine: 7332}}},
+		{Name: "hpack.js", Version: "2.1.6", Constraint: "^2.1.6", Locations: []types.Location{{StartLine: 7334, EndLine: 7342}}},
+		{Name: "html-element-map", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7344, EndLine: 7349}}},
+		{Name: "html-encoding-sniffer", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 7351, EndLine: 7356}}},
+		{Name: "html-entities", Version: "1.2.1", Constraint: "^1.2.0, ^1.2.1", Locations: []types.Location{{StartLine: 7358, EndLine: 7361}}},
+		{Name: "html-minifier", Version: "3.5.21", Constraint: "^3.2.3, ^3.5.20", Locations: []types.Location{{StartLine: 7363, EndLine: 7374}}},
+		{Name: "html-webpack-harddisk-plugin", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 7376, EndLine: 7381}}},
+		{Name: "html-webpack-plugin", Version: "3.2.0", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 7383, EndLine: 7394}}},
+		{Name: "html-webpack-plugin", Version: "4.0.0-beta.5", Constraint: "^4.0.0-beta.2", Locations: []types.Location{{StartLine: 7396, EndLine: 7406}}},
+		{Name: "htmlparser2", Version: "3.10.1", Constraint: "^3.3.0, ^3.9.1", Locations: []types.Location{{StartLine: 7408, EndLine: 7418}}},
+		{Name: "http-cache-semantics", Version: "3.8.1", Constraint: "^3.8.1", Locations: []types.Location{{StartLine: 7420, EndLine: 7423}}},
+		{Name: "http-deceiver", Version: "1.2.7", Constraint: "^1.2.7", Locations: []types.Location{{StartLine: 7425, EndLine: 7428}}},
+		{Name: "http-errors", Version: "1.6.3", Constraint: "1.6.3, ~1.6.2, ~1.6.3", Locations: []types.Location{{StartLine: 7430, EndLine: 7438}}},
+		{Name: "http-parser-js", Version: "0.5.0", Constraint: ">=0.4.0", Locations: []types.Location{{StartLine: 7440, EndLine: 7443}}},
+		{Name: "http-proxy", Version: "1.17.0", Constraint: "^1.17.0", Locations: []types.Location{{StartLine: 7463, EndLine: 7470}}},
+		{Name: "http-proxy-agent", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7445, EndLine: 7451}}},
+		{Name: "http-proxy-middleware", Version: "0.19.1", Constraint: "^0.19.1", Locations: []types.Location{{StartLine: 7453, EndLine: 7461}}},
+		{Name: "http-signature", Version: "1.2.0", Constraint: "~1.2.0", Locations: []types.Location{{StartLine: 7472, EndLine: 7479}}},
+		{Name: "https-browserify", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7481, EndLine: 7484}}},
+		{Name: "https-proxy-agent", Version: "2.2.1", Constraint: "^2.2.0, ^2.2.1", Locations: []types.Location{{StartLine: 7486, EndLine: 7492}}},
+		{Name: "humanize-ms", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 7494, EndLine: 7499}}},
+		{Name: "husky", Version: "1.3.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 7501, EndLine: 7515}}},
+		{Name: "hyphenate-style-name", Version: "1.0.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 7517, EndLine: 7520}}},
+		{Name: "i", Version: "0.3.6", Constraint: "^0.3.6", Locations: []types.Location{{StartLine: 7522, EndLine: 7525}}},
+		{Name: "iconv-lite", Version: "0.4.23", Constraint: "0.4.23", Locations: []types.Location{{StartLine: 7527, EndLine: 7532}}},
+		{Name: "iconv-lite", Version: "0.4.24", Constraint: "0.4.24, ^0.4.24, ^0.4.4, ~0.4.13", Locations: []types.Location{{StartLine: 7534, EndLine: 7539}}},
+		{Name: "icss-replace-symbols", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 7541, EndLine: 7544}}},
+		{Name: "icss-utils", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7546, EndLine: 7551}}},
+		{Name: "ieee754", Version: "1.1.13", Constraint: "^1.1.4", Locations: []types.Location{{StartLine: 7553, EndLine: 7556}}},
+		{Name: "iferr", Version: "0.1.5", Constraint: "^0.1.5", Locations: []types.Location{{StartLine: 7558, EndLine: 7561}}},
+		{Name: "iferr", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 7563, EndLine: 7566}}},
+		{Name: "ignore", Version: "3.3.10", Constraint: "^3.3.5", Locations: []types.Location{{StartLine: 7575, EndLine: 7578}}},
+		{Name: "ignore", Version: "4.0.6", Constraint: "^4.0.6", Locations: []types.Location{{StartLine: 7580, EndLine: 7583}}},
+		{Name: "ignore-walk", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 7568, EndLine: 7573}}},
+		{Name: "immer", Version: "1.7.2", Constraint: "1.7.2", Locations: []types.Location{{StartLine: 7585, EndLine: 7588}}},
+		{Name: "immutable", Version: "3.8.2", Constraint: "^3.8.1", Locations: []types.Location{{StartLine: 7590, EndLine: 7593}}},
+		{Name: "import-cwd", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7595, EndLine: 7600}}},
+		{Name: "import-fresh", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7602, EndLine: 7608}}},
+		{Name: "import-fresh", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 7610, EndLine: 7616}}},
+		{Name: "import-from", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7618, EndLine: 7623}}},
+		{Name: "import-lazy", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7625, EndLine: 7628}}},
+		{Name: "import-local", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7630, EndLine: 7636}}},
+		{Name: "import-local", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7638, EndLine: 7644}}},
+		{Name: "imurmurhash", Version: "0.1.4", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 7646, EndLine: 7649}}},
+		{Name: "indefinite-observable", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 7651, EndLine: 7656}}},
+		{Name: "indent-string", Version: "3.2.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 7658, EndLine: 7661}}},
+		{Name: "indexof", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 7663, EndLine: 7666}}},
+		{Name: "inflight", Version: "1.0.6", Constraint: "^1.0.4, ~1.0.6", Locations: []types.Location{{StartLine: 7668, EndLine: 7674}}},
+		{Name: "inherits", Version: "2.0.1", Constraint: "2.0.1", Locations: []types.Location{{StartLine: 7681, EndLine: 7684}}},
+		{Name: "inherits", Version: "2.0.3", Constraint: "2, 2.0.3, ^2.0.1, ^2.0.3, ~2.0.0, ~2.0.1, ~2.0.3", Locations: []types.Location{{StartLine: 7676, EndLine: 7679}}},
+		{Name: "ini", Version: "1.3.5", Constraint: "^1.3.4, ^1.3.5, ~1.3.0", Locations: []types.Location{{StartLine: 7686, EndLine: 7689}}},
+		{Name: "init-package-json", Version: "1.10.3", Constraint: "^1.10.3", Locations: []types.Location{{StartLine: 7691, EndLine: 7703}}},
+		{Name: "inquirer", Version: "0.11.4", Constraint: "^0.11.0", Locations: []types.Location{{StartLine: 7724, EndLine: 7741}}},
+		{Name: "inquirer", Version: "6.2.0", Constraint: "6.2.0", Locations: []types.Location{{StartLine: 7705, EndLine: 7722}}},
+		{Name: "inquirer", Version: "6.3.1", Constraint: "^6.2.0, ^6.2.2", Locations: []types.Location{{StartLine: 7743, EndLine: 7760}}},
+		{Name: "internal-ip", Version: "4.3.0", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 7762, EndLine: 7768}}},
+		{Name: "interpret", Version: "1.2.0", Constraint: "^1.0.0, ^1.1.0", Locations: []types.Location{{StartLine: 7770, EndLine: 7773}}},
+		{Name: "intl", Version: "1.2.5", Constraint: "^1.2.5", Locations: []types.Location{{StartLine: 7787, EndLine: 7790}}},
+		{Name: "intl-messageformat", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 7780, EndLine: 7785}}},
+		{Name: "intl-messageformat-parser", Version: "1.4.0", Constraint: "1.4.0", Locations: []types.Location{{StartLine: 7775, EndLine: 7778}}},
+		{Name: "invariant", Version: "2.2.4", Constraint: "^2.2.0, ^2.2.2, ^2.2.4", Locations: []types.Location{{StartLine: 7792, EndLine: 7797}}},
+		{Name: "invert-kv", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7799, EndLine: 7802}}},
+		{Name: "invert-kv", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7804, EndLine: 7807}}},
+		{Name: "ip", Version: "1.1.5", Constraint: "^1.1.0, ^1.1.5", Locations: []types.Location{{StartLine: 7814, EndLine: 7817}}},
+		{Name: "ip-regex", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7809, EndLine: 7812}}},
+		{Name: "ipaddr.js", Version: "1.9.0", Constraint: "1.9.0, ^1.9.0", Locations: []types.Location{{StartLine: 7819, EndLine: 7822}}},
+		{Name: "is-accessor-descriptor", Version: "0.1.6", Constraint: "^0.1.6", Locations: []types.Location{{StartLine: 7824, EndLine: 7829}}},
+		{Name: "is-accessor-descriptor", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7831, EndLine: 7836}}},
+		{Name: "is-arrayish", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 7838, EndLine: 7841}}},
+		{Name: "is-binary-path", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7843, EndLine: 7848}}},
+		{Name: "is-boolean-object", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7850, EndLine: 7853}}},
+		{Name: "is-buffer", Version: "1.1.6", Constraint: "^1.0.2, ^1.1.5, ~1.1.1", Locations: []types.Location{{StartLine: 7855, EndLine: 7858}}},
+		{Name: "is-buffer", Version: "2.0.3", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7860, EndLine: 7863}}},
+		{Name: "is-callable", Version: "1.1.4", Constraint: "^1.1.3, ^1.1.4", Locations: []types.Location{{StartLine: 7865, EndLine: 7868}}},
+		{Name: "is-ci", Version: "1.2.1", Constraint: "^1.0.10", Locations: []types.Location{{StartLine: 7870, EndLine: 7875}}},
+		{Name: "is-ci", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7877, EndLine: 7882}}},
+		{Name: "is-cidr", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 7884, EndLine: 7889}}},
+		{Name: "is-data-descriptor", Version: "0.1.4", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 7891, EndLine: 7896}}},
+		{Name: "is-data-descriptor", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7898, EndLine: 7903}}},
+		{Name: "is-date-object", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 7905, EndLine: 7908}}},
+		{Name: "is-descriptor", Version: "0.1.6", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 7910, EndLine: 7917}}},
+		{Name: "is-descriptor", Version: "1.0.2", Constraint: "^1.0.0, ^1.0.2", Locations: []types.Location{{StartLine: 7919, EndLine: 7926}}},
+		{Name: "is-directory", Version: "0.3.1", Constraint: "^0.3.1", Locations: []types.Location{{StartLine: 7928, EndLine: 7931}}},
+		{Name: "is-dom", Version: "1.0.9", Constraint: "^1.0.9", Locations: []types.Location{{StartLine: 7933, EndLine: 7936}}},
+		{Name: "is-dotfile", Version: "1.0.3", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7938, EndLine: 7941}}},
+		{Name: "is-electron", Version: "2.2.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 7943, EndLine: 7946}}},
+		{Name: "is-equal-shallow", Version: "0.1.3", Constraint: "^0.1.3", Locations: []types.Location{{StartLine: 7948, EndLine: 7953}}},
+		{Name: "is-extendable", Version: "0.1.1", Constraint: "^0.1.0, ^0.1.1", Locations: []types.Location{{StartLine: 7955, EndLine: 7958}}},
+		{Name: "is-extendable", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 7960, EndLine: 7965}}},
+		{Name: "is-extglob", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7967, EndLine: 7970}}},
+		{Name: "is-extglob", Version: "2.1.1", Constraint: "^2.1.0, ^2.1.1", Locations: []types.Location{{StartLine: 7972, EndLine: 7975}}},
+		{Name: "is-finite", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7977, EndLine: 7982}}},
+		{Name: "is-fullwidth-code-point", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7984, EndLine: 7989}}},
+		{Name: "is-fullwidth-code-point", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 7991, EndLine: 7994}}},
+		{Name: "is-generator-fn", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 7996, EndLine: 7999}}},
+		{Name: "is-glob", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 8001, EndLine: 8006}}},
+		{Name: "is-glob", Version: "3.1.0", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 8008, EndLine: 8013}}},
+		{Name: "is-glob", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 8015, EndLine: 8020}}},
+		{Name: "is-in-browser", Version: "1.1.3", Constraint: "^1.0.2, ^1.1.3", Locations: []types.Location{{StartLine: 8022, EndLine: 8025}}},
+		{Name: "is-installed-globally", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 8027, EndLine: 8033}}},
+		{Name: "is-npm", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8035, EndLine: 8038}}},
+		{Name: "is-number", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8045, EndLine: 8050}}},
+		{Name: "is-number", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 8052, EndLine: 8057}}},
+		{Name: "is-number", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 8059, EndLine: 8062}}},
+		{Name: "is-number-object", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 8040, EndLine: 8043}}},
+		{Name: "is-obj", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 8064, EndLine: 8067}}},
+		{Name: "is-object", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 8069, EndLine: 8072}}},
+		{Name: "is-observable", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 8074, EndLine: 8079}}},
+		{Name: "is-path-cwd", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8081, EndLine: 8084}}},
+		{Name: "is-path-cwd", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8086, EndLine: 8089}}},
+		{Name: "is-path-in-cwd", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8091, EndLine: 8096}}},
+		{Name: "is-path-in-cwd", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8098, EndLine: 8103}}},
+		{Name: "is-path-inside", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8105, EndLine: 8110}}},
+		{Name: "is-path-inside", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8112, EndLine: 8117}}},
+		{Name: "is-plain-obj", Version: "1.1.0", Constraint: "^1.0.0, ^1.1.0", Locations: []types.Location{{StartLine: 8119, EndLine: 8122}}},
+		{Name: "is-plain-object", Version: "2.0.4", Constraint: "^2.0.1, ^2.0.3, ^2.0.4", Locations: []types.Location{{StartLine: 8124, EndLine: 8129}}},
+		{Name: "is-posix-bracket", Version: "0.1.1", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 8131, EndLine: 8134}}},
+		{Name: "is-primitive", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8136, EndLine: 8139}}},
+		{Name: "is-promise", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8141, EndLine: 8144}}},
+		{Name: "is-redirect", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8146, EndLine: 8149}}},
+		{Name: "is-regex", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 8151, EndLine: 8156}}},
+		{Name: "is-regexp", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8158, EndLine: 8161}}},
+		{Name: "is-retry-allowed", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8163, EndLine: 8166}}},
+		{Name: "is-root", Version: "2.0.0", Constraint: "2.0.0", Locations: []types.Location{{StartLine: 8168, EndLine: 8171}}},
+		{Name: "is-stream", Version: "1.1.0", Constraint: "^1.0.0, ^1.0.1, ^1.1.0", Locations: []types.Location{{StartLine: 8173, EndLine: 8176}}},
+		{Name: "is-string", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 8178, EndLine: 8181}}},
+		{Name: "is-subset", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 8183, EndLine: 8186}}},
+		{Name: "is-symbol", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 8188, EndLine: 8193}}},
+		{Name: "is-typedarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 8195, EndLine: 8198}}},
+		{Name: "is-utf8", Version: "0.2.1", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 8200, EndLine: 8203}}},
+		{Name: "is-windows", Version: "1.0.2", Constraint: "^1.0.1, ^1.0.2", Locations: []types.Location{{StartLine: 8205, EndLine: 8208}}},
+		{Name: "is-wsl", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 8210, EndLine: 8213}}},
+		{Name: "isarray", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 8215, EndLine: 8218}}},
+		{Name: "isarray", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 8220, EndLine: 8223}}},
+		{Name: "isarray", Version: "2.0.1", Constraint: "2.0.1", Locations: []types.Location{{StartLine: 8225, EndLine: 8228}}},
+		{Name: "isexe", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8230, EndLine: 8233}}},
+		{Name: "isobject", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8235, EndLine: 8240}}},
+		{Name: "isobject", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 8242, EndLine: 8245}}},
+		{Name: "isomorphic-fetch", Version: "2.2.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 8247, EndLine: 8253}}},
+		{Name: "isstream", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 8255, EndLine: 8258}}},
+		{Name: "istanbul-api", Version: "1.3.7", Constraint: "^1.3.1", Locations: []types.Location{{StartLine: 8260, EndLine: 8275}}},
+		{Name: "istanbul-lib-coverage", Version: "1.2.1", Constraint: "^1.2.0, ^1.2.1", Locations: []types.Location{{StartLine: 8277, EndLine: 8280}}},
+		{Name: "istanbul-lib-hook", Version: "1.2.2", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 8282, EndLine: 8287}}},
+		{Name: "istanbul-lib-instrument", Version: "1.10.2", Constraint: "^1.10.1, ^1.10.2", Locations: []types.Location{{StartLine: 8289, EndLine: 8300}}},
+		{Name: "istanbul-lib-report", Version: "1.1.5", Constraint: "^1.1.5", Locations: []types.Location{{StartLine: 8302, EndLine: 8310}}},
+		{Name: "istanbul-lib-source-maps", Version: "1.2.6", Constraint: "^1.2.4, ^1.2.6", Locations: []types.Location{{StartLine: 8312, EndLine: 8321}}},
+		{Name: "istanbul-reports", Version: "1.5.1", Constraint: "^1.5.1", Locations: []types.Location{{StartLine: 8323, EndLine: 8328}}},
+		{Name: "isurl", Version: "1.0.0", Constraint: "^1.0.0-alpha5", Locations: []types.Location{{StartLine: 8330, EndLine: 8336}}},
+		{Name: "jest", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8647, EndLine: 8653}}},
+		{Name: "jest-changed-files", Version: "23.4.2", Constraint: "^23.4.2", Locations: []types.Location{{StartLine: 8338, EndLine: 8343}}},
+		{Name: "jest-cli", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8345, EndLine: 8385}}},
+		{Name: "jest-config", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8387, EndLine: 8405}}},
+		{Name: "jest-diff", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8407, EndLine: 8415}}},
+		{Name: "jest-docblock", Version: "23.2.0", Constraint: "^23.2.0", Locations: []types.Location{{StartLine: 8417, EndLine: 8422}}},
+		{Name: "jest-each", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8424, EndLine: 8430}}},
+		{Name: "jest-environment-jsdom", Version: "23.4.0", Constraint: "^23.4.0", Locations: []types.Location{{StartLine: 8432, EndLine: 8439}}},
+		{Name: "jest-environment-node", Version: "23.4.0", Constraint: "^23.4.0", Locations: []types.Location{{StartLine: 8441, EndLine: 8447}}},
+		{Name: "jest-get-type", Version: "22.4.3", Constraint: "^22.1.0", Locations: []types.Location{{StartLine: 8449, EndLine: 8452}}},
+		{Name: "jest-haste-map", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8454, EndLine: 8466}}},
+		{Name: "jest-jasmine2", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8468, EndLine: 8484}}},
+		{Name: "jest-leak-detector", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8486, EndLine: 8491}}},
+		{Name: "jest-matcher-utils", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8493, EndLine: 8500}}},
+		{Name: "jest-message-util", Version: "23.4.0", Constraint: "^23.4.0", Locations: []types.Location{{StartLine: 8502, EndLine: 8511}}},
+		{Name: "jest-mock", Version: "23.2.0", Constraint: "^23.2.0", Locations: []types.Location{{StartLine: 8513, EndLine: 8516}}},
+		{Name: "jest-regex-util", Version: "23.3.0", Constraint: "^23.3.0", Locations: []types.Location{{StartLine: 8518, EndLine: 8521}}},
+		{Name: "jest-resolve", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8531, EndLine: 8538}}},
+		{Name: "jest-resolve-dependencies", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8523, EndLine: 8529}}},
+		{Name: "jest-runner", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8540, EndLine: 8557}}},
+		{Name: "jest-runtime", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8559, EndLine: 8584}}},
+		{Name: "jest-serializer", Version: "23.0.1", Constraint: "^23.0.1", Locations: []types.Location{{StartLine: 8586, EndLine: 8589}}},
+		{Name: "jest-snapshot", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 8591, EndLine: 8605}}},
+		{Name: "jest-util", Version: "23.4.0", Constraint: "^23.4.0", Locations: []types.Location{{StartLine: 8607, EndLine: 8619}}},
+		{Name: "jest-validate", Version: "23.6.0", Constraint: "^23.5.0, ^23.6.0", Locations: []types.Location{{StartLine: 8621, EndLine: 8629}}},
+		{Name: "jest-watcher", Version: "23.4.0", Constraint: "^23.4.0", Locations: []types.Location{{StartLine: 8631, EndLine: 8638}}},
+		{Name: "jest-worker", Version: "23.2.0", Constraint: "^23.2.0", Locations: []types.Location{{StartLine: 8640, EndLine: 8645}}},
+		{Name: "js-file-download", Version: "0.4.5", Constraint: "^0.4.5", Locations: []types.Location{{StartLine: 8655, EndLine: 8658}}},
+		{Name: "js-levenshtein", Version: "1.1.6", Constraint: "^1.1.3", Locations: []types.Location{{StartLine: 8660, EndLine: 8663}}},
+		{Name: "js-tokens", Version: "3.0.2", Constraint: "^3.0.0, ^3.0.2", Locations: []types.Location{{StartLine: 8665, EndLine: 8668}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0, ^4.0.0", Locations: []types.Location{{StartLine: 8670, EndLine: 8673}}},
+		{Name: "js-yaml", Version: "3.13.1", Constraint: "^3.13.0, ^3.13.1, ^3.7.0, ^3.9.0", Locations: []types.Location{{StartLine: 8675, EndLine: 8681}}},
+		{Name: "jsbn", Version: "0.1.1", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 8683, EndLine: 8686}}},
+		{Name: "jscodeshift", Version: "0.5.1", Constraint: "^0.5.1", Locations: []types.Location{{StartLine: 8688, EndLine: 8707}}},
+		{Name: "jsdom", Version: "11.12.0", Constraint: "^11.5.1", Locations: []types.Location{{StartLine: 8709, EndLine: 8739}}},
+		{Name: "jsesc", Version: "0.5.0", Constraint: "~0.5.0", Locations: []types.Location{{StartLine: 8751, EndLine: 8754}}},
+		{Name: "jsesc", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 8741, EndLine: 8744}}},
+		{Name: "jsesc", Version: "2.5.2", Constraint: "^2.5.1", Locations: []types.Location{{StartLine: 8746, EndLine: 8749}}},
+		{Name: "json-parse-better-errors", Version: "1.0.2", Constraint: "^1.0.0, ^1.0.1, ^1.0.2", Locations: []types.Location{{StartLine: 8756, EndLine: 8759}}},
+		{Name: "json-schema", Version: "0.2.3", Constraint: "0.2.3", Locations: []types.Location{{StartLine: 8766, EndLine: 8769}}},
+		{Name: "json-schema-traverse", Version: "0.4.1", Constraint: "^0.4.1", Locations: []types.Location{{StartLine: 8761, EndLine: 8764}}},
+		{Name: "json-stable-stringify-without-jsonify", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 8771, EndLine: 8774}}},
+		{Name: "json-stringify-safe", Version: "5.0.1", Constraint: "^5.0.1, ~5.0.1", Locations: []types.Location{{StartLine: 8776, EndLine: 8779}}},
+		{Name: "json3", Version: "3.3.2", Constraint: "^3.3.2", Locations: []types.Location{{StartLine: 8781, EndLine: 8784}}},
+		{Name: "json5", Version: "0.5.1", Constraint: "^0.5.0, ^0.5.1", Locations: []types.Location{{StartLine: 8786, EndLine: 8789}}},
+		{Name: "json5", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 8791, EndLine: 8796}}},
+		{Name: "json5", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8798, EndLine: 8803}}},
+		{Name: "jsonfile", Version: "2.4.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8805, EndLine: 8810}}},
+		{Name: "jsonfile", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 8812, EndLine: 8817}}},
+		{Name: "jsonify", Version: "0.0.0", Constraint: "~0.0.0", Locations: []types.Location{{StartLine: 8819, EndLine: 8822}}},
+		{Name: "jsonparse", Version: "1.3.1", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 8824, EndLine: 8827}}},
+		{Name: "jsprim", Version: "1.4.1", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 8829, EndLine: 8837}}},
+		{Name: "jss", Version: "9.8.7", Constraint: "^9.8.7", Locations: []types.Location{{StartLine: 8875, EndLine: 8882}}},
+		{Name: "jss-camel-case", Version: "6.1.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 8839, EndLine: 8844}}},
+		{Name: "jss-default-unit", Version: "8.0.2", Constraint: "^8.0.2", Locations: []types.Location{{StartLine: 8846, EndLine: 8849}}},
+		{Name: "jss-global", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 8851, EndLine: 8854}}},
+		{Name: "jss-nested", Version: "6.0.1", Constraint: "^6.0.1", Locations: []types.Location{{StartLine: 8856, EndLine: 8861}}},
+		{Name: "jss-props-sort", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 8863, EndLine: 8866}}},
+		{Name: "jss-vendor-prefixer", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 8868, EndLine: 8873}}},
+		{Name: "jsx-ast-utils", Version: "2.1.0", Constraint: "^2.0.1, ^2.1.0", Locations: []types.Location{{StartLine: 8884, EndLine: 8889}}},
+		{Name: "keycode", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 8891, EndLine: 8894}}},
+		{Name: "killable", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 8896, EndLine: 8899}}},
+		{Name: "kind-of", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 8901, EndLine: 8906}}},
+		{Name: "kind-of", Version: "3.2.2", Constraint: "^3.0.2, ^3.0.3, ^3.2.0", Locations: []types.Location{{StartLine: 8908, EndLine: 8913}}},
+		{Name: "kind-of", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 8915, EndLine: 8920}}},
+		{Name: "kind-of", Version: "5.1.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 8922, EndLine: 8925}}},
+		{Name: "kind-of", Version: "6.0.2", Constraint: "^6.0.0, ^6.0.2", Locations: []types.Location{{StartLine: 8927, EndLine: 8930}}},
+		{Name: "klaw", Version: "1.3.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8932, EndLine: 8937}}},
+		{Name: "kleur", Version: "2.0.2", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 8939, EndLine: 8942}}},
+		{Name: "latest-version", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 8944, EndLine: 8949}}},
+		{Name: "lazy-cache", Version: "0.2.7", Constraint: "^0.2.3", Locations: []types.Location{{StartLine: 8951, EndLine: 8954}}},
+		{Name: "lazy-cache", Version: "1.0.4", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 8956, EndLine: 8959}}},
+		{Name: "lazy-property", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 8961, EndLine: 8964}}},
+		{Name: "lazy-universal-dotenv", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8966, EndLine: 8975}}},
+		{Name: "lcid", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 8977, EndLine: 8982}}},
+		{Name: "lcid", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 8984, EndLine: 8989}}},
+		{Name: "left-pad", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 8991, EndLine: 8994}}},
+		{Name: "leven", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 8996, EndLine: 8999}}},
+		{Name: "levn", Version: "0.3.0", Constraint: "^0.3.0, ~0.3.0", Locations: []types.Location{{StartLine: 9001, EndLine: 9007}}},
+		{Name: "libcipm", Version: "3.0.3", Constraint: "^3.0.3", Locations: []types.Location{{StartLine: 9009, EndLine: 9028}}},
+		{Name: "libnpm", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 9030, EndLine: 9054}}},
+		{Name: "libnpmaccess", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 9056, EndLine: 9064}}},
+		{Name: "libnpmconfig", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 9066, EndLine: 9073}}},
+		{Name: "libnpmhook", Version: "5.0.2", Constraint: "^5.0.2", Locations: []types.Location{{StartLine: 9075, EndLine: 9083}}},
+		{Name: "libnpmorg", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9085, EndLine: 9093}}},
+		{Name: "libnpmpublish", Version: "1.1.1", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 9095, EndLine: 9108}}},
+		{Name: "libnpmsearch", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9110, EndLine: 9117}}},
+		{Name: "libnpmteam", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 9119, EndLine: 9127}}},
+		{Name: "libnpx", Version: "10.2.0", Constraint: "^10.2.0", Locations: []types.Location{{StartLine: 9129, EndLine: 9141}}},
+		{Name: "linear-layout-vector", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 9143, EndLine: 9146}}},
+		{Name: "lint-staged", Version: "7.3.0", Constraint: "^7.1.3", Locations: []types.Location{{StartLine: 9148, EndLine: 9174}}},
+		{Name: "listenercount", Version: "1.0.1", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 9176, EndLine: 9179}}},
+		{Name: "listr", Version: "0.14.3", Constraint: "^0.14.1", Locations: []types.Location{{StartLine: 9210, EndLine: 9223}}},
+		{Name: "listr-silent-renderer", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 9181, EndLine: 9184}}},
+		{Name: "listr-update-renderer", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 9186, EndLine: 9198}}},
+		{Name: "listr-verbose-renderer", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 9200, EndLine: 9208}}},
+		{Name: "load-json-file", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9225, EndLine: 9234}}},
+		{Name: "load-json-file", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9236, EndLine: 9244}}},
+		{Name: "load-json-file", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 9246, EndLine: 9254}}},
+		{Name: "load-script", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9256, EndLine: 9259}}},
+		{Name: "loader-fs-cache", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9261, EndLine: 9267}}},
+		{Name: "loader-runner", Version: "2.4.0", Constraint: "^2.3.0", Locations: []types.Location{{StartLine: 9269, EndLine: 9272}}},
+		{Name: "loader-utils", Version: "0.2.17", Constraint: "^0.2.16", Locations: []types.Location{{StartLine: 9283, EndLine: 9291}}},
+		{Name: "loader-utils", Version: "1.1.0", Constraint: "1.1.0", Locations: []types.Location{{StartLine: 9274, EndLine: 9281}}},
+		{Name: "loader-utils", Version: "1.2.3", Constraint: "^1.0.2, ^1.1.0, ^1.2.3", Locations: []types.Location{{StartLine: 9293, EndLine: 9300}}},
+		{Name: "locate-path", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9302, EndLine: 9308}}},
+		{Name: "locate-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 9310, EndLine: 9316}}},
+		{Name: "lock-verify", Version: "2.1.0", Constraint: "^2.0.2, ^2.1.0", Locations: []types.Location{{StartLine: 9318, EndLine: 9324}}},
+		{Name: "lockfile", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 9326, EndLine: 9331}}},
+		{Name: "lodash", Version: "3.10.1", Constraint: "^3.3.1", Locations: []types.Location{{StartLine: 9421, EndLine: 9424}}},
+		{Name: "lodash", Version: "4.17.11", Constraint: "4.17.11, ^4.0.1, ^4.13.1, ^4.15.0, ^4.17.10, ^4.17.11, ^4.17.3, ^4.17.4, ^4.17.5, ^4.2.0", Locations: []types.Location{{StartLine: 9416, EndLine: 9419}}},
+		{Name: "lodash-es", Version: "4.17.11", Constraint: "^4.17.11", Locations: []types.Location{{StartLine: 9333, EndLine: 9336}}},
+		{Name: "lodash._baseuniq", Version: "4.6.0", Constraint: "~4.6.0", Locations: []types.Location{{StartLine: 9338, EndLine: 9344}}},
+		{Name: "lodash._createset", Version: "4.0.3", Constraint: "~4.0.0", Locations: []types.Location{{StartLine: 9346, EndLine: 9349}}},
+		{Name: "lodash._root", Version: "3.0.1", Constraint: "~3.0.0", Locations: []types.Location{{StartLine: 9351, EndLine: 9354}}},
+		{Name: "lodash.assign", Version: "4.2.0", Constraint: "^4.0.3, ^4.0.6", Locations: []types.Location{{StartLine: 9356, EndLine: 9359}}},
+		{Name: "lodash.clonedeep", Version: "4.5.0", Constraint: "^4.5.0, ~4.5.0", Locations: []types.Location{{StartLine: 9361, EndLine: 9364}}},
+		{Name: "lodash.escape", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 9366, EndLine: 9369}}},
+		{Name: "lodash.flattendeep", Version: "4.4.0", Constraint: "^4.4.0", Locations: []types.Location{{StartLine: 9371, EndLine: 9374}}},
+		{Name: "lodash.isequal", Version: "4.5.0", Constraint: "^4.5.0", Locations: []types.Location{{StartLine: 9376, EndLine: 9379}}},
+		{Name: "lodash.isplainobject", Version: "4.0.6", Constraint: "^4.0.6", Locations: []types.Location{{StartLine: 9381, EndLine: 9384}}},
+		{Name: "lodash.merge", Version: "4.6.1", Constraint: "^4.6.1", Locations: []types.Location{{StartLine: 9386, EndLine: 9389}}},
+		{Name: "lodash.some", Version: "4.6.0", Constraint: "^4.6.0", Locations: []types.Location{{StartLine: 9391, EndLine: 9394}}},
+		{Name: "lodash.sortby", Version: "4.7.0", Constraint: "^4.7.0", Locations: []types.Location{{StartLine: 9396, EndLine: 9399}}},
+		{Name: "lodash.union", Version: "4.6.0", Constraint: "~4.6.0", Locations: []types.Location{{StartLine: 9401, EndLine: 9404}}},
+		{Name: "lodash.uniq", Version: "4.5.0", Constraint: "~4.5.0", Locations: []types.Location{{StartLine: 9406, EndLine: 9409}}},
+		{Name: "lodash.without", Version: "4.4.0", Constraint: "~4.4.0", Locations: []types.Location{{StartLine: 9411, EndLine: 9414}}},
+		{Name: "log-symbols", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 9426, EndLine: 9431}}},
+		{Name: "log-symbols", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 9433, EndLine: 9438}}},
+		{Name: "log-update", Version: "2.3.0", Constraint: "^2.3.0", Locations: []types.Location{{StartLine: 9440, EndLine: 9447}}},
+		{Name: "loglevel", Version: "1.6.1", Constraint: "^1.6.1", Locations: []types.Location{{StartLine: 9449, EndLine: 9452}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.0.0, ^1.1.0, ^1.2.0, ^1.3.0, ^1.3.1, ^1.4.0", Locations: []types.Location{{StartLine: 9454, EndLine: 9459}}},
+		{Name: "lower-case", Version: "1.1.4", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 9461, EndLine: 9464}}},
+		{Name: "lowercase-keys", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9466, EndLine: 9469}}},
+		{Name: "lru-cache", Version: "4.1.5", Constraint: "^4.0.1, ^4.1.1, ^4.1.2, ^4.1.3, ^4.1.5", Locations: []types.Location{{StartLine: 9471, EndLine: 9477}}},
+		{Name: "lru-cache", Version: "5.1.1", Constraint: "^5.1.1", Locations: []types.Location{{StartLine: 9479, EndLine: 9484}}},
+		{Name: "macos-release", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 9486, EndLine: 9489}}},
+		{Name: "make-dir", Version: "1.3.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9491, EndLine: 9496}}},
+		{Name: "make-dir", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9498, EndLine: 9504}}},
+		{Name: "make-error", Version: "1.3.5", Constraint: "^1.3.5", Locations: []types.Location{{StartLine: 9506, EndLine: 9509}}},
+		{Name: "make-fetch-happen", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 9511, EndLine: 9526}}},
+		{Name: "makeerror", Version: "1.0.11", Constraint: "1.0.x", Locations: []types.Location{{StartLine: 9528, EndLine: 9533}}},
+		{Name: "mamacro", Version: "0.0.3", Constraint: "^0.0.3", Locations: []types.Location{{StartLine: 9535, EndLine: 9538}}},
+		{Name: "map-age-cleaner", Version: "0.1.3", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 9540, EndLine: 9545}}},
+		{Name: "map-cache", Version: "0.2.2", Constraint: "^0.2.2", Locations: []types.Location{{StartLine: 9547, EndLine: 9550}}},
+		{Name: "map-visit", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9552, EndLine: 9557}}},
+		{Name: "marked", Version: "0.3.19", Constraint: "^0.3.12", Locations: []types.Location{{StartLine: 9559, EndLine: 9562}}},
+		{Name: "marksy", Version: "6.1.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 9564, EndLine: 9571}}},
+		{Name: "material-colors", Version: "1.2.6", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 9573, EndLine: 9576}}},
+		{Name: "math-random", Version: "1.0.4", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 9578, EndLine: 9581}}},
+		{Name: "md5", Version: "2.2.1", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 9592, EndLine: 9599}}},
+		{Name: "md5.js", Version: "1.3.5", Constraint: "^1.3.4", Locations: []types.Location{{StartLine: 9583, EndLine: 9590}}},
+		{Name: "mdn-data", Version: "1.1.4", Constraint: "~1.1.0", Locations: []types.Location{{StartLine: 9601, EndLine: 9604}}},
+		{Name: "meant", Version: "1.0.1", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 9606, EndLine: 9609}}},
+		{Name: "media-typer", Version: "0.3.0", Constraint: "0.3.0", Locations: []types.Location{{StartLine: 9611, EndLine: 9614}}},
+		{Name: "mem", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 9616, EndLine: 9621}}},
+		{Name: "mem", Version: "4.3.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 9623, EndLine: 9630}}},
+		{Name: "memoize-one", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 9632, EndLine: 9635}}},
+		{Name: "memory-fs", Version: "0.4.1", Constraint: "^0.4.0, ^0.4.1, ~0.4.1", Locations: []types.Location{{StartLine: 9637, EndLine: 9643}}},
+		{Name: "memorystream", Version: "0.3.1", Constraint: "^0.3.1", Locations: []types.Location{{StartLine: 9645, EndLine: 9648}}},
+		{Name: "merge", Version: "1.2.1", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 9686, EndLine: 9689}}},
+		{Name: "merge-deep", Version: "3.0.2", Constraint: "^3.0.2", Locations: []types.Location{{StartLine: 9650, EndLine: 9657}}},
+		{Name: "merge-descriptors", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 9659, EndLine: 9662}}},
+		{Name: "merge-dirs", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 9664, EndLine: 9672}}},
+		{Name: "merge-stream", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 9674, EndLine: 9679}}},
+		{Name: "merge2", Version: "1.2.3", Constraint: "^1.2.3", Locations: []types.Location{{StartLine: 9681, EndLine: 9684}}},
+		{Name: "methods", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 9691, EndLine: 9694}}},
+		{Name: "micromatch", Version: "2.3.11", Constraint: "^2.1.5, ^2.3.11, ^2.3.7", Locations: []types.Location{{StartLine: 9696, EndLine: 9713}}},
+		{Name: "micromatch", Version: "3.1.10", Constraint: "^3.0.4, ^3.1.10, ^3.1.4, ^3.1.8", Locations: []types.Location{{StartLine: 9715, EndLine: 9732}}},
+		{Name: "miller-rabin", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 9734, EndLine: 9740}}},
+		{Name: "mime", Version: "1.4.1", Constraint: "1.4.1", Locations: []types.Location{{StartLine: 9754, EndLine: 9757}}},
+		{Name: "mime", Version: "2.4.2", Constraint: "^2.0.3, ^2.4.2", Locations: []types.Location{{StartLine: 9759, EndLine: 9762}}},
+		{Name: "mime-db", Version: "1.40.0", Constraint: "1.40.0, >= 1.40.0 < 2", Locations: []types.Location{{StartLine: 9742, EndLine: 9745}}},
+		{Name: "mime-types", Version: "2.1.24", Constraint: "^2.1.12, ~2.1.17, ~2.1.19, ~2.1.24", Locations: []types.Location{{StartLine: 9747, EndLine: 9752}}},
+		{Name: "mimic-fn", Version: "1.2.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9764, EndLine: 9767}}},
+		{Name: "mimic-fn", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9769, EndLine: 9772}}},
+		{Name: "mimic-response", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 9774, EndLine: 9777}}},
+		{Name: "min-document", Version: "2.19.0", Constraint: "^2.19.0", Locations: []types.Location{{StartLine: 9779, EndLine: 9784}}},
+		{Name: "mini-css-extract-plugin", Version: "0.4.5", Constraint: "^0.4.4", Locations: []types.Location{{StartLine: 9786, EndLine: 9793}}},
+		{Name: "minimalistic-assert", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 9795, EndLine: 9798}}},
+		{Name: "minimalistic-crypto-utils", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 9800, EndLine: 9803}}},
+		{Name: "minimatch", Version: "3.0.4", Constraint: "3.0.4, ^3.0.2, ^3.0.3, ^3.0.4", Locations: []types.Location{{StartLine: 9805, EndLine: 9810}}},
+		{Name: "minimist", Version: "0.0.10", Constraint: "~0.0.1", Locations: []types.Location{{StartLine: 9822, EndLine: 9825}}},
+		{Name: "minimist", Version: "0.0.8", Constraint: "0.0.8", Locations: []types.Location{{StartLine: 9812, EndLine: 9815}}},
+		{Name: "minimist", Version: "1.2.0", Constraint: "^1.1.1, ^1.2.0", Locations: []types.Location{{StartLine: 9817, EndLine: 9820}}},
+		{Name: "minipass", Version: "2.3.5", Constraint: "^2.2.1, ^2.3.4, ^2.3.5", Locations: []types.Location{{StartLine: 9827, EndLine: 9833}}},
+		{Name: "minizlib", Version: "1.2.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 9835, EndLine: 9840}}},
+		{Name: "mississippi", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 9842, EndLine: 9856}}},
+		{Name: "mississippi", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 9858, EndLine: 9872}}},
+		{Name: "mixin-deep", Version: "1.3.1", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 9874, EndLine: 9880}}},
+		{Name: "mixin-object", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 9882, EndLine: 9888}}},
+		{Name: "mkdirp", Version: "0.5.1", Constraint: "0.5.1, 0.5.x, >=0.5 0, ^0.5.0, ^0.5.1, ~0.5.0, ~0.5.1", Locations: []types.Location{{StartLine: 9890, EndLine: 9895}}},
+		{Name: "moment", Version: "2.23.0", Constraint: "2.23.0", Locations: []types.Location{{StartLine: 9904, EndLine: 9907}}},
+		{Name: "moment", Version: "2.24.0", Constraint: ">= 2.9.0", Locations: []types.Location{{StartLine: 9909, EndLine: 9912}}},
+		{Name: "moment-timezone", Version: "0.5.23", Constraint: "0.5.23", Locations: []types.Location{{StartLine: 9897, EndLine: 9902}}},
+		{Name: "moo", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 9914, EndLine: 9917}}},
+		{Name: "move-concurrently", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 9919, EndLine: 9929}}},
+		{Name: "ms", Version: "2.0.0", Constraint: "2.0.0", Locations: []types.Location{{StartLine: 9931, EndLine: 9934}}},
+		{Name: "ms", Version: "2.1.1", Constraint: "2.1.1, ^2.0.0, ^2.1.1", Locations: []types.Location{{StartLine: 9936, EndLine: 9939}}},
+		{Name: "multicast-dns", Version: "6.2.3", Constraint: "^6.0.1", Locations: []types.Location{{StartLine: 9946, EndLine: 9952}}},
+		{Name: "multicast-dns-service-types", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 9941, EndLine: 9944}}},
+		{Name: "mute-stream", Version: "0.0.5", Constraint: "0.0.5", Locations: []types.Location{{StartLine: 9954, EndLine: 9957}}},
+		{Name: "mute-stream", Version: "0.0.7", Constraint: "0.0.7", Locations: []types.Location{{StartLine: 9959, EndLine: 9962}}},
+		{Name: "mute-stream", Version: "0.0.8", Constraint: "~0.0.4", Locations: []types.Location{{StartLine: 9964, EndLine: 9967}}},
+		{Name: "nan", Version: "2.13.2", Constraint: "^2.12.1", Locations: []types.Location{{StartLine: 9969, EndLine: 9972}}},
+		{Name: "nanomatch", Version: "1.2.13", Constraint: "^1.2.9", Locations: []types.Location{{StartLine: 9974, EndLine: 9989}}},
+		{Name: "natural-compare", Version: "1.4.0", Constraint: "^1.4.0", Locations: []types.Location{{StartLine: 9991, EndLine: 9994}}},
+		{Name: "nearley", Version: "2.16.0", Constraint: "^2.7.10", Locations: []types.Location{{StartLine: 9996, EndLine: 10005}}},
+		{Name: "needle", Version: "2.4.0", Constraint: "^2.2.1", Locations: []types.Location{{StartLine: 10007, EndLine: 10014}}},
+		{Name: "negotiator", Version: "0.6.2", Constraint: "0.6.2", Locations: []types.Location{{StartLine: 10016, EndLine: 10019}}},
+		{Name: "neo-async", Version: "2.6.1", Constraint: "^2.5.0, ^2.6.0", Locations: []types.Location{{StartLine: 10021, EndLine: 10024}}},
+		{Name: "nested-object-assign", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 10026, EndLine: 10029}}},
+		{Name: "nice-try", Version: "1.0.5", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 10031, EndLine: 10034}}},
+		{Name: "no-case", Version: "2.3.2", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 10036, EndLine: 10041}}},
+		{Name: "node-dir", Version: "0.1.17", Constraint: "^0.1.10", Locations: []types.Location{{StartLine: 10048, EndLine: 10053}}},
+		{Name: "node-dir", Version: "0.1.8", Constraint: "0.1.8", Locations: []types.Location{{StartLine: 10043, EndLine: 10046}}},
+		{Name: "node-fetch", Version: "1.7.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 10064, EndLine: 10070}}},
+		{Name: "node-fetch", Version: "2.5.0", Constraint: "^2.1.1, ^2.2.0", Locations: []types.Location{{StartLine: 10072, EndLine: 10075}}},
+		{Name: "node-fetch-npm", Version: "2.0.2", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 10055, EndLine: 10062}}},
+		{Name: "node-forge", Version: "0.7.5", Constraint: "0.7.5", Locations: []types.Location{{StartLine: 10077, EndLine: 10080}}},
+		{Name: "node-fs", Version: "0.1.7", Constraint: "~0.1.7", Locations: []types.Location{{StartLine: 10082, EndLine: 10085}}},
+		{Name: "node-gyp", Version: "3.8.0", Constraint: "^3.8.0", Locations: []types.Location{{StartLine: 10087, EndLine: 10103}}},
+		{Name: "node-gyp", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 10105, EndLine: 10120}}},
+		{Name: "node-int64", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 10122, EndLine: 10125}}},
+		{Name: "node-libs-browser", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10127, EndLine: 10154}}},
+		{Name: "node-modules-regexp", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10156, EndLine: 10159}}},
+		{Name: "node-notifier", Version: "5.4.0", Constraint: "^5.2.1", Locations: []types.Location{{StartLine: 10161, EndLine: 10170}}},
+		{Name: "node-object-hash", Version: "1.4.2", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 10172, EndLine: 10175}}},
+		{Name: "node-pre-gyp", Version: "0.12.0", Constraint: "^0.12.0", Locations: []types.Location{{StartLine: 10177, EndLine: 10191}}},
+		{Name: "node-releases", Version: "1.1.19", Constraint: "^1.0.0-alpha.11, ^1.1.19", Locations: []types.Location{{StartLine: 10193, EndLine: 10198}}},
+		{Name: "node-version", Version: "1.2.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10200, EndLine: 10203}}},
+		{Name: "nomnom", Version: "1.8.1", Constraint: "^1.8.1", Locations: []types.Location{{StartLine: 10205, EndLine: 10211}}},
+		{Name: "nopt", Version: "3.0.6", Constraint: "2 || 3", Locations: []types.Location{{StartLine: 10213, EndLine: 10218}}},
+		{Name: "nopt", Version: "4.0.1", Constraint: "^4.0.1, ~4.0.1", Locations: []types.Location{{StartLine: 10220, EndLine: 10226}}},
+		{Name: "normalize-package-data", Version: "2.5.0", Constraint: "^2.0.0, ^2.3.2, ^2.4.0, ^2.5.0", Locations: []types.Location{{StartLine: 10228, EndLine: 10236}}},
+		{Name: "normalize-path", Version: "2.1.1", Constraint: "^2.0.0, ^2.0.1, ^2.1.1", Locations: []types.Location{{StartLine: 10238, EndLine: 10243}}},
+		{Name: "normalize-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 10245, EndLine: 10248}}},
+		{Name: "normalize-range", Version: "0.1.2", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 10250, EndLine: 10253}}},
+		{Name: "normalize-scroll-left", Version: "0.1.2", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 10255, EndLine: 10258}}},
+		{Name: "npm", Version: "6.9.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 10395, EndLine: 10507}}},
+		{Name: "npm-audit-report", Version: "1.3.2", Constraint: "^1.3.2", Locations: []types.Location{{StartLine: 10260, EndLine: 10266}}},
+		{Name: "npm-bundled", Version: "1.0.6", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 10268, EndLine: 10271}}},
+		{Name: "npm-cache-filename", Version: "1.0.2", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 10273, EndLine: 10276}}},
+		{Name: "npm-install-checks", Version: "3.0.0", Constraint: "~3.0.0", Locations: []types.Location{{StartLine: 10278, EndLine: 10283}}},
+		{Name: "npm-lifecycle", Version: "2.1.1", Constraint: "^2.0.3, ^2.1.0", Locations: []types.Location{{StartLine: 10285, EndLine: 10297}}},
+		{Name: "npm-logical-tree", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 10299, EndLine: 10302}}},
+		{Name: "npm-package-arg", Version: "6.1.0", Constraint: "^4.0.0 || ^5.0.0 || ^6.0.0, ^6.0.0, ^6.1.0", Locations: []types.Location{{StartLine: 10304, EndLine: 10312}}},
+		{Name: "npm-packlist", Version: "1.4.1", Constraint: "^1.1.12, ^1.1.6, ^1.4.1", Locations: []types.Location{{StartLine: 10314, EndLine: 10320}}},
+		{Name: "npm-path", Version: "2.0.4", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 10322, EndLine: 10327}}},
+		{Name: "npm-pick-manifest", Version: "2.2.3", Constraint: "^2.2.3", Locations: []types.Location{{StartLine: 10329, EndLine: 10336}}},
+		{Name: "npm-profile", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 10338, EndLine: 10345}}},
+		{Name: "npm-registry-fetch", Version: "3.9.0", Constraint: "^3.8.0, ^3.9.0", Locations: []types.Location{{StartLine: 10347, EndLine: 10357}}},
+		{Name: "npm-run-all", Version: "4.1.5", Constraint: "^4.1.3", Locations: []types.Location{{StartLine: 10359, EndLine: 10372}}},
+		{Name: "npm-run-path", Version: "2.0.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10374, EndLine: 10379}}},
+		{Name: "npm-user-validate", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 10381, EndLine: 10384}}},
+		{Name: "npm-which", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 10386, EndLine: 10393}}},
+		{Name: "npmlog", Version: "4.1.2", Constraint: "0 || 1 || 2 || 3 || 4, ^4.0.2, ^4.1.2, ~4.1.2", Locations: []types.Location{{StartLine: 10509, EndLine: 10517}}},
+		{Name: "nth-check", Version: "1.0.2", Constraint: "^1.0.2, ~1.0.1", Locations: []types.Location{{StartLine: 10519, EndLine: 10524}}},
+		{Name: "num2fraction", Version: "1.2.2", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 10526, EndLine: 10529}}},
+		{Name: "number-is-nan", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10531, EndLine: 10534}}},
+		{Name: "nwsapi", Version: "2.1.4", Constraint: "^2.0.7", Locations: []types.Location{{StartLine: 10536, EndLine: 10539}}},
+		{Name: "oauth-sign", Version: "0.9.0", Constraint: "~0.9.0", Locations: []types.Location{{StartLine: 10541, EndLine: 10544}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.0.1, ^4.1.0, ^4.1.1", Locations: []types.Location{{StartLine: 10546, EndLine: 10549}}},
+		{Name: "object-component", Version: "0.0.3", Constraint: "0.0.3", Locations: []types.Location{{StartLine: 10551, EndLine: 10554}}},
+		{Name: "object-copy", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 10556, EndLine: 10563}}},
+		{Name: "object-hash", Version: "1.3.1", Constraint: "^1.1.4", Locations: []types.Location{{StartLine: 10565, EndLine: 10568}}},
+		{Name: "object-inspect", Version: "1.6.0", Constraint: "^1.6.0", Locations: []types.Location{{StartLine: 10570, EndLine: 10573}}},
+		{Name: "object-is", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 10575, EndLine: 10578}}},
+		{Name: "object-keys", Version: "1.1.1", Constraint: "^1.0.11, ^1.0.12", Locations: []types.Location{{StartLine: 10580, EndLine: 10583}}},
+		{Name: "object-visit", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10585, EndLine: 10590}}},
+		{Name: "object.assign", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 10592, EndLine: 10600}}},
+		{Name: "object.entries", Version: "1.1.0", Constraint: "^1.0.4, ^1.1.0", Locations: []types.Location{{StartLine: 10602, EndLine: 10610}}},
+		{Name: "object.fromentries", Version: "2.0.0", Constraint: "^2.0.0, ^2.0.0 || ^1.0.0", Locations: []types.Location{{StartLine: 10612, EndLine: 10620}}},
+		{Name: "object.getownpropertydescriptors", Version: "2.0.3", Constraint: "^2.0.3", Locations: []types.Location{{StartLine: 10622, EndLine: 10628}}},
+		{Name: "object.omit", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10630, EndLine: 10636}}},
+		{Name: "object.pick", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 10638, EndLine: 10643}}},
+		{Name: "object.values", Version: "1.1.0", Constraint: "^1.0.4, ^1.1.0", Locations: []types.Location{{StartLine: 10645, EndLine: 10653}}},
+		{Name: "obuf", Version: "1.1.2", Constraint: "^1.0.0, ^1.1.2", Locations: []types.Location{{StartLine: 10655, EndLine: 10658}}},
+		{Name: "on-finished", Version: "2.3.0", Constraint: "~2.3.0", Locations: []types.Location{{StartLine: 10660, EndLine: 10665}}},
+		{Name: "on-headers", Version: "1.0.2", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 10667, EndLine: 10670}}},
+		{Name: "once", Version: "1.4.0", Constraint: "^1.3.0, ^1.3.1, ^1.4.0, ~1.4.0", Locations: []types.Location{{StartLine: 10672, EndLine: 10677}}},
+		{Name: "onetime", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10679, EndLine: 10682}}},
+		{Name: "onetime", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10684, EndLine: 10689}}},
+		{Name: "opener", Version: "1.5.1", Constraint: "^1.5.1", Locations: []types.Location{{StartLine: 10691, EndLine: 10694}}},
+		{Name: "opn", Version: "5.4.0", Constraint: "5.4.0", Locations: []types.Location{{StartLine: 10696, EndLine: 10701}}},
+		{Name: "opn", Version: "5.5.0", Constraint: "^5.4.0, ^5.5.0", Locations: []types.Location{{StartLine: 10703, EndLine: 10708}}},
+		{Name: "optimist", Version: "0.6.1", Constraint: "^0.6.1", Locations: []types.Location{{StartLine: 10710, EndLine: 10716}}},
+		{Name: "optionator", Version: "0.8.2", Constraint: "^0.8.1, ^0.8.2", Locations: []types.Location{{StartLine: 10718, EndLine: 10728}}},
+		{Name: "original", Version: "1.0.2", Constraint: ">=0.0.5, ^1.0.0", Locations: []types.Location{{StartLine: 10730, EndLine: 10735}}},
+		{Name: "os-browserify", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 10737, EndLine: 10740}}},
+		{Name: "os-homedir", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10742, EndLine: 10745}}},
+		{Name: "os-locale", Version: "1.4.0", Constraint: "^1.4.0", Locations: []types.Location{{StartLine: 10747, EndLine: 10752}}},
+		{Name: "os-locale", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10754, EndLine: 10761}}},
+		{Name: "os-locale", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 10763, EndLine: 10770}}},
+		{Name: "os-name", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 10772, EndLine: 10778}}},
+		{Name: "os-tmpdir", Version: "1.0.2", Constraint: "^1.0.0, ^1.0.1, ~1.0.2", Locations: []types.Location{{StartLine: 10780, EndLine: 10783}}},
+		{Name: "osenv", Version: "0.1.5", Constraint: "0, ^0.1.4, ^0.1.5", Locations: []types.Location{{StartLine: 10785, EndLine: 10791}}},
+		{Name: "output-file-sync", Version: "1.1.2", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 10793, EndLine: 10800}}},
+		{Name: "p-cancelable", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 10802, EndLine: 10805}}},
+		{Name: "p-defer", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10807, EndLine: 10810}}},
+		{Name: "p-finally", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10812, EndLine: 10815}}},
+		{Name: "p-is-promise", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10817, EndLine: 10820}}},
+		{Name: "p-limit", Version: "1.3.0", Constraint: "^1.0.0, ^1.1.0", Locations: []types.Location{{StartLine: 10822, EndLine: 10827}}},
+		{Name: "p-limit", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10829, EndLine: 10834}}},
+		{Name: "p-locate", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10836, EndLine: 10841}}},
+		{Name: "p-locate", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 10843, EndLine: 10848}}},
+		{Name: "p-map", Version: "1.2.0", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 10850, EndLine: 10853}}},
+		{Name: "p-map", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10855, EndLine: 10858}}},
+		{Name: "p-timeout", Version: "1.2.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 10860, EndLine: 10865}}},
+		{Name: "p-try", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10867, EndLine: 10870}}},
+		{Name: "p-try", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 10872, EndLine: 10875}}},
+		{Name: "package-json", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 10877, EndLine: 10885}}},
+		{Name: "pacote", Version: "9.5.0", Constraint: "^9.1.0, ^9.2.3, ^9.5.0", Locations: []types.Location{{StartLine: 10887, EndLine: 10918}}},
+		{Name: "pako", Version: "1.0.10", Constraint: "~1.0.5", Locations: []types.Location{{StartLine: 10920, EndLine: 10923}}},
+		{Name: "parallel-transform", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 10925, EndLine: 10932}}},
+		{Name: "param-case", Version: "2.1.1", Constraint: "2.1.x", Locations: []types.Location{{StartLine: 10934, EndLine: 10939}}},
+		{Name: "parent-module", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10941, EndLine: 10946}}},
+		{Name: "parse-asn1", Version: "5.1.4", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 10948, EndLine: 10958}}},
+		{Name: "parse-glob", Version: "3.0.4", Constraint: "^3.0.4", Locations: []types.Location{{StartLine: 10960, EndLine: 10968}}},
+		{Name: "parse-json", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 10970, EndLine: 10975}}},
+		{Name: "parse-json", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 10977, EndLine: 10983}}},
+		{Name: "parse-passwd", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 10985, EndLine: 10988}}},
+		{Name: "parse5", Version: "3.0.3", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 10995, EndLine: 11000}}},
+		{Name: "parse5", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 10990, EndLine: 10993}}},
+		{Name: "parse5", Version: "5.1.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 11002, EndLine: 11005}}},
+		{Name: "parseqs", Version: "0.0.5", Constraint: "0.0.5", Locations: []types.Location{{StartLine: 11007, EndLine: 11012}}},
+		{Name: "parseuri", Version: "0.0.5", Constraint: "0.0.5", Locations: []types.Location{{StartLine: 11014, EndLine: 11019}}},
+		{Name: "parseurl", Version: "1.3.3", Constraint: "~1.3.2", Locations: []types.Location{{StartLine: 11021, EndLine: 11024}}},
+		{Name: "pascalcase", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 11026, EndLine: 11029}}},
+		{Name: "path", Version: "0.12.7", Constraint: "^0.12.7", Locations: []types.Location{{StartLine: 11108, EndLine: 11114}}},
+		{Name: "path-browserify", Version: "0.0.0", Constraint: "0.0.0", Locations: []types.Location{{StartLine: 11031, EndLine: 11034}}},
+		{Name: "path-dirname", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 11036, EndLine: 11039}}},
+		{Name: "path-exists", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11041, EndLine: 11046}}},
+		{Name: "path-exists", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11048, EndLine: 11051}}},
+		{Name: "path-is-absolute", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 11053, EndLine: 11056}}},
+		{Name: "path-is-inside", Version: "1.0.2", Constraint: "^1.0.1, ^1.0.2, ~1.0.2", Locations: []types.Location{{StartLine: 11058, EndLine: 11061}}},
+		{Name: "path-key", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 11063, EndLine: 11066}}},
+		{Name: "path-parse", Version: "1.0.6", Constraint: "^1.0.5, ^1.0.6", Locations: []types.Location{{StartLine: 11068, EndLine: 11071}}},
+		{Name: "path-to-regexp", Version: "0.1.7", Constraint: "0.1.7", Locations: []types.Location{{StartLine: 11073, EndLine: 11076}}},
+		{Name: "path-to-regexp", Version: "1.7.0", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 11078, EndLine: 11083}}},
+		{Name: "path-type", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 11085, EndLine: 11092}}},
+		{Name: "path-type", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11094, EndLine: 11099}}},
+		{Name: "path-type", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11101, EndLine: 11106}}},
+		{Name: "pbkdf2", Version: "3.0.17", Constraint: "^3.0.3", Locations: []types.Location{{StartLine: 11116, EndLine: 11125}}},
+		{Name: "performance-now", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 11127, EndLine: 11130}}},
+		{Name: "pidtree", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 11132, EndLine: 11135}}},
+		{Name: "pify", Version: "2.3.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11137, EndLine: 11140}}},
+		{Name: "pify", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11142, EndLine: 11145}}},
+		{Name: "pify", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 11147, EndLine: 11150}}},
+		{Name: "pinkie", Version: "2.0.4", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11159, EndLine: 11162}}},
+		{Name: "pinkie-promise", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11152, EndLine: 11157}}},
+		{Name: "pirates", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 11164, EndLine: 11169}}},
+		{Name: "pkg-dir", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 11171, EndLine: 11176}}},
+		{Name: "pkg-dir", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11178, EndLine: 11183}}},
+		{Name: "pkg-dir", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11185, EndLine: 11190}}},
+		{Name: "pkg-up", Version: "2.0.0", Constraint: "2.0.0", Locations: []types.Location{{StartLine: 11192, EndLine: 11197}}},
+		{Name: "please-upgrade-node", Version: "3.1.1", Constraint: "^3.0.2, ^3.1.1", Locations: []types.Location{{StartLine: 11199, EndLine: 11204}}},
+		{Name: "pn", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 11206, EndLine: 11209}}},
+		{Name: "popper.js", Version: "1.15.0", Constraint: "^1.14.1, ^1.14.4", Locations: []types.Location{{StartLine: 11211, EndLine: 11214}}},
+		{Name: "portfinder", Version: "1.0.20", Constraint: "^1.0.20", Locations: []types.Location{{StartLine: 11216, EndLine: 11223}}},
+		{Name: "posix-character-classes", Version: "0.1.1", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 11225, EndLine: 11228}}},
+		{Name: "postcss", Version: "6.0.23", Constraint: "^6.0.1, ^6.0.23", Locations: []types.Location{{StartLine: 11291, EndLine: 11298}}},
+		{Name: "postcss", Version: "7.0.16", Constraint: "^7.0.0, ^7.0.14", Locations: []types.Location{{StartLine: 11300, EndLine: 11307}}},
+		{Name: "postcss-flexbugs-fixes", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 11230, EndLine: 11235}}},
+		{Name: "postcss-load-config", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11237, EndLine: 11243}}},
+		{Name: "postcss-loader", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11245, EndLine: 11253}}},
+		{Name: "postcss-modules-extract-imports", Version: "1.2.1", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 11255, EndLine: 11260}}},
+		{Name: "postcss-modules-local-by-default", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 11262, EndLine: 11268}}},
+		{Name: "postcss-modules-scope", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 11270, EndLine: 11276}}},
+		{Name: "postcss-modules-values", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 11278, EndLine: 11284}}},
+		{Name: "postcss-value-parser", Version: "3.3.1", Constraint: "^3.2.3, ^3.3.0, ^3.3.1", Locations: []types.Location{{StartLine: 11286, EndLine: 11289}}},
+		{Name: "prelude-ls", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 11309, EndLine: 11312}}},
+		{Name: "prepend-http", Version: "1.0.4", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 11314, EndLine: 11317}}},
+		{Name: "preserve", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 11319, EndLine: 11322}}},
+		{Name: "pretty-error", Version: "2.1.1", Constraint: "^2.0.2, ^2.1.1", Locations: []types.Location{{StartLine: 11324, EndLine: 11330}}},
+		{Name: "pretty-format", Version: "23.6.0", Constraint: "^23.6.0", Locations: []types.Location{{StartLine: 11332, EndLine: 11338}}},
+		{Name: "pretty-hrtime", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 11340, EndLine: 11343}}},
+		{Name: "private", Version: "0.1.8", Constraint: "^0.1.6, ^0.1.8, ~0.1.5", Locations: []types.Location{{StartLine: 11345, EndLine: 11348}}},
+		{Name: "process", Version: "0.11.10", Constraint: "^0.11.1, ^0.11.10", Locations: []types.Location{{StartLine: 11360, EndLine: 11363}}},
+		{Name: "process", Version: "0.5.2", Constraint: "~0.5.1", Locations: []types.Location{{StartLine: 11365, EndLine: 11368}}},
+		{Name: "process-nextick-args", Version: "1.0.7", Constraint: "~1.0.6", Locations: []types.Location{{StartLine: 11350, EndLine: 11353}}},
+		{Name: "process-nextick-args", Version: "2.0.0", Constraint: "~2.0.0", Locations: []types.Location{{StartLine: 11355, EndLine: 11358}}},
+		{Name: "progress", Version: "2.0.3", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 11370, EndLine: 11373}}},
+		{Name: "promise", Version: "7.3.1", Constraint: "^7.1.1", Locations: []types.Location{{StartLine: 11411, EndLine: 11416}}},
+		{Name: "promise-inflight", Version: "1.0.1", Constraint: "^1.0.1, ~1.0.1", Locations: []types.Location{{StartLine: 11375, EndLine: 11378}}},
+		{Name: "promise-polyfill", Version: "6.1.0", Constraint: "^6.0.1", Locations: []types.Location{{StartLine: 11380, EndLine: 11383}}},
+		{Name: "promise-retry", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 11385, EndLine: 11391}}},
+		{Name: "promise.allsettled", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 11393, EndLine: 11400}}},
+		{Name: "promise.prototype.finally", Version: "3.1.0", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 11402, EndLine: 11409}}},
+		{Name: "prompts", Version: "0.1.14", Constraint: "^0.1.9", Locations: []types.Location{{StartLine: 11418, EndLine: 11424}}},
+		{Name: "promzard", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 11426, EndLine: 11431}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.5.0, ^15.5.10, ^15.5.4, ^15.5.8, ^15.5.9, ^15.6.0, ^15.6.1, ^15.6.2, ^15.7.2", Locations: []types.Location{{StartLine: 11442, EndLine: 11449}}},
+		{Name: "prop-types-exact", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 11433, EndLine: 11440}}},
+		{Name: "property-information", Version: "5.1.0", Constraint: "^5.0.0, ^5.0.1", Locations: []types.Location{{StartLine: 11451, EndLine: 11456}}},
+		{Name: "proto-list", Version: "1.2.4", Constraint: "~1.2.1", Locations: []types.Location{{StartLine: 11458, EndLine: 11461}}},
+		{Name: "protoduck", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 11463, EndLine: 11468}}},
+		{Name: "proxy-addr", Version: "2.0.5", Constraint: "~2.0.4", Locations: []types.Location{{StartLine: 11470, EndLine: 11476}}},
+		{Name: "prr", Version: "1.0.1", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 11478, EndLine: 11481}}},
+		{Name: "pseudomap", Version: "1.0.2", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 11483, EndLine: 11486}}},
+		{Name: "psl", Version: "1.1.31", Constraint: "^1.1.24, ^1.1.28", Locations: []types.Location{{StartLine: 11488, EndLine: 11491}}},
+		{Name: "public-encrypt", Version: "4.0.3", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 11493, EndLine: 11503}}},
+		{Name: "pump", Version: "2.0.1", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 11505, EndLine: 11511}}},
+		{Name: "pump", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11513, EndLine: 11519}}},
+		{Name: "pumpify", Version: "1.5.1", Constraint: "^1.3.3", Locations: []types.Location{{StartLine: 11521, EndLine: 11528}}},
+		{Name: "punycode", Version: "1.3.2", Constraint: "1.3.2", Locations: []types.Location{{StartLine: 11530, EndLine: 11533}}},
+		{Name: "punycode", Version: "1.4.1", Constraint: "^1.2.4, ^1.4.1", Locations: []types.Location{{StartLine: 11535, EndLine: 11538}}},
+		{Name: "punycode", Version: "2.1.1", Constraint: "^2.1.0, ^2.1.1", Locations: []types.Location{{StartLine: 11540, EndLine: 11543}}},
+		{Name: "q", Version: "1.5.1", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 11545, EndLine: 11548}}},
+		{Name: "qrcode-terminal", Version: "0.12.0", Constraint: "^0.12.0", Locations: []types.Location{{StartLine: 11550, EndLine: 11553}}},
+		{Name: "qs", Version: "6.5.2", Constraint: "6.5.2, ~6.5.2", Locations: []types.Location{{StartLine: 11555, EndLine: 11558}}},
+		{Name: "qs", Version: "6.7.0", Constraint: "^6.5.2", Locations: []types.Location{{StartLine: 11560, EndLine: 11563}}},
+		{Name: "query-string", Version: "6.5.0", Constraint: "^6.2.0", Locations: []types.Location{{StartLine: 11565, EndLine: 11572}}},
+		{Name: "querystring", Version: "0.2.0", Constraint: "0.2.0, ^0.2.0", Locations: []types.Location{{StartLine: 11579, EndLine: 11582}}},
+		{Name: "querystring-es3", Version: "0.2.1", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 11574, EndLine: 11577}}},
+		{Name: "querystringify", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 11584, EndLine: 11587}}},
+		{Name: "qw", Version: "1.0.1", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 11589, EndLine: 11592}}},
+		{Name: "raf", Version: "3.4.1", Constraint: "^3.4.0", Locations: []types.Location{{StartLine: 11594, EndLine: 11599}}},
+		{Name: "railroad-diagrams", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 11601, EndLine: 11604}}},
+		{Name: "ramda", Version: "0.21.0", Constraint: "^0.21.0", Locations: []types.Location{{StartLine: 11606, EndLine: 11609}}},
+		{Name: "randexp", Version: "0.4.6", Constraint: "0.4.6", Locations: []types.Location{{StartLine: 11611, EndLine: 11617}}},
+		{Name: "randomatic", Version: "3.1.1", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11619, EndLine: 11626}}},
+		{Name: "randombytes", Version: "2.1.0", Constraint: "^2.0.0, ^2.0.1, ^2.0.5", Locations: []types.Location{{StartLine: 11628, EndLine: 11633}}},
+		{Name: "randomfill", Version: "1.0.4", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 11635, EndLine: 11641}}},
+		{Name: "range-parser", Version: "1.2.1", Constraint: "^1.2.1, ~1.2.0", Locations: []types.Location{{StartLine: 11643, EndLine: 11646}}},
+		{Name: "raven-for-redux", Version: "1.4.0", Constraint: "1.4.0", Locations: []types.Location{{StartLine: 11648, EndLine: 11651}}},
+		{Name: "raven-js", Version: "3.27.0", Constraint: "3.27.0", Locations: []types.Location{{StartLine: 11653, EndLine: 11656}}},
+		{Name: "raw-body", Version: "2.3.3", Constraint: "2.3.3", Locations: []types.Location{{StartLine: 11658, EndLine: 11666}}},
+		{Name: "raw-loader", Version: "0.5.1", Constraint: "^0.5.1", Locations: []types.Location{{StartLine: 11668, EndLine: 11671}}},
+		{Name: "rc", Version: "1.2.8", Constraint: "^1.0.1, ^1.1.6, ^1.2.7", Locations: []types.Location{{StartLine: 11673, EndLine: 11681}}},
+		{Name: "react", Version: "16.8.3", Constraint: "16.8.3", Locations: []types.Location{{StartLine: 12006, EndLine: 12014}}},
+		{Name: "react", Version: "16.8.6", Constraint: "^16.7.0", Locations: []types.Location{{StartLine: 12016, EndLine: 12024}}},
+		{Name: "react-addons-create-fragment", Version: "15.6.2", Constraint: "^15.5.3", Locations: []types.Location{{StartLine: 11683, EndLine: 11690}}},
+		{Name: "react-color", Version: "2.17.3", Constraint: "^2.14.1", Locations: []types.Location{{StartLine: 11692, EndLine: 11702}}},
+		{Name: "react-datepicker", Version: "2.5.0", Constraint: "^2.4.0", Locations: []types.Location{{StartLine: 11704, EndLine: 11713}}},
+		{Name: "react-dev-utils", Version: "6.1.1", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 11715, EndLine: 11743}}},
+		{Name: "react-docgen", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11745, EndLine: 11756}}},
+		{Name: "react-dom", Version: "16.8.3", Constraint: "16.8.3", Locations: []types.Location{{StartLine: 11758, EndLine: 11766}}},
+		{Name: "react-dom", Version: "16.8.6", Constraint: "^16.7.0", Locations: []types.Location{{StartLine: 11768, EndLine: 11776}}},
+		{Name: "react-dropzone", Version: "10.1.4", Constraint: "^10.1.0", Locations: []types.Location{{StartLine: 11778, EndLine: 11785}}},
+		{Name: "react-error-overlay", Version: "5.1.6", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 11787, EndLine: 11790}}},
+		{Name: "react-event-listener", Version: "0.6.6", Constraint: "^0.6.2", Locations: []types.Location{{StartLine: 11792, EndLine: 11799}}},
+		{Name: "react-fast-compare", Version: "2.0.4", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 11801, EndLine: 11804}}},
+		{Name: "react-fuzzy", Version: "0.5.2", Constraint: "^0.5.2", Locations: []types.Location{{StartLine: 11806, EndLine: 11814}}},
+		{Name: "react-ga", Version: "2.5.7", Constraint: "^2.5.3", Locations: []types.Location{{StartLine: 11816, EndLine: 11819}}},
+		{Name: "react-gateway", Version: "3.0.0", Constraint: "3.0.0", Locations: []types.Location{{StartLine: 11821, EndLine: 11827}}},
+		{Name: "react-inspector", Version: "2.3.1", Constraint: "^2.3.0", Locations: []types.Location{{StartLine: 11829, EndLine: 11836}}},
+		{Name: "react-intl-universal", Version: "1.16.2", Constraint: "^1.11.2", Locations: []types.Location{{StartLine: 11838, EndLine: 11853}}},
+		{Name: "react-is", Version: "16.8.6", Constraint: "^16.6.0, ^16.6.3, ^16.7.0, ^16.8.1, ^16.8.2, ^16.8.6", Locations: []types.Location{{StartLine: 11855, EndLine: 11858}}},
+		{Name: "react-lifecycles-compat", Version: "3.0.4", Constraint: "^3.0.0, ^3.0.2, ^3.0.4", Locations: []types.Location{{StartLine: 11860, EndLine: 11863}}},
+		{Name: "react-modal", Version: "3.8.1", Constraint: "^3.6.1", Locations: []types.Location{{StartLine: 11865, EndLine: 11873}}},
+		{Name: "react-onclickoutside", Version: "6.8.0", Constraint: "^6.7.1", Locations: []types.Location{{StartLine: 11875, EndLine: 11878}}},
+		{Name: "react-popper", Version: "1.3.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 11880, EndLine: 11890}}},
+		{Name: "react-prop-types", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 11892, EndLine: 11897}}},
+		{Name: "react-redux", Version: "6.0.1", Constraint: "6.0.1", Locations: []types.Location{{StartLine: 11899, EndLine: 11909}}},
+		{Name: "react-router", Version: "4.3.1", Constraint: "^4.3.1", Locations: []types.Location{{StartLine: 11923, EndLine: 11934}}},
+		{Name: "react-router-dom", Version: "4.3.1", Constraint: "4.3.1", Locations: []types.Location{{StartLine: 11911, EndLine: 11921}}},
+		{Name: "react-split-pane", Version: "0.1.87", Constraint: "^0.1.84", Locations: []types.Location{{StartLine: 11936, EndLine: 11943}}},
+		{Name: "react-style-proptype", Version: "3.2.2", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 11945, EndLine: 11950}}},
+		{Name: "react-test-renderer", Version: "16.8.6", Constraint: "^16.0.0-0", Locations: []types.Location{{StartLine: 11952, EndLine: 11960}}},
+		{Name: "react-textarea-autosize", Version: "7.1.0", Constraint: "^7.0.4", Locations: []types.Location{{StartLine: 11962, EndLine: 11968}}},
+		{Name: "react-transition-group", Version: "2.9.0", Constraint: "^2.0.0, ^2.2.1", Locations: []types.Location{{StartLine: 11970, EndLine: 11978}}},
+		{Name: "react-treebeard", Version: "3.1.0", Constraint: "3.1.0", Locations: []types.Location{{StartLine: 11980, EndLine: 11991}}},
+		{Name: "react-virtualized", Version: "9.21.1", Constraint: "^9.21.0", Locations: []types.Location{{StartLine: 11993, EndLine: 12004}}},
+		{Name: "reactcss", Version: "1.2.3", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 12026, EndLine: 12031}}},
+		{Name: "read", Version: "1.0.7", Constraint: "1, ~1.0.1, ~1.0.7", Locations: []types.Location{{StartLine: 12129, EndLine: 12134}}},
+		{Name: "read-cmd-shim", Version: "1.0.1", Constraint: "^1.0.1, ~1.0.1", Locations: []types.Location{{StartLine: 12033, EndLine: 12038}}},
+		{Name: "read-installed", Version: "4.0.3", Constraint: "~4.0.3", Locations: []types.Location{{StartLine: 12040, EndLine: 12052}}},
+		{Name: "read-package-json", Version: "2.0.13", Constraint: "1 || 2, ^2.0.0, ^2.0.13", Locations: []types.Location{{StartLine: 12054, EndLine: 12064}}},
+		{Name: "read-package-tree", Version: "5.2.2", Constraint: "^5.2.2", Locations: []types.Location{{StartLine: 12066, EndLine: 12075}}},
+		{Name: "read-pkg", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12093, EndLine: 12100}}},
+		{Name: "read-pkg", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12102, EndLine: 12109}}},
+		{Name: "read-pkg", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 12111, EndLine: 12118}}},
+		{Name: "read-pkg", Version: "4.0.1", Constraint: "^4.0.1", Locations: []types.Location{{StartLine: 12120, EndLine: 12127}}},
+		{Name: "read-pkg-up", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 12077, EndLine: 12083}}},
+		{Name: "read-pkg-up", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12085, EndLine: 12091}}},
+		{Name: "readable-stream", Version: "1.1.14", Constraint: "~1.1.10", Locations: []types.Location{{StartLine: 12158, EndLine: 12166}}},
+		{Name: "readable-stream", Version: "2.1.5", Constraint: "~2.1.5", Locations: []types.Location{{StartLine: 12168, EndLine: 12179}}},
+		{Name: "readable-stream", Version: "2.3.6", Constraint: "1 || 2, ^2.0.0, ^2.0.1, ^2.0.2, ^2.0.6, ^2.1.5, ^2.2.2, ^2.3.3, ^2.3.6, ~2.3.6", Locations: []types.Location{{StartLine: 12136, EndLine: 12147}}},
+		{Name: "readable-stream", Version: "3.3.0", Constraint: "^3.0.6, ^3.1.1", Locations: []types.Location{{StartLine: 12149, EndLine: 12156}}},
+		{Name: "readdir-scoped-modules", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12181, EndLine: 12189}}},
+		{Name: "readdirp", Version: "2.2.1", Constraint: "^2.0.0, ^2.2.1", Locations: []types.Location{{StartLine: 12191, EndLine: 12198}}},
+		{Name: "readline2", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 12200, EndLine: 12207}}},
+		{Name: "realpath-native", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12209, EndLine: 12214}}},
+		{Name: "recast", Version: "0.14.7", Constraint: "^0.14.7", Locations: []types.Location{{StartLine: 12216, EndLine: 12224}}},
+		{Name: "recast", Version: "0.15.5", Constraint: "^0.15.0", Locations: []types.Location{{StartLine: 12226, EndLine: 12234}}},
+		{Name: "recast", Version: "0.16.2", Constraint: "^0.16.0", Locations: []types.Location{{StartLine: 12236, EndLine: 12244}}},
+		{Name: "rechoir", Version: "0.6.2", Constraint: "^0.6.2", Locations: []types.Location{{StartLine: 12246, EndLine: 12251}}},
+		{Name: "recompose", Version: "0.30.0", Constraint: "0.28.0 - 0.30.0", Locations: []types.Location{{StartLine: 12253, EndLine: 12263}}},
+		{Name: "recursive-readdir", Version: "2.2.2", Constraint: "2.2.2", Locations: []types.Location{{StartLine: 12265, EndLine: 12270}}},
+		{Name: "redux", Version: "4.0.1", Constraint: "4.0.1, ^4.0.1", Locations: []types.Location{{StartLine: 12277, EndLine: 12283}}},
+		{Name: "redux-thunk", Version: "2.3.0", Constraint: "2.3.0", Locations: []types.Location{{StartLine: 12272, EndLine: 12275}}},
+		{Name: "reflect.ownkeys", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 12285, EndLine: 12288}}},
+		{Name: "regenerate", Version: "1.4.0", Constraint: "^1.2.1, ^1.4.0", Locations: []types.Location{{StartLine: 12297, EndLine: 12300}}},
+		{Name: "regenerate-unicode-properties", Version: "8.1.0", Constraint: "^8.0.2", Locations: []types.Location{{StartLine: 12290, EndLine: 12295}}},
+		{Name: "regenerator-runtime", Version: "0.10.5", Constraint: "^0.10.5", Locations: []types.Location{{StartLine: 12302, EndLine: 12305}}},
+		{Name: "regenerator-runtime", Version: "0.11.1", Constraint: "^0.11.0", Locations: []types.Location{{StartLine: 12307, EndLine: 12310}}},
+		{Name: "regenerator-runtime", Version: "0.12.1", Constraint: "^0.12.0, ^0.12.1", Locations: []types.Location{{StartLine: 12312, EndLine: 12315}}},
+		{Name: "regenerator-runtime", Version: "0.13.2", Constraint: "^0.13.2", Locations: []types.Location{{StartLine: 12317, EndLine: 12320}}},
+		{Name: "regenerator-transform", Version: "0.10.1", Constraint: "^0.10.0", Locations: []types.Location{{StartLine: 12322, EndLine: 12329}}},
+		{Name: "regenerator-transform", Version: "0.13.4", Constraint: "^0.13.4", Locations: []types.Location{{StartLine: 12331, EndLine: 12336}}},
+		{Name: "regex-cache", Version: "0.4.4", Constraint: "^0.4.2", Locations: []types.Location{{StartLine: 12338, EndLine: 12343}}},
+		{Name: "regex-not", Version: "1.0.2", Constraint: "^1.0.0, ^1.0.2", Locations: []types.Location{{StartLine: 12345, EndLine: 12351}}},
+		{Name: "regexp-tree", Version: "0.1.6", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 12353, EndLine: 12356}}},
+		{Name: "regexp.prototype.flags", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 12358, EndLine: 12363}}},
+		{Name: "regexpp", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 12365, EndLine: 12368}}},
+		{Name: "regexpu-core", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12370, EndLine: 12377}}},
+		{Name: "regexpu-core", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12379, EndLine: 12386}}},
+		{Name: "regexpu-core", Version: "4.5.4", Constraint: "^4.5.4", Locations: []types.Location{{StartLine: 12388, EndLine: 12398}}},
+		{Name: "registry-auth-token", Version: "3.4.0", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 12400, EndLine: 12406}}},
+		{Name: "registry-url", Version: "3.1.0", Constraint: "^3.0.3", Locations: []types.Location{{StartLine: 12408, EndLine: 12413}}},
+		{Name: "regjsgen", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 12415, EndLine: 12418}}},
+		{Name: "regjsgen", Version: "0.5.0", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 12420, EndLine: 12423}}},
+		{Name: "regjsparser", Version: "0.1.5", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 12425, EndLine: 12430}}},
+		{Name: "regjsparser", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 12432, EndLine: 12437}}},
+		{Name: "rehype-parse", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 12439, EndLine: 12446}}},
+		{Name: "relateurl", Version: "0.2.7", Constraint: "0.2.x", Locations: []types.Location{{StartLine: 12448, EndLine: 12451}}},
+		{Name: "remove-trailing-separator", Version: "1.1.0", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 12453, EndLine: 12456}}},
+		{Name: "render-fragment", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 12458, EndLine: 12461}}},
+		{Name: "renderkid", Version: "2.0.3", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 12463, EndLine: 12472}}},
+		{Name: "repeat-element", Version: "1.1.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 12474, EndLine: 12477}}},
+		{Name: "repeat-string", Version: "1.6.1", Constraint: "^1.5.2, ^1.6.1", Locations: []types.Location{{StartLine: 12479, EndLine: 12482}}},
+		{Name: "repeating", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12484, EndLine: 12489}}},
+		{Name: "replace-ext", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 12491, EndLine: 12494}}},
+		{Name: "request", Version: "2.88.0", Constraint: "^2.87.0, ^2.88.0", Locations: []types.Location{{StartLine: 12512, EndLine: 12536}}},
+		{Name: "request-promise-core", Version: "1.1.2", Constraint: "1.1.2", Locations: []types.Location{{StartLine: 12496, EndLine: 12501}}},
+		{Name: "request-promise-native", Version: "1.0.7", Constraint: "^1.0.5", Locations: []types.Location{{StartLine: 12503, EndLine: 12510}}},
+		{Name: "require-directory", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 12538, EndLine: 12541}}},
+		{Name: "require-from-string", Version: "2.0.2", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 12543, EndLine: 12546}}},
+		{Name: "require-main-filename", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 12548, EndLine: 12551}}},
+		{Name: "requires-port", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12553, EndLine: 12556}}},
+		{Name: "resolve", Version: "1.1.7", Constraint: "1.1.7", Locations: []types.Location{{StartLine: 12593, EndLine: 12596}}},
+		{Name: "resolve", Version: "1.10.1", Constraint: "^1.1.6, ^1.10.0, ^1.10.1, ^1.3.2, ^1.5.0, ^1.8.1", Locations: []types.Location{{StartLine: 12598, EndLine: 12603}}},
+		{Name: "resolve-cwd", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12558, EndLine: 12563}}},
+		{Name: "resolve-dir", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 12565, EndLine: 12571}}},
+		{Name: "resolve-from", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 12573, EndLine: 12576}}},
+		{Name: "resolve-from", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 12578, EndLine: 12581}}},
+		{Name: "resolve-pathname", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 12583, EndLine: 12586}}},
+		{Name: "resolve-url", Version: "0.2.1", Constraint: "^0.2.1", Locations: []types.Location{{StartLine: 12588, EndLine: 12591}}},
+		{Name: "restore-cursor", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 12605, EndLine: 12611}}},
+		{Name: "restore-cursor", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12613, EndLine: 12619}}},
+		{Name: "ret", Version: "0.1.15", Constraint: "~0.1.10", Locations: []types.Location{{StartLine: 12621, EndLine: 12624}}},
+		{Name: "retry", Version: "0.10.1", Constraint: "^0.10.0", Locations: []types.Location{{StartLine: 12626, EndLine: 12629}}},
+		{Name: "retry", Version: "0.12.0", Constraint: "^0.12.0", Locations: []types.Location{{StartLine: 12631, EndLine: 12634}}},
+		{Name: "rimraf", Version: "2.2.8", Constraint: "~2.2.6", Locations: []types.Location{{StartLine: 12643, EndLine: 12646}}},
+		{Name: "rimraf", Version: "2.6.3", Constraint: "2, 2.6.3, ^2.2.8, ^2.5.2, ^2.5.4, ^2.6.1, ^2.6.2, ^2.6.3", Locations: []types.Location{{StartLine: 12636, EndLine: 12641}}},
+		{Name: "ripemd160", Version: "2.0.2", Constraint: "^2.0.0, ^2.0.1", Locations: []types.Location{{StartLine: 12648, EndLine: 12654}}},
+		{Name: "rst-selector-parser", Version: "2.2.3", Constraint: "^2.2.3", Locations: []types.Location{{StartLine: 12656, EndLine: 12662}}},
+		{Name: "rsvp", Version: "3.6.2", Constraint: "^3.3.3", Locations: []types.Location{{StartLine: 12664, EndLine: 12667}}},
+		{Name: "run-async", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 12669, EndLine: 12674}}},
+		{Name: "run-async", Version: "2.3.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 12676, EndLine: 12681}}},
+		{Name: "run-node", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12683, EndLine: 12686}}},
+		{Name: "run-queue", Version: "1.0.3", Constraint: "^1.0.0, ^1.0.3", Locations: []types.Location{{StartLine: 12688, EndLine: 12693}}},
+		{Name: "rx-lite", Version: "3.1.2", Constraint: "^3.1.2", Locations: []types.Location{{StartLine: 12695, EndLine: 12698}}},
+		{Name: "rxjs", Version: "6.5.2", Constraint: "^6.1.0, ^6.3.3, ^6.4.0", Locations: []types.Location{{StartLine: 12700, EndLine: 12705}}},
+		{Name: "safe-buffer", Version: "5.1.1", Constraint: "5.1.1", Locations: []types.Location{{StartLine: 12707, EndLine: 12710}}},
+		{Name: "safe-buffer", Version: "5.1.2", Constraint: "5.1.2, ^5.0.1, ^5.1.0, ^5.1.1, ^5.1.2, ~5.1.0, ~5.1.1", Locations: []types.Location{{StartLine: 12712, EndLine: 12715}}},
+		{Name: "safe-regex", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 12717, EndLine: 12722}}},
+		{Name: "safer-buffer", Version: "2.1.2", Constraint: ">= 2.1.2 < 3, ^2.0.2, ^2.1.0, ~2.1.0", Locations: []types.Location{{StartLine: 12724, EndLine: 12727}}},
+		{Name: "sane", Version: "2.5.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12729, EndLine: 12743}}},
+		{Name: "sax", Version: "1.2.4", Constraint: "^1.2.4, ~1.2.4", Locations: []types.Location{{StartLine: 12745, EndLine: 12748}}},
+		{Name: "scheduler", Version: "0.13.6", Constraint: "^0.13.3, ^0.13.6", Locations: []types.Location{{StartLine: 12750, EndLine: 12756}}},
+		{Name: "schema-utils", Version: "0.4.7", Constraint: "^0.4.2, ^0.4.5", Locations: []types.Location{{StartLine: 12758, EndLine: 12764}}},
+		{Name: "schema-utils", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12766, EndLine: 12773}}},
+		{Name: "select-hose", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12775, EndLine: 12778}}},
+		{Name: "selfsigned", Version: "1.10.4", Constraint: "^1.10.4", Locations: []types.Location{{StartLine: 12780, EndLine: 12785}}},
+		{Name: "semver", Version: "5.3.0", Constraint: "~5.3.0", Locations: []types.Location{{StartLine: 12809, EndLine: 12812}}},
+		{Name: "semver", Version: "5.7.0", Constraint: "2 || 3 || 4 || 5, 2.x || 3.x || 4 || 5, ^2.3.0 || 3.x || 4 || 5, ^5.0.3, ^5.1.0, ^5.3.0, ^5.4.1, ^5.5.0, ^5.5.1, ^5.6.0", Locations: []types.Location{{StartLine: 12799, EndLine: 12802}}},
+		{Name: "semver", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 12804, EndLine: 12807}}},
+		{Name: "semver-compare", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12787, EndLine: 12790}}},
+		{Name: "semver-diff", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12792, EndLine: 12797}}},
+		{Name: "send", Version: "0.16.2", Constraint: "0.16.2", Locations: []types.Location{{StartLine: 12814, EndLine: 12831}}},
+		{Name: "serialize-javascript", Version: "1.7.0", Constraint: "^1.4.0, ^1.7.0", Locations: []types.Location{{StartLine: 12833, EndLine: 12836}}},
+		{Name: "serve-favicon", Version: "2.5.0", Constraint: "^2.5.0", Locations: []types.Location{{StartLine: 12838, EndLine: 12847}}},
+		{Name: "serve-index", Version: "1.9.1", Constraint: "^1.9.1", Locations: []types.Location{{StartLine: 12849, EndLine: 12860}}},
+		{Name: "serve-static", Version: "1.13.2", Constraint: "1.13.2", Locations: []types.Location{{StartLine: 12862, EndLine: 12870}}},
+		{Name: "set-blocking", Version: "2.0.0", Constraint: "^2.0.0, ~2.0.0", Locations: []types.Location{{StartLine: 12872, EndLine: 12875}}},
+		{Name: "set-value", Version: "0.4.3", Constraint: "^0.4.3", Locations: []types.Location{{StartLine: 12877, EndLine: 12885}}},
+		{Name: "set-value", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12887, EndLine: 12895}}},
+		{Name: "setimmediate", Version: "1.0.5", Constraint: "^1.0.4, ^1.0.5, ~1.0.4", Locations: []types.Location{{StartLine: 12897, EndLine: 12900}}},
+		{Name: "setprototypeof", Version: "1.1.0", Constraint: "1.1.0", Locations: []types.Location{{StartLine: 12902, EndLine: 12905}}},
+		{Name: "sha", Version: "2.0.1", Constraint: "~2.0.1", Locations: []types.Location{{StartLine: 12915, EndLine: 12921}}},
+		{Name: "sha.js", Version: "2.4.11", Constraint: "^2.4.0, ^2.4.8", Locations: []types.Location{{StartLine: 12907, EndLine: 12913}}},
+		{Name: "shallow-clone", Version: "0.1.2", Constraint: "^0.1.2", Locations: []types.Location{{StartLine: 12923, EndLine: 12931}}},
+		{Name: "shallowequal", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 12933, EndLine: 12936}}},
+		{Name: "shebang-command", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 12938, EndLine: 12943}}},
+		{Name: "shebang-regex", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12945, EndLine: 12948}}},
+		{Name: "shell-quote", Version: "1.6.1", Constraint: "1.6.1, ^1.6.1", Locations: []types.Location{{StartLine: 12950, EndLine: 12958}}},
+		{Name: "shelljs", Version: "0.8.3", Constraint: "^0.8.2", Locations: []types.Location{{StartLine: 12960, EndLine: 12967}}},
+		{Name: "shellwords", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 12969, EndLine: 12972}}},
+		{Name: "signal-exit", Version: "3.0.2", Constraint: "^3.0.0, ^3.0.2", Locations: []types.Location{{StartLine: 12974, EndLine: 12977}}},
+		{Name: "sisteransi", Version: "0.1.1", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 12979, EndLine: 12982}}},
+		{Name: "slash", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 12984, EndLine: 12987}}},
+		{Name: "slash", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 12989, EndLine: 12992}}},
+		{Name: "slice-ansi", Version: "0.0.4", Constraint: "0.0.4", Locations: []types.Location{{StartLine: 12994, EndLine: 12997}}},
+		{Name: "slice-ansi", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 12999, EndLine: 13004}}},
+		{Name: "slice-ansi", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 13006, EndLine: 13013}}},
+		{Name: "slide", Version: "1.1.6", Constraint: "^1.1.5, ^1.1.6, ~1.1.3, ~1.1.6", Locations: []types.Location{{StartLine: 13015, EndLine: 13018}}},
+		{Name: "smart-buffer", Version: "4.0.2", Constraint: "4.0.2", Locations: []types.Location{{StartLine: 13020, EndLine: 13023}}},
+		{Name: "snapdragon", Version: "0.8.2", Constraint: "^0.8.1", Locations: []types.Location{{StartLine: 13041, EndLine: 13053}}},
+		{Name: "snapdragon-node", Version: "2.1.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 13025, EndLine: 13032}}},
+		{Name: "snapdragon-util", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 13034, EndLine: 13039}}},
+		{Name: "socket.io", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 13089, EndLine: 13099}}},
+		{Name: "socket.io-adapter", Version: "1.1.1", Constraint: "~1.1.0", Locations: []types.Location{{StartLine: 13055, EndLine: 13058}}},
+		{Name: "socket.io-client", Version: "2.2.0", Constraint: "2.2.0", Locations: []types.Location{{StartLine: 13060, EndLine: 13078}}},
+		{Name: "socket.io-parser", Version: "3.3.0", Constraint: "~3.3.0", Locations: []types.Location{{StartLine: 13080, EndLine: 13087}}},
+		{Name: "sockjs", Version: "0.3.19", Constraint: "0.3.19", Locations: []types.Location{{StartLine: 13125, EndLine: 13131}}},
+		{Name: "sockjs-client", Version: "1.1.5", Constraint: "1.1.5", Locations: []types.Location{{StartLine: 13101, EndLine: 13111}}},
+		{Name: "sockjs-client", Version: "1.3.0", Constraint: "1.3.0", Locations: []types.Location{{StartLine: 13113, EndLine: 13123}}},
+		{Name: "socks", Version: "2.3.2", Constraint: "~2.3.2", Locations: []types.Location{{StartLine: 13141, EndLine: 13147}}},
+		{Name: "socks-proxy-agent", Version: "4.0.2", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 13133, EndLine: 13139}}},
+		{Name: "sort-keys", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13149, EndLine: 13154}}},
+		{Name: "sorted-object", Version: "2.0.1", Constraint: "~2.0.1", Locations: []types.Location{{StartLine: 13156, EndLine: 13159}}},
+		{Name: "sorted-union-stream", Version: "2.1.3", Constraint: "~2.1.3", Locations: []types.Location{{StartLine: 13161, EndLine: 13167}}},
+		{Name: "source-list-map", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13169, EndLine: 13172}}},
+		{Name: "source-map", Version: "0.5.7", Constraint: "^0.5.0, ^0.5.3, ^0.5.6, ^0.5.7", Locations: []types.Location{{StartLine: 13205, EndLine: 13208}}},
+		{Name: "source-map", Version: "0.6.1", Constraint: "^0.6.0, ^0.6.1, ~0.6.0, ~0.6.1", Locations: []types.Location{{StartLine: 13210, EndLine: 13213}}},
+		{Name: "source-map-resolve", Version: "0.5.2", Constraint: "^0.5.0", Locations: []types.Location{{StartLine: 13174, EndLine: 13183}}},
+		{Name: "source-map-support", Version: "0.4.18", Constraint: "^0.4.15", Locations: []types.Location{{StartLine: 13185, EndLine: 13190}}},
+		{Name: "source-map-support", Version: "0.5.12", Constraint: "^0.5.6, ^0.5.9, ~0.5.10", Locations: []types.Location{{StartLine: 13192, EndLine: 13198}}},
+		{Name: "source-map-url", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 13200, EndLine: 13203}}},
+		{Name: "space-separated-tokens", Version: "1.1.4", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13215, EndLine: 13218}}},
+		{Name: "spawn-promise", Version: "0.1.8", Constraint: "^0.1.8", Locations: []types.Location{{StartLine: 13220, EndLine: 13225}}},
+		{Name: "spdx-correct", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13227, EndLine: 13233}}},
+		{Name: "spdx-exceptions", Version: "2.2.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 13235, EndLine: 13238}}},
+		{Name: "spdx-expression-parse", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13240, EndLine: 13246}}},
+		{Name: "spdx-license-ids", Version: "3.0.4", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13248, EndLine: 13251}}},
+		{Name: "spdy", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 13265, EndLine: 13274}}},
+		{Name: "spdy-transport", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13253, EndLine: 13263}}},
+		{Name: "split-on-first", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13276, EndLine: 13279}}},
+		{Name: "split-string", Version: "3.1.0", Constraint: "^3.0.1, ^3.0.2", Locations: []types.Location{{StartLine: 13281, EndLine: 13286}}},
+		{Name: "sprintf-js", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 13288, EndLine: 13291}}},
+		{Name: "sshpk", Version: "1.16.1", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 13293, EndLine: 13306}}},
+		{Name: "ssri", Version: "5.3.0", Constraint: "^5.2.4", Locations: []types.Location{{StartLine: 13308, EndLine: 13313}}},
+		{Name: "ssri", Version: "6.0.1", Constraint: "^6.0.0, ^6.0.1", Locations: []types.Location{{StartLine: 13315, EndLine: 13320}}},
+		{Name: "stable", Version: "0.1.8", Constraint: "^0.1.8", Locations: []types.Location{{StartLine: 13322, EndLine: 13325}}},
+		{Name: "stack-utils", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 13327, EndLine: 13330}}},
+		{Name: "staged-git-files", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 13332, EndLine: 13335}}},
+		{Name: "static-extend", Version: "0.1.2", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 13337, EndLine: 13343}}},
+		{Name: "statuses", Version: "1.4.0", Constraint: "~1.4.0", Locations: []types.Location{{StartLine: 13350, EndLine: 13353}}},
+		{Name: "statuses", Version: "1.5.0", Constraint: ">= 1.4.0 < 2", Locations: []types.Location{{StartLine: 13345, EndLine: 13348}}},
+		{Name: "stealthy-require", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 13355, EndLine: 13358}}},
+		{Name: "stream-browserify", Version: "2.0.2", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 13360, EndLine: 13366}}},
+		{Name: "stream-each", Version: "1.2.3", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 13368, EndLine: 13374}}},
+		{Name: "stream-http", Version: "2.8.3", Constraint: "^2.7.2", Locations: []types.Location{{StartLine: 13376, EndLine: 13385}}},
+		{Name: "stream-iterate", Version: "1.2.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 13387, EndLine: 13393}}},
+		{Name: "stream-shift", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13395, EndLine: 13398}}},
+		{Name: "strict-uri-encode", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13400, EndLine: 13403}}},
+		{Name: "string-argv", Version: "0.0.2", Constraint: "^0.0.2", Locations: []types.Location{{StartLine: 13405, EndLine: 13408}}},
+		{Name: "string-length", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13410, EndLine: 13416}}},
+		{Name: "string-width", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 13418, EndLine: 13425}}},
+		{Name: "string-width", Version: "2.1.1", Constraint: "^1.0.2 || 2, ^2.0.0, ^2.1.0, ^2.1.1", Locations: []types.Location{{StartLine: 13427, EndLine: 13433}}},
+		{Name: "string-width", Version: "3.1.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13435, EndLine: 13442}}},
+		{Name: "string.prototype.matchall", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 13444, EndLine: 13453}}},
+		{Name: "string.prototype.padend", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13455, EndLine: 13462}}},
+		{Name: "string.prototype.padstart", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 13464, EndLine: 13471}}},
+		{Name: "string.prototype.trim", Version: "1.1.2", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 13473, EndLine: 13480}}},
+		{Name: "string_decoder", Version: "0.10.31", Constraint: "~0.10.x", Locations: []types.Location{{StartLine: 13489, EndLine: 13492}}},
+		{Name: "string_decoder", Version: "1.1.1", Constraint: "~1.1.1", Locations: []types.Location{{StartLine: 13494, EndLine: 13499}}},
+		{Name: "string_decoder", Version: "1.2.0", Constraint: "^1.0.0, ^1.1.1", Locations: []types.Location{{StartLine: 13482, EndLine: 13487}}},
+		{Name: "stringify-object", Version: "3.3.0", Constraint: "^3.2.2", Locations: []types.Location{{StartLine: 13501, EndLine: 13508}}},
+		{Name: "stringify-package", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13510, EndLine: 13513}}},
+		{Name: "strip-ansi", Version: "0.1.1", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 13536, EndLine: 13539}}},
+		{Name: "strip-ansi", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 13522, EndLine: 13527}}},
+		{Name: "strip-ansi", Version: "4.0.0", Constraint: "4.0.0, ^4.0.0", Locations: []types.Location{{StartLine: 13515, EndLine: 13520}}},
+		{Name: "strip-ansi", Version: "5.2.0", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 13529, EndLine: 13534}}},
+		{Name: "strip-bom", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13546, EndLine: 13551}}},
+		{Name: "strip-bom", Version: "3.0.0", Constraint: "3.0.0, ^3.0.0", Locations: []types.Location{{StartLine: 13541, EndLine: 13544}}},
+		{Name: "strip-eof", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13553, EndLine: 13556}}},
+		{Name: "strip-json-comments", Version: "2.0.1", Constraint: "^2.0.1, ~2.0.1", Locations: []types.Location{{StartLine: 13558, EndLine: 13561}}},
+		{Name: "style-loader", Version: "0.23.1", Constraint: "^0.23.1", Locations: []types.Location{{StartLine: 13563, EndLine: 13569}}},
+		{Name: "styled-components", Version: "4.1.3", Constraint: "4.1.3", Locations: []types.Location{{StartLine: 13571, EndLine: 13586}}},
+		{Name: "stylis", Version: "3.5.4", Constraint: "^3.5.0", Locations: []types.Location{{StartLine: 13593, EndLine: 13596}}},
+		{Name: "stylis-rule-sheet", Version: "0.0.10", Constraint: "^0.0.10", Locations: []types.Location{{StartLine: 13588, EndLine: 13591}}},
+		{Name: "supports-color", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13598, EndLine: 13601}}},
+		{Name: "supports-color", Version: "3.2.3", Constraint: "^3.1.2", Locations: []types.Location{{StartLine: 13603, EndLine: 13608}}},
+		{Name: "supports-color", Version: "5.5.0", Constraint: "^5.3.0, ^5.4.0, ^5.5.0", Locations: []types.Location{{StartLine: 13610, EndLine: 13615}}},
+		{Name: "supports-color", Version: "6.1.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 13617, EndLine: 13622}}},
+		{Name: "svg-url-loader", Version: "2.3.2", Constraint: "^2.3.2", Locations: []types.Location{{StartLine: 13624, EndLine: 13630}}},
+		{Name: "svgo", Version: "1.2.2", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 13632, EndLine: 13650}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "1.2.0, ^1.0.4, ^1.1.0, ^1.2.0", Locations: []types.Location{{StartLine: 13652, EndLine: 13655}}},
+		{Name: "symbol-tree", Version: "3.2.2", Constraint: "^3.2.2", Locations: []types.Location{{StartLine: 13657, EndLine: 13660}}},
+		{Name: "symbol.prototype.description", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13662, EndLine: 13667}}},
+		{Name: "table", Version: "4.0.3", Constraint: "^4.0.2", Locations: []types.Location{{StartLine: 13669, EndLine: 13679}}},
+		{Name: "table", Version: "5.3.3", Constraint: "^5.2.3", Locations: []types.Location{{StartLine: 13681, EndLine: 13689}}},
+		{Name: "tapable", Version: "1.1.3", Constraint: "^1.0.0, ^1.0.0-beta.5, ^1.1.0", Locations: []types.Location{{StartLine: 13691, EndLine: 13694}}},
+		{Name: "tar", Version: "2.2.2", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13696, EndLine: 13703}}},
+		{Name: "tar", Version: "4.4.8", Constraint: "^4, ^4.4.8", Locations: []types.Location{{StartLine: 13705, EndLine: 13716}}},
+		{Name: "temp", Version: "0.8.3", Constraint: "^0.8.1", Locations: []types.Location{{StartLine: 13718, EndLine: 13724}}},
+		{Name: "term-size", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 13726, EndLine: 13731}}},
+		{Name: "terser", Version: "3.17.0", Constraint: "^3.17.0", Locations: []types.Location{{StartLine: 13748, EndLine: 13755}}},
+		{Name: "terser-webpack-plugin", Version: "1.2.4", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 13733, EndLine: 13746}}},
+		{Name: "test-exclude", Version: "4.2.3", Constraint: "^4.2.1", Locations: []types.Location{{StartLine: 13757, EndLine: 13766}}},
+		{Name: "text-table", Version: "0.2.0", Constraint: "0.2.0, ^0.2.0, ~0.2.0", Locations: []types.Location{{StartLine: 13768, EndLine: 13771}}},
+		{Name: "throat", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 13773, EndLine: 13776}}},
+		{Name: "through", Version: "2.3.8", Constraint: ">=2.2.7 <3, ^2.3.6, ^2.3.8", Locations: []types.Location{{StartLine: 13786, EndLine: 13789}}},
+		{Name: "through2", Version: "2.0.5", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13778, EndLine: 13784}}},
+		{Name: "thunky", Version: "1.0.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 13791, EndLine: 13794}}},
+		{Name: "timed-out", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 13796, EndLine: 13799}}},
+		{Name: "timers-browserify", Version: "2.0.10", Constraint: "^2.0.4", Locations: []types.Location{{StartLine: 13801, EndLine: 13806}}},
+		{Name: "tiny-invariant", Version: "1.0.4", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 13808, EndLine: 13811}}},
+		{Name: "tiny-relative-date", Version: "1.3.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 13813, EndLine: 13816}}},
+		{Name: "tiny-warning", Version: "1.0.2", Constraint: "^1.0.0, ^1.0.2", Locations: []types.Location{{StartLine: 13818, EndLine: 13821}}},
+		{Name: "tinycolor2", Version: "1.4.1", Constraint: "^1.4.1", Locations: []types.Location{{StartLine: 13823, EndLine: 13826}}},
+		{Name: "tmp", Version: "0.0.33", Constraint: "^0.0.33", Locations: []types.Location{{StartLine: 13828, EndLine: 13833}}},
+		{Name: "tmpl", Version: "1.0.4", Constraint: "1.0.x", Locations: []types.Location{{StartLine: 13835, EndLine: 13838}}},
+		{Name: "to-array", Version: "0.1.4", Constraint: "0.1.4", Locations: []types.Location{{StartLine: 13840, EndLine: 13843}}},
+		{Name: "to-arraybuffer", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13845, EndLine: 13848}}},
+		{Name: "to-fast-properties", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 13850, EndLine: 13853}}},
+		{Name: "to-fast-properties", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 13855, EndLine: 13858}}},
+		{Name: "to-object-path", Version: "0.3.0", Constraint: "^0.3.0", Locations: []types.Location{{StartLine: 13860, EndLine: 13865}}},
+		{Name: "to-regex", Version: "3.0.2", Constraint: "^3.0.1, ^3.0.2", Locations: []types.Location{{StartLine: 13875, EndLine: 13883}}},
+		{Name: "to-regex-range", Version: "2.1.1", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 13867, EndLine: 13873}}},
+		{Name: "toggle-selection", Version: "1.0.6", Constraint: "^1.0.6", Locations: []types.Location{{StartLine: 13885, EndLine: 13888}}},
+		{Name: "toposort", Version: "1.0.7", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13890, EndLine: 13893}}},
+		{Name: "tough-cookie", Version: "2.4.3", Constraint: "~2.4.3", Locations: []types.Location{{StartLine: 13903, EndLine: 13909}}},
+		{Name: "tough-cookie", Version: "2.5.0", Constraint: "^2.3.3, ^2.3.4", Locations: []types.Location{{StartLine: 13895, EndLine: 13901}}},
+		{Name: "tr46", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 13911, EndLine: 13916}}},
+		{Name: "traverse", Version: "0.3.9", Constraint: ">=0.3.0 <0.4", Locations: []types.Location{{StartLine: 13918, EndLine: 13921}}},
+		{Name: "trim-right", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 13923, EndLine: 13926}}},
+		{Name: "trough", Version: "1.0.4", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13928, EndLine: 13931}}},
+		{Name: "tryer", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 13933, EndLine: 13936}}},
+		{Name: "tslib", Version: "1.9.3", Constraint: "^1.9.0, ^1.9.3", Locations: []types.Location{{StartLine: 13938, EndLine: 13941}}},
+		{Name: "tty-browserify", Version: "0.0.0", Constraint: "0.0.0", Locations: []types.Location{{StartLine: 13943, EndLine: 13946}}},
+		{Name: "tunnel-agent", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 13948, EndLine: 13953}}},
+		{Name: "tweetnacl", Version: "0.14.5", Constraint: "^0.14.3, ~0.14.0", Locations: []types.Location{{StartLine: 13955, EndLine: 13958}}},
+		{Name: "type-check", Version: "0.3.2", Constraint: "~0.3.2", Locations: []types.Location{{StartLine: 13960, EndLine: 13965}}},
+		{Name: "type-is", Version: "1.6.18", Constraint: "~1.6.16", Locations: []types.Location{{StartLine: 13967, EndLine: 13973}}},
+		{Name: "typed-styles", Version: "0.0.7", Constraint: "^0.0.7", Locations: []types.Location{{StartLine: 13975, EndLine: 13978}}},
+		{Name: "typedarray", Version: "0.0.6", Constraint: "^0.0.6", Locations: []types.Location{{StartLine: 13980, EndLine: 13983}}},
+		{Name: "ua-parser-js", Version: "0.7.19", Constraint: "^0.7.18", Locations: []types.Location{{StartLine: 13985, EndLine: 13988}}},
+		{Name: "uglify-js", Version: "3.4.10", Constraint: "3.4.x", Locations: []types.Location{{StartLine: 13990, EndLine: 13996}}},
+		{Name: "uglify-js", Version: "3.5.12", Constraint: "^3.1.4", Locations: []types.Location{{StartLine: 13998, EndLine: 14004}}},
+		{Name: "uid-number", Version: "0.0.6", Constraint: "0.0.6", Locations: []types.Location{{StartLine: 14006, EndLine: 14009}}},
+		{Name: "umask", Version: "1.1.0", Constraint: "^1.1.0, ~1.1.0", Locations: []types.Location{{StartLine: 14011, EndLine: 14014}}},
+		{Name: "underscore", Version: "1.6.0", Constraint: "~1.6.0", Locations: []types.Location{{StartLine: 14016, EndLine: 14019}}},
+		{Name: "unicode-canonical-property-names-ecmascript", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 14021, EndLine: 14024}}},
+		{Name: "unicode-match-property-ecmascript", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 14026, EndLine: 14032}}},
+		{Name: "unicode-match-property-value-ecmascript", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 14034, EndLine: 14037}}},
+		{Name: "unicode-property-aliases-ecmascript", Version: "1.0.5", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 14039, EndLine: 14042}}},
+		{Name: "unified", Version: "7.1.0", Constraint: "^7.1.0", Locations: []types.Location{{StartLine: 14044, EndLine: 14056}}},
+		{Name: "union-value", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14058, EndLine: 14066}}},
+		{Name: "unique-filename", Version: "1.1.1", Constraint: "^1.1.0, ^1.1.1", Locations: []types.Location{{StartLine: 14068, EndLine: 14073}}},
+		{Name: "unique-slug", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14075, EndLine: 14080}}},
+		{Name: "unique-string", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14082, EndLine: 14087}}},
+		{Name: "unist-util-stringify-position", Version: "1.1.2", Constraint: "^1.0.0, ^1.1.1", Locations: []types.Location{{StartLine: 14089, EndLine: 14092}}},
+		{Name: "unist-util-stringify-position", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14094, EndLine: 14099}}},
+		{Name: "universal-user-agent", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14101, EndLine: 14106}}},
+		{Name: "universalify", Version: "0.1.2", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 14108, EndLine: 14111}}},
+		{Name: "unpipe", Version: "1.0.0", Constraint: "1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 14113, EndLine: 14116}}},
+		{Name: "unquote", Version: "1.1.1", Constraint: "~1.1.1", Locations: []types.Location{{StartLine: 14118, EndLine: 14121}}},
+		{Name: "unset-value", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14123, EndLine: 14129}}},
+		{Name: "unzip-response", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 14131, EndLine: 14134}}},
+		{Name: "unzipper", Version: "0.8.14", Constraint: "^0.8.11", Locations: []types.Location{{StartLine: 14136, EndLine: 14149}}},
+		{Name: "upath", Version: "1.1.2", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 14151, EndLine: 14154}}},
+		{Name: "update-notifier", Version: "2.5.0", Constraint: "^2.3.0, ^2.5.0", Locations: []types.Location{{StartLine: 14156, EndLine: 14170}}},
+		{Name: "upper-case", Version: "1.1.3", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 14172, EndLine: 14175}}},
+		{Name: "uri-js", Version: "4.2.2", Constraint: "^4.2.2", Locations: []types.Location{{StartLine: 14177, EndLine: 14182}}},
+		{Name: "urix", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 14184, EndLine: 14187}}},
+		{Name: "url", Version: "0.11.0", Constraint: "^0.11.0", Locations: []types.Location{{StartLine: 14223, EndLine: 14229}}},
+		{Name: "url-loader", Version: "1.1.2", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 14189, EndLine: 14196}}},
+		{Name: "url-parse", Version: "1.4.7", Constraint: "^1.1.8, ^1.4.3", Locations: []types.Location{{StartLine: 14205, EndLine: 14211}}},
+		{Name: "url-parse-lax", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14198, EndLine: 14203}}},
+		{Name: "url-template", Version: "2.0.8", Constraint: "^2.0.8", Locations: []types.Location{{StartLine: 14213, EndLine: 14216}}},
+		{Name: "url-to-options", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 14218, EndLine: 14221}}},
+		{Name: "use", Version: "3.1.1", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 14231, EndLine: 14234}}},
+		{Name: "user-home", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 14236, EndLine: 14239}}},
+		{Name: "util", Version: "0.10.3", Constraint: "0.10.3", Locations: []types.Location{{StartLine: 14259, EndLine: 14264}}},
+		{Name: "util", Version: "0.10.4", Constraint: "^0.10.3", Locations: []types.Location{{StartLine: 14266, EndLine: 14271}}},
+		{Name: "util", Version: "0.11.1", Constraint: "^0.11.0", Locations: []types.Location{{StartLine: 14273, EndLine: 14278}}},
+		{Name: "util-deprecate", Version: "1.0.2", Constraint: "^1.0.1, ^1.0.2, ~1.0.1", Locations: []types.Location{{StartLine: 14241, EndLine: 14244}}},
+		{Name: "util-extend", Version: "1.0.3", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 14246, EndLine: 14249}}},
+		{Name: "util.promisify", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 14251, EndLine: 14257}}},
+		{Name: "utila", Version: "0.4.0", Constraint: "^0.4.0, ~0.4", Locations: []types.Location{{StartLine: 14280, EndLine: 14283}}},
+		{Name: "utils-merge", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 14285, EndLine: 14288}}},
+		{Name: "uuid", Version: "3.3.2", Constraint: "^3.0.1, ^3.3.2", Locations: []types.Location{{StartLine: 14290, EndLine: 14293}}},
+		{Name: "v8-compile-cache", Version: "2.0.3", Constraint: "^2.0.2", Locations: []types.Location{{StartLine: 14295, EndLine: 14298}}},
+		{Name: "v8flags", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 14300, EndLine: 14305}}},
+		{Name: "validate-npm-package-license", Version: "3.0.4", Constraint: "^3.0.1, ^3.0.4", Locations: []types.Location{{StartLine: 14307, EndLine: 14313}}},
+		{Name: "validate-npm-package-name", Version: "3.0.0", Constraint: "^3.0.0, ~3.0.0", Locations: []types.Location{{StartLine: 14315, EndLine: 14320}}},
+		{Name: "value-equal", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 14322, EndLine: 14325}}},
+		{Name: "vary", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 14327, EndLine: 14330}}},
+		{Name: "velocity-animate", Version: "1.5.2", Constraint: "^1.4.0", Locations: []types.Location{{StartLine: 14332, EndLine: 14335}}},
+		{Name: "velocity-react", Version: "1.4.3", Constraint: "^1.4.1", Locations: []types.Location{{StartLine: 14337, EndLine: 14345}}},
+		{Name: "verror", Version: "1.10.0", Constraint: "1.10.0", Locations: []types.Location{{StartLine: 14347, EndLine: 14354}}},
+		{Name: "vfile", Version: "3.0.1", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 14371, EndLine: 14379}}},
+		{Name: "vfile", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 14381, EndLine: 14390}}},
+		{Name: "vfile-message", Version: "1.1.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14356, EndLine: 14361}}},
+		{Name: "vfile-message", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14363, EndLine: 14369}}},
+		{Name: "vm-browserify", Version: "0.0.4", Constraint: "0.0.4", Locations: []types.Location{{StartLine: 14392, EndLine: 14397}}},
+		{Name: "w3c-hr-time", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 14399, EndLine: 14404}}},
+		{Name: "walker", Version: "1.0.7", Constraint: "~1.0.5", Locations: []types.Location{{StartLine: 14406, EndLine: 14411}}},
+		{Name: "warning", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 14413, EndLine: 14418}}},
+		{Name: "warning", Version: "4.0.3", Constraint: "^4.0.1, ^4.0.2", Locations: []types.Location{{StartLine: 14420, EndLine: 14425}}},
+		{Name: "watch", Version: "0.18.0", Constraint: "~0.18.0", Locations: []types.Location{{StartLine: 14427, EndLine: 14433}}},
+		{Name: "watchpack", Version: "1.6.0", Constraint: "^1.5.0", Locations: []types.Location{{StartLine: 14435, EndLine: 14442}}},
+		{Name: "wbuf", Version: "1.7.3", Constraint: "^1.1.0, ^1.7.3", Locations: []types.Location{{StartLine: 14444, EndLine: 14449}}},
+		{Name: "wcwidth", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14451, EndLine: 14456}}},
+		{Name: "web-namespaces", Version: "1.1.3", Constraint: "^1.1.2", Locations: []types.Location{{StartLine: 14458, EndLine: 14461}}},
+		{Name: "webidl-conversions", Version: "4.0.2", Constraint: "^4.0.2", Locations: []types.Location{{StartLine: 14463, EndLine: 14466}}},
+		{Name: "webpack", Version: "4.31.0", Constraint: "^4.11.1, ^4.23.1", Locations: []types.Location{{StartLine: 14583, EndLine: 14611}}},
+		{Name: "webpack-bundle-analyzer", Version: "3.3.2", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 14468, EndLine: 14485}}},
+		{Name: "webpack-cli", Version: "3.3.2", Constraint: "^3.0.3", Locations: []types.Location{{StartLine: 14487, EndLine: 14502}}},
+		{Name: "webpack-dev-middleware", Version: "3.7.0", Constraint: "^3.4.0, ^3.6.2", Locations: []types.Location{{StartLine: 14504, EndLine: 14512}}},
+		{Name: "webpack-dev-server", Version: "3.3.1", Constraint: "^3.1.4", Locations: []types.Location{{StartLine: 14514, EndLine: 14548}}},
+		{Name: "webpack-hot-middleware", Version: "2.25.0", Constraint: "^2.24.3", Locations: []types.Location{{StartLine: 14550, EndLine: 14558}}},
+		{Name: "webpack-log", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14560, EndLine: 14566}}},
+		{Name: "webpack-merge", Version: "4.2.1", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 14568, EndLine: 14573}}},
+		{Name: "webpack-sources", Version: "1.3.0", Constraint: "^1.0.1, ^1.1.0, ^1.3.0", Locations: []types.Location{{StartLine: 14575, EndLine: 14581}}},
+		{Name: "websocket-driver", Version: "0.7.0", Constraint: ">=0.5.1", Locations: []types.Location{{StartLine: 14613, EndLine: 14619}}},
+		{Name: "websocket-extensions", Version: "0.1.3", Constraint: ">=0.1.1", Locations: []types.Location{{StartLine: 14621, EndLine: 14624}}},
+		{Name: "whatwg-encoding", Version: "1.0.5", Constraint: "^1.0.1, ^1.0.3", Locations: []types.Location{{StartLine: 14626, EndLine: 14631}}},
+		{Name: "whatwg-fetch", Version: "3.0.0", Constraint: ">=0.10.0", Locations: []types.Location{{StartLine: 14633, EndLine: 14636}}},
+		{Name: "whatwg-mimetype", Version: "2.3.0", Constraint: "^2.1.0, ^2.2.0", Locations: []types.Location{{StartLine: 14638, EndLine: 14641}}},
+		{Name: "whatwg-url", Version: "6.5.0", Constraint: "^6.4.1", Locations: []types.Location{{StartLine: 14643, EndLine: 14650}}},
+		{Name: "whatwg-url", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 14652, EndLine: 14659}}},
+		{Name: "which", Version: "1.3.1", Constraint: "1, ^1.2.10, ^1.2.12, ^1.2.14, ^1.2.9, ^1.3.0, ^1.3.1", Locations: []types.Location{{StartLine: 14671, EndLine: 14676}}},
+		{Name: "which-module", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 14661, EndLine: 14664}}},
+		{Name: "which-module", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14666, EndLine: 14669}}},
+		{Name: "wide-align", Version: "1.1.3", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 14678, EndLine: 14683}}},
+		{Name: "widest-line", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14685, EndLine: 14690}}},
+		{Name: "window-size", Version: "0.2.0", Constraint: "^0.2.0", Locations: []types.Location{{StartLine: 14692, EndLine: 14695}}},
+		{Name: "windows-release", Version: "3.2.0", Constraint: "^3.1.0", Locations: []types.Location{{StartLine: 14697, EndLine: 14702}}},
+		{Name: "wordwrap", Version: "0.0.3", Constraint: "~0.0.2", Locations: []types.Location{{StartLine: 14704, EndLine: 14707}}},
+		{Name: "wordwrap", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 14709, EndLine: 14712}}},
+		{Name: "worker-farm", Version: "1.7.0", Constraint: "^1.6.0, ^1.7.0", Locations: []types.Location{{StartLine: 14714, EndLine: 14719}}},
+		{Name: "wrap-ansi", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 14721, EndLine: 14727}}},
+		{Name: "wrap-ansi", Version: "3.0.1", Constraint: "^3.0.1", Locations: []types.Location{{StartLine: 14729, EndLine: 14735}}},
+		{Name: "wrappy", Version: "1.0.2", Constraint: "1", Locations: []types.Location{{StartLine: 14737, EndLine: 14740}}},
+		{Name: "write", Version: "1.0.3", Constraint: "1.0.3", Locations: []types.Location{{StartLine: 14772, EndLine: 14777}}},
+		{Name: "write-file-atomic", Version: "1.3.4", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 14742, EndLine: 14749}}},
+		{Name: "write-file-atomic", Version: "2.4.2", Constraint: "^2.0.0, ^2.1.0, ^2.3.0, ^2.4.2", Locations: []types.Location{{StartLine: 14751, EndLine: 14758}}},
+		{Name: "write-json-file", Version: "2.3.0", Constraint: "^2.3.0", Locations: []types.Location{{StartLine: 14760, EndLine: 14770}}},
+		{Name: "ws", Version: "5.2.2", Constraint: "^5.2.0", Locations: []types.Location{{StartLine: 14779, EndLine: 14784}}},
+		{Name: "ws", Version: "6.1.4", Constraint: "~6.1.0", Locations: []types.Location{{StartLine: 14793, EndLine: 14798}}},
+		{Name: "ws", Version: "6.2.1", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 14786, EndLine: 14791}}},
+		{Name: "x-is-string", Version: "0.1.0", Constraint: "^0.1.0", Locations: []types.Location{{StartLine: 14800, EndLine: 14803}}},
+		{Name: "xdg-basedir", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 14805, EndLine: 14808}}},
+		{Name: "xml-name-validator", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 14810, EndLine: 14813}}},
+		{Name: "xmlhttprequest-ssl", Version: "1.5.5", Constraint: "~1.5.4", Locations: []types.Location{{StartLine: 14815, EndLine: 14818}}},
+		{Name: "xtend", Version: "4.0.1", Constraint: "^4.0.0, ^4.0.1, ~4.0.1", Locations: []types.Location{{StartLine: 14820, EndLine: 14823}}},
+		{Name: "y18n", Version: "3.2.1", Constraint: "^3.2.1", Locations: []types.Location{{StartLine: 14825, EndLine: 14828}}},
+		{Name: "y18n", Version: "4.0.0", Constraint: "^3.2.1 || ^4.0.0, ^4.0.0", Locations: []types.Location{{StartLine: 14830, EndLine: 14833}}},
+		{Name: "yallist", Version: "2.1.2", Constraint: "^2.1.2", Locations: []types.Location{{StartLine: 14835, EndLine: 14838}}},
+		{Name: "yallist", Version: "3.0.3", Constraint: "^3.0.0, ^3.0.2", Locations: []types.Location{{StartLine: 14840, EndLine: 14843}}},
+		{Name: "yargs", Version: "11.1.0", Constraint: "^11.0.0", Locations: []types.Location{{StartLine: 14886, EndLine: 14902}}},
+		{Name: "yargs", Version: "12.0.5", Constraint: "12.0.5, ^12.0.5", Locations: []types.Location{{StartLine: 14868, EndLine: 14884}}},
+		{Name: "yargs", Version: "4.8.1", Constraint: "^4.2.0", Locations: []types.Location{{StartLine: 14904, EndLine: 14922}}},
+		{Name: "yargs-parser", Version: "11.1.1", Constraint: "^11.1.1", Locations: []types.Location{{StartLine: 14845, EndLine: 14851}}},
+		{Name: "yargs-parser", Version: "2.4.1", Constraint: "^2.4.1", Locations: []types.Location{{StartLine: 14853, EndLine: 14859}}},
+		{Name: "yargs-parser", Version: "9.0.2", Constraint: "^9.0.2", Locations: []types.Location{{StartLine: 14861, EndLine: 14866}}},
+		{Name: "yeast", Version: "0.1.2", Constraint: "0.1.2", Locations: []types.Location{{StartLine: 14924, EndLine: 14927}}},
 	}
 
 	// docker run --name yarn2 --rm -it -w /code node:12-alpine sh
@@ -2323,521 +2323,521 @@ var (
 	// yarn add promise jquery
 	// yarn info --recursive --dependents --json | jq -r .value | grep -v workspace | awk -F'[@:]' '{printf("{\""$1"\", \""$3"\", \"\"},\n")}'
 	yarnV2Normal = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.5.1", ""},
-		{"promise", "8.1.0", ""},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 8, EndLine: 13}}},
+		{Name: "jquery", Version: "3.5.1", Constraint: "^3.5.1", Locations: []types.Location{{StartLine: 24, EndLine: 29}}},
+		{Name: "promise", Version: "8.1.0", Constraint: "^8.1.0", Locations: []types.Location{{StartLine: 31, EndLine: 38}}},
 	}
 
 	// ... and
 	// yarn add react redux
 	// yarn info --recursive --dependents --json | jq -r .value | grep -v workspace | awk -F'[@:]' '{printf("{\""$1"\", \""$3"\", \"\"},\n")}'
 	yarnV2React = []types.Library{
-		{"asap", "2.0.6", ""},
-		{"jquery", "3.5.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"promise", "8.1.0", ""},
-		{"prop-types", "15.7.2", ""},
-		{"react-is", "16.13.1", ""},
-		{"react", "16.13.1", ""},
-		{"redux", "4.0.5", ""},
-		{"symbol-observable", "1.2.0", ""},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 8, EndLine: 13}}},
+		{Name: "jquery", Version: "3.5.1", Constraint: "^3.5.1", Locations: []types.Location{{StartLine: 26, EndLine: 31}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 33, EndLine: 38}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 40, EndLine: 49}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.1", Locations: []types.Location{{StartLine: 51, EndLine: 56}}},
+		{Name: "promise", Version: "8.1.0", Constraint: "^8.1.0", Locations: []types.Location{{StartLine: 58, EndLine: 65}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 67, EndLine: 76}}},
+		{Name: "react-is", Version: "16.13.1", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 78, EndLine: 83}}},
+		{Name: "react", Version: "16.13.1", Constraint: "^16.13.1", Locations: []types.Location{{StartLine: 85, EndLine: 94}}},
+		{Name: "redux", Version: "4.0.5", Constraint: "^4.0.5", Locations: []types.Location{{StartLine: 96, EndLine: 104}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 106, EndLine: 111}}},
 	}
 
 	// ... and
 	// yarn add -D mocha
 	// yarn info --recursive --dependents --json | jq -r .value | grep -v workspace | awk -F'[@:]' '{printf("{\""$1"\", \""$3"\", \"\"},\n")}'
 	yarnV2WithDev = []types.Library{
-		{"@types/color-name", "1.1.1", ""},
-		{"abbrev", "1.1.1", ""},
-		{"ajv", "6.12.4", ""},
-		{"ansi-colors", "4.1.1", ""},
-		{"ansi-regex", "2.1.1", ""},
-		{"ansi-regex", "3.0.0", ""},
-		{"ansi-regex", "4.1.0", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"ansi-styles", "4.2.1", ""},
-		{"anymatch", "3.1.1", ""},
-		{"aproba", "1.2.0", ""},
-		{"are-we-there-yet", "1.1.5", ""},
-		{"argparse", "1.0.10", ""},
-		{"array.prototype.map", "1.0.2", ""},
-		{"asap", "2.0.6", ""},
-		{"asn1", "0.2.4", ""},
-		{"assert-plus", "1.0.0", ""},
-		{"asynckit", "0.4.0", ""},
-		{"aws-sign2", "0.7.0", ""},
-		{"aws4", "1.10.1", ""},
-		{"balanced-match", "1.0.0", ""},
-		{"bcrypt-pbkdf", "1.0.2", ""},
-		{"binary-extensions", "2.1.0", ""},
-		{"brace-expansion", "1.1.11", ""},
-		{"braces", "3.0.2", ""},
-		{"browser-stdout", "1.3.1", ""},
-		{"camelcase", "5.3.1", ""},
-		{"caseless", "0.12.0", ""},
-		{"chalk", "4.1.0", ""},
-		{"chokidar", "3.4.2", ""},
-		{"chownr", "2.0.0", ""},
-		{"cliui", "5.0.0", ""},
-		{"code-point-at", "1.1.0", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-convert", "2.0.1", ""},
-		{"color-name", "1.1.3", ""},
-		{"color-name", "1.1.4", ""},
-		{"combined-stream", "1.0.8", ""},
-		{"concat-map", "0.0.1", ""},
-		{"console-control-strings", "1.1.0", ""},
-		{"core-util-is", "1.0.2", ""},
-		{"dashdash", "1.14.1", ""},
-		{"debug", "4.1.1", ""},
-		{"decamelize", "1.2.0", ""},
-		{"define-properties", "1.1.3", ""},
-		{"delayed-stream", "1.0.0", ""},
-		{"delegates", "1.0.0", ""},
-		{"diff", "4.0.2", ""},
-		{"ecc-jsbn", "0.1.2", ""},
-		{"emoji-regex", "7.0.3", ""},
-		{"env-paths", "2.2.0", ""},
-		{"es-abstract", "1.17.6", ""},
-		{"es-array-method-boxes-properly", "1.0.0", ""},
-		{"es-get-iterator", "1.1.0", ""},
-		{"es-to-primitive", "1.2.1", ""},
-		{"escape-string-regexp", "4.0.0", ""},
-		{"esprima", "4.0.1", ""},
-		{"extend", "3.0.2", ""},
-		{"extsprintf", "1.3.0", ""},
-		{"fast-deep-equal", "3.1.3", ""},
-		{"fast-json-stable-stringify", "2.1.0", ""},
-		{"fill-range", "7.0.1", ""},
-		{"find-up", "5.0.0", ""},
-		{"find-up", "3.0.0", ""},
-		{"flat", "4.1.0", ""},
-		{"forever-agent", "0.6.1", ""},
-		{"form-data", "2.3.3", ""},
-		{"fs-minipass", "2.1.0", ""},
-		{"fs.realpath", "1.0.0", ""},
-		{"fsevents", "2.1.3", ""},
-		{"function-bind", "1.1.1", ""},
-		{"gauge", "2.7.4", ""},
-		{"get-caller-file", "2.0.5", ""},
-		{"getpass", "0.1.7", ""},
-		{"glob-parent", "5.1.1", ""},
-		{"glob", "7.1.6", ""},
-		{"graceful-fs", "4.2.4", ""},
-		{"growl", "1.10.5", ""},
-		{"har-schema", "2.0.0", ""},
-		{"har-validator", "5.1.5", ""},
-		{"has-flag", "4.0.0", ""},
-		{"has-symbols", "1.0.1", ""},
-		{"has-unicode", "2.0.1", ""},
-		{"has", "1.0.3", ""},
-		{"he", "1.2.0", ""},
-		{"http-signature", "1.2.0", ""},
-		{"inflight", "1.0.6", ""},
-		{"inherits", "2.0.4", ""},
-		{"is-arguments", "1.0.4", ""},
-		{"is-binary-path", "2.1.0", ""},
-		{"is-buffer", "2.0.4", ""},
-		{"is-callable", "1.2.0", ""},
-		{"is-date-object", "1.0.2", ""},
-		{"is-extglob", "2.1.1", ""},
-		{"is-fullwidth-code-point", "1.0.0", ""},
-		{"is-fullwidth-code-point", "2.0.0", ""},
-		{"is-glob", "4.0.1", ""},
-		{"is-map", "2.0.1", ""},
-		{"is-number", "7.0.0", ""},
-		{"is-plain-obj", "1.1.0", ""},
-		{"is-regex", "1.1.1", ""},
-		{"is-set", "2.0.1", ""},
-		{"is-string", "1.0.5", ""},
-		{"is-symbol", "1.0.3", ""},
-		{"is-typedarray", "1.0.0", ""},
-		{"isarray", "2.0.5", ""},
-		{"isarray", "1.0.0", ""},
-		{"isexe", "2.0.0", ""},
-		{"isstream", "0.1.2", ""},
-		{"iterate-iterator", "1.0.1", ""},
-		{"iterate-value", "1.0.2", ""},
-		{"jquery", "3.5.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"js-yaml", "3.14.0", ""},
-		{"jsbn", "0.1.1", ""},
-		{"json-schema-traverse", "0.4.1", ""},
-		{"json-schema", "0.2.3", ""},
-		{"json-stringify-safe", "5.0.1", ""},
-		{"jsprim", "1.4.1", ""},
-		{"locate-path", "3.0.0", ""},
-		{"locate-path", "6.0.0", ""},
-		{"log-symbols", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"mime-db", "1.44.0", ""},
-		{"mime-types", "2.1.27", ""},
-		{"minimatch", "3.0.4", ""},
-		{"minipass", "3.1.3", ""},
-		{"minizlib", "2.1.2", ""},
-		{"mkdirp", "1.0.4", ""},
-		{"mocha", "8.1.3", ""},
-		{"ms", "2.1.2", ""},
-		{"node-gyp", "7.1.0", ""},
-		{"nopt", "4.0.3", ""},
-		{"normalize-path", "3.0.0", ""},
-		{"npmlog", "4.1.2", ""},
-		{"number-is-nan", "1.0.1", ""},
-		{"oauth-sign", "0.9.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"object-inspect", "1.8.0", ""},
-		{"object-keys", "1.1.1", ""},
-		{"object.assign", "4.1.0", ""},
-		{"once", "1.4.0", ""},
-		{"os-homedir", "1.0.2", ""},
-		{"os-tmpdir", "1.0.2", ""},
-		{"osenv", "0.1.5", ""},
-		{"p-limit", "2.3.0", ""},
-		{"p-limit", "3.0.2", ""},
-		{"p-locate", "3.0.0", ""},
-		{"p-locate", "5.0.0", ""},
-		{"p-try", "2.2.0", ""},
-		{"path-exists", "3.0.0", ""},
-		{"path-exists", "4.0.0", ""},
-		{"path-is-absolute", "1.0.1", ""},
-		{"performance-now", "2.1.0", ""},
-		{"picomatch", "2.2.2", ""},
-		{"process-nextick-args", "2.0.1", ""},
-		{"promise.allsettled", "1.0.2", ""},
-		{"promise", "8.1.0", ""},
-		{"prop-types", "15.7.2", ""},
-		{"psl", "1.8.0", ""},
-		{"punycode", "2.1.1", ""},
-		{"qs", "6.5.2", ""},
-		{"randombytes", "2.1.0", ""},
-		{"react-is", "16.13.1", ""},
-		{"react", "16.13.1", ""},
-		{"readable-stream", "2.3.7", ""},
-		{"readdirp", "3.4.0", ""},
-		{"redux", "4.0.5", ""},
-		{"request", "2.88.2", ""},
-		{"require-directory", "2.1.1", ""},
-		{"require-main-filename", "2.0.0", ""},
-		{"rimraf", "2.7.1", ""},
-		{"safe-buffer", "5.2.1", ""},
-		{"safe-buffer", "5.1.2", ""},
-		{"safer-buffer", "2.1.2", ""},
-		{"semver", "7.3.2", ""},
-		{"serialize-javascript", "4.0.0", ""},
-		{"set-blocking", "2.0.0", ""},
-		{"signal-exit", "3.0.3", ""},
-		{"sprintf-js", "1.0.3", ""},
-		{"sshpk", "1.16.1", ""},
-		{"string-width", "1.0.2", ""},
-		{"string-width", "2.1.1", ""},
-		{"string-width", "3.1.0", ""},
-		{"string.prototype.trimend", "1.0.1", ""},
-		{"string.prototype.trimstart", "1.0.1", ""},
-		{"string_decoder", "1.1.1", ""},
-		{"strip-ansi", "3.0.1", ""},
-		{"strip-ansi", "4.0.0", ""},
-		{"strip-ansi", "5.2.0", ""},
-		{"strip-json-comments", "3.0.1", ""},
-		{"supports-color", "7.1.0", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"tar", "6.0.5", ""},
-		{"to-regex-range", "5.0.1", ""},
-		{"tough-cookie", "2.5.0", ""},
-		{"tunnel-agent", "0.6.0", ""},
-		{"tweetnacl", "0.14.5", ""},
-		{"uri-js", "4.4.0", ""},
-		{"util-deprecate", "1.0.2", ""},
-		{"uuid", "3.4.0", ""},
-		{"verror", "1.10.0", ""},
-		{"which-module", "2.0.0", ""},
-		{"which", "2.0.2", ""},
-		{"wide-align", "1.1.3", ""},
-		{"workerpool", "6.0.0", ""},
-		{"wrap-ansi", "5.1.0", ""},
-		{"wrappy", "1.0.2", ""},
-		{"y18n", "4.0.0", ""},
-		{"yallist", "4.0.0", ""},
-		{"yargs-parser", "13.1.2", ""},
-		{"yargs-parser", "15.0.1", ""},
-		{"yargs-unparser", "1.6.1", ""},
-		{"yargs", "13.3.2", ""},
-		{"yargs", "14.2.3", ""},
+		{Name: "@types/color-name", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 8, EndLine: 13}}},
+		{Name: "abbrev", Version: "1.1.1", Constraint: "1", Locations: []types.Location{{StartLine: 15, EndLine: 20}}},
+		{Name: "ajv", Version: "6.12.4", Constraint: "^6.12.3", Locations: []types.Location{{StartLine: 22, EndLine: 32}}},
+		{Name: "ansi-colors", Version: "4.1.1", Constraint: "4.1.1", Locations: []types.Location{{StartLine: 34, EndLine: 39}}},
+		{Name: "ansi-regex", Version: "2.1.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 41, EndLine: 46}}},
+		{Name: "ansi-regex", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 48, EndLine: 53}}},
+		{Name: "ansi-regex", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 55, EndLine: 60}}},
+		{Name: "ansi-styles", Version: "3.2.1", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 62, EndLine: 69}}},
+		{Name: "ansi-styles", Version: "4.2.1", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 71, EndLine: 79}}},
+		{Name: "anymatch", Version: "3.1.1", Constraint: "~3.1.1", Locations: []types.Location{{StartLine: 81, EndLine: 89}}},
+		{Name: "aproba", Version: "1.2.0", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 91, EndLine: 96}}},
+		{Name: "are-we-there-yet", Version: "1.1.5", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 98, EndLine: 106}}},
+		{Name: "argparse", Version: "1.0.10", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 108, EndLine: 115}}},
+		{Name: "array.prototype.map", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 117, EndLine: 127}}},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 129, EndLine: 134}}},
+		{Name: "asn1", Version: "0.2.4", Constraint: "~0.2.3", Locations: []types.Location{{StartLine: 136, EndLine: 143}}},
+		{Name: "assert-plus", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0", Locations: []types.Location{{StartLine: 145, EndLine: 150}}},
+		{Name: "asynckit", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 152, EndLine: 157}}},
+		{Name: "aws-sign2", Version: "0.7.0", Constraint: "~0.7.0", Locations: []types.Location{{StartLine: 159, EndLine: 164}}},
+		{Name: "aws4", Version: "1.10.1", Constraint: "^1.8.0", Locations: []types.Location{{StartLine: 166, EndLine: 171}}},
+		{Name: "balanced-match", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 173, EndLine: 178}}},
+		{Name: "bcrypt-pbkdf", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 180, EndLine: 187}}},
+		{Name: "binary-extensions", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 189, EndLine: 194}}},
+		{Name: "brace-expansion", Version: "1.1.11", Constraint: "^1.1.7", Locations: []types.Location{{StartLine: 196, EndLine: 204}}},
+		{Name: "braces", Version: "3.0.2", Constraint: "~3.0.2", Locations: []types.Location{{StartLine: 206, EndLine: 213}}},
+		{Name: "browser-stdout", Version: "1.3.1", Constraint: "1.3.1", Locations: []types.Location{{StartLine: 215, EndLine: 220}}},
+		{Name: "camelcase", Version: "5.3.1", Constraint: "^5.0.0, ^5.3.1", Locations: []types.Location{{StartLine: 222, EndLine: 227}}},
+		{Name: "caseless", Version: "0.12.0", Constraint: "~0.12.0", Locations: []types.Location{{StartLine: 229, EndLine: 234}}},
+		{Name: "chalk", Version: "4.1.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 236, EndLine: 244}}},
+		{Name: "chokidar", Version: "3.4.2", Constraint: "3.4.2", Locations: []types.Location{{StartLine: 246, EndLine: 263}}},
+		{Name: "chownr", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 265, EndLine: 270}}},
+		{Name: "cliui", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 272, EndLine: 281}}},
+		{Name: "code-point-at", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 283, EndLine: 288}}},
+		{Name: "color-convert", Version: "1.9.3", Constraint: "^1.9.0", Locations: []types.Location{{StartLine: 302, EndLine: 309}}},
+		{Name: "color-convert", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 311, EndLine: 318}}},
+		{Name: "color-name", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 320, EndLine: 325}}},
+		{Name: "color-name", Version: "1.1.4", Constraint: "~1.1.4", Locations: []types.Location{{StartLine: 327, EndLine: 332}}},
+		{Name: "combined-stream", Version: "1.0.8", Constraint: "^1.0.6, ~1.0.6", Locations: []types.Location{{StartLine: 334, EndLine: 341}}},
+		{Name: "concat-map", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 343, EndLine: 348}}},
+		{Name: "console-control-strings", Version: "1.1.0", Constraint: "^1.0.0, ~1.1.0", Locations: []types.Location{{StartLine: 350, EndLine: 355}}},
+		{Name: "core-util-is", Version: "1.0.2", Constraint: "1.0.2, ~1.0.0", Locations: []types.Location{{StartLine: 357, EndLine: 362}}},
+		{Name: "dashdash", Version: "1.14.1", Constraint: "^1.12.0", Locations: []types.Location{{StartLine: 364, EndLine: 371}}},
+		{Name: "debug", Version: "4.1.1", Constraint: "4.1.1", Locations: []types.Location{{StartLine: 373, EndLine: 380}}},
+		{Name: "decamelize", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 382, EndLine: 387}}},
+		{Name: "define-properties", Version: "1.1.3", Constraint: "^1.1.2, ^1.1.3", Locations: []types.Location{{StartLine: 389, EndLine: 396}}},
+		{Name: "delayed-stream", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 398, EndLine: 403}}},
+		{Name: "delegates", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 405, EndLine: 410}}},
+		{Name: "diff", Version: "4.0.2", Constraint: "4.0.2", Locations: []types.Location{{StartLine: 412, EndLine: 417}}},
+		{Name: "ecc-jsbn", Version: "0.1.2", Constraint: "~0.1.1", Locations: []types.Location{{StartLine: 419, EndLine: 427}}},
+		{Name: "emoji-regex", Version: "7.0.3", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 429, EndLine: 434}}},
+		{Name: "env-paths", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 436, EndLine: 441}}},
+		{Name: "es-abstract", Version: "1.17.6", Constraint: "^1.17.0-next.1, ^1.17.4, ^1.17.5", Locations: []types.Location{{StartLine: 443, EndLine: 460}}},
+		{Name: "es-array-method-boxes-properly", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 462, EndLine: 467}}},
+		{Name: "es-get-iterator", Version: "1.1.0", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 469, EndLine: 482}}},
+		{Name: "es-to-primitive", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 484, EndLine: 493}}},
+		{Name: "escape-string-regexp", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 495, EndLine: 500}}},
+		{Name: "esprima", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 502, EndLine: 510}}},
+		{Name: "extend", Version: "3.0.2", Constraint: "~3.0.2", Locations: []types.Location{{StartLine: 512, EndLine: 517}}},
+		{Name: "extsprintf", Version: "1.3.0", Constraint: "1.3.0, ^1.2.0", Locations: []types.Location{{StartLine: 519, EndLine: 524}}},
+		{Name: "fast-deep-equal", Version: "3.1.3", Constraint: "^3.1.1", Locations: []types.Location{{StartLine: 526, EndLine: 531}}},
+		{Name: "fast-json-stable-stringify", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 533, EndLine: 538}}},
+		{Name: "fill-range", Version: "7.0.1", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 540, EndLine: 547}}},
+		{Name: "find-up", Version: "5.0.0", Constraint: "5.0.0", Locations: []types.Location{{StartLine: 549, EndLine: 557}}},
+		{Name: "find-up", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 559, EndLine: 566}}},
+		{Name: "flat", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 568, EndLine: 577}}},
+		{Name: "forever-agent", Version: "0.6.1", Constraint: "~0.6.1", Locations: []types.Location{{StartLine: 579, EndLine: 584}}},
+		{Name: "form-data", Version: "2.3.3", Constraint: "~2.3.2", Locations: []types.Location{{StartLine: 586, EndLine: 595}}},
+		{Name: "fs-minipass", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 597, EndLine: 604}}},
+		{Name: "fs.realpath", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 606, EndLine: 611}}},
+		{Name: "fsevents", Version: "2.1.3", Constraint: "~2.1.2", Locations: []types.Location{{StartLine: 622, EndLine: 629}}},
+		{Name: "function-bind", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 631, EndLine: 636}}},
+		{Name: "gauge", Version: "2.7.4", Constraint: "~2.7.3", Locations: []types.Location{{StartLine: 638, EndLine: 652}}},
+		{Name: "get-caller-file", Version: "2.0.5", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 654, EndLine: 659}}},
+		{Name: "getpass", Version: "0.1.7", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 661, EndLine: 668}}},
+		{Name: "glob-parent", Version: "5.1.1", Constraint: "~5.1.0", Locations: []types.Location{{StartLine: 670, EndLine: 677}}},
+		{Name: "glob", Version: "7.1.6", Constraint: "7.1.6, ^7.1.3, ^7.1.4", Locations: []types.Location{{StartLine: 679, EndLine: 691}}},
+		{Name: "graceful-fs", Version: "4.2.4", Constraint: "^4.2.3", Locations: []types.Location{{StartLine: 693, EndLine: 698}}},
+		{Name: "growl", Version: "1.10.5", Constraint: "1.10.5", Locations: []types.Location{{StartLine: 700, EndLine: 705}}},
+		{Name: "har-schema", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 707, EndLine: 712}}},
+		{Name: "har-validator", Version: "5.1.5", Constraint: "~5.1.3", Locations: []types.Location{{StartLine: 714, EndLine: 722}}},
+		{Name: "has-flag", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 724, EndLine: 729}}},
+		{Name: "has-symbols", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 731, EndLine: 736}}},
+		{Name: "has-unicode", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 738, EndLine: 743}}},
+		{Name: "has", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 745, EndLine: 752}}},
+		{Name: "he", Version: "1.2.0", Constraint: "1.2.0", Locations: []types.Location{{StartLine: 754, EndLine: 761}}},
+		{Name: "http-signature", Version: "1.2.0", Constraint: "~1.2.0", Locations: []types.Location{{StartLine: 763, EndLine: 772}}},
+		{Name: "inflight", Version: "1.0.6", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 774, EndLine: 782}}},
+		{Name: "inherits", Version: "2.0.4", Constraint: "2, ~2.0.3", Locations: []types.Location{{StartLine: 784, EndLine: 789}}},
+		{Name: "is-arguments", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 791, EndLine: 796}}},
+		{Name: "is-binary-path", Version: "2.1.0", Constraint: "~2.1.0", Locations: []types.Location{{StartLine: 798, EndLine: 805}}},
+		{Name: "is-buffer", Version: "2.0.4", Constraint: "~2.0.3", Locations: []types.Location{{StartLine: 807, EndLine: 812}}},
+		{Name: "is-callable", Version: "1.2.0", Constraint: "^1.1.4, ^1.2.0", Locations: []types.Location{{StartLine: 814, EndLine: 819}}},
+		{Name: "is-date-object", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 821, EndLine: 826}}},
+		{Name: "is-extglob", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 828, EndLine: 833}}},
+		{Name: "is-fullwidth-code-point", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 835, EndLine: 842}}},
+		{Name: "is-fullwidth-code-point", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 844, EndLine: 849}}},
+		{Name: "is-glob", Version: "4.0.1", Constraint: "^4.0.1, ~4.0.1", Locations: []types.Location{{StartLine: 851, EndLine: 858}}},
+		{Name: "is-map", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 860, EndLine: 865}}},
+		{Name: "is-number", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 867, EndLine: 872}}},
+		{Name: "is-plain-obj", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 874, EndLine: 879}}},
+		{Name: "is-regex", Version: "1.1.1", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 881, EndLine: 888}}},
+		{Name: "is-set", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 890, EndLine: 895}}},
+		{Name: "is-string", Version: "1.0.5", Constraint: "^1.0.4, ^1.0.5", Locations: []types.Location{{StartLine: 897, EndLine: 902}}},
+		{Name: "is-symbol", Version: "1.0.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 904, EndLine: 911}}},
+		{Name: "is-typedarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 913, EndLine: 918}}},
+		{Name: "isarray", Version: "2.0.5", Constraint: "^2.0.5", Locations: []types.Location{{StartLine: 920, EndLine: 925}}},
+		{Name: "isarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 927, EndLine: 932}}},
+		{Name: "isexe", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 934, EndLine: 939}}},
+		{Name: "isstream", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 941, EndLine: 946}}},
+		{Name: "iterate-iterator", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 948, EndLine: 953}}},
+		{Name: "iterate-value", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 955, EndLine: 963}}},
+		{Name: "jquery", Version: "3.5.1", Constraint: "^3.5.1", Locations: []types.Location{{StartLine: 965, EndLine: 970}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 972, EndLine: 977}}},
+		{Name: "js-yaml", Version: "3.14.0", Constraint: "3.14.0", Locations: []types.Location{{StartLine: 979, EndLine: 989}}},
+		{Name: "jsbn", Version: "0.1.1", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 991, EndLine: 996}}},
+		{Name: "json-schema-traverse", Version: "0.4.1", Constraint: "^0.4.1", Locations: []types.Location{{StartLine: 998, EndLine: 1003}}},
+		{Name: "json-schema", Version: "0.2.3", Constraint: "0.2.3", Locations: []types.Location{{StartLine: 1005, EndLine: 1010}}},
+		{Name: "json-stringify-safe", Version: "5.0.1", Constraint: "~5.0.1", Locations: []types.Location{{StartLine: 1012, EndLine: 1017}}},
+		{Name: "jsprim", Version: "1.4.1", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 1019, EndLine: 1029}}},
+		{Name: "locate-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1031, EndLine: 1039}}},
+		{Name: "locate-path", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 1041, EndLine: 1048}}},
+		{Name: "log-symbols", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 1050, EndLine: 1057}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 1059, EndLine: 1068}}},
+		{Name: "mime-db", Version: "1.44.0", Constraint: "1.44.0", Locations: []types.Location{{StartLine: 1070, EndLine: 1075}}},
+		{Name: "mime-types", Version: "2.1.27", Constraint: "^2.1.12, ~2.1.19", Locations: []types.Location{{StartLine: 1077, EndLine: 1084}}},
+		{Name: "minimatch", Version: "3.0.4", Constraint: "3.0.4, ^3.0.4", Locations: []types.Location{{StartLine: 1086, EndLine: 1093}}},
+		{Name: "minipass", Version: "3.1.3", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1095, EndLine: 1102}}},
+		{Name: "minizlib", Version: "2.1.2", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1104, EndLine: 1112}}},
+		{Name: "mkdirp", Version: "1.0.4", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 1114, EndLine: 1121}}},
+		{Name: "mocha", Version: "8.1.3", Constraint: "^8.1.3", Locations: []types.Location{{StartLine: 1123, EndLine: 1157}}},
+		{Name: "ms", Version: "2.1.2", Constraint: "2.1.2, ^2.1.1", Locations: []types.Location{{StartLine: 1159, EndLine: 1164}}},
+		{Name: "node-gyp", Version: "7.1.0", Constraint: "latest", Locations: []types.Location{{StartLine: 1166, EndLine: 1184}}},
+		{Name: "nopt", Version: "4.0.3", Constraint: "^4.0.3", Locations: []types.Location{{StartLine: 1186, EndLine: 1196}}},
+		{Name: "normalize-path", Version: "3.0.0", Constraint: "^3.0.0, ~3.0.0", Locations: []types.Location{{StartLine: 1198, EndLine: 1203}}},
+		{Name: "npmlog", Version: "4.1.2", Constraint: "^4.1.2", Locations: []types.Location{{StartLine: 1205, EndLine: 1215}}},
+		{Name: "number-is-nan", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1217, EndLine: 1222}}},
+		{Name: "oauth-sign", Version: "0.9.0", Constraint: "~0.9.0", Locations: []types.Location{{StartLine: 1224, EndLine: 1229}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.0, ^4.1.1", Locations: []types.Location{{StartLine: 1231, EndLine: 1236}}},
+		{Name: "object-inspect", Version: "1.8.0", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 1238, EndLine: 1243}}},
+		{Name: "object-keys", Version: "1.1.1", Constraint: "^1.0.11, ^1.0.12, ^1.1.1", Locations: []types.Location{{StartLine: 1245, EndLine: 1250}}},
+		{Name: "object.assign", Version: "4.1.0", Constraint: "4.1.0, ^4.1.0", Locations: []types.Location{{StartLine: 1252, EndLine: 1262}}},
+		{Name: "once", Version: "1.4.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 1264, EndLine: 1271}}},
+		{Name: "os-homedir", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1273, EndLine: 1278}}},
+		{Name: "os-tmpdir", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1280, EndLine: 1285}}},
+		{Name: "osenv", Version: "0.1.5", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 1287, EndLine: 1295}}},
+		{Name: "p-limit", Version: "2.3.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1297, EndLine: 1304}}},
+		{Name: "p-limit", Version: "3.0.2", Constraint: "^3.0.2", Locations: []types.Location{{StartLine: 1306, EndLine: 1313}}},
+		{Name: "p-locate", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1315, EndLine: 1322}}},
+		{Name: "p-locate", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 1324, EndLine: 1331}}},
+		{Name: "p-try", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1333, EndLine: 1338}}},
+		{Name: "path-exists", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1340, EndLine: 1345}}},
+		{Name: "path-exists", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1347, EndLine: 1352}}},
+		{Name: "path-is-absolute", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1354, EndLine: 1359}}},
+		{Name: "performance-now", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1361, EndLine: 1366}}},
+		{Name: "picomatch", Version: "2.2.2", Constraint: "^2.0.4, ^2.2.1", Locations: []types.Location{{StartLine: 1368, EndLine: 1373}}},
+		{Name: "process-nextick-args", Version: "2.0.1", Constraint: "~2.0.0", Locations: []types.Location{{StartLine: 1375, EndLine: 1380}}},
+		{Name: "promise.allsettled", Version: "1.0.2", Constraint: "1.0.2", Locations: []types.Location{{StartLine: 1382, EndLine: 1393}}},
+		{Name: "promise", Version: "8.1.0", Constraint: "^8.1.0", Locations: []types.Location{{StartLine: 1395, EndLine: 1402}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 1404, EndLine: 1413}}},
+		{Name: "psl", Version: "1.8.0", Constraint: "^1.1.28", Locations: []types.Location{{StartLine: 1415, EndLine: 1420}}},
+		{Name: "punycode", Version: "2.1.1", Constraint: "^2.1.0, ^2.1.1", Locations: []types.Location{{StartLine: 1422, EndLine: 1427}}},
+		{Name: "qs", Version: "6.5.2", Constraint: "~6.5.2", Locations: []types.Location{{StartLine: 1429, EndLine: 1434}}},
+		{Name: "randombytes", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1436, EndLine: 1443}}},
+		{Name: "react-is", Version: "16.13.1", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 1445, EndLine: 1450}}},
+		{Name: "react", Version: "16.13.1", Constraint: "^16.13.1", Locations: []types.Location{{StartLine: 1452, EndLine: 1461}}},
+		{Name: "readable-stream", Version: "2.3.7", Constraint: "^2.0.6", Locations: []types.Location{{StartLine: 1463, EndLine: 1476}}},
+		{Name: "readdirp", Version: "3.4.0", Constraint: "~3.4.0", Locations: []types.Location{{StartLine: 1478, EndLine: 1485}}},
+		{Name: "redux", Version: "4.0.5", Constraint: "^4.0.5", Locations: []types.Location{{StartLine: 1487, EndLine: 1495}}},
+		{Name: "request", Version: "2.88.2", Constraint: "^2.88.2", Locations: []types.Location{{StartLine: 1497, EndLine: 1523}}},
+		{Name: "require-directory", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1525, EndLine: 1530}}},
+		{Name: "require-main-filename", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1532, EndLine: 1537}}},
+		{Name: "rimraf", Version: "2.7.1", Constraint: "^2.6.3", Locations: []types.Location{{StartLine: 1539, EndLine: 1548}}},
+		{Name: "safe-buffer", Version: "5.2.1", Constraint: "^5.0.1, ^5.1.0, ^5.1.2", Locations: []types.Location{{StartLine: 1550, EndLine: 1555}}},
+		{Name: "safe-buffer", Version: "5.1.2", Constraint: "~5.1.0, ~5.1.1", Locations: []types.Location{{StartLine: 1557, EndLine: 1562}}},
+		{Name: "safer-buffer", Version: "2.1.2", Constraint: "^2.0.2, ^2.1.0, ~2.1.0", Locations: []types.Location{{StartLine: 1564, EndLine: 1569}}},
+		{Name: "semver", Version: "7.3.2", Constraint: "^7.3.2", Locations: []types.Location{{StartLine: 1571, EndLine: 1578}}},
+		{Name: "serialize-javascript", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 1580, EndLine: 1587}}},
+		{Name: "set-blocking", Version: "2.0.0", Constraint: "^2.0.0, ~2.0.0", Locations: []types.Location{{StartLine: 1589, EndLine: 1594}}},
+		{Name: "signal-exit", Version: "3.0.3", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1596, EndLine: 1601}}},
+		{Name: "sprintf-js", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 1603, EndLine: 1608}}},
+		{Name: "sshpk", Version: "1.16.1", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 1610, EndLine: 1629}}},
+		{Name: "string-width", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1631, EndLine: 1640}}},
+		{Name: "string-width", Version: "2.1.1", Constraint: "^1.0.2 || 2", Locations: []types.Location{{StartLine: 1642, EndLine: 1650}}},
+		{Name: "string-width", Version: "3.1.0", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 1652, EndLine: 1661}}},
+		{Name: "string.prototype.trimend", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1663, EndLine: 1671}}},
+		{Name: "string.prototype.trimstart", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1673, EndLine: 1681}}},
+		{Name: "string_decoder", Version: "1.1.1", Constraint: "~1.1.1", Locations: []types.Location{{StartLine: 1683, EndLine: 1690}}},
+		{Name: "strip-ansi", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 1692, EndLine: 1699}}},
+		{Name: "strip-ansi", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1701, EndLine: 1708}}},
+		{Name: "strip-ansi", Version: "5.2.0", Constraint: "^5.0.0, ^5.1.0, ^5.2.0", Locations: []types.Location{{StartLine: 1710, EndLine: 1717}}},
+		{Name: "strip-json-comments", Version: "3.0.1", Constraint: "3.0.1", Locations: []types.Location{{StartLine: 1719, EndLine: 1724}}},
+		{Name: "supports-color", Version: "7.1.0", Constraint: "7.1.0, ^7.1.0", Locations: []types.Location{{StartLine: 1726, EndLine: 1733}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 1735, EndLine: 1740}}},
+		{Name: "tar", Version: "6.0.5", Constraint: "^6.0.1", Locations: []types.Location{{StartLine: 1742, EndLine: 1754}}},
+		{Name: "to-regex-range", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 1756, EndLine: 1763}}},
+		{Name: "tough-cookie", Version: "2.5.0", Constraint: "~2.5.0", Locations: []types.Location{{StartLine: 1765, EndLine: 1773}}},
+		{Name: "tunnel-agent", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 1775, EndLine: 1782}}},
+		{Name: "tweetnacl", Version: "0.14.5", Constraint: "^0.14.3, ~0.14.0", Locations: []types.Location{{StartLine: 1784, EndLine: 1789}}},
+		{Name: "uri-js", Version: "4.4.0", Constraint: "^4.2.2", Locations: []types.Location{{StartLine: 1791, EndLine: 1798}}},
+		{Name: "util-deprecate", Version: "1.0.2", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 1800, EndLine: 1805}}},
+		{Name: "uuid", Version: "3.4.0", Constraint: "^3.3.2", Locations: []types.Location{{StartLine: 1807, EndLine: 1814}}},
+		{Name: "verror", Version: "1.10.0", Constraint: "1.10.0", Locations: []types.Location{{StartLine: 1816, EndLine: 1825}}},
+		{Name: "which-module", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1827, EndLine: 1832}}},
+		{Name: "which", Version: "2.0.2", Constraint: "2.0.2, ^2.0.2", Locations: []types.Location{{StartLine: 1834, EndLine: 1843}}},
+		{Name: "wide-align", Version: "1.1.3", Constraint: "1.1.3, ^1.1.0", Locations: []types.Location{{StartLine: 1845, EndLine: 1852}}},
+		{Name: "workerpool", Version: "6.0.0", Constraint: "6.0.0", Locations: []types.Location{{StartLine: 1854, EndLine: 1859}}},
+		{Name: "wrap-ansi", Version: "5.1.0", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 1861, EndLine: 1870}}},
+		{Name: "wrappy", Version: "1.0.2", Constraint: "1", Locations: []types.Location{{StartLine: 1872, EndLine: 1877}}},
+		{Name: "y18n", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1879, EndLine: 1884}}},
+		{Name: "yallist", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1886, EndLine: 1891}}},
+		{Name: "yargs-parser", Version: "13.1.2", Constraint: "13.1.2, ^13.1.2", Locations: []types.Location{{StartLine: 1893, EndLine: 1901}}},
+		{Name: "yargs-parser", Version: "15.0.1", Constraint: "^15.0.1", Locations: []types.Location{{StartLine: 1903, EndLine: 1911}}},
+		{Name: "yargs-unparser", Version: "1.6.1", Constraint: "1.6.1", Locations: []types.Location{{StartLine: 1913, EndLine: 1924}}},
+		{Name: "yargs", Version: "13.3.2", Constraint: "13.3.2", Locations: []types.Location{{StartLine: 1926, EndLine: 1942}}},
+		{Name: "yargs", Version: "14.2.3", Constraint: "^14.2.3", Locations: []types.Location{{StartLine: 1944, EndLine: 1961}}},
 	}
 
 	// ... and
 	// yarn add lodash request chalk commander express async axios vue
 	// yarn info --recursive --dependents --json | jq -r .value | grep -v workspace | awk -F'[@:]' '{printf("{\""$1"\", \""$3"\", \"\"},\n")}'
 	yarnV2Many = []types.Library{
-		{"@types/color-name", "1.1.1", ""},
-		{"abbrev", "1.1.1", ""},
-		{"accepts", "1.3.7", ""},
-		{"ajv", "6.12.4", ""},
-		{"ansi-colors", "4.1.1", ""},
-		{"ansi-regex", "2.1.1", ""},
-		{"ansi-regex", "3.0.0", ""},
-		{"ansi-regex", "4.1.0", ""},
-		{"ansi-styles", "3.2.1", ""},
-		{"ansi-styles", "4.2.1", ""},
-		{"anymatch", "3.1.1", ""},
-		{"aproba", "1.2.0", ""},
-		{"are-we-there-yet", "1.1.5", ""},
-		{"argparse", "1.0.10", ""},
-		{"array-flatten", "1.1.1", ""},
-		{"array.prototype.map", "1.0.2", ""},
-		{"asap", "2.0.6", ""},
-		{"asn1", "0.2.4", ""},
-		{"assert-plus", "1.0.0", ""},
-		{"async", "3.2.0", ""},
-		{"asynckit", "0.4.0", ""},
-		{"aws-sign2", "0.7.0", ""},
-		{"aws4", "1.10.1", ""},
-		{"axios", "0.20.0", ""},
-		{"balanced-match", "1.0.0", ""},
-		{"bcrypt-pbkdf", "1.0.2", ""},
-		{"binary-extensions", "2.1.0", ""},
-		{"body-parser", "1.19.0", ""},
-		{"brace-expansion", "1.1.11", ""},
-		{"braces", "3.0.2", ""},
-		{"browser-stdout", "1.3.1", ""},
-		{"bytes", "3.1.0", ""},
-		{"camelcase", "5.3.1", ""},
-		{"caseless", "0.12.0", ""},
-		{"chalk", "4.1.0", ""},
-		{"chokidar", "3.4.2", ""},
-		{"chownr", "2.0.0", ""},
-		{"cliui", "5.0.0", ""},
-		{"code-point-at", "1.1.0", ""},
-		{"color-convert", "1.9.3", ""},
-		{"color-convert", "2.0.1", ""},
-		{"color-name", "1.1.3", ""},
-		{"color-name", "1.1.4", ""},
-		{"combined-stream", "1.0.8", ""},
-		{"commander", "6.1.0", ""},
-		{"concat-map", "0.0.1", ""},
-		{"console-control-strings", "1.1.0", ""},
-		{"content-disposition", "0.5.3", ""},
-		{"content-type", "1.0.4", ""},
-		{"cookie-signature", "1.0.6", ""},
-		{"cookie", "0.4.0", ""},
-		{"core-util-is", "1.0.2", ""},
-		{"dashdash", "1.14.1", ""},
-		{"debug", "2.6.9", ""},
-		{"debug", "4.1.1", ""},
-		{"decamelize", "1.2.0", ""},
-		{"define-properties", "1.1.3", ""},
-		{"delayed-stream", "1.0.0", ""},
-		{"delegates", "1.0.0", ""},
-		{"depd", "1.1.2", ""},
-		{"destroy", "1.0.4", ""},
-		{"diff", "4.0.2", ""},
-		{"ecc-jsbn", "0.1.2", ""},
-		{"ee-first", "1.1.1", ""},
-		{"emoji-regex", "7.0.3", ""},
-		{"encodeurl", "1.0.2", ""},
-		{"env-paths", "2.2.0", ""},
-		{"es-abstract", "1.17.6", ""},
-		{"es-array-method-boxes-properly", "1.0.0", ""},
-		{"es-get-iterator", "1.1.0", ""},
-		{"es-to-primitive", "1.2.1", ""},
-		{"escape-html", "1.0.3", ""},
-		{"escape-string-regexp", "4.0.0", ""},
-		{"esprima", "4.0.1", ""},
-		{"etag", "1.8.1", ""},
-		{"express", "4.17.1", ""},
-		{"extend", "3.0.2", ""},
-		{"extsprintf", "1.3.0", ""},
-		{"fast-deep-equal", "3.1.3", ""},
-		{"fast-json-stable-stringify", "2.1.0", ""},
-		{"fill-range", "7.0.1", ""},
-		{"finalhandler", "1.1.2", ""},
-		{"find-up", "5.0.0", ""},
-		{"find-up", "3.0.0", ""},
-		{"flat", "4.1.0", ""},
-		{"follow-redirects", "1.13.0", ""},
-		{"forever-agent", "0.6.1", ""},
-		{"form-data", "2.3.3", ""},
-		{"forwarded", "0.1.2", ""},
-		{"fresh", "0.5.2", ""},
-		{"fs-minipass", "2.1.0", ""},
-		{"fs.realpath", "1.0.0", ""},
-		{"fsevents", "2.1.3", ""},
-		{"function-bind", "1.1.1", ""},
-		{"gauge", "2.7.4", ""},
-		{"get-caller-file", "2.0.5", ""},
-		{"getpass", "0.1.7", ""},
-		{"glob-parent", "5.1.1", ""},
-		{"glob", "7.1.6", ""},
-		{"graceful-fs", "4.2.4", ""},
-		{"growl", "1.10.5", ""},
-		{"har-schema", "2.0.0", ""},
-		{"har-validator", "5.1.5", ""},
-		{"has-flag", "4.0.0", ""},
-		{"has-symbols", "1.0.1", ""},
-		{"has-unicode", "2.0.1", ""},
-		{"has", "1.0.3", ""},
-		{"he", "1.2.0", ""},
-		{"http-errors", "1.7.2", ""},
-		{"http-errors", "1.7.3", ""},
-		{"http-signature", "1.2.0", ""},
-		{"iconv-lite", "0.4.24", ""},
-		{"inflight", "1.0.6", ""},
-		{"inherits", "2.0.4", ""},
-		{"inherits", "2.0.3", ""},
-		{"ipaddr.js", "1.9.1", ""},
-		{"is-arguments", "1.0.4", ""},
-		{"is-binary-path", "2.1.0", ""},
-		{"is-buffer", "2.0.4", ""},
-		{"is-callable", "1.2.0", ""},
-		{"is-date-object", "1.0.2", ""},
-		{"is-extglob", "2.1.1", ""},
-		{"is-fullwidth-code-point", "1.0.0", ""},
-		{"is-fullwidth-code-point", "2.0.0", ""},
-		{"is-glob", "4.0.1", ""},
-		{"is-map", "2.0.1", ""},
-		{"is-number", "7.0.0", ""},
-		{"is-plain-obj", "1.1.0", ""},
-		{"is-regex", "1.1.1", ""},
-		{"is-set", "2.0.1", ""},
-		{"is-string", "1.0.5", ""},
-		{"is-symbol", "1.0.3", ""},
-		{"is-typedarray", "1.0.0", ""},
-		{"isarray", "2.0.5", ""},
-		{"isarray", "1.0.0", ""},
-		{"isexe", "2.0.0", ""},
-		{"isstream", "0.1.2", ""},
-		{"iterate-iterator", "1.0.1", ""},
-		{"iterate-value", "1.0.2", ""},
-		{"jquery", "3.5.1", ""},
-		{"js-tokens", "4.0.0", ""},
-		{"js-yaml", "3.14.0", ""},
-		{"jsbn", "0.1.1", ""},
-		{"json-schema-traverse", "0.4.1", ""},
-		{"json-schema", "0.2.3", ""},
-		{"json-stringify-safe", "5.0.1", ""},
-		{"jsprim", "1.4.1", ""},
-		{"locate-path", "3.0.0", ""},
-		{"locate-path", "6.0.0", ""},
-		{"lodash", "4.17.20", ""},
-		{"log-symbols", "4.0.0", ""},
-		{"loose-envify", "1.4.0", ""},
-		{"media-typer", "0.3.0", ""},
-		{"merge-descriptors", "1.0.1", ""},
-		{"methods", "1.1.2", ""},
-		{"mime-db", "1.44.0", ""},
-		{"mime-types", "2.1.27", ""},
-		{"mime", "1.6.0", ""},
-		{"minimatch", "3.0.4", ""},
-		{"minipass", "3.1.3", ""},
-		{"minizlib", "2.1.2", ""},
-		{"mkdirp", "1.0.4", ""},
-		{"mocha", "8.1.3", ""},
-		{"ms", "2.0.0", ""},
-		{"ms", "2.1.1", ""},
-		{"ms", "2.1.2", ""},
-		{"negotiator", "0.6.2", ""},
-		{"node-gyp", "7.1.0", ""},
-		{"nopt", "4.0.3", ""},
-		{"normalize-path", "3.0.0", ""},
-		{"npmlog", "4.1.2", ""},
-		{"number-is-nan", "1.0.1", ""},
-		{"oauth-sign", "0.9.0", ""},
-		{"object-assign", "4.1.1", ""},
-		{"object-inspect", "1.8.0", ""},
-		{"object-keys", "1.1.1", ""},
-		{"object.assign", "4.1.0", ""},
-		{"on-finished", "2.3.0", ""},
-		{"once", "1.4.0", ""},
-		{"os-homedir", "1.0.2", ""},
-		{"os-tmpdir", "1.0.2", ""},
-		{"osenv", "0.1.5", ""},
-		{"p-limit", "2.3.0", ""},
-		{"p-limit", "3.0.2", ""},
-		{"p-locate", "3.0.0", ""},
-		{"p-locate", "5.0.0", ""},
-		{"p-try", "2.2.0", ""},
-		{"parseurl", "1.3.3", ""},
-		{"path-exists", "3.0.0", ""},
-		{"path-exists", "4.0.0", ""},
-		{"path-is-absolute", "1.0.1", ""},
-		{"path-to-regexp", "0.1.7", ""},
-		{"performance-now", "2.1.0", ""},
-		{"picomatch", "2.2.2", ""},
-		{"process-nextick-args", "2.0.1", ""},
-		{"promise.allsettled", "1.0.2", ""},
-		{"promise", "8.1.0", ""},
-		{"prop-types", "15.7.2", ""},
-		{"proxy-addr", "2.0.6", ""},
-		{"psl", "1.8.0", ""},
-		{"punycode", "2.1.1", ""},
-		{"qs", "6.7.0", ""},
-		{"qs", "6.5.2", ""},
-		{"randombytes", "2.1.0", ""},
-		{"range-parser", "1.2.1", ""},
-		{"raw-body", "2.4.0", ""},
-		{"react-is", "16.13.1", ""},
-		{"react", "16.13.1", ""},
-		{"readable-stream", "2.3.7", ""},
-		{"readdirp", "3.4.0", ""},
-		{"redux", "4.0.5", ""},
-		{"request", "2.88.2", ""},
-		{"require-directory", "2.1.1", ""},
-		{"require-main-filename", "2.0.0", ""},
-		{"rimraf", "2.7.1", ""},
-		{"safe-buffer", "5.1.2", ""},
-		{"safe-buffer", "5.2.1", ""},
-		{"safer-buffer", "2.1.2", ""},
-		{"semver", "7.3.2", ""},
-		{"send", "0.17.1", ""},
-		{"serialize-javascript", "4.0.0", ""},
-		{"serve-static", "1.14.1", ""},
-		{"set-blocking", "2.0.0", ""},
-		{"setprototypeof", "1.1.1", ""},
-		{"signal-exit", "3.0.3", ""},
-		{"sprintf-js", "1.0.3", ""},
-		{"sshpk", "1.16.1", ""},
-		{"statuses", "1.5.0", ""},
-		{"string-width", "1.0.2", ""},
-		{"string-width", "2.1.1", ""},
-		{"string-width", "3.1.0", ""},
-		{"string.prototype.trimend", "1.0.1", ""},
-		{"string.prototype.trimstart", "1.0.1", ""},
-		{"string_decoder", "1.1.1", ""},
-		{"strip-ansi", "3.0.1", ""},
-		{"strip-ansi", "4.0.0", ""},
-		{"strip-ansi", "5.2.0", ""},
-		{"strip-json-comments", "3.0.1", ""},
-		{"supports-color", "7.1.0", ""},
-		{"symbol-observable", "1.2.0", ""},
-		{"tar", "6.0.5", ""},
-		{"to-regex-range", "5.0.1", ""},
-		{"toidentifier", "1.0.0", ""},
-		{"tough-cookie", "2.5.0", ""},
-		{"tunnel-agent", "0.6.0", ""},
-		{"tweetnacl", "0.14.5", ""},
-		{"type-is", "1.6.18", ""},
-		{"unpipe", "1.0.0", ""},
-		{"uri-js", "4.4.0", ""},
-		{"util-deprecate", "1.0.2", ""},
-		{"utils-merge", "1.0.1", ""},
-		{"uuid", "3.4.0", ""},
-		{"vary", "1.1.2", ""},
-		{"verror", "1.10.0", ""},
-		{"vue", "2.6.12", ""},
-		{"which-module", "2.0.0", ""},
-		{"which", "2.0.2", ""},
-		{"wide-align", "1.1.3", ""},
-		{"workerpool", "6.0.0", ""},
-		{"wrap-ansi", "5.1.0", ""},
-		{"wrappy", "1.0.2", ""},
-		{"y18n", "4.0.0", ""},
-		{"yallist", "4.0.0", ""},
-		{"yargs-parser", "13.1.2", ""},
-		{"yargs-parser", "15.0.1", ""},
-		{"yargs-unparser", "1.6.1", ""},
-		{"yargs", "13.3.2", ""},
-		{"yargs", "14.2.3", ""},
+		{Name: "@types/color-name", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 8, EndLine: 13}}},
+		{Name: "abbrev", Version: "1.1.1", Constraint: "1", Locations: []types.Location{{StartLine: 15, EndLine: 20}}},
+		{Name: "accepts", Version: "1.3.7", Constraint: "~1.3.7", Locations: []types.Location{{StartLine: 22, EndLine: 30}}},
+		{Name: "ajv", Version: "6.12.4", Constraint: "^6.12.3", Locations: []types.Location{{StartLine: 32, EndLine: 42}}},
+		{Name: "ansi-colors", Version: "4.1.1", Constraint: "4.1.1", Locations: []types.Location{{StartLine: 44, EndLine: 49}}},
+		{Name: "ansi-regex", Version: "2.1.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 51, EndLine: 56}}},
+		{Name: "ansi-regex", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 58, EndLine: 63}}},
+		{Name: "ansi-regex", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 65, EndLine: 70}}},
+		{Name: "ansi-styles", Version: "3.2.1", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 72, EndLine: 79}}},
+		{Name: "ansi-styles", Version: "4.2.1", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 81, EndLine: 89}}},
+		{Name: "anymatch", Version: "3.1.1", Constraint: "~3.1.1", Locations: []types.Location{{StartLine: 91, EndLine: 99}}},
+		{Name: "aproba", Version: "1.2.0", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 101, EndLine: 106}}},
+		{Name: "are-we-there-yet", Version: "1.1.5", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 108, EndLine: 116}}},
+		{Name: "argparse", Version: "1.0.10", Constraint: "^1.0.7", Locations: []types.Location{{StartLine: 118, EndLine: 125}}},
+		{Name: "array-flatten", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 127, EndLine: 132}}},
+		{Name: "array.prototype.map", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 134, EndLine: 144}}},
+		{Name: "asap", Version: "2.0.6", Constraint: "~2.0.6", Locations: []types.Location{{StartLine: 146, EndLine: 151}}},
+		{Name: "asn1", Version: "0.2.4", Constraint: "~0.2.3", Locations: []types.Location{{StartLine: 153, EndLine: 160}}},
+		{Name: "assert-plus", Version: "1.0.0", Constraint: "1.0.0, ^1.0.0", Locations: []types.Location{{StartLine: 162, EndLine: 167}}},
+		{Name: "async", Version: "3.2.0", Constraint: "^3.2.0", Locations: []types.Location{{StartLine: 169, EndLine: 174}}},
+		{Name: "asynckit", Version: "0.4.0", Constraint: "^0.4.0", Locations: []types.Location{{StartLine: 176, EndLine: 181}}},
+		{Name: "aws-sign2", Version: "0.7.0", Constraint: "~0.7.0", Locations: []types.Location{{StartLine: 183, EndLine: 188}}},
+		{Name: "aws4", Version: "1.10.1", Constraint: "^1.8.0", Locations: []types.Location{{StartLine: 190, EndLine: 195}}},
+		{Name: "axios", Version: "0.20.0", Constraint: "^0.20.0", Locations: []types.Location{{StartLine: 197, EndLine: 204}}},
+		{Name: "balanced-match", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 206, EndLine: 211}}},
+		{Name: "bcrypt-pbkdf", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 213, EndLine: 220}}},
+		{Name: "binary-extensions", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 222, EndLine: 227}}},
+		{Name: "body-parser", Version: "1.19.0", Constraint: "1.19.0", Locations: []types.Location{{StartLine: 229, EndLine: 245}}},
+		{Name: "brace-expansion", Version: "1.1.11", Constraint: "^1.1.7", Locations: []types.Location{{StartLine: 247, EndLine: 255}}},
+		{Name: "braces", Version: "3.0.2", Constraint: "~3.0.2", Locations: []types.Location{{StartLine: 257, EndLine: 264}}},
+		{Name: "browser-stdout", Version: "1.3.1", Constraint: "1.3.1", Locations: []types.Location{{StartLine: 266, EndLine: 271}}},
+		{Name: "bytes", Version: "3.1.0", Constraint: "3.1.0", Locations: []types.Location{{StartLine: 273, EndLine: 278}}},
+		{Name: "camelcase", Version: "5.3.1", Constraint: "^5.0.0, ^5.3.1", Locations: []types.Location{{StartLine: 280, EndLine: 285}}},
+		{Name: "caseless", Version: "0.12.0", Constraint: "~0.12.0", Locations: []types.Location{{StartLine: 287, EndLine: 292}}},
+		{Name: "chalk", Version: "4.1.0", Constraint: "^4.0.0, ^4.1.0", Locations: []types.Location{{StartLine: 294, EndLine: 302}}},
+		{Name: "chokidar", Version: "3.4.2", Constraint: "3.4.2", Locations: []types.Location{{StartLine: 304, EndLine: 321}}},
+		{Name: "chownr", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 323, EndLine: 328}}},
+		{Name: "cliui", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 330, EndLine: 339}}},
+		{Name: "code-point-at", Version: "1.1.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 341, EndLine: 346}}},
+		{Name: "color-convert", Version: "1.9.3", Constraint: "^1.9.0", Locations: []types.Location{{StartLine: 368, EndLine: 375}}},
+		{Name: "color-convert", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 377, EndLine: 384}}},
+		{Name: "color-name", Version: "1.1.3", Constraint: "1.1.3", Locations: []types.Location{{StartLine: 386, EndLine: 391}}},
+		{Name: "color-name", Version: "1.1.4", Constraint: "~1.1.4", Locations: []types.Location{{StartLine: 393, EndLine: 398}}},
+		{Name: "combined-stream", Version: "1.0.8", Constraint: "^1.0.6, ~1.0.6", Locations: []types.Location{{StartLine: 400, EndLine: 407}}},
+		{Name: "commander", Version: "6.1.0", Constraint: "^6.1.0", Locations: []types.Location{{StartLine: 409, EndLine: 414}}},
+		{Name: "concat-map", Version: "0.0.1", Constraint: "0.0.1", Locations: []types.Location{{StartLine: 416, EndLine: 421}}},
+		{Name: "console-control-strings", Version: "1.1.0", Constraint: "^1.0.0, ~1.1.0", Locations: []types.Location{{StartLine: 423, EndLine: 428}}},
+		{Name: "content-disposition", Version: "0.5.3", Constraint: "0.5.3", Locations: []types.Location{{StartLine: 430, EndLine: 437}}},
+		{Name: "content-type", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 439, EndLine: 444}}},
+		{Name: "cookie-signature", Version: "1.0.6", Constraint: "1.0.6", Locations: []types.Location{{StartLine: 446, EndLine: 451}}},
+		{Name: "cookie", Version: "0.4.0", Constraint: "0.4.0", Locations: []types.Location{{StartLine: 453, EndLine: 458}}},
+		{Name: "core-util-is", Version: "1.0.2", Constraint: "1.0.2, ~1.0.0", Locations: []types.Location{{StartLine: 460, EndLine: 465}}},
+		{Name: "dashdash", Version: "1.14.1", Constraint: "^1.12.0", Locations: []types.Location{{StartLine: 467, EndLine: 474}}},
+		{Name: "debug", Version: "2.6.9", Constraint: "2.6.9", Locations: []types.Location{{StartLine: 476, EndLine: 483}}},
+		{Name: "debug", Version: "4.1.1", Constraint: "4.1.1", Locations: []types.Location{{StartLine: 485, EndLine: 492}}},
+		{Name: "decamelize", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 494, EndLine: 499}}},
+		{Name: "define-properties", Version: "1.1.3", Constraint: "^1.1.2, ^1.1.3", Locations: []types.Location{{StartLine: 501, EndLine: 508}}},
+		{Name: "delayed-stream", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 510, EndLine: 515}}},
+		{Name: "delegates", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 517, EndLine: 522}}},
+		{Name: "depd", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 524, EndLine: 529}}},
+		{Name: "destroy", Version: "1.0.4", Constraint: "~1.0.4", Locations: []types.Location{{StartLine: 531, EndLine: 536}}},
+		{Name: "diff", Version: "4.0.2", Constraint: "4.0.2", Locations: []types.Location{{StartLine: 538, EndLine: 543}}},
+		{Name: "ecc-jsbn", Version: "0.1.2", Constraint: "~0.1.1", Locations: []types.Location{{StartLine: 545, EndLine: 553}}},
+		{Name: "ee-first", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 555, EndLine: 560}}},
+		{Name: "emoji-regex", Version: "7.0.3", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 562, EndLine: 567}}},
+		{Name: "encodeurl", Version: "1.0.2", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 569, EndLine: 574}}},
+		{Name: "env-paths", Version: "2.2.0", Constraint: "^2.2.0", Locations: []types.Location{{StartLine: 576, EndLine: 581}}},
+		{Name: "es-abstract", Version: "1.17.6", Constraint: "^1.17.0-next.1, ^1.17.4, ^1.17.5", Locations: []types.Location{{StartLine: 583, EndLine: 600}}},
+		{Name: "es-array-method-boxes-properly", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 602, EndLine: 607}}},
+		{Name: "es-get-iterator", Version: "1.1.0", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 609, EndLine: 622}}},
+		{Name: "es-to-primitive", Version: "1.2.1", Constraint: "^1.2.1", Locations: []types.Location{{StartLine: 624, EndLine: 633}}},
+		{Name: "escape-html", Version: "1.0.3", Constraint: "~1.0.3", Locations: []types.Location{{StartLine: 635, EndLine: 640}}},
+		{Name: "escape-string-regexp", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 642, EndLine: 647}}},
+		{Name: "esprima", Version: "4.0.1", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 649, EndLine: 657}}},
+		{Name: "etag", Version: "1.8.1", Constraint: "~1.8.1", Locations: []types.Location{{StartLine: 659, EndLine: 664}}},
+		{Name: "express", Version: "4.17.1", Constraint: "^4.17.1", Locations: []types.Location{{StartLine: 666, EndLine: 702}}},
+		{Name: "extend", Version: "3.0.2", Constraint: "~3.0.2", Locations: []types.Location{{StartLine: 704, EndLine: 709}}},
+		{Name: "extsprintf", Version: "1.3.0", Constraint: "1.3.0, ^1.2.0", Locations: []types.Location{{StartLine: 711, EndLine: 716}}},
+		{Name: "fast-deep-equal", Version: "3.1.3", Constraint: "^3.1.1", Locations: []types.Location{{StartLine: 718, EndLine: 723}}},
+		{Name: "fast-json-stable-stringify", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 725, EndLine: 730}}},
+		{Name: "fill-range", Version: "7.0.1", Constraint: "^7.0.1", Locations: []types.Location{{StartLine: 732, EndLine: 739}}},
+		{Name: "finalhandler", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 741, EndLine: 754}}},
+		{Name: "find-up", Version: "5.0.0", Constraint: "5.0.0", Locations: []types.Location{{StartLine: 756, EndLine: 764}}},
+		{Name: "find-up", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 766, EndLine: 773}}},
+		{Name: "flat", Version: "4.1.0", Constraint: "^4.1.0", Locations: []types.Location{{StartLine: 775, EndLine: 784}}},
+		{Name: "follow-redirects", Version: "1.13.0", Constraint: "^1.10.0", Locations: []types.Location{{StartLine: 786, EndLine: 791}}},
+		{Name: "forever-agent", Version: "0.6.1", Constraint: "~0.6.1", Locations: []types.Location{{StartLine: 793, EndLine: 798}}},
+		{Name: "form-data", Version: "2.3.3", Constraint: "~2.3.2", Locations: []types.Location{{StartLine: 800, EndLine: 809}}},
+		{Name: "forwarded", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 811, EndLine: 816}}},
+		{Name: "fresh", Version: "0.5.2", Constraint: "0.5.2", Locations: []types.Location{{StartLine: 818, EndLine: 823}}},
+		{Name: "fs-minipass", Version: "2.1.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 825, EndLine: 832}}},
+		{Name: "fs.realpath", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 834, EndLine: 839}}},
+		{Name: "fsevents", Version: "2.1.3", Constraint: "~2.1.2", Locations: []types.Location{{StartLine: 850, EndLine: 857}}},
+		{Name: "function-bind", Version: "1.1.1", Constraint: "^1.1.1", Locations: []types.Location{{StartLine: 859, EndLine: 864}}},
+		{Name: "gauge", Version: "2.7.4", Constraint: "~2.7.3", Locations: []types.Location{{StartLine: 866, EndLine: 880}}},
+		{Name: "get-caller-file", Version: "2.0.5", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 882, EndLine: 887}}},
+		{Name: "getpass", Version: "0.1.7", Constraint: "^0.1.1", Locations: []types.Location{{StartLine: 889, EndLine: 896}}},
+		{Name: "glob-parent", Version: "5.1.1", Constraint: "~5.1.0", Locations: []types.Location{{StartLine: 898, EndLine: 905}}},
+		{Name: "glob", Version: "7.1.6", Constraint: "7.1.6, ^7.1.3, ^7.1.4", Locations: []types.Location{{StartLine: 907, EndLine: 919}}},
+		{Name: "graceful-fs", Version: "4.2.4", Constraint: "^4.2.3", Locations: []types.Location{{StartLine: 921, EndLine: 926}}},
+		{Name: "growl", Version: "1.10.5", Constraint: "1.10.5", Locations: []types.Location{{StartLine: 928, EndLine: 933}}},
+		{Name: "har-schema", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 935, EndLine: 940}}},
+		{Name: "har-validator", Version: "5.1.5", Constraint: "~5.1.3", Locations: []types.Location{{StartLine: 942, EndLine: 950}}},
+		{Name: "has-flag", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 952, EndLine: 957}}},
+		{Name: "has-symbols", Version: "1.0.1", Constraint: "^1.0.0, ^1.0.1", Locations: []types.Location{{StartLine: 959, EndLine: 964}}},
+		{Name: "has-unicode", Version: "2.0.1", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 966, EndLine: 971}}},
+		{Name: "has", Version: "1.0.3", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 973, EndLine: 980}}},
+		{Name: "he", Version: "1.2.0", Constraint: "1.2.0", Locations: []types.Location{{StartLine: 982, EndLine: 989}}},
+		{Name: "http-errors", Version: "1.7.2", Constraint: "1.7.2", Locations: []types.Location{{StartLine: 991, EndLine: 1002}}},
+		{Name: "http-errors", Version: "1.7.3", Constraint: "~1.7.2", Locations: []types.Location{{StartLine: 1004, EndLine: 1015}}},
+		{Name: "http-signature", Version: "1.2.0", Constraint: "~1.2.0", Locations: []types.Location{{StartLine: 1017, EndLine: 1026}}},
+		{Name: "iconv-lite", Version: "0.4.24", Constraint: "0.4.24", Locations: []types.Location{{StartLine: 1028, EndLine: 1035}}},
+		{Name: "inflight", Version: "1.0.6", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 1037, EndLine: 1045}}},
+		{Name: "inherits", Version: "2.0.4", Constraint: "2, 2.0.4, ~2.0.3", Locations: []types.Location{{StartLine: 1047, EndLine: 1052}}},
+		{Name: "inherits", Version: "2.0.3", Constraint: "2.0.3", Locations: []types.Location{{StartLine: 1054, EndLine: 1059}}},
+		{Name: "ipaddr.js", Version: "1.9.1", Constraint: "1.9.1", Locations: []types.Location{{StartLine: 1061, EndLine: 1066}}},
+		{Name: "is-arguments", Version: "1.0.4", Constraint: "^1.0.4", Locations: []types.Location{{StartLine: 1068, EndLine: 1073}}},
+		{Name: "is-binary-path", Version: "2.1.0", Constraint: "~2.1.0", Locations: []types.Location{{StartLine: 1075, EndLine: 1082}}},
+		{Name: "is-buffer", Version: "2.0.4", Constraint: "~2.0.3", Locations: []types.Location{{StartLine: 1084, EndLine: 1089}}},
+		{Name: "is-callable", Version: "1.2.0", Constraint: "^1.1.4, ^1.2.0", Locations: []types.Location{{StartLine: 1091, EndLine: 1096}}},
+		{Name: "is-date-object", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1098, EndLine: 1103}}},
+		{Name: "is-extglob", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1105, EndLine: 1110}}},
+		{Name: "is-fullwidth-code-point", Version: "1.0.0", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1112, EndLine: 1119}}},
+		{Name: "is-fullwidth-code-point", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1121, EndLine: 1126}}},
+		{Name: "is-glob", Version: "4.0.1", Constraint: "^4.0.1, ~4.0.1", Locations: []types.Location{{StartLine: 1128, EndLine: 1135}}},
+		{Name: "is-map", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 1137, EndLine: 1142}}},
+		{Name: "is-number", Version: "7.0.0", Constraint: "^7.0.0", Locations: []types.Location{{StartLine: 1144, EndLine: 1149}}},
+		{Name: "is-plain-obj", Version: "1.1.0", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 1151, EndLine: 1156}}},
+		{Name: "is-regex", Version: "1.1.1", Constraint: "^1.1.0", Locations: []types.Location{{StartLine: 1158, EndLine: 1165}}},
+		{Name: "is-set", Version: "2.0.1", Constraint: "^2.0.1", Locations: []types.Location{{StartLine: 1167, EndLine: 1172}}},
+		{Name: "is-string", Version: "1.0.5", Constraint: "^1.0.4, ^1.0.5", Locations: []types.Location{{StartLine: 1174, EndLine: 1179}}},
+		{Name: "is-symbol", Version: "1.0.3", Constraint: "^1.0.2", Locations: []types.Location{{StartLine: 1181, EndLine: 1188}}},
+		{Name: "is-typedarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 1190, EndLine: 1195}}},
+		{Name: "isarray", Version: "2.0.5", Constraint: "^2.0.5", Locations: []types.Location{{StartLine: 1197, EndLine: 1202}}},
+		{Name: "isarray", Version: "1.0.0", Constraint: "~1.0.0", Locations: []types.Location{{StartLine: 1204, EndLine: 1209}}},
+		{Name: "isexe", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1211, EndLine: 1216}}},
+		{Name: "isstream", Version: "0.1.2", Constraint: "~0.1.2", Locations: []types.Location{{StartLine: 1218, EndLine: 1223}}},
+		{Name: "iterate-iterator", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 1225, EndLine: 1230}}},
+		{Name: "iterate-value", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1232, EndLine: 1240}}},
+		{Name: "jquery", Version: "3.5.1", Constraint: "^3.5.1", Locations: []types.Location{{StartLine: 1242, EndLine: 1247}}},
+		{Name: "js-tokens", Version: "4.0.0", Constraint: "^3.0.0 || ^4.0.0", Locations: []types.Location{{StartLine: 1249, EndLine: 1254}}},
+		{Name: "js-yaml", Version: "3.14.0", Constraint: "3.14.0", Locations: []types.Location{{StartLine: 1256, EndLine: 1266}}},
+		{Name: "jsbn", Version: "0.1.1", Constraint: "~0.1.0", Locations: []types.Location{{StartLine: 1268, EndLine: 1273}}},
+		{Name: "json-schema-traverse", Version: "0.4.1", Constraint: "^0.4.1", Locations: []types.Location{{StartLine: 1275, EndLine: 1280}}},
+		{Name: "json-schema", Version: "0.2.3", Constraint: "0.2.3", Locations: []types.Location{{StartLine: 1282, EndLine: 1287}}},
+		{Name: "json-stringify-safe", Version: "5.0.1", Constraint: "~5.0.1", Locations: []types.Location{{StartLine: 1289, EndLine: 1294}}},
+		{Name: "jsprim", Version: "1.4.1", Constraint: "^1.2.2", Locations: []types.Location{{StartLine: 1296, EndLine: 1306}}},
+		{Name: "locate-path", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1308, EndLine: 1316}}},
+		{Name: "locate-path", Version: "6.0.0", Constraint: "^6.0.0", Locations: []types.Location{{StartLine: 1318, EndLine: 1325}}},
+		{Name: "lodash", Version: "4.17.20", Constraint: "^4.17.20", Locations: []types.Location{{StartLine: 1327, EndLine: 1332}}},
+		{Name: "log-symbols", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 1334, EndLine: 1341}}},
+		{Name: "loose-envify", Version: "1.4.0", Constraint: "^1.1.0, ^1.4.0", Locations: []types.Location{{StartLine: 1343, EndLine: 1352}}},
+		{Name: "media-typer", Version: "0.3.0", Constraint: "0.3.0", Locations: []types.Location{{StartLine: 1354, EndLine: 1359}}},
+		{Name: "merge-descriptors", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 1361, EndLine: 1366}}},
+		{Name: "methods", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 1368, EndLine: 1373}}},
+		{Name: "mime-db", Version: "1.44.0", Constraint: "1.44.0", Locations: []types.Location{{StartLine: 1375, EndLine: 1380}}},
+		{Name: "mime-types", Version: "2.1.27", Constraint: "^2.1.12, ~2.1.19, ~2.1.24", Locations: []types.Location{{StartLine: 1382, EndLine: 1389}}},
+		{Name: "mime", Version: "1.6.0", Constraint: "1.6.0", Locations: []types.Location{{StartLine: 1391, EndLine: 1398}}},
+		{Name: "minimatch", Version: "3.0.4", Constraint: "3.0.4, ^3.0.4", Locations: []types.Location{{StartLine: 1400, EndLine: 1407}}},
+		{Name: "minipass", Version: "3.1.3", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1409, EndLine: 1416}}},
+		{Name: "minizlib", Version: "2.1.2", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1418, EndLine: 1426}}},
+		{Name: "mkdirp", Version: "1.0.4", Constraint: "^1.0.3", Locations: []types.Location{{StartLine: 1428, EndLine: 1435}}},
+		{Name: "mocha", Version: "8.1.3", Constraint: "^8.1.3", Locations: []types.Location{{StartLine: 1437, EndLine: 1471}}},
+		{Name: "ms", Version: "2.0.0", Constraint: "2.0.0", Locations: []types.Location{{StartLine: 1473, EndLine: 1478}}},
+		{Name: "ms", Version: "2.1.1", Constraint: "2.1.1", Locations: []types.Location{{StartLine: 1480, EndLine: 1485}}},
+		{Name: "ms", Version: "2.1.2", Constraint: "2.1.2, ^2.1.1", Locations: []types.Location{{StartLine: 1487, EndLine: 1492}}},
+		{Name: "negotiator", Version: "0.6.2", Constraint: "0.6.2", Locations: []types.Location{{StartLine: 1494, EndLine: 1499}}},
+		{Name: "node-gyp", Version: "7.1.0", Constraint: "latest", Locations: []types.Location{{StartLine: 1501, EndLine: 1519}}},
+		{Name: "nopt", Version: "4.0.3", Constraint: "^4.0.3", Locations: []types.Location{{StartLine: 1521, EndLine: 1531}}},
+		{Name: "normalize-path", Version: "3.0.0", Constraint: "^3.0.0, ~3.0.0", Locations: []types.Location{{StartLine: 1533, EndLine: 1538}}},
+		{Name: "npmlog", Version: "4.1.2", Constraint: "^4.1.2", Locations: []types.Location{{StartLine: 1540, EndLine: 1550}}},
+		{Name: "number-is-nan", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1552, EndLine: 1557}}},
+		{Name: "oauth-sign", Version: "0.9.0", Constraint: "~0.9.0", Locations: []types.Location{{StartLine: 1559, EndLine: 1564}}},
+		{Name: "object-assign", Version: "4.1.1", Constraint: "^4.1.0, ^4.1.1", Locations: []types.Location{{StartLine: 1566, EndLine: 1571}}},
+		{Name: "object-inspect", Version: "1.8.0", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 1573, EndLine: 1578}}},
+		{Name: "object-keys", Version: "1.1.1", Constraint: "^1.0.11, ^1.0.12, ^1.1.1", Locations: []types.Location{{StartLine: 1580, EndLine: 1585}}},
+		{Name: "object.assign", Version: "4.1.0", Constraint: "4.1.0, ^4.1.0", Locations: []types.Location{{StartLine: 1587, EndLine: 1597}}},
+		{Name: "on-finished", Version: "2.3.0", Constraint: "~2.3.0", Locations: []types.Location{{StartLine: 1599, EndLine: 1606}}},
+		{Name: "once", Version: "1.4.0", Constraint: "^1.3.0", Locations: []types.Location{{StartLine: 1608, EndLine: 1615}}},
+		{Name: "os-homedir", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1617, EndLine: 1622}}},
+		{Name: "os-tmpdir", Version: "1.0.2", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1624, EndLine: 1629}}},
+		{Name: "osenv", Version: "0.1.5", Constraint: "^0.1.4", Locations: []types.Location{{StartLine: 1631, EndLine: 1639}}},
+		{Name: "p-limit", Version: "2.3.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1641, EndLine: 1648}}},
+		{Name: "p-limit", Version: "3.0.2", Constraint: "^3.0.2", Locations: []types.Location{{StartLine: 1650, EndLine: 1657}}},
+		{Name: "p-locate", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1659, EndLine: 1666}}},
+		{Name: "p-locate", Version: "5.0.0", Constraint: "^5.0.0", Locations: []types.Location{{StartLine: 1668, EndLine: 1675}}},
+		{Name: "p-try", Version: "2.2.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1677, EndLine: 1682}}},
+		{Name: "parseurl", Version: "1.3.3", Constraint: "~1.3.3", Locations: []types.Location{{StartLine: 1684, EndLine: 1689}}},
+		{Name: "path-exists", Version: "3.0.0", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 1691, EndLine: 1696}}},
+		{Name: "path-exists", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 1698, EndLine: 1703}}},
+		{Name: "path-is-absolute", Version: "1.0.1", Constraint: "^1.0.0", Locations: []types.Location{{StartLine: 1705, EndLine: 1710}}},
+		{Name: "path-to-regexp", Version: "0.1.7", Constraint: "0.1.7", Locations: []types.Location{{StartLine: 1712, EndLine: 1717}}},
+		{Name: "performance-now", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1719, EndLine: 1724}}},
+		{Name: "picomatch", Version: "2.2.2", Constraint: "^2.0.4, ^2.2.1", Locations: []types.Location{{StartLine: 1726, EndLine: 1731}}},
+		{Name: "process-nextick-args", Version: "2.0.1", Constraint: "~2.0.0", Locations: []types.Location{{StartLine: 1733, EndLine: 1738}}},
+		{Name: "promise.allsettled", Version: "1.0.2", Constraint: "1.0.2", Locations: []types.Location{{StartLine: 1740, EndLine: 1751}}},
+		{Name: "promise", Version: "8.1.0", Constraint: "^8.1.0", Locations: []types.Location{{StartLine: 1753, EndLine: 1760}}},
+		{Name: "prop-types", Version: "15.7.2", Constraint: "^15.6.2", Locations: []types.Location{{StartLine: 1762, EndLine: 1771}}},
+		{Name: "proxy-addr", Version: "2.0.6", Constraint: "~2.0.5", Locations: []types.Location{{StartLine: 1773, EndLine: 1781}}},
+		{Name: "psl", Version: "1.8.0", Constraint: "^1.1.28", Locations: []types.Location{{StartLine: 1783, EndLine: 1788}}},
+		{Name: "punycode", Version: "2.1.1", Constraint: "^2.1.0, ^2.1.1", Locations: []types.Location{{StartLine: 1790, EndLine: 1795}}},
+		{Name: "qs", Version: "6.7.0", Constraint: "6.7.0", Locations: []types.Location{{StartLine: 1797, EndLine: 1802}}},
+		{Name: "qs", Version: "6.5.2", Constraint: "~6.5.2", Locations: []types.Location{{StartLine: 1804, EndLine: 1809}}},
+		{Name: "randombytes", Version: "2.1.0", Constraint: "^2.1.0", Locations: []types.Location{{StartLine: 1811, EndLine: 1818}}},
+		{Name: "range-parser", Version: "1.2.1", Constraint: "~1.2.1", Locations: []types.Location{{StartLine: 1820, EndLine: 1825}}},
+		{Name: "raw-body", Version: "2.4.0", Constraint: "2.4.0", Locations: []types.Location{{StartLine: 1827, EndLine: 1837}}},
+		{Name: "react-is", Version: "16.13.1", Constraint: "^16.8.1", Locations: []types.Location{{StartLine: 1839, EndLine: 1844}}},
+		{Name: "react", Version: "16.13.1", Constraint: "^16.13.1", Locations: []types.Location{{StartLine: 1846, EndLine: 1855}}},
+		{Name: "readable-stream", Version: "2.3.7", Constraint: "^2.0.6", Locations: []types.Location{{StartLine: 1857, EndLine: 1870}}},
+		{Name: "readdirp", Version: "3.4.0", Constraint: "~3.4.0", Locations: []types.Location{{StartLine: 1872, EndLine: 1879}}},
+		{Name: "redux", Version: "4.0.5", Constraint: "^4.0.5", Locations: []types.Location{{StartLine: 1881, EndLine: 1889}}},
+		{Name: "request", Version: "2.88.2", Constraint: "^2.88.2", Locations: []types.Location{{StartLine: 1891, EndLine: 1917}}},
+		{Name: "require-directory", Version: "2.1.1", Constraint: "^2.1.1", Locations: []types.Location{{StartLine: 1919, EndLine: 1924}}},
+		{Name: "require-main-filename", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 1926, EndLine: 1931}}},
+		{Name: "rimraf", Version: "2.7.1", Constraint: "^2.6.3", Locations: []types.Location{{StartLine: 1933, EndLine: 1942}}},
+		{Name: "safe-buffer", Version: "5.1.2", Constraint: "5.1.2, ~5.1.0, ~5.1.1", Locations: []types.Location{{StartLine: 1944, EndLine: 1949}}},
+		{Name: "safe-buffer", Version: "5.2.1", Constraint: "^5.0.1, ^5.1.0, ^5.1.2", Locations: []types.Location{{StartLine: 1951, EndLine: 1956}}},
+		{Name: "safer-buffer", Version: "2.1.2", Constraint: ">= 2.1.2 < 3, ^2.0.2, ^2.1.0, ~2.1.0", Locations: []types.Location{{StartLine: 1958, EndLine: 1963}}},
+		{Name: "semver", Version: "7.3.2", Constraint: "^7.3.2", Locations: []types.Location{{StartLine: 1965, EndLine: 1972}}},
+		{Name: "send", Version: "0.17.1", Constraint: "0.17.1", Locations: []types.Location{{StartLine: 1974, EndLine: 1993}}},
+		{Name: "serialize-javascript", Version: "4.0.0", Constraint: "4.0.0", Locations: []types.Location{{StartLine: 1995, EndLine: 2002}}},
+		{Name: "serve-static", Version: "1.14.1", Constraint: "1.14.1", Locations: []types.Location{{StartLine: 2004, EndLine: 2014}}},
+		{Name: "set-blocking", Version: "2.0.0", Constraint: "^2.0.0, ~2.0.0", Locations: []types.Location{{StartLine: 2016, EndLine: 2021}}},
+		{Name: "setprototypeof", Version: "1.1.1", Constraint: "1.1.1", Locations: []types.Location{{StartLine: 2023, EndLine: 2028}}},
+		{Name: "signal-exit", Version: "3.0.3", Constraint: "^3.0.0", Locations: []types.Location{{StartLine: 2030, EndLine: 2035}}},
+		{Name: "sprintf-js", Version: "1.0.3", Constraint: "~1.0.2", Locations: []types.Location{{StartLine: 2037, EndLine: 2042}}},
+		{Name: "sshpk", Version: "1.16.1", Constraint: "^1.7.0", Locations: []types.Location{{StartLine: 2044, EndLine: 2063}}},
+		{Name: "statuses", Version: "1.5.0", Constraint: ">= 1.5.0 < 2, ~1.5.0", Locations: []types.Location{{StartLine: 2065, EndLine: 2070}}},
+		{Name: "string-width", Version: "1.0.2", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2072, EndLine: 2081}}},
+		{Name: "string-width", Version: "2.1.1", Constraint: "^1.0.2 || 2", Locations: []types.Location{{StartLine: 2083, EndLine: 2091}}},
+		{Name: "string-width", Version: "3.1.0", Constraint: "^3.0.0, ^3.1.0", Locations: []types.Location{{StartLine: 2093, EndLine: 2102}}},
+		{Name: "string.prototype.trimend", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2104, EndLine: 2112}}},
+		{Name: "string.prototype.trimstart", Version: "1.0.1", Constraint: "^1.0.1", Locations: []types.Location{{StartLine: 2114, EndLine: 2122}}},
+		{Name: "string_decoder", Version: "1.1.1", Constraint: "~1.1.1", Locations: []types.Location{{StartLine: 2124, EndLine: 2131}}},
+		{Name: "strip-ansi", Version: "3.0.1", Constraint: "^3.0.0, ^3.0.1", Locations: []types.Location{{StartLine: 2133, EndLine: 2140}}},
+		{Name: "strip-ansi", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 2142, EndLine: 2149}}},
+		{Name: "strip-ansi", Version: "5.2.0", Constraint: "^5.0.0, ^5.1.0, ^5.2.0", Locations: []types.Location{{StartLine: 2151, EndLine: 2158}}},
+		{Name: "strip-json-comments", Version: "3.0.1", Constraint: "3.0.1", Locations: []types.Location{{StartLine: 2160, EndLine: 2165}}},
+		{Name: "supports-color", Version: "7.1.0", Constraint: "7.1.0, ^7.1.0", Locations: []types.Location{{StartLine: 2167, EndLine: 2174}}},
+		{Name: "symbol-observable", Version: "1.2.0", Constraint: "^1.2.0", Locations: []types.Location{{StartLine: 2176, EndLine: 2181}}},
+		{Name: "tar", Version: "6.0.5", Constraint: "^6.0.1", Locations: []types.Location{{StartLine: 2183, EndLine: 2195}}},
+		{Name: "to-regex-range", Version: "5.0.1", Constraint: "^5.0.1", Locations: []types.Location{{StartLine: 2197, EndLine: 2204}}},
+		{Name: "toidentifier", Version: "1.0.0", Constraint: "1.0.0", Locations: []types.Location{{StartLine: 2206, EndLine: 2211}}},
+		{Name: "tough-cookie", Version: "2.5.0", Constraint: "~2.5.0", Locations: []types.Location{{StartLine: 2213, EndLine: 2221}}},
+		{Name: "tunnel-agent", Version: "0.6.0", Constraint: "^0.6.0", Locations: []types.Location{{StartLine: 2223, EndLine: 2230}}},
+		{Name: "tweetnacl", Version: "0.14.5", Constraint: "^0.14.3, ~0.14.0", Locations: []types.Location{{StartLine: 2232, EndLine: 2237}}},
+		{Name: "type-is", Version: "1.6.18", Constraint: "~1.6.17, ~1.6.18", Locations: []types.Location{{StartLine: 2239, EndLine: 2247}}},
+		{Name: "unpipe", Version: "1.0.0", Constraint: "1.0.0, ~1.0.0", Locations: []types.Location{{StartLine: 2249, EndLine: 2254}}},
+		{Name: "uri-js", Version: "4.4.0", Constraint: "^4.2.2", Locations: []types.Location{{StartLine: 2256, EndLine: 2263}}},
+		{Name: "util-deprecate", Version: "1.0.2", Constraint: "~1.0.1", Locations: []types.Location{{StartLine: 2265, EndLine: 2270}}},
+		{Name: "utils-merge", Version: "1.0.1", Constraint: "1.0.1", Locations: []types.Location{{StartLine: 2272, EndLine: 2277}}},
+		{Name: "uuid", Version: "3.4.0", Constraint: "^3.3.2", Locations: []types.Location{{StartLine: 2279, EndLine: 2286}}},
+		{Name: "vary", Version: "1.1.2", Constraint: "~1.1.2", Locations: []types.Location{{StartLine: 2288, EndLine: 2293}}},
+		{Name: "verror", Version: "1.10.0", Constraint: "1.10.0", Locations: []types.Location{{StartLine: 2295, EndLine: 2304}}},
+		{Name: "vue", Version: "2.6.12", Constraint: "^2.6.12", Locations: []types.Location{{StartLine: 2306, EndLine: 2311}}},
+		{Name: "which-module", Version: "2.0.0", Constraint: "^2.0.0", Locations: []types.Location{{StartLine: 2313, EndLine: 2318}}},
+		{Name: "which", Version: "2.0.2", Constraint: "2.0.2, ^2.0.2", Locations: []types.Location{{StartLine: 2320, EndLine: 2329}}},
+		{Name: "wide-align", Version: "1.1.3", Constraint: "1.1.3, ^1.1.0", Locations: []types.Location{{StartLine: 2331, EndLine: 2338}}},
+		{Name: "workerpool", Version: "6.0.0", Constraint: "6.0.0", Locations: []types.Location{{StartLine: 2340, EndLine: 2345}}},
+		{Name: "wrap-ansi", Version: "5.1.0", Constraint: "^5.1.0", Locations: []types.Location{{StartLine: 2347, EndLine: 2356}}},
+		{Name: "wrappy", Version: "1.0.2", Constraint: "1", Locations: []types.Location{{StartLine: 2358, EndLine: 2363}}},
+		{Name: "y18n", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 2365, EndLine: 2370}}},
+		{Name: "yallist", Version: "4.0.0", Constraint: "^4.0.0", Locations: []types.Location{{StartLine: 2372, EndLine: 2377}}},
+		{Name: "yargs-parser", Version: "13.1.2", Constraint: "13.1.2, ^13.1.2", Locations: []types.Location{{StartLine: 2379, EndLine: 2387}}},
+		{Name: "yargs-parser", Version: "15.0.1", Constraint: "^15.0.1", Locations: []types.Location{{StartLine: 2389, EndLine: 2397}}},
+		{Name: "yargs-unparser", Version: "1.6.1", Constraint: "1.6.1", Locations: []types.Location{{StartLine: 2399, EndLine: 2410}}},
+		{Name: "yargs", Version: "13.3.2", Constraint: "13.3.2", Locations: []types.Location{{StartLine: 2412, EndLine: 2428}}},
+		{Name: "yargs", Version: "14.2.3", Constraint: "^14.2.3", Locations: []types.Location{{StartLine: 2430, EndLine: 2447}}},
 	}
 
 	// docker run --name node --rm -it node:16-alpine sh
@@ -2846,6 +2846,6 @@ var (
 	// yarn add jquery
 	// npm install
 	yarnWithNpm = []types.Library{
-		{"jquery", "3.6.0", ""},
+		{Name: "jquery", Version: "3.6.0", Constraint: "^3.6.0", Locations: []types.Location{{StartLine: 1, EndLine: 4}}},
 	}
 )
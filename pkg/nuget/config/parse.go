@@ -7,6 +7,7 @@ import (
 	"golang.org/x/xerrors"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type cfgPackageReference struct {
@@ -22,13 +23,30 @@ type config struct {
 	Packages []cfgPackageReference `xml:"package"`
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+// Parser implements types.Parser for packages.config
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var cfgData config
-	if err := xml.NewDecoder(r).Decode(&cfgData); err != nil {
-		return nil, xerrors.Errorf("failed to decode .config file: %w", err)
+	// packages.config written by Visual Studio may be in UTF-16
+	decoder := xml.NewDecoder(utils.NewUTF8Reader(r))
+	decoder.CharsetReader = utils.CharsetReader
+	if err := decoder.Decode(&cfgData); err != nil {
+		return nil, xerrors.Errorf("failed to decode .config file: %w", &types.ErrMalformedInput{Err: err})
 	}
 
-	uniqueLibs := map[types.Library]struct{}{}
+	var libs []types.Library
 	for _, pkg := range cfgData.Packages {
 		if pkg.ID == "" || pkg.DevDependency {
 			continue
@@ -38,13 +56,8 @@ func Parse(r io.Reader) ([]types.Library, error) {
 			Name:    pkg.ID,
 			Version: pkg.Version,
 		}
-		uniqueLibs[lib] = struct{}{}
-	}
-
-	var libs []types.Library
-	for lib := range uniqueLibs {
 		libs = append(libs, lib)
 	}
 
-	return libs, nil
+	return utils.UniqueLibraries(libs), nil
 }
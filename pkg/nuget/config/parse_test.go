@@ -33,6 +33,20 @@ func TestParse(t *testing.T) {
 				{Name: "Newtonsoft.Json", Version: "8.0.3"},
 			},
 		},
+		{
+			name:      "UTF-16",
+			inputFile: "testdata/utf16.config",
+			want: []types.Library{
+				{Name: "Newtonsoft.Json", Version: "12.0.3"},
+			},
+		},
+		{
+			name:      "UTF-16 big endian without byte order mark",
+			inputFile: "testdata/utf16be.config",
+			want: []types.Library{
+				{Name: "Newtonsoft.Json", Version: "12.0.3"},
+			},
+		},
 		{
 			name:      "sad path",
 			inputFile: "testdata/malformed_xml.config",
@@ -3,10 +3,12 @@ package lock
 import (
 	"encoding/json"
 	"io"
+	"sort"
 
 	"golang.org/x/xerrors"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 type LockFile struct {
@@ -17,19 +19,45 @@ type LockFile struct {
 type Dependencies map[string]Dependency
 
 type Dependency struct {
-	Type     string
-	Resolved string
+	Type string
+	// Requested is the version range of direct dependencies. e.g. "[1.0.0, )"
+	Requested string
+	Resolved  string
+	// e.g. "Newtonsoft.Json": "12.0.3"
+	Dependencies map[string]string
 }
 
+// Parser implements types.Parser for packages.lock.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return parse(r)
+}
+
+// Parse parses packages.lock.json and returns the libraries.
+// The dependency graph between them is returned by Parser.Parse.
 func Parse(r io.Reader) ([]types.Library, error) {
+	libs, _, err := parse(r)
+	return libs, err
+}
+
+// parse returns the libraries and the dependency graph between them.
+func parse(r io.Reader) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var lockFile LockFile
-	decoder := json.NewDecoder(r)
+	decoder := json.NewDecoder(utils.NewUTF8Reader(r))
 
 	if err := decoder.Decode(&lockFile); err != nil {
-		return nil, xerrors.Errorf("failed to decode packages.lock.json: %w", err)
+		return nil, nil, xerrors.Errorf("failed to decode packages.lock.json: %w", &types.ErrMalformedInput{Err: err})
 	}
 
-	uniqueLibs := map[types.Library]struct{}{}
+	var libraries []types.Library
+	dependsOn := map[string]map[string]struct{}{}
 	for _, targetContent := range lockFile.Targets {
 		for packageName, packageContent := range targetContent {
 			// If package type is "project", it is the actual project, and we skip it.
@@ -37,18 +65,55 @@ func Parse(r io.Reader) ([]types.Library, error) {
 				continue
 			}
 
+			id := utils.PackageID(packageName, packageContent.Resolved)
 			lib := types.Library{
+				ID:      id,
 				Name:    packageName,
 				Version: packageContent.Resolved,
+				// Only direct dependencies are requested
+				Constraint: packageContent.Requested,
+				// e.g. Transitive, CentralTransitive
+				Indirect: packageContent.Type != "Direct",
+			}
+			libraries = append(libraries, lib)
+
+			// Dependencies are resolved within the same target framework,
+			// and they can differ between target frameworks.
+			for depName := range packageContent.Dependencies {
+				dep, ok := targetContent[depName]
+				if !ok || dep.Type == "Project" {
+					continue
+				}
+				if dependsOn[id] == nil {
+					dependsOn[id] = map[string]struct{}{}
+				}
+				dependsOn[id][utils.PackageID(depName, dep.Resolved)] = struct{}{}
 			}
-			uniqueLibs[lib] = struct{}{}
 		}
 	}
 
-	var libraries []types.Library
-	for lib := range uniqueLibs {
-		libraries = append(libraries, lib)
+	// A package can be direct in one target framework and transitive in another.
+	// Direct ones come first so that they are kept by UniqueLibraries.
+	sort.SliceStable(libraries, func(i, j int) bool {
+		return !libraries[i].Indirect && libraries[j].Indirect
+	})
+
+	var deps []types.Dependency
+	for id, depIDs := range dependsOn {
+		var ids []string
+		for depID := range depIDs {
+			ids = append(ids, depID)
+		}
+		sort.Strings(ids)
+		deps = append(deps, types.Dependency{
+			ID:        id,
+			DependsOn: ids,
+		})
 	}
 
-	return libraries, nil
+	// Target frameworks and dependencies are decoded into maps, whose iteration order is random
+	libs := utils.UniqueLibraries(libraries)
+	utils.SortLibraries(libs)
+	utils.SortDependencies(deps)
+	return libs, deps, nil
 }
@@ -3,8 +3,6 @@ package lock
 import (
 	"os"
 	"path"
-	"sort"
-	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,20 +13,23 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
 			file: "testdata/packages_lock_simple.json",
 			want: nuGetSimple,
 		},
 		{
-			file: "testdata/packages_lock_subdependencies.json",
-			want: nuGetSubDependencies,
+			file:     "testdata/packages_lock_subdependencies.json",
+			want:     nuGetSubDependencies,
+			wantDeps: nuGetSubDependenciesDeps,
 		},
 		{
-			file: "testdata/packages_lock_multi.json",
-			want: nuGetMultiTarget,
+			file:     "testdata/packages_lock_multi.json",
+			want:     nuGetMultiTarget,
+			wantDeps: nuGetMultiTargetDeps,
 		},
 		{
 			file: "testdata/packages_lock_legacy.json",
@@ -41,26 +42,11 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, gotDeps, err := NewParser().Parse(f)
 			require.NoError(t, err)
 
-			sort.Slice(got, func(i, j int) bool {
-				ret := strings.Compare(got[i].Name, got[j].Name)
-				if ret == 0 {
-					return got[i].Version < got[j].Version
-				}
-				return ret < 0
-			})
-
-			sort.Slice(v.want, func(i, j int) bool {
-				ret := strings.Compare(v.want[i].Name, v.want[j].Name)
-				if ret == 0 {
-					return v.want[i].Version < v.want[j].Version
-				}
-				return ret < 0
-			})
-
 			assert.Equal(t, v.want, got)
+			assert.Equal(t, v.wantDeps, gotDeps)
 		})
 	}
 }
@@ -0,0 +1,86 @@
+package opam
+
+import (
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// The "depends" field of *.opam.locked
+	// and the "installed" field of "opam switch export"
+	// e.g. depends: [
+	fieldRegexp = regexp.MustCompile(`(?m)^(depends|installed):\s*\[`)
+
+	// A pinned dependency in *.opam.locked
+	// e.g. "base" {= "v0.15.1"}
+	//      "dune" {= "3.4.1" & with-test}
+	pinnedRegexp = regexp.MustCompile(`"([^"]+)"\s*\{\s*=\s*"([^"]+)"[^}]*\}`)
+
+	// An installed package in the switch export
+	// e.g. "base.v0.15.1"
+	installedRegexp = regexp.MustCompile(`"([^"]+)"`)
+)
+
+// Parser implements types.Parser for *.opam.locked
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses *.opam.locked and the output of "opam switch export"
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+	content := string(b)
+
+	var libs []types.Library
+	for _, loc := range fieldRegexp.FindAllStringSubmatchIndex(content, -1) {
+		field := content[loc[2]:loc[3]]
+		list := content[loc[1]:]
+		end := strings.Index(list, "]")
+		if end == -1 {
+			return nil, &types.ErrMalformedInput{Err: xerrors.Errorf("unterminated %s field", field)}
+		}
+		list = list[:end]
+
+		switch field {
+		case "depends":
+			for _, m := range pinnedRegexp.FindAllStringSubmatch(list, -1) {
+				libs = append(libs, types.Library{
+					Name:    m[1],
+					Version: m[2],
+				})
+			}
+		case "installed":
+			for _, m := range installedRegexp.FindAllStringSubmatch(list, -1) {
+				// opam package names can't contain dots
+				// e.g. "base.v0.15.1" => "base", "v0.15.1"
+				ss := strings.SplitN(m[1], ".", 2)
+				if len(ss) != 2 {
+					continue
+				}
+				libs = append(libs, types.Library{
+					Name:    ss[0],
+					Version: ss[1],
+				})
+			}
+		}
+	}
+	return libs, nil
+}
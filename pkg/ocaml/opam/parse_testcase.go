@@ -0,0 +1,25 @@
+package opam
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name opam --rm -it ocaml/opam:debian-ocaml-4.14 bash
+	// opam lock ./normal.opam
+	opamLocked = []types.Library{
+		{Name: "astring", Version: "0.8.5"},
+		{Name: "base", Version: "v0.15.1"},
+		{Name: "base-threads", Version: "base"},
+		{Name: "dune", Version: "3.4.1"},
+		{Name: "ocaml", Version: "4.14.0"},
+		{Name: "ppx_inline_test", Version: "v0.15.0"},
+	}
+
+	// opam switch export switch.export
+	opamSwitchExport = []types.Library{
+		{Name: "base-bigarray", Version: "base"},
+		{Name: "dune", Version: "3.4.1"},
+		{Name: "ocaml", Version: "4.14.0"},
+		{Name: "ocaml-base-compiler", Version: "4.14.0"},
+		{Name: "ocaml-config", Version: "2"},
+	}
+)
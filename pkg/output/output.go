@@ -0,0 +1,134 @@
+// Package output writes the applications found by the scanner in the formats of the go-dep-parser command.
+//
+// e.g.
+//
+//	apps, _ := scanner.Scan(os.DirFS("."), ".")
+//	err := output.Write(os.Stdout, output.FormatCycloneDX, "app", apps)
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/merge"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/cyclonedx"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/spdx"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Format is the format of the output.
+type Format string
+
+const (
+	// FormatJSON writes an array of the applications with types.SchemaVersion.
+	FormatJSON Format = "json"
+	// FormatTable writes a line per library, aligned in columns.
+	FormatTable Format = "table"
+	// FormatCycloneDX writes a CycloneDX BOM of the libraries in all the applications.
+	FormatCycloneDX Format = "cyclonedx"
+	// FormatSPDX writes an SPDX document of the libraries in all the applications.
+	FormatSPDX Format = "spdx"
+)
+
+// Formats are the supported formats.
+var Formats = []Format{FormatJSON, FormatTable, FormatCycloneDX, FormatSPDX}
+
+// Valid reports whether the format is one of Formats.
+func (f Format) Valid() bool {
+	for _, format := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Application is the JSON representation of an application.
+type Application struct {
+	FilePath      string             `json:"FilePath"`
+	SchemaVersion int                `json:"SchemaVersion"`
+	Libraries     []types.Library    `json:"Libraries"`
+	Dependencies  []types.Dependency `json:"Dependencies"`
+}
+
+// Write writes the applications in the format.
+// The name is the name of the SPDX document, and not used by the other formats.
+//
+// SBOM formats merge the applications with merge.Merge, so that each library has the file it was found in.
+// Package URLs are not filled, as the file names don't tell the ecosystems of all the parsers.
+func Write(w io.Writer, format Format, name string, apps []scanner.Application) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, apps)
+	case FormatTable:
+		return writeTable(w, apps)
+	case FormatCycloneDX:
+		libs, deps := mergeApps(apps)
+		return cyclonedx.Encode(w, libs, deps)
+	case FormatSPDX:
+		libs, deps := mergeApps(apps)
+		return spdx.Encode(w, name, libs, deps)
+	}
+	return xerrors.Errorf("unknown format: %s", format)
+}
+
+func writeJSON(w io.Writer, apps []scanner.Application) error {
+	out := make([]Application, 0, len(apps))
+	for _, app := range apps {
+		a := Application{
+			FilePath:      app.FilePath,
+			SchemaVersion: types.SchemaVersion,
+			Libraries:     app.Libraries,
+			Dependencies:  app.Dependencies,
+		}
+		// The same as types.Result
+		if a.Libraries == nil {
+			a.Libraries = []types.Library{}
+		}
+		if a.Dependencies == nil {
+			a.Dependencies = []types.Dependency{}
+		}
+		out = append(out, a)
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(out); err != nil {
+		return xerrors.Errorf("json encode error: %w", err)
+	}
+	return nil
+}
+
+// writeTable writes the libraries with the files they were found in.
+func writeTable(w io.Writer, apps []scanner.Application) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tNAME\tVERSION\tSCOPE")
+	for _, app := range apps {
+		for _, lib := range app.Libraries {
+			filePath := app.FilePath
+			if lib.FilePath != "" && !strings.HasSuffix(app.FilePath, lib.FilePath) {
+				// e.g. app.war: WEB-INF/lib/commons-lang3-3.11.jar
+				filePath += ": " + lib.FilePath
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", filePath, lib.Name, lib.Version, lib.Scope)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return xerrors.Errorf("table write error: %w", err)
+	}
+	return nil
+}
+
+func mergeApps(apps []scanner.Application) ([]types.Library, []types.Dependency) {
+	results := make([]merge.Result, 0, len(apps))
+	for _, app := range apps {
+		results = append(results, merge.Result(app))
+	}
+	return merge.Merge(results)
+}
@@ -0,0 +1,93 @@
+package output_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/cyclonedx"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/spdx"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+var apps = []scanner.Application{
+	{
+		FilePath: "app/package-lock.json",
+		Libraries: []types.Library{
+			{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9", Scope: types.ScopeRuntime},
+			{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0", Scope: types.ScopeRuntime},
+		},
+		Dependencies: []types.Dependency{{ID: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}}},
+	},
+	{
+		FilePath: "app.war",
+		Libraries: []types.Library{
+			{Name: "org.apache.commons:commons-lang3", Version: "3.11", FilePath: "WEB-INF/lib/commons-lang3-3.11.jar"},
+		},
+	},
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, output.Write(&buf, output.FormatJSON, "app", apps))
+
+		var got []output.Application
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		want := []output.Application{
+			{
+				FilePath:      "app/package-lock.json",
+				SchemaVersion: types.SchemaVersion,
+				Libraries:     apps[0].Libraries,
+				Dependencies:  apps[0].Dependencies,
+			},
+			{
+				FilePath:      "app.war",
+				SchemaVersion: types.SchemaVersion,
+				Libraries:     apps[1].Libraries,
+				Dependencies:  []types.Dependency{},
+			},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("table", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, output.Write(&buf, output.FormatTable, "app", apps))
+		want := "FILE                                         NAME                              VERSION  SCOPE\n" +
+			"app/package-lock.json                        debug                             2.6.9    runtime\n" +
+			"app/package-lock.json                        ms                                2.0.0    runtime\n" +
+			"app.war: WEB-INF/lib/commons-lang3-3.11.jar  org.apache.commons:commons-lang3  3.11     \n"
+		assert.Equal(t, want, buf.String())
+	})
+
+	t.Run("cyclonedx", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, output.Write(&buf, output.FormatCycloneDX, "app", apps))
+
+		var got cyclonedx.BOM
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Len(t, got.Components, 3)
+		assert.Equal(t, []cyclonedx.Dependency{{Ref: "debug@2.6.9", DependsOn: []string{"ms@2.0.0"}}}, got.Dependencies)
+	})
+
+	t.Run("spdx", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, output.Write(&buf, output.FormatSPDX, "app", apps))
+
+		var got spdx.Document
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Equal(t, "app", got.Name)
+		assert.Len(t, got.Packages, 3)
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		err := output.Write(&bytes.Buffer{}, "xml", "app", apps)
+		assert.EqualError(t, err, "unknown format: xml")
+	})
+}
@@ -0,0 +1,90 @@
+package carton
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+const distributionsMarker = "DISTRIBUTIONS"
+
+// Parser implements types.Parser for cpanfile.snapshot
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses cpanfile.snapshot generated by Carton
+//
+// e.g.
+//
+//	DISTRIBUTIONS
+//	  Class-Accessor-0.51
+//	    pathname: K/KA/KASEI/Class-Accessor-0.51.tar.gz
+//	    provides:
+//	      Class::Accessor 0.51
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	var inDistributions bool
+	var lineNum int
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if countLeadingSpace(line) == 0 {
+			inDistributions = line == distributionsMarker
+			continue
+		}
+
+		// Distributions are indented by 2 spaces, and their fields by 4 or more
+		if !inDistributions || countLeadingSpace(line) != 2 {
+			continue
+		}
+
+		name, version := splitDistribution(strings.TrimSpace(line))
+		if name == "" || version == "" {
+			continue
+		}
+		libs = append(libs, types.Library{
+			Name:      name,
+			Version:   version,
+			Locations: []types.Location{{StartLine: lineNum, EndLine: lineNum}},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, nil
+}
+
+// splitDistribution splits a distribution into its name and version.
+// e.g. Class-Accessor-0.51 => Class-Accessor, 0.51
+func splitDistribution(dist string) (string, string) {
+	idx := strings.LastIndex(dist, "-")
+	if idx == -1 {
+		return "", ""
+	}
+	return dist[:idx], dist[idx+1:]
+}
+
+func countLeadingSpace(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
@@ -0,0 +1,16 @@
+package carton
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name carton --rm -it perl:5.36 bash
+	// cpanm Carton
+	// echo "requires 'Class::Accessor'; requires 'Moo'; requires 'LWP';" > cpanfile
+	// carton install
+	cartonNormal = []types.Library{
+		{Name: "Class-Accessor", Version: "0.51", Locations: []types.Location{{StartLine: 3, EndLine: 3}}},
+		{Name: "Module-Runtime", Version: "0.016", Locations: []types.Location{{StartLine: 12, EndLine: 12}}},
+		{Name: "Moo", Version: "2.005004", Locations: []types.Location{{StartLine: 19, EndLine: 19}}},
+		{Name: "libwww-perl", Version: "6.67", Locations: []types.Location{{StartLine: 29, EndLine: 29}}},
+	}
+)
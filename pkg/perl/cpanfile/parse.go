@@ -0,0 +1,81 @@
+package cpanfile
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// Capture a runtime requirement
+	// e.g. requires 'Plack', '>= 1.0000';
+	//      => Plack, >= 1.0000
+	requiresRegexp = regexp.MustCompile(`^requires\s+['"]([^'"]+)['"]\s*(?:(?:,|=>)\s*['"]?([^'";]+)['"]?)?\s*;`)
+
+	// Capture the phase of an "on" block
+	// e.g. on 'test' => sub {
+	//      => test
+	phaseRegexp = regexp.MustCompile(`^on\s+['"]?(\w+)['"]?\s*=>\s*sub\s*\{`)
+)
+
+// Parser implements types.Parser for cpanfile
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses cpanfile
+// Only runtime requirements are returned when the Carton snapshot doesn't exist.
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	var phase string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if m := phaseRegexp.FindStringSubmatch(line); m != nil {
+			phase = m[1]
+			continue
+		}
+		if phase != "" && strings.HasPrefix(line, "}") {
+			phase = ""
+			continue
+		}
+		if phase != "" && phase != "runtime" {
+			continue
+		}
+
+		m := requiresRegexp.FindStringSubmatch(line)
+		if m == nil || m[1] == "perl" {
+			continue
+		}
+		constraint := strings.TrimSpace(m[2])
+		libs = append(libs, types.Library{
+			Name:       m[1],
+			Version:    constraint,
+			Constraint: constraint,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, nil
+}
@@ -0,0 +1,13 @@
+package cpanfile
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	cpanfileNormal = []types.Library{
+		{Name: "Plack", Version: "1.0", Constraint: "1.0"},
+		{Name: "DBI", Version: ">= 1.600", Constraint: ">= 1.600"},
+		{Name: "JSON::XS"},
+		{Name: "Moo", Version: "2.0", Constraint: "2.0"},
+		{Name: "Try::Tiny"},
+	}
+)
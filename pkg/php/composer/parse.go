@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
@@ -16,12 +17,26 @@ type packageInfo struct {
 	Version string
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+// Parser implements types.Parser for composer.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var lockFile lockFile
 	decoder := json.NewDecoder(r)
-	err := decoder.Decode(&lockFile)
+	err = decoder.Decode(&lockFile)
 	if err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
 	}
 
 	var libs []types.Library
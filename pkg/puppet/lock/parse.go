@@ -0,0 +1,98 @@
+package lock
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Parser implements types.Parser for Puppetfile.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Puppetfile.lock generated by librarian-puppet
+//
+// It has the same layout as Gemfile.lock, where resolved modules are indented by 4 spaces.
+// e.g.
+//
+//	FORGE
+//	  remote: https://forgeapi.puppetlabs.com
+//	  specs:
+//	    puppetlabs-concat (7.2.0)
+//	      puppetlabs-stdlib (< 9.0.0, >= 4.13.1)
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	direct := map[string]bool{}
+	var inDependencies bool
+	var lineNum int
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+
+		// Modules listed in the Puppetfile
+		// e.g.
+		//   DEPENDENCIES
+		//     puppetlabs-concat (>= 0)
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := countLeadingSpace(line)
+		if indent == 0 {
+			inDependencies = line == "DEPENDENCIES"
+			continue
+		}
+		if inDependencies && indent == 2 {
+			direct[normalize(strings.Fields(line)[0])] = true
+			continue
+		}
+		if indent != 4 {
+			continue
+		}
+
+		s := strings.Fields(line)
+		if len(s) != 2 {
+			continue
+		}
+		libs = append(libs, types.Library{
+			Name:      normalize(s[0]),
+			Version:   strings.Trim(s[1], "()"),
+			Locations: []types.Location{{StartLine: lineNum, EndLine: lineNum}},
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+
+	if len(direct) > 0 {
+		for i := range libs {
+			libs[i].Indirect = !direct[libs[i].Name]
+		}
+	}
+	return libs, nil
+}
+
+// normalize replaces the separator of "author/module" with "-" used by the Forge
+func normalize(name string) string {
+	return strings.Replace(name, "/", "-", 1)
+}
+
+func countLeadingSpace(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
@@ -0,0 +1,14 @@
+package lock
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name puppet --rm -it ruby:2.7 bash
+	// gem install librarian-puppet
+	// librarian-puppet install
+	puppetfileLock = []types.Library{
+		{Name: "puppetlabs-concat", Version: "7.2.0", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "puppetlabs-stdlib", Version: "8.4.0", Indirect: true, Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
+		{Name: "puppetlabs-apache", Version: "8.0.0", Locations: []types.Location{{StartLine: 13, EndLine: 13}}},
+	}
+)
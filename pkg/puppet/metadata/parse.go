@@ -0,0 +1,63 @@
+package metadata
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type metadata struct {
+	Name         string       `json:"name"`
+	Version      string       `json:"version"`
+	Dependencies []dependency `json:"dependencies"`
+}
+
+type dependency struct {
+	// e.g. puppetlabs/stdlib
+	Name string `json:"name"`
+	// e.g. >= 8.4.0 < 9.0.0
+	VersionRequirement string `json:"version_requirement"`
+}
+
+// Parser implements types.Parser for metadata.json of Puppet modules
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses metadata.json of Puppet modules
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var m metadata
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var libs []types.Library
+	for _, dep := range m.Dependencies {
+		libs = append(libs, types.Library{
+			Name:       normalizeName(dep.Name),
+			Version:    dep.VersionRequirement,
+			Constraint: dep.VersionRequirement,
+		})
+	}
+	return libs, nil
+}
+
+// normalizeName returns the name used on the Forge.
+// e.g. puppetlabs/stdlib => puppetlabs-stdlib
+func normalizeName(name string) string {
+	return strings.Replace(name, "/", "-", 1)
+}
@@ -0,0 +1,11 @@
+package metadata
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// https://github.com/puppetlabs/puppetlabs-apache/blob/v8.0.0/metadata.json
+	metadataNormal = []types.Library{
+		{Name: "puppetlabs-stdlib", Version: ">= 4.13.1 < 9.0.0", Constraint: ">= 4.13.1 < 9.0.0"},
+		{Name: "puppetlabs-concat", Version: ">= 2.2.1 < 8.0.0", Constraint: ">= 2.2.1 < 8.0.0"},
+	}
+)
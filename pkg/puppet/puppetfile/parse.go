@@ -0,0 +1,114 @@
+package puppetfile
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// A quoted string, e.g. 'puppetlabs-stdlib'
+	stringRegexp = regexp.MustCompile(`^\s*['"]([^'"]*)['"]\s*$`)
+
+	// A hash argument
+	// e.g. :git => 'https://github.com/puppetlabs/puppetlabs-apache'
+	//      tag: 'v8.0.0'
+	hashArgRegexp = regexp.MustCompile(`^\s*:?(\w+)\s*(?:=>|:)\s*['"]([^'"]*)['"]\s*$`)
+)
+
+// Git references in order of preference
+var refKeys = []string{"commit", "tag", "ref", "branch"}
+
+// Parser implements types.Parser for Puppetfile
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Puppetfile
+//
+// e.g.
+//
+//	mod 'puppetlabs-stdlib', '8.4.0'
+//	mod 'apache',
+//	  :git => 'https://github.com/puppetlabs/puppetlabs-apache',
+//	  :tag => 'v8.0.0'
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	var stmt string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// A statement continues while the line ends with a comma
+		stmt += " " + line
+		if strings.HasSuffix(line, ",") {
+			continue
+		}
+
+		if lib, ok := parseMod(strings.TrimSpace(stmt)); ok {
+			libs = append(libs, lib)
+		}
+		stmt = ""
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, nil
+}
+
+func parseMod(stmt string) (types.Library, bool) {
+	if !strings.HasPrefix(stmt, "mod ") && !strings.HasPrefix(stmt, "mod(") {
+		return types.Library{}, false
+	}
+	stmt = strings.TrimPrefix(stmt, "mod")
+	stmt = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(stmt), "("), ")")
+
+	args := strings.Split(stmt, ",")
+	m := stringRegexp.FindStringSubmatch(args[0])
+	if m == nil {
+		return types.Library{}, false
+	}
+	lib := types.Library{Name: strings.Replace(m[1], "/", "-", 1)}
+
+	hashArgs := map[string]string{}
+	for _, arg := range args[1:] {
+		if m = stringRegexp.FindStringSubmatch(arg); m != nil {
+			// e.g. mod 'puppetlabs-stdlib', '8.4.0'
+			lib.Version = m[1]
+		} else if m = hashArgRegexp.FindStringSubmatch(arg); m != nil {
+			hashArgs[m[1]] = m[2]
+		}
+		// Symbols such as :latest don't pin a version
+	}
+
+	for _, key := range refKeys {
+		if ref, ok := hashArgs[key]; ok {
+			lib.Version = ref
+			break
+		}
+	}
+	return lib, true
+}
@@ -0,0 +1,14 @@
+package puppetfile
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	puppetfileNormal = []types.Library{
+		{Name: "puppetlabs-stdlib", Version: "8.4.0"},
+		{Name: "puppetlabs-concat", Version: "7.2.0"},
+		{Name: "puppetlabs-apt"},
+		{Name: "puppetlabs-ntp"},
+		{Name: "apache", Version: "v8.0.0"},
+		{Name: "firewall", Version: "0d2ae2d3c9d3ea0b6c3e8d2b7a1f4e5c6d7b8a9f"},
+	}
+)
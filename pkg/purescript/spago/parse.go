@@ -0,0 +1,122 @@
+package spago
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// The dependencies of spago.dhall
+	// e.g. dependencies = [ "console", "effect", "prelude" ]
+	dhallDependenciesRegexp = regexp.MustCompile(`dependencies\s*=\s*\[([^\]]*)\]`)
+
+	// e.g. "console"
+	dhallStringRegexp = regexp.MustCompile(`"([^"]+)"`)
+)
+
+type lockFile struct {
+	Workspace struct {
+		Packages map[string]struct {
+			// e.g. "prelude", or {"prelude": ">=6.0.0 <7.0.0"} with a range
+			Dependencies []interface{} `yaml:"dependencies"`
+		} `yaml:"packages"`
+	} `yaml:"workspace"`
+	Packages map[string]lockPackage `yaml:"packages"`
+}
+
+type lockPackage struct {
+	// e.g. registry, git, local
+	Type      string `yaml:"type"`
+	Version   string `yaml:"version"`
+	Integrity string `yaml:"integrity"`
+	Rev       string `yaml:"rev"`
+}
+
+// Parser implements types.Parser for spago.lock and spago.dhall
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses spago.lock, or spago.dhall when the lock file doesn't exist
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	if m := dhallDependenciesRegexp.FindSubmatch(b); m != nil {
+		return parseDhall(m[1]), nil
+	}
+
+	var lock lockFile
+	if err = yaml.NewDecoder(bytes.NewReader(b)).Decode(&lock); err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	// Dependencies of the workspace packages are direct
+	direct := map[string]bool{}
+	for _, wp := range lock.Workspace.Packages {
+		for _, dep := range wp.Dependencies {
+			switch d := dep.(type) {
+			case string:
+				direct[d] = true
+			case map[string]interface{}:
+				for name := range d {
+					direct[name] = true
+				}
+			}
+		}
+	}
+
+	var libs []types.Library
+	for name, pkg := range lock.Packages {
+		var version string
+		switch pkg.Type {
+		case "registry":
+			version = pkg.Version
+		case "git":
+			version = pkg.Rev
+		default:
+			// Local packages are part of the workspace
+			continue
+		}
+
+		libs = append(libs, types.Library{
+			Name:     name,
+			Version:  version,
+			Indirect: !direct[name],
+			Digest:   pkg.Integrity,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
+
+// parseDhall returns the dependencies of spago.dhall.
+// Their versions are defined by the package set, which is not available here.
+func parseDhall(deps []byte) []types.Library {
+	var libs []types.Library
+	for _, m := range dhallStringRegexp.FindAllSubmatch(deps, -1) {
+		libs = append(libs, types.Library{
+			Name: string(m[1]),
+		})
+	}
+	return libs
+}
@@ -0,0 +1,24 @@
+package spago
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name spago --rm -it node:18 bash
+	// npm install -g purescript spago@next
+	// spago init && spago install console effect
+	spagoLock = []types.Library{
+		{Name: "console", Version: "6.0.0", Digest: "sha256-WwoVtaMtBrBSZ9DS9Sp+8OkjYzwe8nEBxfGnSRyG8OI="},
+		{Name: "effect", Version: "4.0.0", Digest: "sha256-eBtZu+HZcMa5HilvI6kaDyVX3ji8p0W9MGKy2K4T6+M="},
+		{Name: "prelude", Version: "6.0.1", Digest: "sha256-o8p6SLYmVPqzXZhQFd2hGAWEwBoXl1swxLG/scpJ0V0="},
+		{Name: "yoga-json", Version: "2d4e2e5bd7d64e3f3b6d1c2a8f9e0b1c2d3e4f5a", Indirect: true},
+	}
+
+	// docker run --name spago --rm -it node:18 bash
+	// npm install -g purescript spago@0.20
+	// spago init
+	spagoDhall = []types.Library{
+		{Name: "console"},
+		{Name: "effect"},
+		{Name: "prelude"},
+	}
+)
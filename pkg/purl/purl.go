@@ -0,0 +1,191 @@
+// Package purl converts the libraries returned by the parsers into Package URLs.
+// See https://github.com/package-url/purl-spec
+//
+// Parsers don't know the ecosystem of the file they are given,
+// so the caller picks the type matching the parser.
+//
+// e.g.
+//
+//	libs, _ := npm.Parse(f)
+//	libs = purl.Fill(purl.TypeNPM, libs)
+package purl
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// Types defined by the purl specification
+const (
+	TypeCargo     = "cargo"     // rust/cargo
+	TypeCocoaPods = "cocoapods" // CocoaPods
+	TypeComposer  = "composer"  // php/composer
+	TypeCPAN      = "cpan"      // perl/carton, perl/cpanfile
+	TypeCRAN      = "cran"      // r/renv
+	TypeGem       = "gem"       // ruby/bundler, ruby/gemspec
+	TypeGeneric   = "generic"   // any file without a dedicated type
+	TypeGitHub    = "github"    // packages fetched from GitHub repositories
+	TypeGolang    = "golang"    // golang/mod, golang/binary
+	TypeHex       = "hex"       // gleam/manifest
+	TypeLuaRocks  = "luarocks"  // lua/luarocks, lua/rockspec
+	TypeMaven     = "maven"     // java/jar, java/mvn, java/ivy, java/coursier
+	TypeNPM       = "npm"       // nodejs/npm, nodejs/yarn, nodejs/packagejson
+	TypeNuGet     = "nuget"     // nuget/config, nuget/lock
+	TypePyPI      = "pypi"      // python/pip, python/pipenv, python/poetry, python/packaging
+	TypeSwift     = "swift"     // swift/swiftpm, swift/xcode
+)
+
+// Qualifiers set by the parsers to Library.Qualifiers
+const (
+	QualifierClassifier    = "classifier"     // maven
+	QualifierType          = "type"           // maven. "jar" is the default and omitted
+	QualifierPlatform      = "platform"       // gem. e.g. x86_64-linux and java
+	QualifierRepositoryURL = "repository_url" // npm and others, when the package is not from the default registry
+)
+
+// PackageURL is the parsed form of a Package URL.
+// e.g. pkg:maven/org.apache.commons/commons-lang3@3.12.0
+type PackageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+}
+
+// New returns the Package URL of the library.
+// The name is split into namespace and name, and normalized as required by the type.
+// Library.Qualifiers are added as qualifiers.
+func New(typ string, lib types.Library) PackageURL {
+	namespace, name := splitName(typ, lib.Name)
+	version := lib.Version
+	switch typ {
+	case TypeNPM, TypeComposer, TypeGitHub, TypeHex:
+		namespace = strings.ToLower(namespace)
+		name = strings.ToLower(name)
+	case TypePyPI:
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	case TypeGolang:
+		// The parsers trim "v" from Go module versions
+		if version != "" && !strings.HasPrefix(version, "v") {
+			version = "v" + version
+		}
+	}
+	return PackageURL{
+		Type:       typ,
+		Namespace:  namespace,
+		Name:       name,
+		Version:    version,
+		Qualifiers: qualifiers(typ, lib.Qualifiers),
+	}
+}
+
+// qualifiers copies the qualifiers of the library, omitting the default values of the type.
+func qualifiers(typ string, q map[string]string) map[string]string {
+	var copied map[string]string
+	for k, v := range q {
+		if v == "" || (typ == TypeMaven && k == QualifierType && v == "jar") {
+			continue
+		}
+		if copied == nil {
+			copied = map[string]string{}
+		}
+		copied[k] = v
+	}
+	return copied
+}
+
+// Fill sets PURL of each library, and returns the libraries for convenience.
+func Fill(typ string, libs []types.Library) []types.Library {
+	for i := range libs {
+		libs[i].PURL = New(typ, libs[i]).String()
+	}
+	return libs
+}
+
+func splitName(typ, name string) (string, string) {
+	switch typ {
+	case TypeMaven:
+		// groupId:artifactId
+		if i := strings.Index(name, ":"); i != -1 {
+			return name[:i], name[i+1:]
+		}
+	case TypeNPM:
+		// Only scoped packages have a namespace. e.g. @babel/core
+		if strings.HasPrefix(name, "@") {
+			if i := strings.Index(name, "/"); i != -1 {
+				return name[:i], name[i+1:]
+			}
+		}
+	case TypeComposer, TypeGitHub, TypeGolang, TypeSwift, TypeLuaRocks:
+		// e.g. vendor/name, owner/repo, github.com/owner/repo
+		if i := strings.LastIndex(name, "/"); i != -1 {
+			return name[:i], name[i+1:]
+		}
+	}
+	return "", name
+}
+
+// String returns the canonical form of the Package URL.
+func (p PackageURL) String() string {
+	var sb strings.Builder
+	sb.WriteString("pkg:")
+	sb.WriteString(strings.ToLower(p.Type))
+	sb.WriteString("/")
+	if p.Namespace != "" {
+		var segments []string
+		for _, s := range strings.Split(p.Namespace, "/") {
+			if s != "" {
+				segments = append(segments, escape(s))
+			}
+		}
+		sb.WriteString(strings.Join(segments, "/"))
+		sb.WriteString("/")
+	}
+	sb.WriteString(escape(p.Name))
+	if p.Version != "" {
+		sb.WriteString("@")
+		sb.WriteString(escape(p.Version))
+	}
+
+	var keys []string
+	for k, v := range p.Qualifiers {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			sb.WriteString("?")
+		} else {
+			sb.WriteString("&")
+		}
+		sb.WriteString(strings.ToLower(k))
+		sb.WriteString("=")
+		sb.WriteString(escape(p.Qualifiers[k]))
+	}
+	return sb.String()
+}
+
+// escape percent-encodes everything but the unreserved characters and the colon,
+// which the specification allows to be left as is.
+func escape(s string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~', c == ':':
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hex[c>>4])
+			sb.WriteByte(hex[c&15])
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,129 @@
+package purl_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  string
+		lib  types.Library
+		want string
+	}{
+		{
+			name: "maven",
+			typ:  purl.TypeMaven,
+			lib:  types.Library{Name: "org.apache.commons:commons-lang3", Version: "3.12.0"},
+			want: "pkg:maven/org.apache.commons/commons-lang3@3.12.0",
+		},
+		{
+			name: "npm scoped",
+			typ:  purl.TypeNPM,
+			lib:  types.Library{Name: "@Babel/Core", Version: "7.18.6"},
+			want: "pkg:npm/%40babel/core@7.18.6",
+		},
+		{
+			name: "maven classifier",
+			typ:  purl.TypeMaven,
+			lib: types.Library{
+				Name:       "org.typelevel:cats-core_2.13",
+				Version:    "2.8.0",
+				Qualifiers: map[string]string{purl.QualifierType: "jar", purl.QualifierClassifier: "sources"},
+			},
+			want: "pkg:maven/org.typelevel/cats-core_2.13@2.8.0?classifier=sources",
+		},
+		{
+			name: "npm registry",
+			typ:  purl.TypeNPM,
+			lib: types.Library{
+				Name:       "lodash",
+				Version:    "4.17.21",
+				Qualifiers: map[string]string{purl.QualifierRepositoryURL: "https://npm.example.com/repo"},
+			},
+			want: "pkg:npm/lodash@4.17.21?repository_url=https:%2F%2Fnpm.example.com%2Frepo",
+		},
+		{
+			name: "gem platform",
+			typ:  purl.TypeGem,
+			lib:  types.Library{Name: "nokogiri", Version: "1.13.10", Qualifiers: map[string]string{purl.QualifierPlatform: "x86_64-linux"}},
+			want: "pkg:gem/nokogiri@1.13.10?platform=x86_64-linux",
+		},
+		{
+			name: "npm",
+			typ:  purl.TypeNPM,
+			lib:  types.Library{Name: "lodash", Version: "4.17.21"},
+			want: "pkg:npm/lodash@4.17.21",
+		},
+		{
+			name: "pypi",
+			typ:  purl.TypePyPI,
+			lib:  types.Library{Name: "Django_Rest", Version: "1.0.0"},
+			want: "pkg:pypi/django-rest@1.0.0",
+		},
+		{
+			name: "golang",
+			typ:  purl.TypeGolang,
+			lib:  types.Library{Name: "github.com/gorilla/context", Version: "1.1.1"},
+			want: "pkg:golang/github.com/gorilla/context@v1.1.1",
+		},
+		{
+			name: "composer",
+			typ:  purl.TypeComposer,
+			lib:  types.Library{Name: "pear/PEAR_Exception", Version: "v1.0.0"},
+			want: "pkg:composer/pear/pear_exception@v1.0.0",
+		},
+		{
+			name: "gem without version",
+			typ:  purl.TypeGem,
+			lib:  types.Library{Name: "rails"},
+			want: "pkg:gem/rails",
+		},
+		{
+			name: "escaped version",
+			typ:  purl.TypeMaven,
+			lib:  types.Library{Name: "com.example:app", Version: "1.0.0+build 1"},
+			want: "pkg:maven/com.example/app@1.0.0%2Bbuild%201",
+		},
+		{
+			name: "generic with slash",
+			typ:  purl.TypeGeneric,
+			lib:  types.Library{Name: "fmtlib/fmt", Version: "10.0.0"},
+			want: "pkg:generic/fmtlib%2Ffmt@10.0.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := purl.New(tt.typ, tt.lib).String()
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPackageURL_String(t *testing.T) {
+	p := purl.PackageURL{
+		Type:       purl.TypeMaven,
+		Namespace:  "org.example",
+		Name:       "app",
+		Version:    "1.0.0",
+		Qualifiers: map[string]string{"type": "war", "classifier": "sources", "empty": ""},
+	}
+	assert.Equal(t, "pkg:maven/org.example/app@1.0.0?classifier=sources&type=war", p.String())
+}
+
+func TestFill(t *testing.T) {
+	libs := []types.Library{
+		{Name: "serde", Version: "1.0.136"},
+		{Name: "libc", Version: "0.2.54"},
+	}
+	want := []types.Library{
+		{Name: "serde", Version: "1.0.136", PURL: "pkg:cargo/serde@1.0.136"},
+		{Name: "libc", Version: "0.2.54", PURL: "pkg:cargo/libc@0.2.54"},
+	}
+	assert.Equal(t, want, purl.Fill(purl.TypeCargo, libs))
+}
@@ -4,24 +4,63 @@ import (
 	"bufio"
 	"io"
 	"net/textproto"
+	"strings"
 
 	"golang.org/x/xerrors"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
+// Parser implements types.Parser for egg and wheel metadata
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	lib, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{lib}, nil, nil
+}
+
 // Parse parses egg and wheel metadata.
 // e.g. .egg-info/PKG-INFO and dist-info/METADATA
-func Parse(r io.Reader) (types.Library, error) {
+func Parse(r io.Reader) (_ types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	rd := textproto.NewReader(bufio.NewReader(r))
 	h, err := rd.ReadMIMEHeader()
 	if err != nil && err != io.EOF {
-		return types.Library{}, xerrors.Errorf("read MIME error: %w", err)
+		return types.Library{}, xerrors.Errorf("read MIME error: %w", &types.ErrMalformedInput{Err: err})
 	}
 
-	return types.Library{
+	lib := types.Library{
 		Name:    h.Get("Name"),
 		Version: h.Get("Version"),
-		License: h.Get("License"),
-	}, nil
+	}
+	lib.SetLicenses(license.NormalizeAll(parseLicenses(h)))
+	return lib, nil
+}
+
+// parseLicenses returns the License field, or the license classifiers if it is not available
+// e.g. Classifier: License :: OSI Approved :: MIT License => MIT License
+func parseLicenses(h textproto.MIMEHeader) []string {
+	if l := h.Get("License"); l != "" && l != "UNKNOWN" {
+		return []string{l}
+	}
+
+	var licenses []string
+	for _, classifier := range h.Values("Classifier") {
+		if !strings.HasPrefix(classifier, "License ::") {
+			continue
+		}
+		ss := strings.Split(classifier, " :: ")
+		licenses = append(licenses, ss[len(ss)-1])
+	}
+	return licenses
 }
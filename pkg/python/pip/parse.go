@@ -2,40 +2,180 @@ package pip
 
 import (
 	"bufio"
+	"bytes"
 	"io"
+	"io/fs"
+	"path"
 	"strings"
 	"unicode"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/pep440"
 	"golang.org/x/xerrors"
 )
 
 const (
 	commentMarker string = "#"
 	endColon      string = ";"
+
+	requirementOption      = "--requirement"
+	requirementShortOption = "-r"
 )
 
-func Parse(r io.Reader) ([]types.Library, error) {
-	scanner := bufio.NewScanner(r)
+// Parser implements types.Parser for requirements.txt
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func (p *Parser) ParseFS(fsys fs.FS, filePath string) ([]types.Library, []types.Dependency, error) {
+	libs, err := ParseFS(fsys, filePath)
+	return libs, nil, err
+}
+
+// Parse parses requirements.txt
+// Included files are not followed, as they can't be opened from r. Use ParseFS for them.
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	libs, _, err := parse(r)
+	return libs, err
+}
+
+// ParseFS parses requirements.txt at filePath in fsys, and the files included by it.
+// FilePath of the libraries is set to the file they are found in.
+//
+// e.g.
+//
+//	-r base.txt
+//	--requirement dev/requirements.txt
+func ParseFS(fsys fs.FS, filePath string) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	return parseFS(fsys, filePath, map[string]struct{}{})
+}
+
+func parseFS(fsys fs.FS, filePath string, visited map[string]struct{}) ([]types.Library, error) {
+	// Requirement files including each other are parsed only once
+	if _, ok := visited[filePath]; ok {
+		return nil, nil
+	}
+	visited[filePath] = struct{}{}
+
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return nil, xerrors.Errorf("file open error: %w", err)
+	}
+	defer f.Close()
+
+	libs, includes, err := parse(f)
+	if err != nil {
+		return nil, xerrors.Errorf("%s: %w", filePath, err)
+	}
+	for i := range libs {
+		libs[i].FilePath = filePath
+	}
+
+	// Broken included files are skipped, so that the libraries of the others are still returned
+	var errs []error
+	for _, include := range includes {
+		// fs.FS doesn't allow rooted paths, so they are resolved from the root of fsys
+		if !path.IsAbs(include) {
+			include = path.Join(path.Dir(filePath), include)
+		}
+		includedLibs, err := parseFS(fsys, strings.TrimPrefix(include, "/"), visited)
+		libs = append(libs, includedLibs...)
+		if err != nil {
+			// Errors of the files included by the included file are flattened
+			if _, ok := err.(*types.ErrPartialResult); ok {
+				errs = append(errs, err)
+				continue
+			}
+			errs = append(errs, xerrors.Errorf("unable to parse the file included by %s: %w", filePath, err))
+		}
+	}
+	return libs, types.NewErrPartialResult(errs)
+}
+
+// parse returns the libraries and the paths of the included requirement files.
+func parse(r io.Reader) ([]types.Library, []string, error) {
+	scanner := bufio.NewScanner(utils.NewUTF8Reader(r))
 	var libs []types.Library
+	var includes []string
+	var lineNum int
 	for scanner.Scan() {
-		line := scanner.Text()
+		lineNum++
+		// Only pinned requirements and options can be returned, so the other lines are skipped
+		// before being converted into strings.
+		b := bytes.TrimSpace(scanner.Bytes())
+		if len(b) == 0 || b[0] == '#' || (b[0] != '-' && bytes.IndexByte(b, '=') == -1) {
+			continue
+		}
+		line := string(b)
+		if include, ok := includedFile(line); ok {
+			includes = append(includes, include)
+			continue
+		}
 		line = strings.ReplaceAll(line, " ", "")
 		line = rStripByKey(line, commentMarker)
 		line = rStripByKey(line, endColon)
-		s := strings.Split(line, "==")
-		if len(s) != 2 {
+		name, version, ok := pinned(line)
+		if !ok {
 			continue
 		}
 		libs = append(libs, types.Library{
-			Name:    s[0],
-			Version: s[1],
+			Name:      name,
+			Version:   version,
+			Locations: []types.Location{{StartLine: lineNum, EndLine: lineNum}},
 		})
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, xerrors.Errorf("scan error: %w", err)
+		return nil, nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, includes, nil
+}
+
+// pinned returns the name and the version of the requirement pinned with "==" or "===".
+// Prefix matches such as "==1.0.*" and invalid versions are not pinned.
+func pinned(line string) (string, string, bool) {
+	i := strings.Index(line, "==")
+	if i <= 0 {
+		return "", "", false
+	}
+	spec, err := pep440.NewSpecifier(line[i:])
+	if err != nil || !spec.Pinned() {
+		return "", "", false
+	}
+	return line[:i], spec.Version, true
+}
+
+// includedFile returns the path in "-r", "--requirement" and "--requirement=" options.
+// Remote files are not supported.
+func includedFile(line string) (string, bool) {
+	line = strings.TrimSpace(rStripByKey(line, commentMarker))
+	var ref string
+	switch {
+	case strings.HasPrefix(line, requirementOption+"="):
+		ref = strings.TrimPrefix(line, requirementOption+"=")
+	case strings.HasPrefix(line, requirementOption+" "):
+		ref = strings.TrimPrefix(line, requirementOption)
+	case strings.HasPrefix(line, requirementShortOption):
+		ref = strings.TrimPrefix(line, requirementShortOption)
+	default:
+		return "", false
+	}
+	ref = strings.TrimSpace(ref)
+	if ref == "" || strings.Contains(ref, "://") {
+		return "", false
 	}
-	return libs, nil
+	return ref, true
 }
 
 func rStripByKey(line string, key string) string {
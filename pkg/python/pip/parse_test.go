@@ -1,9 +1,11 @@
 package pip
 
 import (
+	"io/fs"
 	"os"
 	"path"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +38,14 @@ func TestParse(t *testing.T) {
 			file: "testdata/requirements_operator.txt",
 			want: requirementsOperator,
 		},
+		{
+			file: "testdata/requirements_pinned.txt",
+			want: requirementsPinned,
+		},
+		{
+			file: "testdata/requirements_bom.txt",
+			want: requirementsBOM,
+		},
 	}
 
 	for _, v := range vectors {
@@ -50,3 +60,52 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFS(t *testing.T) {
+	tests := []struct {
+		name     string
+		fsys     fs.FS
+		filePath string
+		want     []types.Library
+		wantErr  string
+	}{
+		{
+			name:     "includes",
+			fsys:     os.DirFS("testdata"),
+			filePath: "include/requirements.txt",
+			want:     requirementsInclude,
+		},
+		{
+			name: "cycle",
+			fsys: fstest.MapFS{
+				"a.txt": &fstest.MapFile{Data: []byte("-r b.txt\nclick==8.0.0\n")},
+				"b.txt": &fstest.MapFile{Data: []byte("-r a.txt\nFlask==2.0.0\n")},
+			},
+			filePath: "a.txt",
+			want: []types.Library{
+				{Name: "click", Version: "8.0.0", FilePath: "a.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+				{Name: "Flask", Version: "2.0.0", FilePath: "b.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+			},
+		},
+		{
+			name: "missing include",
+			fsys: fstest.MapFS{
+				"requirements.txt": &fstest.MapFile{Data: []byte("-r missing.txt\n")},
+			},
+			filePath: "requirements.txt",
+			wantErr:  "unable to parse the file included by requirements.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFS(tt.fsys, tt.filePath)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,34 +4,54 @@ import "github.com/aquasecurity/go-dep-parser/pkg/types"
 
 var (
 	requirementsFlask = []types.Library{
-		{"click", "8.0.0", ""},
-		{"Flask", "2.0.0", ""},
-		{"itsdangerous", "2.0.0", ""},
-		{"Jinja2", "3.0.0", ""},
-		{"MarkupSafe", "2.0.0", ""},
-		{"Werkzeug", "2.0.0", ""},
+		{Name: "click", Version: "8.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+		{Name: "itsdangerous", Version: "2.0.0", Locations: []types.Location{{StartLine: 3, EndLine: 3}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "MarkupSafe", Version: "2.0.0", Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
+		{Name: "Werkzeug", Version: "2.0.0", Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
 	}
 
 	requirementsComments = []types.Library{
-		{"click", "8.0.0", ""},
-		{"Flask", "2.0.0", ""},
-		{"Jinja2", "3.0.0", ""},
-		{"MarkupSafe", "2.0.0", ""},
+		{Name: "click", Version: "8.0.0", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
+		{Name: "MarkupSafe", Version: "2.0.0", Locations: []types.Location{{StartLine: 7, EndLine: 7}}},
 	}
 
 	requirementsSpaces = []types.Library{
-		{"click", "8.0.0", ""},
-		{"Flask", "2.0.0", ""},
-		{"itsdangerous", "2.0.0", ""},
-		{"Jinja2", "3.0.0", ""},
+		{Name: "click", Version: "8.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+		{Name: "itsdangerous", Version: "2.0.0", Locations: []types.Location{{StartLine: 3, EndLine: 3}}},
+		{Name: "Jinja2", Version: "3.0.0", Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
 	}
 
 	requirementsNoVersion = []types.Library{
-		{"Flask", "2.0.0", ""},
+		{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
 	}
 
 	requirementsOperator = []types.Library{
-		{"Django", "2.3.4", ""},
-		{"SomeProject", "5.4", ""},
+		{Name: "Django", Version: "2.3.4", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "SomeProject", Version: "5.4", Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
+	}
+
+	// Prefix matches and multiple specifiers are not pinned
+	requirementsPinned = []types.Library{
+		{Name: "urllib3", Version: "1.26.12", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+		{Name: "certifi", Version: "2022.9.24", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+	}
+
+	// Saved with the byte order mark and CRLF on Windows
+	requirementsBOM = []types.Library{
+		{Name: "click", Version: "8.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+	}
+
+	// testdata/include/requirements.txt and the files included by it
+	requirementsInclude = []types.Library{
+		{Name: "Flask", Version: "2.0.0", FilePath: "include/requirements.txt", Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "click", Version: "8.0.0", FilePath: "include/base.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		{Name: "Jinja2", Version: "3.0.0", FilePath: "include/base.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+		{Name: "pytest", Version: "7.1.2", FilePath: "include/dev/requirements-dev.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
 	}
 )
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
@@ -17,12 +18,26 @@ type dependency struct {
 	Version string
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+// Parser implements types.Parser for Pipfile.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var lockFile lockFile
 	decoder := json.NewDecoder(r)
-	err := decoder.Decode(&lockFile)
+	err = decoder.Decode(&lockFile)
 	if err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
 	}
 
 	var libs []types.Library
@@ -32,5 +47,7 @@ func Parse(r io.Reader) ([]types.Library, error) {
 			Version: strings.TrimLeft(dependency.Version, "=="),
 		})
 	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
 	return libs, nil
 }
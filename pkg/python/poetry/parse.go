@@ -5,6 +5,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
@@ -22,18 +23,57 @@ type Lockfile struct {
 	} `toml:"package"`
 }
 
-func Parse(r io.Reader) ([]types.Library, error) {
+// Parser implements types.Parser for poetry.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
 	var lockfile Lockfile
-	if _, err := toml.DecodeReader(r, &lockfile); err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+	if _, err = toml.Decode(string(b), &lockfile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
 	}
+	locs := utils.TOMLTableLocations(b, "package")
 
 	var libs []types.Library
-	for _, pkg := range lockfile.Packages {
-		libs = append(libs, types.Library{
+	for i, pkg := range lockfile.Packages {
+		lib := types.Library{
 			Name:    pkg.Name,
 			Version: pkg.Version,
-		})
+			Scope:   scope(pkg.Category, pkg.Optional),
+		}
+		if i < len(locs) {
+			lib.Locations = []types.Location{locs[i]}
+		}
+		libs = append(libs, lib)
 	}
 	return libs, nil
 }
+
+// scope maps the category of poetry into the normalized scope.
+// Optional packages are only installed with the extras requiring them.
+func scope(category string, optional bool) types.Scope {
+	switch {
+	case category == "dev":
+		return types.ScopeDev
+	case optional:
+		return types.ScopeOptional
+	case category == "main":
+		return types.ScopeRuntime
+	}
+	return ""
+}
@@ -11,15 +11,15 @@ var (
 	// poetry add pypi
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryNormal = []types.Library{
-		{"atomicwrites", "1.3.0", ""},
-		{"attrs", "19.1.0", ""},
-		{"colorama", "0.4.1", ""},
-		{"more-itertools", "7.0.0", ""},
-		{"pluggy", "0.11.0", ""},
-		{"py", "1.8.0", ""},
-		{"pypi", "2.1", ""},
-		{"pytest", "3.10.1", ""},
-		{"six", "1.12.0", ""},
+		{Name: "atomicwrites", Version: "1.3.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 1, EndLine: 7}}},
+		{Name: "attrs", Version: "19.1.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 9, EndLine: 15}}},
+		{Name: "colorama", Version: "0.4.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 17, EndLine: 24}}},
+		{Name: "more-itertools", Version: "7.0.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 26, EndLine: 32}}},
+		{Name: "pluggy", Version: "0.11.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 34, EndLine: 40}}},
+		{Name: "py", Version: "1.8.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 42, EndLine: 48}}},
+		{Name: "pypi", Version: "2.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 50, EndLine: 56}}},
+		{Name: "pytest", Version: "3.10.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 58, EndLine: 74}}},
+		{Name: "six", Version: "1.12.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 76, EndLine: 82}}},
 	}
 
 	// docker run --name pipenv --rm -it python:3.9-alpine sh
@@ -29,84 +29,84 @@ var (
 	// Use https://github.com/sdispater/poetry/blob/master/poetry.lock
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryMany = []types.Library{
-		{"appdirs", "1.4.3", ""},
-		{"aspy.yaml", "1.2.0", ""},
-		{"atomicwrites", "1.3.0", ""},
-		{"attrs", "19.1.0", ""},
-		{"black", "19.3b0", ""},
-		{"cachecontrol", "0.12.5", ""},
-		{"cachy", "0.2.0", ""},
-		{"certifi", "2019.3.9", ""},
-		{"cfgv", "1.6.0", ""},
-		{"chardet", "3.0.4", ""},
-		{"cleo", "0.6.8", ""},
-		{"click", "7.0", ""},
-		{"colorama", "0.4.1", ""},
-		{"configparser", "3.7.4", ""},
-		{"contextlib2", "0.5.5", ""},
-		{"coverage", "4.5.3", ""},
-		{"enum34", "1.1.6", ""},
-		{"filelock", "3.0.10", ""},
-		{"funcsigs", "1.0.2", ""},
-		{"functools32", "3.2.3-2", ""},
-		{"futures", "3.2.0", ""},
-		{"glob2", "0.6", ""},
-		{"html5lib", "1.0.1", ""},
-		{"httpretty", "0.9.6", ""},
-		{"identify", "1.4.3", ""},
-		{"idna", "2.8", ""},
-		{"importlib-metadata", "0.12", ""},
-		{"importlib-resources", "1.0.2", ""},
-		{"jinja2", "2.10.1", ""},
-		{"jsonschema", "3.0.1", ""},
-		{"livereload", "2.6.1", ""},
-		{"lockfile", "0.12.2", ""},
-		{"markdown", "3.0.1", ""},
-		{"markdown", "3.1", ""},
-		{"markupsafe", "1.1.1", ""},
-		{"mkdocs", "1.0.4", ""},
-		{"mock", "3.0.5", ""},
-		{"more-itertools", "5.0.0", ""},
-		{"more-itertools", "7.0.0", ""},
-		{"msgpack", "0.6.1", ""},
-		{"nodeenv", "1.3.3", ""},
-		{"packaging", "19.0", ""},
-		{"pastel", "0.1.0", ""},
-		{"pathlib2", "2.3.3", ""},
-		{"pkginfo", "1.5.0.1", ""},
-		{"pluggy", "0.11.0", ""},
-		{"pre-commit", "1.16.1", ""},
-		{"py", "1.8.0", ""},
-		{"pygments", "2.3.1", ""},
-		{"pygments", "2.4.0", ""},
-		{"pygments-github-lexers", "0.0.5", ""},
-		{"pylev", "1.3.0", ""},
-		{"pymdown-extensions", "6.0", ""},
-		{"pyparsing", "2.4.0", ""},
-		{"pyrsistent", "0.14.11", ""},
-		{"pytest", "4.5.0", ""},
-		{"pytest-cov", "2.7.1", ""},
-		{"pytest-mock", "1.10.4", ""},
-		{"pytest-sugar", "0.9.2", ""},
-		{"pyyaml", "5.1", ""},
-		{"requests", "2.21.0", ""},
-		{"requests", "2.22.0", ""},
-		{"requests-toolbelt", "0.8.0", ""},
-		{"scandir", "1.10.0", ""},
-		{"shellingham", "1.3.1", ""},
-		{"six", "1.12.0", ""},
-		{"termcolor", "1.1.0", ""},
-		{"toml", "0.10.0", ""},
-		{"tomlkit", "0.5.3", ""},
-		{"tornado", "5.1.1", ""},
-		{"tox", "3.11.1", ""},
-		{"typing", "3.6.6", ""},
-		{"urllib3", "1.24.3", ""},
-		{"urllib3", "1.25.2", ""},
-		{"virtualenv", "16.6.0", ""},
-		{"wcwidth", "0.1.7", ""},
-		{"webencodings", "0.5.1", ""},
-		{"zipp", "0.5.1", ""},
+		{Name: "appdirs", Version: "1.4.3", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 1, EndLine: 8}}},
+		{Name: "aspy.yaml", Version: "1.2.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 10, EndLine: 19}}},
+		{Name: "atomicwrites", Version: "1.3.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 21, EndLine: 27}}},
+		{Name: "attrs", Version: "19.1.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 29, EndLine: 35}}},
+		{Name: "black", Version: "19.3b0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 37, EndLine: 50}}},
+		{Name: "cachecontrol", Version: "0.12.5", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 52, EndLine: 63}}},
+		{Name: "cachy", Version: "0.2.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 65, EndLine: 71}}},
+		{Name: "certifi", Version: "2019.3.9", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 73, EndLine: 79}}},
+		{Name: "cfgv", Version: "1.6.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 81, EndLine: 90}}},
+		{Name: "chardet", Version: "3.0.4", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 92, EndLine: 98}}},
+		{Name: "cleo", Version: "0.6.8", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 100, EndLine: 110}}},
+		{Name: "click", Version: "7.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 112, EndLine: 119}}},
+		{Name: "colorama", Version: "0.4.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 121, EndLine: 128}}},
+		{Name: "configparser", Version: "3.7.4", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 130, EndLine: 137}}},
+		{Name: "contextlib2", Version: "0.5.5", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 139, EndLine: 146}}},
+		{Name: "coverage", Version: "4.5.3", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 148, EndLine: 154}}},
+		{Name: "enum34", Version: "1.1.6", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 156, EndLine: 163}}},
+		{Name: "filelock", Version: "3.0.10", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 165, EndLine: 171}}},
+		{Name: "funcsigs", Version: "1.0.2", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 173, EndLine: 180}}},
+		{Name: "functools32", Version: "3.2.3-2", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 182, EndLine: 189}}},
+		{Name: "futures", Version: "3.2.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 191, EndLine: 198}}},
+		{Name: "glob2", Version: "0.6", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 200, EndLine: 207}}},
+		{Name: "html5lib", Version: "1.0.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 209, EndLine: 219}}},
+		{Name: "httpretty", Version: "0.9.6", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 221, EndLine: 230}}},
+		{Name: "identify", Version: "1.4.3", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 232, EndLine: 238}}},
+		{Name: "idna", Version: "2.8", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 240, EndLine: 246}}},
+		{Name: "importlib-metadata", Version: "0.12", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 248, EndLine: 265}}},
+		{Name: "importlib-resources", Version: "1.0.2", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 267, EndLine: 283}}},
+		{Name: "jinja2", Version: "2.10.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 285, EndLine: 295}}},
+		{Name: "jsonschema", Version: "3.0.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 297, EndLine: 313}}},
+		{Name: "livereload", Version: "2.6.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 315, EndLine: 326}}},
+		{Name: "lockfile", Version: "0.12.2", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 328, EndLine: 335}}},
+		{Name: "markdown", Version: "3.0.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 337, EndLine: 343}}},
+		{Name: "markdown", Version: "3.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 345, EndLine: 354}}},
+		{Name: "markupsafe", Version: "1.1.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 356, EndLine: 363}}},
+		{Name: "mkdocs", Version: "1.0.4", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 365, EndLine: 380}}},
+		{Name: "mock", Version: "3.0.5", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 382, EndLine: 396}}},
+		{Name: "more-itertools", Version: "5.0.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 398, EndLine: 408}}},
+		{Name: "more-itertools", Version: "7.0.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 410, EndLine: 417}}},
+		{Name: "msgpack", Version: "0.6.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 419, EndLine: 425}}},
+		{Name: "nodeenv", Version: "1.3.3", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 427, EndLine: 433}}},
+		{Name: "packaging", Version: "19.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 435, EndLine: 445}}},
+		{Name: "pastel", Version: "0.1.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 447, EndLine: 453}}},
+		{Name: "pathlib2", Version: "2.3.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 455, EndLine: 469}}},
+		{Name: "pkginfo", Version: "1.5.0.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 471, EndLine: 477}}},
+		{Name: "pluggy", Version: "0.11.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 479, EndLine: 485}}},
+		{Name: "pre-commit", Version: "1.16.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 487, EndLine: 512}}},
+		{Name: "py", Version: "1.8.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 514, EndLine: 520}}},
+		{Name: "pygments", Version: "2.3.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 522, EndLine: 528}}},
+		{Name: "pygments", Version: "2.4.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 530, EndLine: 536}}},
+		{Name: "pygments-github-lexers", Version: "0.0.5", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 538, EndLine: 547}}},
+		{Name: "pylev", Version: "1.3.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 549, EndLine: 555}}},
+		{Name: "pymdown-extensions", Version: "6.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 557, EndLine: 566}}},
+		{Name: "pyparsing", Version: "2.4.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 568, EndLine: 574}}},
+		{Name: "pyrsistent", Version: "0.14.11", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 576, EndLine: 585}}},
+		{Name: "pytest", Version: "4.5.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 587, EndLine: 619}}},
+		{Name: "pytest-cov", Version: "2.7.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 621, EndLine: 631}}},
+		{Name: "pytest-mock", Version: "1.10.4", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 633, EndLine: 646}}},
+		{Name: "pytest-sugar", Version: "0.9.2", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 648, EndLine: 659}}},
+		{Name: "pyyaml", Version: "5.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 661, EndLine: 667}}},
+		{Name: "requests", Version: "2.21.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 669, EndLine: 681}}},
+		{Name: "requests", Version: "2.22.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 683, EndLine: 695}}},
+		{Name: "requests-toolbelt", Version: "0.8.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 697, EndLine: 706}}},
+		{Name: "scandir", Version: "1.10.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 708, EndLine: 715}}},
+		{Name: "shellingham", Version: "1.3.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 717, EndLine: 723}}},
+		{Name: "six", Version: "1.12.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 725, EndLine: 731}}},
+		{Name: "termcolor", Version: "1.1.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 733, EndLine: 739}}},
+		{Name: "toml", Version: "0.10.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 741, EndLine: 747}}},
+		{Name: "tomlkit", Version: "0.5.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 749, EndLine: 768}}},
+		{Name: "tornado", Version: "5.1.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 770, EndLine: 777}}},
+		{Name: "tox", Version: "3.11.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 779, EndLine: 794}}},
+		{Name: "typing", Version: "3.6.6", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 796, EndLine: 803}}},
+		{Name: "urllib3", Version: "1.24.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 805, EndLine: 811}}},
+		{Name: "urllib3", Version: "1.25.2", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 813, EndLine: 819}}},
+		{Name: "virtualenv", Version: "16.6.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 821, EndLine: 827}}},
+		{Name: "wcwidth", Version: "0.1.7", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 829, EndLine: 835}}},
+		{Name: "webencodings", Version: "0.5.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 837, EndLine: 843}}},
+		{Name: "zipp", Version: "0.5.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 845, EndLine: 851}}},
 	}
 
 	// docker run --name pipenv --rm -it python:3.9-alpine sh
@@ -117,19 +117,19 @@ var (
 	// poetry add flask
 	// poetry show -a | awk '{gsub(/\(!\)/, ""); printf("{\""$1"\", \""$2"\", \"\"},\n") }'
 	poetryFlask = []types.Library{
-		{"atomicwrites", "1.3.0", ""},
-		{"attrs", "19.1.0", ""},
-		{"click", "7.0", ""},
-		{"colorama", "0.4.1", ""},
-		{"flask", "1.0.3", ""},
-		{"itsdangerous", "1.1.0", ""},
-		{"jinja2", "2.10.1", ""},
-		{"markupsafe", "1.1.1", ""},
-		{"more-itertools", "7.0.0", ""},
-		{"pluggy", "0.11.0", ""},
-		{"py", "1.8.0", ""},
-		{"pytest", "3.10.1", ""},
-		{"six", "1.12.0", ""},
-		{"werkzeug", "0.15.4", ""},
+		{Name: "atomicwrites", Version: "1.3.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 1, EndLine: 7}}},
+		{Name: "attrs", Version: "19.1.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 9, EndLine: 15}}},
+		{Name: "click", Version: "7.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 17, EndLine: 23}}},
+		{Name: "colorama", Version: "0.4.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 25, EndLine: 32}}},
+		{Name: "flask", Version: "1.0.3", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 34, EndLine: 46}}},
+		{Name: "itsdangerous", Version: "1.1.0", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 48, EndLine: 54}}},
+		{Name: "jinja2", Version: "2.10.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 56, EndLine: 65}}},
+		{Name: "markupsafe", Version: "1.1.1", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 67, EndLine: 73}}},
+		{Name: "more-itertools", Version: "7.0.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 75, EndLine: 81}}},
+		{Name: "pluggy", Version: "0.11.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 83, EndLine: 89}}},
+		{Name: "py", Version: "1.8.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 91, EndLine: 97}}},
+		{Name: "pytest", Version: "3.10.1", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 99, EndLine: 115}}},
+		{Name: "six", Version: "1.12.0", Scope: types.ScopeDev, Locations: []types.Location{{StartLine: 117, EndLine: 123}}},
+		{Name: "werkzeug", Version: "0.15.4", Scope: types.ScopeRuntime, Locations: []types.Location{{StartLine: 125, EndLine: 131}}},
 	}
 )
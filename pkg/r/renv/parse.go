@@ -0,0 +1,128 @@
+package renv
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type lockFile struct {
+	R        rInfo
+	Packages map[string]packageInfo
+}
+
+type rInfo struct {
+	Repositories []repository
+}
+
+// repository is a CRAN-like repository, which packages refer to by the name.
+type repository struct {
+	Name string
+	URL  string
+}
+
+type packageInfo struct {
+	Package string
+	Version string
+	// e.g. Repository, Bioconductor, GitHub, git and URL
+	Source string
+	// The name of the repository for "Repository". e.g. CRAN
+	Repository string
+	Hash       string
+
+	// for Bioconductor
+	GitURL        string `json:"git_url"`
+	GitLastCommit string `json:"git_last_commit"`
+
+	// for GitHub, git and URL
+	RemoteHost     string
+	RemoteUsername string
+	RemoteRepo     string
+	RemoteURL      string `json:"RemoteUrl"`
+	RemoteSha      string
+}
+
+// Parser implements types.Parser for renv.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses renv.lock
+// The source of each package is returned as an external reference,
+// such as the repository for CRAN and the git repository for Bioconductor and GitHub.
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var lockFile lockFile
+	if err := json.NewDecoder(r).Decode(&lockFile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	repositories := map[string]string{}
+	for _, repo := range lockFile.R.Repositories {
+		repositories[repo.Name] = repo.URL
+	}
+
+	var libs []types.Library
+	for name, pkg := range lockFile.Packages {
+		// "Package" should be the same as the key, but older lock files may omit it.
+		if pkg.Package != "" {
+			name = pkg.Package
+		}
+
+		var digest string
+		if pkg.Hash != "" {
+			digest = "md5:" + pkg.Hash
+		}
+
+		libs = append(libs, types.Library{
+			Name:               name,
+			Version:            pkg.Version,
+			Digest:             digest,
+			ExternalReferences: pkg.externalReferences(repositories),
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
+
+// externalReferences returns where the package is installed from.
+// Packages installed from unknown repositories and local sources have none.
+func (pkg packageInfo) externalReferences(repositories map[string]string) []types.ExternalReference {
+	var ref types.ExternalReference
+	switch pkg.Source {
+	case "Repository":
+		ref = types.ExternalReference{Type: types.RefTypeRegistry, URL: repositories[pkg.Repository]}
+	case "Bioconductor":
+		ref = types.ExternalReference{Type: types.RefTypeVCS, URL: pkg.GitURL, Ref: pkg.GitLastCommit}
+	case "GitHub":
+		// Packages of GitHub Enterprise have the API host of their servers, which doesn't tell the web host
+		if pkg.RemoteUsername != "" && pkg.RemoteRepo != "" && (pkg.RemoteHost == "" || pkg.RemoteHost == "api.github.com") {
+			ref = types.ExternalReference{
+				Type: types.RefTypeVCS,
+				URL:  "https://github.com/" + pkg.RemoteUsername + "/" + pkg.RemoteRepo,
+				Ref:  pkg.RemoteSha,
+			}
+		}
+	case "git":
+		ref = types.ExternalReference{Type: types.RefTypeVCS, URL: pkg.RemoteURL, Ref: pkg.RemoteSha}
+	case "URL":
+		ref = types.ExternalReference{Type: types.RefTypeDistribution, URL: pkg.RemoteURL}
+	}
+	if ref.URL == "" {
+		return nil
+	}
+	return []types.ExternalReference{ref}
+}
@@ -0,0 +1,56 @@
+package renv
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name renv --rm -it r-base:4.1.2 bash
+	// R -e 'install.packages(c("renv", "BiocManager", "R6", "remotes"))'
+	// R -e 'BiocManager::install("BiocGenerics"); remotes::install_github("r-lib/remotes")'
+	// R -e 'renv::init()'
+	// jq -rc '.Packages[] | "{Name: \"\(.Package)\", Version: \"\(.Version)\", Digest: \"md5:\(.Hash)\"},"' renv.lock
+	// (and add the external references of the sources)
+	renvNormal = []types.Library{
+		{
+			Name:    "BiocGenerics",
+			Version: "0.40.0",
+			Digest:  "md5:0cb3a5e6f5c9cfb8e5c3a1f0d0f0ea43",
+			ExternalReferences: []types.ExternalReference{
+				{Type: types.RefTypeVCS, URL: "https://git.bioconductor.org/packages/BiocGenerics", Ref: "0bc1e0e"},
+			},
+		},
+		{Name: "BiocManager", Version: "1.30.16", Digest: "md5:2fdca0877debdd4668190832cdee4c31", ExternalReferences: cran},
+		{Name: "R6", Version: "2.5.1", Digest: "md5:470851b6d5d0ac559e9d01bb352b4021", ExternalReferences: cran},
+		{
+			Name:    "remotes",
+			Version: "2.4.2",
+			Digest:  "md5:227045be9aee47e6dda9bb38ac870d67",
+			ExternalReferences: []types.ExternalReference{
+				{Type: types.RefTypeVCS, URL: "https://github.com/r-lib/remotes", Ref: "f2e5e5a8f6c62a7a0e4a1b9cde4a5b8ab3e0a68e"},
+			},
+		},
+		{Name: "renv", Version: "0.15.2", Digest: "md5:206c4ef8b7ad6fb1060d69aa7b9dfe69", ExternalReferences: cran},
+	}
+
+	// Packages of the repositories not listed in "R" have no reference
+	renvRemotes = []types.Library{
+		{
+			Name:    "glue",
+			Version: "1.6.2",
+			Digest:  "md5:4f2596dfb05dac67b9dc558e5c6fba2e",
+			ExternalReferences: []types.ExternalReference{
+				{Type: types.RefTypeVCS, URL: "https://github.com/tidyverse/glue.git", Ref: "2b09ed1a8a2b7d6bb2cb8e7bb3c1c86c4a1ee0f4"},
+			},
+		},
+		{Name: "internal", Version: "0.1.0", Digest: "md5:0d37bf4f5e5a6e3f0a1d9f2a6cfe6b77"},
+		{
+			Name:    "mypkg",
+			Version: "0.2.0",
+			Digest:  "md5:7d0ad5b0c3b1aa5a5f1c6a8f2e3d8c1b",
+			ExternalReferences: []types.ExternalReference{
+				{Type: types.RefTypeDistribution, URL: "https://example.com/mypkg_0.2.0.tar.gz"},
+			},
+		},
+	}
+
+	cran = []types.ExternalReference{{Type: types.RefTypeRegistry, URL: "https://cloud.r-project.org"}}
+)
@@ -0,0 +1,109 @@
+package registry
+
+import (
+	"sort"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+)
+
+// Capability describes what a built-in parser supports, so that integrators can display the coverage
+// and turn on the features the parsers fill in.
+type Capability struct {
+	// Parser is the package of the parser under pkg. e.g. nodejs/npm
+	Parser string `json:"Parser"`
+
+	// Ecosystem is the purl type of the packages, as given to purl.Fill, for the ecosystems with one.
+	// The others are named after their package managers. e.g. npm, pypi and nimble
+	Ecosystem string `json:"Ecosystem"`
+
+	// Patterns are the file patterns looked up by Lookup, in the syntax of Register.
+	// It is empty for the parsers of files which can't be told apart by name, such as java/mvn.
+	Patterns []string `json:"Patterns"`
+
+	// LockfileVersions are the format versions of the file the parser accepts.
+	// Files of other versions are refused with *types.ErrUnsupportedLockfileVersion.
+	// It is empty when the format isn't versioned or the version isn't checked.
+	LockfileVersions []string `json:"LockfileVersions,omitempty"`
+
+	// Graph is true when the parser returns the dependency graph.
+	Graph bool `json:"Graph,omitempty"`
+	// Licenses is true when the parser fills types.Library.Licenses.
+	Licenses bool `json:"Licenses,omitempty"`
+	// Digests is true when the parser fills types.Library.Digest.
+	Digests bool `json:"Digests,omitempty"`
+}
+
+// capabilities of the built-in parsers except for their patterns, which are taken from the entries
+var capabilities = []Capability{
+	{Parser: "cmake/fetchcontent", Ecosystem: "cmake", Digests: true},
+	{Parser: "crystal/shards", Ecosystem: "shards"},
+	{Parser: "d/dub", Ecosystem: "dub", LockfileVersions: []string{"1"}},
+	{Parser: "elm/elmjson", Ecosystem: "elm"},
+	{Parser: "frameworks/wordpress", Ecosystem: "wordpress"},
+	{Parser: "gleam/manifest", Ecosystem: purl.TypeHex, Digests: true},
+	{Parser: "golang/binary", Ecosystem: purl.TypeGolang},
+	{Parser: "golang/mod", Ecosystem: purl.TypeGolang},
+	{Parser: "helm/chart", Ecosystem: "helm"},
+	{Parser: "java/coursier", Ecosystem: purl.TypeMaven, Graph: true},
+	{Parser: "java/ivy", Ecosystem: purl.TypeMaven},
+	{Parser: "java/jar", Ecosystem: purl.TypeMaven, Licenses: true},
+	{Parser: "java/mvn", Ecosystem: purl.TypeMaven, Graph: true},
+	{Parser: "julia/manifest", Ecosystem: "julia", LockfileVersions: []string{"1.0", "2.0"}, Digests: true},
+	{Parser: "lua/luarocks", Ecosystem: purl.TypeLuaRocks},
+	{Parser: "lua/rockspec", Ecosystem: purl.TypeLuaRocks},
+	{Parser: "meson/wrap", Ecosystem: "meson", Digests: true},
+	{Parser: "nim/nimble", Ecosystem: "nimble", LockfileVersions: []string{"1", "2"}, Digests: true},
+	{Parser: "nix/flake", Ecosystem: "nix", LockfileVersions: []string{"5", "6", "7"}, Digests: true},
+	{Parser: "nodejs/npm", Ecosystem: purl.TypeNPM, LockfileVersions: []string{"1", "2"}, Graph: true},
+	{Parser: "nodejs/packagejson", Ecosystem: purl.TypeNPM, Licenses: true},
+	{Parser: "nodejs/yarn", Ecosystem: purl.TypeNPM},
+	{Parser: "nuget/config", Ecosystem: purl.TypeNuGet},
+	{Parser: "nuget/lock", Ecosystem: purl.TypeNuGet, Graph: true},
+	{Parser: "ocaml/opam", Ecosystem: "opam"},
+	{Parser: "perl/carton", Ecosystem: purl.TypeCPAN},
+	{Parser: "perl/cpanfile", Ecosystem: purl.TypeCPAN},
+	{Parser: "php/composer", Ecosystem: purl.TypeComposer},
+	{Parser: "puppet/lock", Ecosystem: "puppet"},
+	{Parser: "puppet/metadata", Ecosystem: "puppet"},
+	{Parser: "puppet/puppetfile", Ecosystem: "puppet"},
+	{Parser: "purescript/spago", Ecosystem: "spago", Digests: true},
+	{Parser: "python/packaging", Ecosystem: purl.TypePyPI, Licenses: true},
+	{Parser: "python/pip", Ecosystem: purl.TypePyPI},
+	{Parser: "python/pipenv", Ecosystem: purl.TypePyPI},
+	{Parser: "python/poetry", Ecosystem: purl.TypePyPI},
+	{Parser: "r/renv", Ecosystem: purl.TypeCRAN, Digests: true},
+	{Parser: "ruby/bundler", Ecosystem: purl.TypeGem},
+	{Parser: "ruby/gemspec", Ecosystem: purl.TypeGem, Licenses: true},
+	{Parser: "rust/cargo", Ecosystem: purl.TypeCargo, Graph: true},
+	{Parser: "swift/swiftpm", Ecosystem: purl.TypeSwift, LockfileVersions: []string{"1", "2", "3"}},
+	{Parser: "swift/xcode", Ecosystem: purl.TypeSwift},
+	{Parser: "terraform/lock", Ecosystem: "terraform", Digests: true},
+	{Parser: "unity/upm", Ecosystem: "unity"},
+	{Parser: "zig/zon", Ecosystem: "zig", Digests: true},
+}
+
+// Capabilities returns what the built-in parsers support, sorted by Parser.
+// Parsers added by Register are not listed, as nothing is known about them.
+//
+// e.g.
+//
+//	for _, c := range registry.Capabilities() {
+//		fmt.Println(c.Ecosystem, c.Patterns, c.Graph)
+//	}
+func Capabilities() []Capability {
+	patterns := map[string][]string{}
+	for _, e := range entries {
+		patterns[e.parser] = append(patterns[e.parser], e.pattern)
+	}
+
+	caps := make([]Capability, 0, len(capabilities))
+	for _, c := range capabilities {
+		c.Patterns = patterns[c.Parser]
+		c.LockfileVersions = append([]string(nil), c.LockfileVersions...)
+		caps = append(caps, c)
+	}
+	sort.Slice(caps, func(i, j int) bool {
+		return caps[i].Parser < caps[j].Parser
+	})
+	return caps
+}
@@ -0,0 +1,86 @@
+package registry_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+)
+
+func findCapability(caps []registry.Capability, parser string) *registry.Capability {
+	for i := range caps {
+		if caps[i].Parser == parser {
+			return &caps[i]
+		}
+	}
+	return nil
+}
+
+func TestCapabilities(t *testing.T) {
+	// Files which can't be told apart by name are not registered
+	unregistered := map[string]bool{
+		"golang/binary":   true,
+		"java/coursier":   true,
+		"java/mvn":        true,
+		"puppet/metadata": true,
+	}
+
+	caps := registry.Capabilities()
+	for i, c := range caps {
+		if unregistered[c.Parser] {
+			assert.Empty(t, c.Patterns, c.Parser)
+		} else {
+			assert.NotEmpty(t, c.Patterns, c.Parser)
+		}
+		assert.NotEmpty(t, c.Ecosystem, c.Parser)
+		if i > 0 {
+			assert.Less(t, caps[i-1].Parser, c.Parser)
+		}
+	}
+
+	npm := findCapability(caps, "nodejs/npm")
+	require.NotNil(t, npm)
+	assert.Equal(t, registry.Capability{
+		Parser:           "nodejs/npm",
+		Ecosystem:        "npm",
+		Patterns:         []string{"package-lock.json", "npm-shrinkwrap.json"},
+		LockfileVersions: []string{"1", "2"},
+		Graph:            true,
+	}, *npm)
+
+	// Changing the result doesn't change the next one
+	npm.LockfileVersions[0] = "3"
+	npm.Patterns[0] = "package.json"
+	assert.Equal(t, []string{"1", "2"}, findCapability(registry.Capabilities(), "nodejs/npm").LockfileVersions)
+	assert.Equal(t, "package-lock.json", findCapability(registry.Capabilities(), "nodejs/npm").Patterns[0])
+}
+
+// Every package under pkg with NewParser is a built-in parser
+func TestCapabilities_AllParsers(t *testing.T) {
+	caps := registry.Capabilities()
+	err := filepath.Walk("..", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(b), "\nfunc NewParser(") {
+			return nil
+		}
+		parser := filepath.ToSlash(filepath.Dir(strings.TrimPrefix(path, ".."+string(filepath.Separator))))
+		assert.NotNil(t, findCapability(caps, parser), parser)
+		return nil
+	})
+	require.NoError(t, err)
+}
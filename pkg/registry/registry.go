@@ -0,0 +1,211 @@
+// Package registry finds the parser for a file by its name.
+//
+// e.g.
+//
+//	p, ok := registry.Lookup("app/package-lock.json")
+//	if ok {
+//		libs, deps, err := p.Parse(f)
+//	}
+//
+// Files that can't be told apart by name are not registered,
+// such as Go binaries, the output of mvn, coursier reports and metadata.json of Puppet modules.
+// Their parsers can still be added with Register.
+package registry
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/cmake/fetchcontent"
+	"github.com/aquasecurity/go-dep-parser/pkg/crystal/shards"
+	"github.com/aquasecurity/go-dep-parser/pkg/d/dub"
+	"github.com/aquasecurity/go-dep-parser/pkg/elm/elmjson"
+	"github.com/aquasecurity/go-dep-parser/pkg/frameworks/wordpress"
+	gleam "github.com/aquasecurity/go-dep-parser/pkg/gleam/manifest"
+	"github.com/aquasecurity/go-dep-parser/pkg/golang/mod"
+	"github.com/aquasecurity/go-dep-parser/pkg/helm/chart"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/ivy"
+	"github.com/aquasecurity/go-dep-parser/pkg/java/jar"
+	julia "github.com/aquasecurity/go-dep-parser/pkg/julia/manifest"
+	"github.com/aquasecurity/go-dep-parser/pkg/lua/luarocks"
+	"github.com/aquasecurity/go-dep-parser/pkg/lua/rockspec"
+	"github.com/aquasecurity/go-dep-parser/pkg/meson/wrap"
+	"github.com/aquasecurity/go-dep-parser/pkg/nim/nimble"
+	"github.com/aquasecurity/go-dep-parser/pkg/nix/flake"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/npm"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/packagejson"
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/yarn"
+	nugetconfig "github.com/aquasecurity/go-dep-parser/pkg/nuget/config"
+	nugetlock "github.com/aquasecurity/go-dep-parser/pkg/nuget/lock"
+	"github.com/aquasecurity/go-dep-parser/pkg/ocaml/opam"
+	"github.com/aquasecurity/go-dep-parser/pkg/perl/carton"
+	"github.com/aquasecurity/go-dep-parser/pkg/perl/cpanfile"
+	"github.com/aquasecurity/go-dep-parser/pkg/php/composer"
+	puppetlock "github.com/aquasecurity/go-dep-parser/pkg/puppet/lock"
+	"github.com/aquasecurity/go-dep-parser/pkg/puppet/puppetfile"
+	"github.com/aquasecurity/go-dep-parser/pkg/purescript/spago"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/packaging"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/pip"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/pipenv"
+	"github.com/aquasecurity/go-dep-parser/pkg/python/poetry"
+	"github.com/aquasecurity/go-dep-parser/pkg/r/renv"
+	"github.com/aquasecurity/go-dep-parser/pkg/ruby/bundler"
+	"github.com/aquasecurity/go-dep-parser/pkg/ruby/gemspec"
+	"github.com/aquasecurity/go-dep-parser/pkg/rust/cargo"
+	"github.com/aquasecurity/go-dep-parser/pkg/swift/swiftpm"
+	"github.com/aquasecurity/go-dep-parser/pkg/swift/xcode"
+	terraformlock "github.com/aquasecurity/go-dep-parser/pkg/terraform/lock"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/unity/upm"
+	"github.com/aquasecurity/go-dep-parser/pkg/zig/zon"
+)
+
+type entry struct {
+	// pattern is matched against the trailing path elements of the file, using path.Match.
+	// e.g. "Cargo.lock", "*.gemspec" and "subprojects/*.wrap"
+	pattern string
+	// parser names the parser in Capabilities. Entries added by Register have none.
+	parser    string
+	newParser func() types.Parser
+}
+
+var (
+	mu sync.RWMutex
+
+	// Entries added by Register, which are looked up before the built-in ones. The latest comes first.
+	registered []entry
+
+	// The first matching entry wins, so more specific patterns come first.
+	entries = []entry{
+		{"CMakeLists.txt", "cmake/fetchcontent", fetchcontent.NewParser},
+		{"*.cmake", "cmake/fetchcontent", fetchcontent.NewParser},
+		{"shard.lock", "crystal/shards", shards.NewParser},
+		{"shard.yml", "crystal/shards", shards.NewParser},
+		{"dub.selections.json", "d/dub", dub.NewParser},
+		{"elm.json", "elm/elmjson", elmjson.NewParser},
+		{"wp-includes/version.php", "frameworks/wordpress", wordpress.NewParser},
+		{"manifest.toml", "gleam/manifest", gleam.NewParser},
+		{"go.sum", "golang/mod", mod.NewParser},
+		{"Chart.lock", "helm/chart", chart.NewParser},
+		{"Chart.yaml", "helm/chart", chart.NewParser},
+		{"ivy.xml", "java/ivy", newIvyParser},
+		{"*.jar", "java/jar", newJARParser},
+		{"*.war", "java/jar", newJARParser},
+		{"*.ear", "java/jar", newJARParser},
+		{"*.par", "java/jar", newJARParser},
+		{"Manifest.toml", "julia/manifest", julia.NewParser},
+		{"JuliaManifest.toml", "julia/manifest", julia.NewParser},
+		{"luarocks.lock", "lua/luarocks", luarocks.NewParser},
+		{"*.rockspec", "lua/rockspec", rockspec.NewParser},
+		{"subprojects/*.wrap", "meson/wrap", wrap.NewParser},
+		{"nimble.lock", "nim/nimble", nimble.NewParser},
+		{"flake.lock", "nix/flake", flake.NewParser},
+		{"package-lock.json", "nodejs/npm", npm.NewParser},
+		{"npm-shrinkwrap.json", "nodejs/npm", npm.NewParser},
+		{"node_modules/*/package.json", "nodejs/packagejson", packagejson.NewParser},
+		{"node_modules/@*/*/package.json", "nodejs/packagejson", packagejson.NewParser},
+		{"yarn.lock", "nodejs/yarn", yarn.NewParser},
+		{"packages.config", "nuget/config", nugetconfig.NewParser},
+		{"packages.lock.json", "nuget/lock", nugetlock.NewParser},
+		{"*.opam.locked", "ocaml/opam", opam.NewParser},
+		{"cpanfile.snapshot", "perl/carton", carton.NewParser},
+		{"cpanfile", "perl/cpanfile", cpanfile.NewParser},
+		{"composer.lock", "php/composer", composer.NewParser},
+		{"Puppetfile.lock", "puppet/lock", puppetlock.NewParser},
+		{"Puppetfile", "puppet/puppetfile", puppetfile.NewParser},
+		{"spago.lock", "purescript/spago", spago.NewParser},
+		{"spago.dhall", "purescript/spago", spago.NewParser},
+		{"*.dist-info/METADATA", "python/packaging", packaging.NewParser},
+		{"*.egg-info/PKG-INFO", "python/packaging", packaging.NewParser},
+		{"EGG-INFO/PKG-INFO", "python/packaging", packaging.NewParser},
+		{"requirements.txt", "python/pip", pip.NewParser},
+		{"Pipfile.lock", "python/pipenv", pipenv.NewParser},
+		{"poetry.lock", "python/poetry", poetry.NewParser},
+		{"renv.lock", "r/renv", renv.NewParser},
+		{"Gemfile.lock", "ruby/bundler", bundler.NewParser},
+		{"*.gemspec", "ruby/gemspec", gemspec.NewParser},
+		{"Cargo.lock", "rust/cargo", cargo.NewParser},
+		{"Package.resolved", "swift/swiftpm", swiftpm.NewParser},
+		{"project.pbxproj", "swift/xcode", xcode.NewParser},
+		{".terraform.lock.hcl", "terraform/lock", terraformlock.NewParser},
+		{"Packages/packages-lock.json", "unity/upm", upm.NewParser},
+		{"build.zig.zon", "zig/zon", zon.NewParser},
+	}
+)
+
+func newIvyParser() types.Parser {
+	return ivy.NewParser()
+}
+
+func newJARParser() types.Parser {
+	return jar.NewParser()
+}
+
+// Register adds a parser for the pattern at runtime, which takes precedence over the built-in ones
+// and the ones registered before. Registering the same pattern again replaces the parser.
+// The pattern uses the syntax of path.Match, and may contain slashes to match parent directories.
+//
+// e.g.
+//
+//	err := registry.Register("*.acme.lock", acme.NewParser)
+func Register(pattern string, newParser func() types.Parser) error {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return xerrors.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	if newParser == nil {
+		return xerrors.Errorf("nil parser for %q", pattern)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	registered = append([]entry{{pattern: pattern, newParser: newParser}}, removeEntry(registered, pattern)...)
+	return nil
+}
+
+// Unregister removes the parser added by Register for the pattern. Built-in parsers are not removed.
+func Unregister(pattern string) {
+	mu.Lock()
+	defer mu.Unlock()
+	registered = removeEntry(registered, pattern)
+}
+
+func removeEntry(entries []entry, pattern string) []entry {
+	var kept []entry
+	for _, e := range entries {
+		if e.pattern != pattern {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// Lookup returns the parser for the file.
+// It returns false when no parser is registered for the file name.
+func Lookup(filePath string) (types.Parser, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	filePath = filepath.ToSlash(filePath)
+	for _, e := range append(registered[:len(registered):len(registered)], entries...) {
+		if match(e.pattern, filePath) {
+			return e.newParser(), true
+		}
+	}
+	return nil, false
+}
+
+// match reports whether the last elements of filePath match the pattern,
+// as many elements as the pattern has.
+func match(pattern, filePath string) bool {
+	elems := strings.Split(filePath, "/")
+	n := strings.Count(pattern, "/") + 1
+	if len(elems) < n {
+		return false
+	}
+	ok, err := path.Match(pattern, strings.Join(elems[len(elems)-n:], "/"))
+	return err == nil && ok
+}
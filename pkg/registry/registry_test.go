@@ -0,0 +1,99 @@
+package registry_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/nodejs/packagejson"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/rust/cargo"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/unity/upm"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+		want     types.Parser
+	}{
+		{
+			name:     "file name",
+			filePath: "app/Cargo.lock",
+			want:     cargo.NewParser(),
+		},
+		{
+			name:     "parent directory",
+			filePath: "project/Packages/packages-lock.json",
+			want:     upm.NewParser(),
+		},
+		{
+			name:     "glob",
+			filePath: "node_modules/@babel/core/package.json",
+			want:     packagejson.NewParser(),
+		},
+		{
+			name:     "missing parent directory",
+			filePath: "packages-lock.json",
+		},
+		{
+			name:     "unknown",
+			filePath: "app/main.go",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := registry.Lookup(tt.filePath)
+			assert.Equal(t, tt.want != nil, ok)
+			assert.IsType(t, tt.want, got)
+		})
+	}
+}
+
+type fakeParser struct{}
+
+func (p fakeParser) Parse(_ io.Reader) ([]types.Library, []types.Dependency, error) {
+	return []types.Library{{Name: "fake", Version: "1.0.0"}}, nil, nil
+}
+
+func TestRegister(t *testing.T) {
+	require.NoError(t, registry.Register("*.fake.lock", func() types.Parser { return fakeParser{} }))
+	defer registry.Unregister("*.fake.lock")
+
+	p, ok := registry.Lookup("deps/app.fake.lock")
+	require.True(t, ok)
+
+	libs, deps, err := p.Parse(strings.NewReader(""))
+	require.NoError(t, err)
+	assert.Equal(t, []types.Library{{Name: "fake", Version: "1.0.0"}}, libs)
+	assert.Nil(t, deps)
+}
+
+func TestRegister_Override(t *testing.T) {
+	require.NoError(t, registry.Register("Cargo.lock", func() types.Parser { return fakeParser{} }))
+
+	p, ok := registry.Lookup("Cargo.lock")
+	require.True(t, ok)
+	assert.IsType(t, fakeParser{}, p)
+
+	// The built-in parser is used again
+	registry.Unregister("Cargo.lock")
+	p, ok = registry.Lookup("Cargo.lock")
+	require.True(t, ok)
+	assert.IsType(t, cargo.NewParser(), p)
+}
+
+func TestRegister_Invalid(t *testing.T) {
+	err := registry.Register("[.lock", func() types.Parser { return fakeParser{} })
+	assert.EqualError(t, err, `invalid pattern "[.lock": syntax error in pattern`)
+
+	err = registry.Register("app.lock", nil)
+	assert.EqualError(t, err, `nil parser for "app.lock"`)
+
+	_, ok := registry.Lookup("app.lock")
+	assert.False(t, ok)
+}
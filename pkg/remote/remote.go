@@ -0,0 +1,218 @@
+// Package remote parses a manifest or lock file fetched over HTTP(S), such as a raw file of a Git repository
+// or an artifact in a repository manager, so that repositories can be analyzed without cloning them.
+//
+// e.g.
+//
+//	app, err := remote.Parse(ctx, "https://raw.githubusercontent.com/user/repo/main/package-lock.json",
+//		remote.WithBearerToken(os.Getenv("GITHUB_TOKEN")))
+//
+// The parser is looked up by the path of the URL, as registry.Lookup does, and WithFileName gives the name
+// for URLs not ending with it. Files followed by the parser, such as those included by requirements.txt,
+// are fetched relative to the URL with the same credentials and query.
+package remote
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/redact"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+type options struct {
+	client   *http.Client
+	header   http.Header
+	timeout  time.Duration
+	fileName string
+	limits   types.Limits
+}
+
+type Option func(*options)
+
+// WithHTTPClient sends the requests with client. The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// WithHeader adds the header to the requests. e.g. PRIVATE-TOKEN of GitLab
+func WithHeader(key, value string) Option {
+	return func(o *options) {
+		o.header.Add(key, value)
+	}
+}
+
+// WithBearerToken authenticates the requests with the token. e.g. personal access tokens of GitHub
+func WithBearerToken(token string) Option {
+	return WithHeader("Authorization", "Bearer "+token)
+}
+
+// WithBasicAuth authenticates the requests with the user name and the password.
+// e.g. the credentials of Artifactory and Nexus
+func WithBasicAuth(username, password string) Option {
+	return func(o *options) {
+		r := http.Request{Header: o.header}
+		r.SetBasicAuth(username, password)
+	}
+}
+
+// WithTimeout gives up fetching and parsing the file after d, including the files followed by the parser.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// WithFileName looks up the parser by the name instead of the path of the URL.
+// e.g. "package-lock.json" for https://nexus.example.com/service/rest/v1/assets/123/download
+// If the path ends with the name, files followed by the parser are resolved from the directory the name is in.
+func WithFileName(name string) Option {
+	return func(o *options) {
+		o.fileName = name
+	}
+}
+
+// WithLimits bounds the resources used for parsing the file, including decompression.
+func WithLimits(limits types.Limits) Option {
+	return func(o *options) {
+		o.limits = limits
+	}
+}
+
+// Parse fetches the file at rawURL, and parses it with the parser found by registry.Lookup in the same way as
+// scanner.ParseFile. Gzipped files are decompressed. FilePath of the application is rawURL masked by redact.URL.
+// Responses other than 200 fail, and 404 of the file fails with fs.ErrNotExist.
+func Parse(ctx context.Context, rawURL string, opts ...Option) (scanner.Application, error) {
+	o := options{client: http.DefaultClient, header: http.Header{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return scanner.Application{}, xerrors.Errorf("url parse error: %w", redact.Error(err))
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return scanner.Application{}, xerrors.Errorf("unsupported scheme: %s", u.Scheme)
+	}
+
+	fsys, err := newHTTPFS(u, o)
+	if err != nil {
+		return scanner.Application{}, err
+	}
+	if o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	fsys.ctx = ctx
+
+	app, err := scanner.ParseFile(fsys, fsys.name, scanner.WithLimits(o.limits))
+	app.FilePath = redact.URL(rawURL)
+	return app, err
+}
+
+// httpFS opens the files by fetching them relative to root, and the parsed file by name.
+type httpFS struct {
+	ctx context.Context
+	options
+	// The URL of the parsed file
+	url *url.URL
+	// The name the parsed file is looked up and opened by
+	name string
+	// The path of the URL fsys is rooted at, which ends with "/"
+	root string
+}
+
+func newHTTPFS(u *url.URL, o options) (*httpFS, error) {
+	fsys := &httpFS{options: o, url: u, name: strings.TrimPrefix(u.Path, "/"), root: "/"}
+	if o.fileName == "" {
+		if fsys.name == "" || strings.HasSuffix(fsys.name, "/") {
+			return nil, xerrors.Errorf("no file name in %s", redact.URL(u.String()))
+		}
+		return fsys, nil
+	}
+
+	fsys.name = o.fileName
+	if !fs.ValidPath(fsys.name) {
+		return nil, xerrors.Errorf("invalid file name: %s", o.fileName)
+	}
+	fsys.root = path.Dir(u.Path) + "/"
+	if strings.HasSuffix(u.Path, "/"+fsys.name) {
+		fsys.root = strings.TrimSuffix(u.Path, fsys.name)
+	}
+	return fsys, nil
+}
+
+func (fsys *httpFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	u := *fsys.url
+	if name != fsys.name {
+		u.Path = path.Join(fsys.root, name)
+		u.RawPath = ""
+	}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+	req = req.WithContext(fsys.ctx)
+	for key, values := range fsys.header {
+		req.Header[key] = values
+	}
+
+	resp, err := fsys.client.Do(req)
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: xerrors.Errorf("http error: %w", redact.Error(err))}
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return &httpFile{resp: resp, name: path.Base(name)}, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	resp.Body.Close()
+	return nil, &fs.PathError{Op: "open", Path: name, Err: xerrors.Errorf("status %s from %s", resp.Status, redact.URL(u.String()))}
+}
+
+// httpFile reads the body of the response.
+type httpFile struct {
+	resp *http.Response
+	name string
+}
+
+func (f *httpFile) Read(p []byte) (int, error) {
+	return f.resp.Body.Read(p)
+}
+
+func (f *httpFile) Close() error {
+	return f.resp.Body.Close()
+}
+
+func (f *httpFile) Stat() (fs.FileInfo, error) {
+	return fileInfo{name: f.name, size: f.resp.ContentLength}, nil
+}
+
+type fileInfo struct {
+	name string
+	size int64
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return fi.size }
+func (fi fileInfo) Mode() fs.FileMode  { return 0o444 }
+func (fi fileInfo) ModTime() time.Time { return time.Time{} }
+func (fi fileInfo) IsDir() bool        { return false }
+func (fi fileInfo) Sys() interface{}   { return nil }
@@ -0,0 +1,158 @@
+package remote_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/remote"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	files := map[string]string{
+		"/user/repo/main/app/requirements.txt": "-r ../base.txt\nclick==8.0.0\n",
+		"/user/repo/main/base.txt":             "Flask==2.0.0\n",
+		"/assets/123/download":                 "Flask==2.0.0\n",
+		"/user/repo/main/README.md":            "# app\n",
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" || r.URL.Query().Get("ref") != "main" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	defer ts.Close()
+
+	flask := types.Library{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}}
+
+	tests := []struct {
+		name    string
+		url     string
+		opts    []remote.Option
+		want    scanner.Application
+		wantErr string
+	}{
+		{
+			name: "included file",
+			url:  ts.URL + "/user/repo/main/app/requirements.txt?ref=main",
+			opts: []remote.Option{remote.WithBearerToken("secret")},
+			want: scanner.Application{
+				FilePath: ts.URL + "/user/repo/main/app/requirements.txt?ref=main",
+				Libraries: []types.Library{
+					{Name: "click", Version: "8.0.0", FilePath: "user/repo/main/app/requirements.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+					func() types.Library {
+						lib := flask
+						lib.FilePath = "user/repo/main/base.txt"
+						return lib
+					}(),
+				},
+			},
+		},
+		{
+			name: "file name",
+			url:  ts.URL + "/assets/123/download?ref=main",
+			opts: []remote.Option{remote.WithBearerToken("secret"), remote.WithFileName("requirements.txt")},
+			want: scanner.Application{
+				FilePath: ts.URL + "/assets/123/download?ref=main",
+				Libraries: []types.Library{
+					func() types.Library {
+						lib := flask
+						lib.FilePath = "requirements.txt"
+						return lib
+					}(),
+				},
+			},
+		},
+		{
+			name:    "unauthorized",
+			url:     ts.URL + "/user/repo/main/base.txt?ref=main",
+			opts:    []remote.Option{remote.WithFileName("requirements.txt")},
+			wantErr: "status 401 Unauthorized from " + ts.URL + "/user/repo/main/base.txt?ref=main",
+		},
+		{
+			name:    "no parser",
+			url:     ts.URL + "/user/repo/main/README.md?ref=main",
+			opts:    []remote.Option{remote.WithBearerToken("secret")},
+			wantErr: "no parser for user/repo/main/README.md",
+		},
+		{
+			name:    "unsupported scheme",
+			url:     "file:///etc/requirements.txt",
+			wantErr: "unsupported scheme: file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := remote.Parse(context.Background(), tt.url, tt.opts...)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParse_NotFound(t *testing.T) {
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+
+	_, err := remote.Parse(context.Background(), ts.URL+"/package-lock.json")
+	assert.True(t, errors.Is(err, fs.ErrNotExist), err)
+}
+
+func TestWithTimeout(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer ts.Close()
+
+	_, err := remote.Parse(context.Background(), ts.URL+"/go.sum", remote.WithTimeout(10*time.Millisecond))
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+}
+
+func TestWithBasicAuth(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n"))
+	}))
+	defer ts.Close()
+
+	got, err := remote.Parse(context.Background(), ts.URL+"/go.sum", remote.WithBasicAuth("user", "pass"))
+	require.NoError(t, err)
+	assert.Equal(t, []types.Library{{Name: "github.com/pkg/errors", Version: "0.9.1"}}, got.Libraries)
+}
+
+func TestParse_RedactsCredentials(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	rawURL := strings.Replace(ts.URL, "://", "://user:secret@", 1) + "/go.sum?private_token=secret"
+	got, err := remote.Parse(context.Background(), rawURL)
+	require.Error(t, err)
+	assert.NotContains(t, err.Error(), "secret")
+	assert.Equal(t, strings.Replace(ts.URL, "://", "://user:xxxxx@", 1)+"/go.sum?private_token=xxxxx", got.FilePath)
+}
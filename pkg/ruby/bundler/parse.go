@@ -2,44 +2,107 @@ package bundler
 
 import (
 	"bufio"
+	"bytes"
 	"io"
 	"strings"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
-func Parse(r io.Reader) ([]types.Library, error) {
+var dependenciesSection = []byte("DEPENDENCIES")
+
+// Parser implements types.Parser for Gemfile.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
 	var libs []types.Library
+	// The requirements of the gems listed in the Gemfile
+	direct := map[string]string{}
+	var inDependencies bool
+	var lineNum int
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		line := scanner.Text()
-		if countLeadingSpace(line) == 4 {
-			line = strings.TrimSpace(line)
-			s := strings.Fields(line)
+		lineNum++
+		// Lines are parsed as bytes, and only the names and versions are converted into strings.
+		// Most lines are the dependencies of gems, which are skipped without garbage.
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		// Gems listed in the Gemfile
+		// e.g.
+		//   DEPENDENCIES
+		//     dotenv (~> 2.7)
+		//     rails!
+		//     puma (>= 3.0, < 6.0)
+		indent := countLeadingSpace(line)
+		if indent == 0 {
+			inDependencies = bytes.Equal(line, dependenciesSection)
+			continue
+		}
+		if inDependencies && indent == 2 {
+			line = bytes.TrimSpace(line)
+			name, constraint := line, ""
+			if i := bytes.Index(line, []byte(" (")); i != -1 {
+				name, constraint = line[:i], string(bytes.TrimSuffix(line[i+2:], []byte(")")))
+			}
+			direct[string(bytes.TrimSuffix(name, []byte("!")))] = constraint
+			continue
+		}
+
+		if indent == 4 {
+			s := bytes.Fields(line)
 			if len(s) != 2 {
 				continue
 			}
-			libs = append(libs, types.Library{
-				Name:    s[0],
-				Version: strings.Trim(s[1], "()"),
-			})
+			lib := types.Library{
+				Name:      string(s[0]),
+				Version:   string(bytes.Trim(s[1], "()")),
+				Locations: []types.Location{{StartLine: lineNum, EndLine: lineNum}},
+			}
+			// Gem versions don't contain "-", which separates the platform of native gems.
+			// e.g. nokogiri (1.13.10-x86_64-linux)
+			if i := strings.Index(lib.Version, "-"); i != -1 {
+				lib.Qualifiers = map[string]string{purl.QualifierPlatform: lib.Version[i+1:]}
+				lib.Version = lib.Version[:i]
+			}
+			libs = append(libs, lib)
 		}
 	}
 	if err := scanner.Err(); err != nil {
 		return nil, xerrors.Errorf("scan error: %w", err)
 	}
+
+	// Old lock files might not have the DEPENDENCIES section
+	if len(direct) > 0 {
+		for i := range libs {
+			constraint, ok := direct[libs[i].Name]
+			libs[i].Indirect = !ok
+			libs[i].Constraint = constraint
+		}
+	}
 	return libs, nil
 }
 
-func countLeadingSpace(line string) int {
+func countLeadingSpace(line []byte) int {
 	i := 0
-	for _, runeValue := range line {
-		if runeValue == ' ' {
-			i++
-		} else {
-			break
-		}
+	for i < len(line) && line[i] == ' ' {
+		i++
 	}
 	return i
 }
@@ -28,6 +28,10 @@ func TestParse(t *testing.T) {
 			file: "testdata/Gemfile_many.lock",
 			want: BundlerMany,
 		},
+		{
+			file: "testdata/Gemfile_platform.lock",
+			want: BundlerPlatform,
+		},
 	}
 
 	for _, v := range vectors {
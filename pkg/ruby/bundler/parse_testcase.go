@@ -8,23 +8,23 @@ var (
 	// bundle add dotenv json faker rubocop pry
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\", \"\"},\n");}'
 	BundlerNormal = []types.Library{
-		{"ast", "2.4.0", ""},
-		{"coderay", "1.1.2", ""},
-		{"concurrent-ruby", "1.1.5", ""},
-		{"dotenv", "2.7.2", ""},
-		{"faker", "1.9.3", ""},
-		{"i18n", "1.6.0", ""},
-		{"jaro_winkler", "1.5.2", ""},
-		{"json", "2.2.0", ""},
-		{"method_source", "0.9.2", ""},
-		{"parallel", "1.17.0", ""},
-		{"parser", "2.6.3.0", ""},
-		{"pry", "0.12.2", ""},
-		{"psych", "3.1.0", ""},
-		{"rainbow", "3.0.0", ""},
-		{"rubocop", "0.67.2", ""},
-		{"ruby-progressbar", "1.10.0", ""},
-		{"unicode-display_width", "1.5.0", ""},
+		{Name: "ast", Version: "2.4.0", Indirect: true, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "coderay", Version: "1.1.2", Indirect: true, Locations: []types.Location{{StartLine: 5, EndLine: 5}}},
+		{Name: "concurrent-ruby", Version: "1.1.5", Indirect: true, Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
+		{Name: "dotenv", Version: "2.7.2", Constraint: "~> 2.7", Locations: []types.Location{{StartLine: 7, EndLine: 7}}},
+		{Name: "faker", Version: "1.9.3", Constraint: "~> 1.9", Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
+		{Name: "i18n", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 10, EndLine: 10}}},
+		{Name: "jaro_winkler", Version: "1.5.2", Indirect: true, Locations: []types.Location{{StartLine: 12, EndLine: 12}}},
+		{Name: "json", Version: "2.2.0", Constraint: "~> 2.2", Locations: []types.Location{{StartLine: 13, EndLine: 13}}},
+		{Name: "method_source", Version: "0.9.2", Indirect: true, Locations: []types.Location{{StartLine: 14, EndLine: 14}}},
+		{Name: "parallel", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 15, EndLine: 15}}},
+		{Name: "parser", Version: "2.6.3.0", Indirect: true, Locations: []types.Location{{StartLine: 16, EndLine: 16}}},
+		{Name: "pry", Version: "0.12.2", Constraint: "~> 0.12.2", Locations: []types.Location{{StartLine: 18, EndLine: 18}}},
+		{Name: "psych", Version: "3.1.0", Indirect: true, Locations: []types.Location{{StartLine: 21, EndLine: 21}}},
+		{Name: "rainbow", Version: "3.0.0", Indirect: true, Locations: []types.Location{{StartLine: 22, EndLine: 22}}},
+		{Name: "rubocop", Version: "0.67.2", Constraint: "~> 0.67.2", Locations: []types.Location{{StartLine: 23, EndLine: 23}}},
+		{Name: "ruby-progressbar", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 31, EndLine: 31}}},
+		{Name: "unicode-display_width", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 32, EndLine: 32}}},
 	}
 
 	// docker run --name bundler --rm -it ruby:2.6 bash
@@ -33,60 +33,60 @@ var (
 	// bundle add rails
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\", \"\"},\n");}'
 	BundlerRails = []types.Library{
-		{"actioncable", "5.2.3", ""},
-		{"actionmailer", "5.2.3", ""},
-		{"actionpack", "5.2.3", ""},
-		{"actionview", "5.2.3", ""},
-		{"activejob", "5.2.3", ""},
-		{"activemodel", "5.2.3", ""},
-		{"activerecord", "5.2.3", ""},
-		{"activestorage", "5.2.3", ""},
-		{"activesupport", "5.2.3", ""},
-		{"arel", "9.0.0", ""},
-		{"ast", "2.4.0", ""},
-		{"builder", "3.2.3", ""},
-		{"coderay", "1.1.2", ""},
-		{"concurrent-ruby", "1.1.5", ""},
-		{"crass", "1.0.4", ""},
-		{"dotenv", "2.7.2", ""},
-		{"erubi", "1.8.0", ""},
-		{"faker", "1.9.3", ""},
-		{"globalid", "0.4.2", ""},
-		{"i18n", "1.6.0", ""},
-		{"jaro_winkler", "1.5.2", ""},
-		{"json", "2.2.0", ""},
-		{"loofah", "2.2.3", ""},
-		{"mail", "2.7.1", ""},
-		{"marcel", "0.3.3", ""},
-		{"method_source", "0.9.2", ""},
-		{"mimemagic", "0.3.3", ""},
-		{"mini_mime", "1.0.1", ""},
-		{"mini_portile2", "2.4.0", ""},
-		{"minitest", "5.11.3", ""},
-		{"nio4r", "2.3.1", ""},
-		{"nokogiri", "1.10.3", ""},
-		{"parallel", "1.17.0", ""},
-		{"parser", "2.6.3.0", ""},
-		{"pry", "0.12.2", ""},
-		{"psych", "3.1.0", ""},
-		{"rack", "2.0.7", ""},
-		{"rack-test", "1.1.0", ""},
-		{"rails", "5.2.3", ""},
-		{"rails-dom-testing", "2.0.3", ""},
-		{"rails-html-sanitizer", "1.0.4", ""},
-		{"railties", "5.2.3", ""},
-		{"rainbow", "3.0.0", ""},
-		{"rake", "12.3.2", ""},
-		{"rubocop", "0.67.2", ""},
-		{"ruby-progressbar", "1.10.0", ""},
-		{"sprockets", "3.7.2", ""},
-		{"sprockets-rails", "3.2.1", ""},
-		{"thor", "0.20.3", ""},
-		{"thread_safe", "0.3.6", ""},
-		{"tzinfo", "1.2.5", ""},
-		{"unicode-display_width", "1.5.0", ""},
-		{"websocket-driver", "0.7.0", ""},
-		{"websocket-extensions", "0.1.3", ""},
+		{Name: "actioncable", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "actionmailer", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
+		{Name: "actionpack", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 14, EndLine: 14}}},
+		{Name: "actionview", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 21, EndLine: 21}}},
+		{Name: "activejob", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 27, EndLine: 27}}},
+		{Name: "activemodel", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 30, EndLine: 30}}},
+		{Name: "activerecord", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 32, EndLine: 32}}},
+		{Name: "activestorage", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 36, EndLine: 36}}},
+		{Name: "activesupport", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 40, EndLine: 40}}},
+		{Name: "arel", Version: "9.0.0", Indirect: true, Locations: []types.Location{{StartLine: 45, EndLine: 45}}},
+		{Name: "ast", Version: "2.4.0", Indirect: true, Locations: []types.Location{{StartLine: 46, EndLine: 46}}},
+		{Name: "builder", Version: "3.2.3", Indirect: true, Locations: []types.Location{{StartLine: 47, EndLine: 47}}},
+		{Name: "coderay", Version: "1.1.2", Indirect: true, Locations: []types.Location{{StartLine: 48, EndLine: 48}}},
+		{Name: "concurrent-ruby", Version: "1.1.5", Indirect: true, Locations: []types.Location{{StartLine: 49, EndLine: 49}}},
+		{Name: "crass", Version: "1.0.4", Indirect: true, Locations: []types.Location{{StartLine: 50, EndLine: 50}}},
+		{Name: "dotenv", Version: "2.7.2", Constraint: "~> 2.7", Locations: []types.Location{{StartLine: 51, EndLine: 51}}},
+		{Name: "erubi", Version: "1.8.0", Indirect: true, Locations: []types.Location{{StartLine: 52, EndLine: 52}}},
+		{Name: "faker", Version: "1.9.3", Constraint: "~> 1.9", Locations: []types.Location{{StartLine: 53, EndLine: 53}}},
+		{Name: "globalid", Version: "0.4.2", Indirect: true, Locations: []types.Location{{StartLine: 55, EndLine: 55}}},
+		{Name: "i18n", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 57, EndLine: 57}}},
+		{Name: "jaro_winkler", Version: "1.5.2", Indirect: true, Locations: []types.Location{{StartLine: 59, EndLine: 59}}},
+		{Name: "json", Version: "2.2.0", Constraint: "~> 2.2", Locations: []types.Location{{StartLine: 60, EndLine: 60}}},
+		{Name: "loofah", Version: "2.2.3", Indirect: true, Locations: []types.Location{{StartLine: 61, EndLine: 61}}},
+		{Name: "mail", Version: "2.7.1", Indirect: true, Locations: []types.Location{{StartLine: 64, EndLine: 64}}},
+		{Name: "marcel", Version: "0.3.3", Indirect: true, Locations: []types.Location{{StartLine: 66, EndLine: 66}}},
+		{Name: "method_source", Version: "0.9.2", Indirect: true, Locations: []types.Location{{StartLine: 68, EndLine: 68}}},
+		{Name: "mimemagic", Version: "0.3.3", Indirect: true, Locations: []types.Location{{StartLine: 69, EndLine: 69}}},
+		{Name: "mini_mime", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 70, EndLine: 70}}},
+		{Name: "mini_portile2", Version: "2.4.0", Indirect: true, Locations: []types.Location{{StartLine: 71, EndLine: 71}}},
+		{Name: "minitest", Version: "5.11.3", Indirect: true, Locations: []types.Location{{StartLine: 72, EndLine: 72}}},
+		{Name: "nio4r", Version: "2.3.1", Indirect: true, Locations: []types.Location{{StartLine: 73, EndLine: 73}}},
+		{Name: "nokogiri", Version: "1.10.3", Indirect: true, Locations: []types.Location{{StartLine: 74, EndLine: 74}}},
+		{Name: "parallel", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 76, EndLine: 76}}},
+		{Name: "parser", Version: "2.6.3.0", Indirect: true, Locations: []types.Location{{StartLine: 77, EndLine: 77}}},
+		{Name: "pry", Version: "0.12.2", Constraint: "~> 0.12.2", Locations: []types.Location{{StartLine: 79, EndLine: 79}}},
+		{Name: "psych", Version: "3.1.0", Indirect: true, Locations: []types.Location{{StartLine: 82, EndLine: 82}}},
+		{Name: "rack", Version: "2.0.7", Indirect: true, Locations: []types.Location{{StartLine: 83, EndLine: 83}}},
+		{Name: "rack-test", Version: "1.1.0", Indirect: true, Locations: []types.Location{{StartLine: 84, EndLine: 84}}},
+		{Name: "rails", Version: "5.2.3", Constraint: "~> 5.2", Locations: []types.Location{{StartLine: 86, EndLine: 86}}},
+		{Name: "rails-dom-testing", Version: "2.0.3", Indirect: true, Locations: []types.Location{{StartLine: 99, EndLine: 99}}},
+		{Name: "rails-html-sanitizer", Version: "1.0.4", Indirect: true, Locations: []types.Location{{StartLine: 102, EndLine: 102}}},
+		{Name: "railties", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 104, EndLine: 104}}},
+		{Name: "rainbow", Version: "3.0.0", Indirect: true, Locations: []types.Location{{StartLine: 110, EndLine: 110}}},
+		{Name: "rake", Version: "12.3.2", Indirect: true, Locations: []types.Location{{StartLine: 111, EndLine: 111}}},
+		{Name: "rubocop", Version: "0.67.2", Constraint: "~> 0.67.2", Locations: []types.Location{{StartLine: 112, EndLine: 112}}},
+		{Name: "ruby-progressbar", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 120, EndLine: 120}}},
+		{Name: "sprockets", Version: "3.7.2", Indirect: true, Locations: []types.Location{{StartLine: 121, EndLine: 121}}},
+		{Name: "sprockets-rails", Version: "3.2.1", Indirect: true, Locations: []types.Location{{StartLine: 124, EndLine: 124}}},
+		{Name: "thor", Version: "0.20.3", Indirect: true, Locations: []types.Location{{StartLine: 128, EndLine: 128}}},
+		{Name: "thread_safe", Version: "0.3.6", Indirect: true, Locations: []types.Location{{StartLine: 129, EndLine: 129}}},
+		{Name: "tzinfo", Version: "1.2.5", Indirect: true, Locations: []types.Location{{StartLine: 130, EndLine: 130}}},
+		{Name: "unicode-display_width", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 132, EndLine: 132}}},
+		{Name: "websocket-driver", Version: "0.7.0", Indirect: true, Locations: []types.Location{{StartLine: 133, EndLine: 133}}},
+		{Name: "websocket-extensions", Version: "0.1.3", Indirect: true, Locations: []types.Location{{StartLine: 135, EndLine: 135}}},
 	}
 	// docker run --name bundler --rm -it ruby:2.6 bash
 	// bundle init
@@ -95,252 +95,259 @@ var (
 	// bundle add sinatra multi-json thor sass aws-sdk faraday
 	// bundler show | grep "*" | grep -v bundler | awk '{if(match($0, /\((.*)\)/)) printf("{\""$2"\", \""substr($0, RSTART+1, RLENGTH-2)"\"}, \"\"},\n");}'
 	BundlerMany = []types.Library{
-		{"actioncable", "5.2.3", ""},
-		{"actionmailer", "5.2.3", ""},
-		{"actionpack", "5.2.3", ""},
-		{"actionview", "5.2.3", ""},
-		{"activejob", "5.2.3", ""},
-		{"activemodel", "5.2.3", ""},
-		{"activerecord", "5.2.3", ""},
-		{"activestorage", "5.2.3", ""},
-		{"activesupport", "5.2.3", ""},
-		{"arel", "9.0.0", ""},
-		{"ast", "2.4.0", ""},
-		{"aws-eventstream", "1.0.3", ""},
-		{"aws-partitions", "1.154.0", ""},
-		{"aws-sdk", "3.0.1", ""},
-		{"aws-sdk-acm", "1.19.0", ""},
-		{"aws-sdk-acmpca", "1.13.0", ""},
-		{"aws-sdk-alexaforbusiness", "1.20.0", ""},
-		{"aws-sdk-amplify", "1.3.0", ""},
-		{"aws-sdk-apigateway", "1.26.0", ""},
-		{"aws-sdk-apigatewaymanagementapi", "1.3.0", ""},
-		{"aws-sdk-apigatewayv2", "1.4.0", ""},
-		{"aws-sdk-applicationautoscaling", "1.22.0", ""},
-		{"aws-sdk-applicationdiscoveryservice", "1.15.0", ""},
-		{"aws-sdk-appmesh", "1.6.0", ""},
-		{"aws-sdk-appstream", "1.25.0", ""},
-		{"aws-sdk-appsync", "1.12.0", ""},
-		{"aws-sdk-athena", "1.12.0", ""},
-		{"aws-sdk-autoscaling", "1.20.0", ""},
-		{"aws-sdk-autoscalingplans", "1.12.0", ""},
-		{"aws-sdk-backup", "1.3.0", ""},
-		{"aws-sdk-batch", "1.17.0", ""},
-		{"aws-sdk-budgets", "1.18.0", ""},
-		{"aws-sdk-chime", "1.6.0", ""},
-		{"aws-sdk-cloud9", "1.11.0", ""},
-		{"aws-sdk-clouddirectory", "1.14.0", ""},
-		{"aws-sdk-cloudformation", "1.19.0", ""},
-		{"aws-sdk-cloudfront", "1.15.0", ""},
-		{"aws-sdk-cloudhsm", "1.12.0", ""},
-		{"aws-sdk-cloudhsmv2", "1.12.0", ""},
-		{"aws-sdk-cloudsearch", "1.9.0", ""},
-		{"aws-sdk-cloudsearchdomain", "1.9.0", ""},
-		{"aws-sdk-cloudtrail", "1.11.0", ""},
-		{"aws-sdk-cloudwatch", "1.20.0", ""},
-		{"aws-sdk-cloudwatchevents", "1.17.0", ""},
-		{"aws-sdk-cloudwatchlogs", "1.17.0", ""},
-		{"aws-sdk-codebuild", "1.32.0", ""},
-		{"aws-sdk-codecommit", "1.17.0", ""},
-		{"aws-sdk-codedeploy", "1.18.0", ""},
-		{"aws-sdk-codepipeline", "1.15.0", ""},
-		{"aws-sdk-codestar", "1.11.0", ""},
-		{"aws-sdk-cognitoidentity", "1.10.0", ""},
-		{"aws-sdk-cognitoidentityprovider", "1.18.0", ""},
-		{"aws-sdk-cognitosync", "1.9.0", ""},
-		{"aws-sdk-comprehend", "1.18.0", ""},
-		{"aws-sdk-comprehendmedical", "1.3.0", ""},
-		{"aws-sdk-configservice", "1.26.0", ""},
-		{"aws-sdk-connect", "1.13.0", ""},
-		{"aws-sdk-core", "3.48.6", ""},
-		{"aws-sdk-costandusagereportservice", "1.10.0", ""},
-		{"aws-sdk-costexplorer", "1.21.0", ""},
-		{"aws-sdk-databasemigrationservice", "1.20.0", ""},
-		{"aws-sdk-datapipeline", "1.9.0", ""},
-		{"aws-sdk-datasync", "1.3.0", ""},
-		{"aws-sdk-dax", "1.11.0", ""},
-		{"aws-sdk-devicefarm", "1.19.0", ""},
-		{"aws-sdk-directconnect", "1.16.0", ""},
-		{"aws-sdk-directoryservice", "1.15.0", ""},
-		{"aws-sdk-dlm", "1.11.0", ""},
-		{"aws-sdk-docdb", "1.4.0", ""},
-		{"aws-sdk-dynamodb", "1.26.0", ""},
-		{"aws-sdk-dynamodbstreams", "1.9.0", ""},
-		{"aws-sdk-ec2", "1.80.0", ""},
-		{"aws-sdk-ecr", "1.14.0", ""},
-		{"aws-sdk-ecs", "1.36.0", ""},
-		{"aws-sdk-efs", "1.13.0", ""},
-		{"aws-sdk-eks", "1.15.0", ""},
-		{"aws-sdk-elasticache", "1.14.0", ""},
-		{"aws-sdk-elasticbeanstalk", "1.19.0", ""},
-		{"aws-sdk-elasticloadbalancing", "1.12.0", ""},
-		{"aws-sdk-elasticloadbalancingv2", "1.26.0", ""},
-		{"aws-sdk-elasticsearchservice", "1.19.0", ""},
-		{"aws-sdk-elastictranscoder", "1.11.0", ""},
-		{"aws-sdk-emr", "1.14.0", ""},
-		{"aws-sdk-firehose", "1.14.0", ""},
-		{"aws-sdk-fms", "1.12.0", ""},
-		{"aws-sdk-fsx", "1.4.0", ""},
-		{"aws-sdk-gamelift", "1.16.0", ""},
-		{"aws-sdk-glacier", "1.18.0", ""},
-		{"aws-sdk-globalaccelerator", "1.4.0", ""},
-		{"aws-sdk-glue", "1.30.0", ""},
-		{"aws-sdk-greengrass", "1.17.0", ""},
-		{"aws-sdk-guardduty", "1.14.0", ""},
-		{"aws-sdk-health", "1.12.0", ""},
-		{"aws-sdk-iam", "1.19.0", ""},
-		{"aws-sdk-importexport", "1.9.0", ""},
-		{"aws-sdk-inspector", "1.16.0", ""},
-		{"aws-sdk-iot", "1.29.0", ""},
-		{"aws-sdk-iot1clickdevicesservice", "1.11.0", ""},
-		{"aws-sdk-iot1clickprojects", "1.10.0", ""},
-		{"aws-sdk-iotanalytics", "1.16.0", ""},
-		{"aws-sdk-iotdataplane", "1.9.0", ""},
-		{"aws-sdk-iotjobsdataplane", "1.10.0", ""},
-		{"aws-sdk-kafka", "1.4.0", ""},
-		{"aws-sdk-kinesis", "1.13.1", ""},
-		{"aws-sdk-kinesisanalytics", "1.12.0", ""},
-		{"aws-sdk-kinesisanalyticsv2", "1.3.0", ""},
-		{"aws-sdk-kinesisvideo", "1.12.0", ""},
-		{"aws-sdk-kinesisvideoarchivedmedia", "1.11.0", ""},
-		{"aws-sdk-kinesisvideomedia", "1.10.0", ""},
-		{"aws-sdk-kms", "1.17.0", ""},
-		{"aws-sdk-lambda", "1.22.0", ""},
-		{"aws-sdk-lambdapreview", "1.9.0", ""},
-		{"aws-sdk-lex", "1.12.0", ""},
-		{"aws-sdk-lexmodelbuildingservice", "1.15.0", ""},
-		{"aws-sdk-licensemanager", "1.3.0", ""},
-		{"aws-sdk-lightsail", "1.18.0", ""},
-		{"aws-sdk-machinelearning", "1.10.0", ""},
-		{"aws-sdk-macie", "1.9.0", ""},
-		{"aws-sdk-marketplacecommerceanalytics", "1.9.0", ""},
-		{"aws-sdk-marketplaceentitlementservice", "1.9.0", ""},
-		{"aws-sdk-marketplacemetering", "1.11.0", ""},
-		{"aws-sdk-mediaconnect", "1.5.0", ""},
-		{"aws-sdk-mediaconvert", "1.25.0", ""},
-		{"aws-sdk-medialive", "1.28.0", ""},
-		{"aws-sdk-mediapackage", "1.15.0", ""},
-		{"aws-sdk-mediastore", "1.12.0", ""},
-		{"aws-sdk-mediastoredata", "1.11.0", ""},
-		{"aws-sdk-mediatailor", "1.14.0", ""},
-		{"aws-sdk-migrationhub", "1.11.0", ""},
-		{"aws-sdk-mobile", "1.9.0", ""},
-		{"aws-sdk-mq", "1.13.0", ""},
-		{"aws-sdk-mturk", "1.12.0", ""},
-		{"aws-sdk-neptune", "1.11.0", ""},
-		{"aws-sdk-opsworks", "1.13.0", ""},
-		{"aws-sdk-opsworkscm", "1.16.0", ""},
-		{"aws-sdk-organizations", "1.24.0", ""},
-		{"aws-sdk-pi", "1.9.0", ""},
-		{"aws-sdk-pinpoint", "1.19.0", ""},
-		{"aws-sdk-pinpointemail", "1.6.0", ""},
-		{"aws-sdk-pinpointsmsvoice", "1.6.0", ""},
-		{"aws-sdk-polly", "1.19.0", ""},
-		{"aws-sdk-pricing", "1.9.0", ""},
-		{"aws-sdk-quicksight", "1.5.0", ""},
-		{"aws-sdk-ram", "1.4.0", ""},
-		{"aws-sdk-rds", "1.50.0", ""},
-		{"aws-sdk-rdsdataservice", "1.4.0", ""},
-		{"aws-sdk-redshift", "1.23.0", ""},
-		{"aws-sdk-rekognition", "1.22.0", ""},
-		{"aws-sdk-resourcegroups", "1.14.0", ""},
-		{"aws-sdk-resourcegroupstaggingapi", "1.9.0", ""},
-		{"aws-sdk-resources", "3.41.0", ""},
-		{"aws-sdk-robomaker", "1.5.0", ""},
-		{"aws-sdk-route53", "1.22.0", ""},
-		{"aws-sdk-route53domains", "1.11.0", ""},
-		{"aws-sdk-route53resolver", "1.4.0", ""},
-		{"aws-sdk-s3", "1.36.1", ""},
-		{"aws-sdk-s3control", "1.4.0", ""},
-		{"aws-sdk-sagemaker", "1.33.0", ""},
-		{"aws-sdk-sagemakerruntime", "1.10.0", ""},
-		{"aws-sdk-secretsmanager", "1.24.0", ""},
-		{"aws-sdk-securityhub", "1.4.0", ""},
-		{"aws-sdk-serverlessapplicationrepository", "1.15.0", ""},
-		{"aws-sdk-servicecatalog", "1.20.0", ""},
-		{"aws-sdk-servicediscovery", "1.12.0", ""},
-		{"aws-sdk-ses", "1.18.0", ""},
-		{"aws-sdk-shield", "1.13.0", ""},
-		{"aws-sdk-signer", "1.9.0", ""},
-		{"aws-sdk-simpledb", "1.9.0", ""},
-		{"aws-sdk-sms", "1.10.0", ""},
-		{"aws-sdk-snowball", "1.14.0", ""},
-		{"aws-sdk-sns", "1.13.0", ""},
-		{"aws-sdk-sqs", "1.13.0", ""},
-		{"aws-sdk-ssm", "1.43.0", ""},
-		{"aws-sdk-states", "1.14.0", ""},
-		{"aws-sdk-storagegateway", "1.21.0", ""},
-		{"aws-sdk-support", "1.9.0", ""},
-		{"aws-sdk-swf", "1.9.0", ""},
-		{"aws-sdk-textract", "1.4.0", ""},
-		{"aws-sdk-transcribeservice", "1.19.0", ""},
-		{"aws-sdk-transcribestreamingservice", "1.2.0", ""},
-		{"aws-sdk-transfer", "1.5.0", ""},
-		{"aws-sdk-translate", "1.11.0", ""},
-		{"aws-sdk-waf", "1.16.0", ""},
-		{"aws-sdk-wafregional", "1.17.0", ""},
-		{"aws-sdk-workdocs", "1.12.0", ""},
-		{"aws-sdk-worklink", "1.4.0", ""},
-		{"aws-sdk-workmail", "1.11.0", ""},
-		{"aws-sdk-workspaces", "1.19.0", ""},
-		{"aws-sdk-xray", "1.13.0", ""},
-		{"aws-sigv2", "1.0.1", ""},
-		{"aws-sigv4", "1.1.0", ""},
-		{"builder", "3.2.3", ""},
-		{"coderay", "1.1.2", ""},
-		{"concurrent-ruby", "1.1.5", ""},
-		{"crass", "1.0.4", ""},
-		{"dotenv", "2.7.2", ""},
-		{"erubi", "1.8.0", ""},
-		{"faker", "1.9.3", ""},
-		{"faraday", "0.15.4", ""},
-		{"ffi", "1.10.0", ""},
-		{"globalid", "0.4.2", ""},
-		{"i18n", "1.6.0", ""},
-		{"jaro_winkler", "1.5.2", ""},
-		{"jmespath", "1.4.0", ""},
-		{"json", "2.2.0", ""},
-		{"loofah", "2.2.3", ""},
-		{"mail", "2.7.1", ""},
-		{"marcel", "0.3.3", ""},
-		{"method_source", "0.9.2", ""},
-		{"mimemagic", "0.3.3", ""},
-		{"mini_mime", "1.0.1", ""},
-		{"mini_portile2", "2.4.0", ""},
-		{"minitest", "5.11.3", ""},
-		{"multi_json", "1.13.1", ""},
-		{"multipart-post", "2.0.0", ""},
-		{"mustermann", "1.0.3", ""},
-		{"nio4r", "2.3.1", ""},
-		{"nokogiri", "1.10.3", ""},
-		{"parallel", "1.17.0", ""},
-		{"parser", "2.6.3.0", ""},
-		{"pry", "0.12.2", ""},
-		{"psych", "3.1.0", ""},
-		{"rack", "2.0.7", ""},
-		{"rack-protection", "2.0.5", ""},
-		{"rack-test", "1.1.0", ""},
-		{"rails", "5.2.3", ""},
-		{"rails-dom-testing", "2.0.3", ""},
-		{"rails-html-sanitizer", "1.0.4", ""},
-		{"railties", "5.2.3", ""},
-		{"rainbow", "3.0.0", ""},
-		{"rake", "12.3.2", ""},
-		{"rb-fsevent", "0.10.3", ""},
-		{"rb-inotify", "0.10.0", ""},
-		{"rubocop", "0.67.2", ""},
-		{"ruby-progressbar", "1.10.0", ""},
-		{"sass", "3.7.4", ""},
-		{"sass-listen", "4.0.0", ""},
-		{"sinatra", "2.0.5", ""},
-		{"sprockets", "3.7.2", ""},
-		{"sprockets-rails", "3.2.1", ""},
-		{"thor", "0.20.3", ""},
-		{"thread_safe", "0.3.6", ""},
-		{"tilt", "2.0.9", ""},
-		{"tzinfo", "1.2.5", ""},
-		{"unicode-display_width", "1.5.0", ""},
-		{"websocket-driver", "0.7.0", ""},
-		{"websocket-extensions", "0.1.3", ""},
+		{Name: "actioncable", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "actionmailer", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
+		{Name: "actionpack", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 14, EndLine: 14}}},
+		{Name: "actionview", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 21, EndLine: 21}}},
+		{Name: "activejob", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 27, EndLine: 27}}},
+		{Name: "activemodel", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 30, EndLine: 30}}},
+		{Name: "activerecord", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 32, EndLine: 32}}},
+		{Name: "activestorage", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 36, EndLine: 36}}},
+		{Name: "activesupport", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 40, EndLine: 40}}},
+		{Name: "arel", Version: "9.0.0", Indirect: true, Locations: []types.Location{{StartLine: 45, EndLine: 45}}},
+		{Name: "ast", Version: "2.4.0", Indirect: true, Locations: []types.Location{{StartLine: 46, EndLine: 46}}},
+		{Name: "aws-eventstream", Version: "1.0.3", Indirect: true, Locations: []types.Location{{StartLine: 47, EndLine: 47}}},
+		{Name: "aws-partitions", Version: "1.154.0", Indirect: true, Locations: []types.Location{{StartLine: 48, EndLine: 48}}},
+		{Name: "aws-sdk", Version: "3.0.1", Constraint: "~> 3.0", Locations: []types.Location{{StartLine: 49, EndLine: 49}}},
+		{Name: "aws-sdk-acm", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 51, EndLine: 51}}},
+		{Name: "aws-sdk-acmpca", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 54, EndLine: 54}}},
+		{Name: "aws-sdk-alexaforbusiness", Version: "1.20.0", Indirect: true, Locations: []types.Location{{StartLine: 57, EndLine: 57}}},
+		{Name: "aws-sdk-amplify", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 60, EndLine: 60}}},
+		{Name: "aws-sdk-apigateway", Version: "1.26.0", Indirect: true, Locations: []types.Location{{StartLine: 63, EndLine: 63}}},
+		{Name: "aws-sdk-apigatewaymanagementapi", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 66, EndLine: 66}}},
+		{Name: "aws-sdk-apigatewayv2", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 69, EndLine: 69}}},
+		{Name: "aws-sdk-applicationautoscaling", Version: "1.22.0", Indirect: true, Locations: []types.Location{{StartLine: 72, EndLine: 72}}},
+		{Name: "aws-sdk-applicationdiscoveryservice", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 75, EndLine: 75}}},
+		{Name: "aws-sdk-appmesh", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 78, EndLine: 78}}},
+		{Name: "aws-sdk-appstream", Version: "1.25.0", Indirect: true, Locations: []types.Location{{StartLine: 81, EndLine: 81}}},
+		{Name: "aws-sdk-appsync", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 84, EndLine: 84}}},
+		{Name: "aws-sdk-athena", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 87, EndLine: 87}}},
+		{Name: "aws-sdk-autoscaling", Version: "1.20.0", Indirect: true, Locations: []types.Location{{StartLine: 90, EndLine: 90}}},
+		{Name: "aws-sdk-autoscalingplans", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 93, EndLine: 93}}},
+		{Name: "aws-sdk-backup", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 96, EndLine: 96}}},
+		{Name: "aws-sdk-batch", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 99, EndLine: 99}}},
+		{Name: "aws-sdk-budgets", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 102, EndLine: 102}}},
+		{Name: "aws-sdk-chime", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 105, EndLine: 105}}},
+		{Name: "aws-sdk-cloud9", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 108, EndLine: 108}}},
+		{Name: "aws-sdk-clouddirectory", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 111, EndLine: 111}}},
+		{Name: "aws-sdk-cloudformation", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 114, EndLine: 114}}},
+		{Name: "aws-sdk-cloudfront", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 117, EndLine: 117}}},
+		{Name: "aws-sdk-cloudhsm", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 120, EndLine: 120}}},
+		{Name: "aws-sdk-cloudhsmv2", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 123, EndLine: 123}}},
+		{Name: "aws-sdk-cloudsearch", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 126, EndLine: 126}}},
+		{Name: "aws-sdk-cloudsearchdomain", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 129, EndLine: 129}}},
+		{Name: "aws-sdk-cloudtrail", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 132, EndLine: 132}}},
+		{Name: "aws-sdk-cloudwatch", Version: "1.20.0", Indirect: true, Locations: []types.Location{{StartLine: 135, EndLine: 135}}},
+		{Name: "aws-sdk-cloudwatchevents", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 138, EndLine: 138}}},
+		{Name: "aws-sdk-cloudwatchlogs", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 141, EndLine: 141}}},
+		{Name: "aws-sdk-codebuild", Version: "1.32.0", Indirect: true, Locations: []types.Location{{StartLine: 144, EndLine: 144}}},
+		{Name: "aws-sdk-codecommit", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 147, EndLine: 147}}},
+		{Name: "aws-sdk-codedeploy", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 150, EndLine: 150}}},
+		{Name: "aws-sdk-codepipeline", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 153, EndLine: 153}}},
+		{Name: "aws-sdk-codestar", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 156, EndLine: 156}}},
+		{Name: "aws-sdk-cognitoidentity", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 159, EndLine: 159}}},
+		{Name: "aws-sdk-cognitoidentityprovider", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 162, EndLine: 162}}},
+		{Name: "aws-sdk-cognitosync", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 165, EndLine: 165}}},
+		{Name: "aws-sdk-comprehend", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 168, EndLine: 168}}},
+		{Name: "aws-sdk-comprehendmedical", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 171, EndLine: 171}}},
+		{Name: "aws-sdk-configservice", Version: "1.26.0", Indirect: true, Locations: []types.Location{{StartLine: 174, EndLine: 174}}},
+		{Name: "aws-sdk-connect", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 177, EndLine: 177}}},
+		{Name: "aws-sdk-core", Version: "3.48.6", Indirect: true, Locations: []types.Location{{StartLine: 180, EndLine: 180}}},
+		{Name: "aws-sdk-costandusagereportservice", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 185, EndLine: 185}}},
+		{Name: "aws-sdk-costexplorer", Version: "1.21.0", Indirect: true, Locations: []types.Location{{StartLine: 188, EndLine: 188}}},
+		{Name: "aws-sdk-databasemigrationservice", Version: "1.20.0", Indirect: true, Locations: []types.Location{{StartLine: 191, EndLine: 191}}},
+		{Name: "aws-sdk-datapipeline", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 194, EndLine: 194}}},
+		{Name: "aws-sdk-datasync", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 197, EndLine: 197}}},
+		{Name: "aws-sdk-dax", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 200, EndLine: 200}}},
+		{Name: "aws-sdk-devicefarm", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 203, EndLine: 203}}},
+		{Name: "aws-sdk-directconnect", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 206, EndLine: 206}}},
+		{Name: "aws-sdk-directoryservice", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 209, EndLine: 209}}},
+		{Name: "aws-sdk-dlm", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 212, EndLine: 212}}},
+		{Name: "aws-sdk-docdb", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 215, EndLine: 215}}},
+		{Name: "aws-sdk-dynamodb", Version: "1.26.0", Indirect: true, Locations: []types.Location{{StartLine: 218, EndLine: 218}}},
+		{Name: "aws-sdk-dynamodbstreams", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 221, EndLine: 221}}},
+		{Name: "aws-sdk-ec2", Version: "1.80.0", Indirect: true, Locations: []types.Location{{StartLine: 224, EndLine: 224}}},
+		{Name: "aws-sdk-ecr", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 227, EndLine: 227}}},
+		{Name: "aws-sdk-ecs", Version: "1.36.0", Indirect: true, Locations: []types.Location{{StartLine: 230, EndLine: 230}}},
+		{Name: "aws-sdk-efs", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 233, EndLine: 233}}},
+		{Name: "aws-sdk-eks", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 236, EndLine: 236}}},
+		{Name: "aws-sdk-elasticache", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 239, EndLine: 239}}},
+		{Name: "aws-sdk-elasticbeanstalk", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 242, EndLine: 242}}},
+		{Name: "aws-sdk-elasticloadbalancing", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 245, EndLine: 245}}},
+		{Name: "aws-sdk-elasticloadbalancingv2", Version: "1.26.0", Indirect: true, Locations: []types.Location{{StartLine: 248, EndLine: 248}}},
+		{Name: "aws-sdk-elasticsearchservice", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 251, EndLine: 251}}},
+		{Name: "aws-sdk-elastictranscoder", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 254, EndLine: 254}}},
+		{Name: "aws-sdk-emr", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 257, EndLine: 257}}},
+		{Name: "aws-sdk-firehose", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 260, EndLine: 260}}},
+		{Name: "aws-sdk-fms", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 263, EndLine: 263}}},
+		{Name: "aws-sdk-fsx", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 266, EndLine: 266}}},
+		{Name: "aws-sdk-gamelift", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 269, EndLine: 269}}},
+		{Name: "aws-sdk-glacier", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 272, EndLine: 272}}},
+		{Name: "aws-sdk-globalaccelerator", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 275, EndLine: 275}}},
+		{Name: "aws-sdk-glue", Version: "1.30.0", Indirect: true, Locations: []types.Location{{StartLine: 278, EndLine: 278}}},
+		{Name: "aws-sdk-greengrass", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 281, EndLine: 281}}},
+		{Name: "aws-sdk-guardduty", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 284, EndLine: 284}}},
+		{Name: "aws-sdk-health", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 287, EndLine: 287}}},
+		{Name: "aws-sdk-iam", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 290, EndLine: 290}}},
+		{Name: "aws-sdk-importexport", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 293, EndLine: 293}}},
+		{Name: "aws-sdk-inspector", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 296, EndLine: 296}}},
+		{Name: "aws-sdk-iot", Version: "1.29.0", Indirect: true, Locations: []types.Location{{StartLine: 299, EndLine: 299}}},
+		{Name: "aws-sdk-iot1clickdevicesservice", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 302, EndLine: 302}}},
+		{Name: "aws-sdk-iot1clickprojects", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 305, EndLine: 305}}},
+		{Name: "aws-sdk-iotanalytics", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 308, EndLine: 308}}},
+		{Name: "aws-sdk-iotdataplane", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 311, EndLine: 311}}},
+		{Name: "aws-sdk-iotjobsdataplane", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 314, EndLine: 314}}},
+		{Name: "aws-sdk-kafka", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 317, EndLine: 317}}},
+		{Name: "aws-sdk-kinesis", Version: "1.13.1", Indirect: true, Locations: []types.Location{{StartLine: 320, EndLine: 320}}},
+		{Name: "aws-sdk-kinesisanalytics", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 323, EndLine: 323}}},
+		{Name: "aws-sdk-kinesisanalyticsv2", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 326, EndLine: 326}}},
+		{Name: "aws-sdk-kinesisvideo", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 329, EndLine: 329}}},
+		{Name: "aws-sdk-kinesisvideoarchivedmedia", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 332, EndLine: 332}}},
+		{Name: "aws-sdk-kinesisvideomedia", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 335, EndLine: 335}}},
+		{Name: "aws-sdk-kms", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 338, EndLine: 338}}},
+		{Name: "aws-sdk-lambda", Version: "1.22.0", Indirect: true, Locations: []types.Location{{StartLine: 341, EndLine: 341}}},
+		{Name: "aws-sdk-lambdapreview", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 344, EndLine: 344}}},
+		{Name: "aws-sdk-lex", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 347, EndLine: 347}}},
+		{Name: "aws-sdk-lexmodelbuildingservice", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 350, EndLine: 350}}},
+		{Name: "aws-sdk-licensemanager", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 353, EndLine: 353}}},
+		{Name: "aws-sdk-lightsail", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 356, EndLine: 356}}},
+		{Name: "aws-sdk-machinelearning", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 359, EndLine: 359}}},
+		{Name: "aws-sdk-macie", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 362, EndLine: 362}}},
+		{Name: "aws-sdk-marketplacecommerceanalytics", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 365, EndLine: 365}}},
+		{Name: "aws-sdk-marketplaceentitlementservice", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 368, EndLine: 368}}},
+		{Name: "aws-sdk-marketplacemetering", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 371, EndLine: 371}}},
+		{Name: "aws-sdk-mediaconnect", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 374, EndLine: 374}}},
+		{Name: "aws-sdk-mediaconvert", Version: "1.25.0", Indirect: true, Locations: []types.Location{{StartLine: 377, EndLine: 377}}},
+		{Name: "aws-sdk-medialive", Version: "1.28.0", Indirect: true, Locations: []types.Location{{StartLine: 380, EndLine: 380}}},
+		{Name: "aws-sdk-mediapackage", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 383, EndLine: 383}}},
+		{Name: "aws-sdk-mediastore", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 386, EndLine: 386}}},
+		{Name: "aws-sdk-mediastoredata", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 389, EndLine: 389}}},
+		{Name: "aws-sdk-mediatailor", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 392, EndLine: 392}}},
+		{Name: "aws-sdk-migrationhub", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 395, EndLine: 395}}},
+		{Name: "aws-sdk-mobile", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 398, EndLine: 398}}},
+		{Name: "aws-sdk-mq", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 401, EndLine: 401}}},
+		{Name: "aws-sdk-mturk", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 404, EndLine: 404}}},
+		{Name: "aws-sdk-neptune", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 407, EndLine: 407}}},
+		{Name: "aws-sdk-opsworks", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 410, EndLine: 410}}},
+		{Name: "aws-sdk-opsworkscm", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 413, EndLine: 413}}},
+		{Name: "aws-sdk-organizations", Version: "1.24.0", Indirect: true, Locations: []types.Location{{StartLine: 416, EndLine: 416}}},
+		{Name: "aws-sdk-pi", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 419, EndLine: 419}}},
+		{Name: "aws-sdk-pinpoint", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 422, EndLine: 422}}},
+		{Name: "aws-sdk-pinpointemail", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 425, EndLine: 425}}},
+		{Name: "aws-sdk-pinpointsmsvoice", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 428, EndLine: 428}}},
+		{Name: "aws-sdk-polly", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 431, EndLine: 431}}},
+		{Name: "aws-sdk-pricing", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 434, EndLine: 434}}},
+		{Name: "aws-sdk-quicksight", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 437, EndLine: 437}}},
+		{Name: "aws-sdk-ram", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 440, EndLine: 440}}},
+		{Name: "aws-sdk-rds", Version: "1.50.0", Indirect: true, Locations: []types.Location{{StartLine: 443, EndLine: 443}}},
+		{Name: "aws-sdk-rdsdataservice", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 446, EndLine: 446}}},
+		{Name: "aws-sdk-redshift", Version: "1.23.0", Indirect: true, Locations: []types.Location{{StartLine: 449, EndLine: 449}}},
+		{Name: "aws-sdk-rekognition", Version: "1.22.0", Indirect: true, Locations: []types.Location{{StartLine: 452, EndLine: 452}}},
+		{Name: "aws-sdk-resourcegroups", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 455, EndLine: 455}}},
+		{Name: "aws-sdk-resourcegroupstaggingapi", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 458, EndLine: 458}}},
+		{Name: "aws-sdk-resources", Version: "3.41.0", Indirect: true, Locations: []types.Location{{StartLine: 461, EndLine: 461}}},
+		{Name: "aws-sdk-robomaker", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 635, EndLine: 635}}},
+		{Name: "aws-sdk-route53", Version: "1.22.0", Indirect: true, Locations: []types.Location{{StartLine: 638, EndLine: 638}}},
+		{Name: "aws-sdk-route53domains", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 641, EndLine: 641}}},
+		{Name: "aws-sdk-route53resolver", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 644, EndLine: 644}}},
+		{Name: "aws-sdk-s3", Version: "1.36.1", Indirect: true, Locations: []types.Location{{StartLine: 647, EndLine: 647}}},
+		{Name: "aws-sdk-s3control", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 651, EndLine: 651}}},
+		{Name: "aws-sdk-sagemaker", Version: "1.33.0", Indirect: true, Locations: []types.Location{{StartLine: 654, EndLine: 654}}},
+		{Name: "aws-sdk-sagemakerruntime", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 657, EndLine: 657}}},
+		{Name: "aws-sdk-secretsmanager", Version: "1.24.0", Indirect: true, Locations: []types.Location{{StartLine: 660, EndLine: 660}}},
+		{Name: "aws-sdk-securityhub", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 663, EndLine: 663}}},
+		{Name: "aws-sdk-serverlessapplicationrepository", Version: "1.15.0", Indirect: true, Locations: []types.Location{{StartLine: 666, EndLine: 666}}},
+		{Name: "aws-sdk-servicecatalog", Version: "1.20.0", Indirect: true, Locations: []types.Location{{StartLine: 669, EndLine: 669}}},
+		{Name: "aws-sdk-servicediscovery", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 672, EndLine: 672}}},
+		{Name: "aws-sdk-ses", Version: "1.18.0", Indirect: true, Locations: []types.Location{{StartLine: 675, EndLine: 675}}},
+		{Name: "aws-sdk-shield", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 678, EndLine: 678}}},
+		{Name: "aws-sdk-signer", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 681, EndLine: 681}}},
+		{Name: "aws-sdk-simpledb", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 684, EndLine: 684}}},
+		{Name: "aws-sdk-sms", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 687, EndLine: 687}}},
+		{Name: "aws-sdk-snowball", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 690, EndLine: 690}}},
+		{Name: "aws-sdk-sns", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 693, EndLine: 693}}},
+		{Name: "aws-sdk-sqs", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 696, EndLine: 696}}},
+		{Name: "aws-sdk-ssm", Version: "1.43.0", Indirect: true, Locations: []types.Location{{StartLine: 699, EndLine: 699}}},
+		{Name: "aws-sdk-states", Version: "1.14.0", Indirect: true, Locations: []types.Location{{StartLine: 702, EndLine: 702}}},
+		{Name: "aws-sdk-storagegateway", Version: "1.21.0", Indirect: true, Locations: []types.Location{{StartLine: 705, EndLine: 705}}},
+		{Name: "aws-sdk-support", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 708, EndLine: 708}}},
+		{Name: "aws-sdk-swf", Version: "1.9.0", Indirect: true, Locations: []types.Location{{StartLine: 711, EndLine: 711}}},
+		{Name: "aws-sdk-textract", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 714, EndLine: 714}}},
+		{Name: "aws-sdk-transcribeservice", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 717, EndLine: 717}}},
+		{Name: "aws-sdk-transcribestreamingservice", Version: "1.2.0", Indirect: true, Locations: []types.Location{{StartLine: 720, EndLine: 720}}},
+		{Name: "aws-sdk-transfer", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 723, EndLine: 723}}},
+		{Name: "aws-sdk-translate", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 726, EndLine: 726}}},
+		{Name: "aws-sdk-waf", Version: "1.16.0", Indirect: true, Locations: []types.Location{{StartLine: 729, EndLine: 729}}},
+		{Name: "aws-sdk-wafregional", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 732, EndLine: 732}}},
+		{Name: "aws-sdk-workdocs", Version: "1.12.0", Indirect: true, Locations: []types.Location{{StartLine: 735, EndLine: 735}}},
+		{Name: "aws-sdk-worklink", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 738, EndLine: 738}}},
+		{Name: "aws-sdk-workmail", Version: "1.11.0", Indirect: true, Locations: []types.Location{{StartLine: 741, EndLine: 741}}},
+		{Name: "aws-sdk-workspaces", Version: "1.19.0", Indirect: true, Locations: []types.Location{{StartLine: 744, EndLine: 744}}},
+		{Name: "aws-sdk-xray", Version: "1.13.0", Indirect: true, Locations: []types.Location{{StartLine: 747, EndLine: 747}}},
+		{Name: "aws-sigv2", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 750, EndLine: 750}}},
+		{Name: "aws-sigv4", Version: "1.1.0", Indirect: true, Locations: []types.Location{{StartLine: 751, EndLine: 751}}},
+		{Name: "builder", Version: "3.2.3", Indirect: true, Locations: []types.Location{{StartLine: 753, EndLine: 753}}},
+		{Name: "coderay", Version: "1.1.2", Indirect: true, Locations: []types.Location{{StartLine: 754, EndLine: 754}}},
+		{Name: "concurrent-ruby", Version: "1.1.5", Indirect: true, Locations: []types.Location{{StartLine: 755, EndLine: 755}}},
+		{Name: "crass", Version: "1.0.4", Indirect: true, Locations: []types.Location{{StartLine: 756, EndLine: 756}}},
+		{Name: "dotenv", Version: "2.7.2", Constraint: "~> 2.7", Locations: []types.Location{{StartLine: 757, EndLine: 757}}},
+		{Name: "erubi", Version: "1.8.0", Indirect: true, Locations: []types.Location{{StartLine: 758, EndLine: 758}}},
+		{Name: "faker", Version: "1.9.3", Constraint: "~> 1.9", Locations: []types.Location{{StartLine: 759, EndLine: 759}}},
+		{Name: "faraday", Version: "0.15.4", Constraint: "~> 0.15.4", Locations: []types.Location{{StartLine: 761, EndLine: 761}}},
+		{Name: "ffi", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 763, EndLine: 763}}},
+		{Name: "globalid", Version: "0.4.2", Indirect: true, Locations: []types.Location{{StartLine: 764, EndLine: 764}}},
+		{Name: "i18n", Version: "1.6.0", Indirect: true, Locations: []types.Location{{StartLine: 766, EndLine: 766}}},
+		{Name: "jaro_winkler", Version: "1.5.2", Indirect: true, Locations: []types.Location{{StartLine: 768, EndLine: 768}}},
+		{Name: "jmespath", Version: "1.4.0", Indirect: true, Locations: []types.Location{{StartLine: 769, EndLine: 769}}},
+		{Name: "json", Version: "2.2.0", Constraint: "~> 2.2", Locations: []types.Location{{StartLine: 770, EndLine: 770}}},
+		{Name: "loofah", Version: "2.2.3", Indirect: true, Locations: []types.Location{{StartLine: 771, EndLine: 771}}},
+		{Name: "mail", Version: "2.7.1", Indirect: true, Locations: []types.Location{{StartLine: 774, EndLine: 774}}},
+		{Name: "marcel", Version: "0.3.3", Indirect: true, Locations: []types.Location{{StartLine: 776, EndLine: 776}}},
+		{Name: "method_source", Version: "0.9.2", Indirect: true, Locations: []types.Location{{StartLine: 778, EndLine: 778}}},
+		{Name: "mimemagic", Version: "0.3.3", Indirect: true, Locations: []types.Location{{StartLine: 779, EndLine: 779}}},
+		{Name: "mini_mime", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 780, EndLine: 780}}},
+		{Name: "mini_portile2", Version: "2.4.0", Indirect: true, Locations: []types.Location{{StartLine: 781, EndLine: 781}}},
+		{Name: "minitest", Version: "5.11.3", Indirect: true, Locations: []types.Location{{StartLine: 782, EndLine: 782}}},
+		{Name: "multi_json", Version: "1.13.1", Constraint: "~> 1.13", Locations: []types.Location{{StartLine: 783, EndLine: 783}}},
+		{Name: "multipart-post", Version: "2.0.0", Indirect: true, Locations: []types.Location{{StartLine: 784, EndLine: 784}}},
+		{Name: "mustermann", Version: "1.0.3", Indirect: true, Locations: []types.Location{{StartLine: 785, EndLine: 785}}},
+		{Name: "nio4r", Version: "2.3.1", Indirect: true, Locations: []types.Location{{StartLine: 786, EndLine: 786}}},
+		{Name: "nokogiri", Version: "1.10.3", Indirect: true, Locations: []types.Location{{StartLine: 787, EndLine: 787}}},
+		{Name: "parallel", Version: "1.17.0", Indirect: true, Locations: []types.Location{{StartLine: 789, EndLine: 789}}},
+		{Name: "parser", Version: "2.6.3.0", Indirect: true, Locations: []types.Location{{StartLine: 790, EndLine: 790}}},
+		{Name: "pry", Version: "0.12.2", Constraint: "~> 0.12.2", Locations: []types.Location{{StartLine: 792, EndLine: 792}}},
+		{Name: "psych", Version: "3.1.0", Indirect: true, Locations: []types.Location{{StartLine: 795, EndLine: 795}}},
+		{Name: "rack", Version: "2.0.7", Indirect: true, Locations: []types.Location{{StartLine: 796, EndLine: 796}}},
+		{Name: "rack-protection", Version: "2.0.5", Indirect: true, Locations: []types.Location{{StartLine: 797, EndLine: 797}}},
+		{Name: "rack-test", Version: "1.1.0", Indirect: true, Locations: []types.Location{{StartLine: 799, EndLine: 799}}},
+		{Name: "rails", Version: "5.2.3", Constraint: "~> 5.2", Locations: []types.Location{{StartLine: 801, EndLine: 801}}},
+		{Name: "rails-dom-testing", Version: "2.0.3", Indirect: true, Locations: []types.Location{{StartLine: 814, EndLine: 814}}},
+		{Name: "rails-html-sanitizer", Version: "1.0.4", Indirect: true, Locations: []types.Location{{StartLine: 817, EndLine: 817}}},
+		{Name: "railties", Version: "5.2.3", Indirect: true, Locations: []types.Location{{StartLine: 819, EndLine: 819}}},
+		{Name: "rainbow", Version: "3.0.0", Indirect: true, Locations: []types.Location{{StartLine: 825, EndLine: 825}}},
+		{Name: "rake", Version: "12.3.2", Indirect: true, Locations: []types.Location{{StartLine: 826, EndLine: 826}}},
+		{Name: "rb-fsevent", Version: "0.10.3", Indirect: true, Locations: []types.Location{{StartLine: 827, EndLine: 827}}},
+		{Name: "rb-inotify", Version: "0.10.0", Indirect: true, Locations: []types.Location{{StartLine: 828, EndLine: 828}}},
+		{Name: "rubocop", Version: "0.67.2", Constraint: "~> 0.67.2", Locations: []types.Location{{StartLine: 830, EndLine: 830}}},
+		{Name: "ruby-progressbar", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 838, EndLine: 838}}},
+		{Name: "sass", Version: "3.7.4", Constraint: "~> 3.7", Locations: []types.Location{{StartLine: 839, EndLine: 839}}},
+		{Name: "sass-listen", Version: "4.0.0", Indirect: true, Locations: []types.Location{{StartLine: 841, EndLine: 841}}},
+		{Name: "sinatra", Version: "2.0.5", Constraint: "~> 2.0", Locations: []types.Location{{StartLine: 844, EndLine: 844}}},
+		{Name: "sprockets", Version: "3.7.2", Indirect: true, Locations: []types.Location{{StartLine: 849, EndLine: 849}}},
+		{Name: "sprockets-rails", Version: "3.2.1", Indirect: true, Locations: []types.Location{{StartLine: 852, EndLine: 852}}},
+		{Name: "thor", Version: "0.20.3", Constraint: "~> 0.20.3", Locations: []types.Location{{StartLine: 856, EndLine: 856}}},
+		{Name: "thread_safe", Version: "0.3.6", Indirect: true, Locations: []types.Location{{StartLine: 857, EndLine: 857}}},
+		{Name: "tilt", Version: "2.0.9", Indirect: true, Locations: []types.Location{{StartLine: 858, EndLine: 858}}},
+		{Name: "tzinfo", Version: "1.2.5", Indirect: true, Locations: []types.Location{{StartLine: 859, EndLine: 859}}},
+		{Name: "unicode-display_width", Version: "1.5.0", Indirect: true, Locations: []types.Location{{StartLine: 861, EndLine: 861}}},
+		{Name: "websocket-driver", Version: "0.7.0", Indirect: true, Locations: []types.Location{{StartLine: 862, EndLine: 862}}},
+		{Name: "websocket-extensions", Version: "0.1.3", Indirect: true, Locations: []types.Location{{StartLine: 864, EndLine: 864}}},
+	}
+
+	// Native gems have the platform after the version
+	BundlerPlatform = []types.Library{
+		{Name: "nokogiri", Version: "1.13.10", Constraint: "~> 1.13", Qualifiers: map[string]string{"platform": "arm64-darwin"}, Locations: []types.Location{{StartLine: 4, EndLine: 4}}},
+		{Name: "nokogiri", Version: "1.13.10", Constraint: "~> 1.13", Qualifiers: map[string]string{"platform": "x86_64-linux"}, Locations: []types.Location{{StartLine: 6, EndLine: 6}}},
+		{Name: "racc", Version: "1.6.2", Indirect: true, Locations: []types.Location{{StartLine: 8, EndLine: 8}}},
 	}
 )
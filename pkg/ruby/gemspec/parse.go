@@ -10,7 +10,9 @@ import (
 
 	"golang.org/x/xerrors"
 
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 )
 
 const specNewStr = "Gem::Specification.new"
@@ -42,8 +44,26 @@ var (
 	licensesRegexp = regexp.MustCompile(`\.licenses\s*=\s*\[(?P<licenses>.+)\]`)
 )
 
-func Parse(r io.Reader) (types.Library, error) {
-	var newVar, name, version, license string
+// Parser implements types.Parser for *.gemspec
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	lib, err := Parse(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{lib}, nil, nil
+}
+
+func Parse(r io.Reader) (_ types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var newVar, name, version string
+	var licenses []string
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
@@ -68,32 +88,33 @@ func Parse(r io.Reader) (types.Library, error) {
 			version = trim(version)
 		case strings.HasPrefix(line, fmt.Sprintf("%s.licenses", newVar)):
 			// https://guides.rubygems.org/specification-reference/#licenses=
-			license = findSubString(licensesRegexp, line, "licenses")
-			license = parseLicenses(license)
+			licenses = parseLicenses(findSubString(licensesRegexp, line, "licenses"))
 		case strings.HasPrefix(line, fmt.Sprintf("%s.license", newVar)):
 			// https://guides.rubygems.org/specification-reference/#license=
-			license = findSubString(licenseRegexp, line, "license")
-			license = trim(license)
+			if license := trim(findSubString(licenseRegexp, line, "license")); license != "" {
+				licenses = []string{license}
+			}
 		}
 
 		// No need to iterate the loop anymore
-		if name != "" && version != "" && license != "" {
+		if name != "" && version != "" && len(licenses) > 0 {
 			break
 		}
 	}
 	if err := scanner.Err(); err != nil {
-		return types.Library{}, xerrors.Errorf("failed to parse gemspec: %w", err)
+		return types.Library{}, xerrors.Errorf("failed to parse gemspec: %w", &types.ErrMalformedInput{Err: err})
 	}
 
 	if name == "" || version == "" {
-		return types.Library{}, xerrors.New("failed to parse gemspec")
+		return types.Library{}, &types.ErrMalformedInput{Err: xerrors.New("failed to parse gemspec")}
 	}
 
-	return types.Library{
+	lib := types.Library{
 		Name:    name,
 		Version: version,
-		License: license,
-	}, nil
+	}
+	lib.SetLicenses(license.NormalizeAll(licenses))
+	return lib, nil
 }
 
 func findSubString(re *regexp.Regexp, line, name string) string {
@@ -111,7 +132,7 @@ func trim(s string) string {
 	return strings.Trim(s, `'"`)
 }
 
-func parseLicenses(s string) string {
+func parseLicenses(s string) []string {
 	// e.g. `"Ruby".freeze, "BSDL".freeze`
 	//      => {"\"Ruby\".freeze", "\"BSDL\".freeze"}
 	ss := strings.FieldsFunc(s, func(r rune) bool {
@@ -125,5 +146,5 @@ func parseLicenses(s string) string {
 		licenses = append(licenses, trim(l))
 	}
 
-	return strings.Join(licenses, ", ")
+	return licenses
 }
@@ -22,9 +22,10 @@ func TestParse(t *testing.T) {
 			name:      "happy",
 			inputFile: "testdata/normal00.gemspec",
 			want: types.Library{
-				Name:    "rake",
-				Version: "13.0.3",
-				License: "MIT",
+				Name:     "rake",
+				Version:  "13.0.3",
+				Licenses: []string{"MIT"},
+				License:  "MIT",
 			},
 		},
 		{
@@ -39,18 +40,20 @@ func TestParse(t *testing.T) {
 			name:      "license",
 			inputFile: "testdata/license.gemspec",
 			want: types.Library{
-				Name:    "async",
-				Version: "1.25.0",
-				License: "MIT",
+				Name:     "async",
+				Version:  "1.25.0",
+				Licenses: []string{"MIT"},
+				License:  "MIT",
 			},
 		},
 		{
 			name:      "multiple licenses",
 			inputFile: "testdata/multiple_licenses.gemspec",
 			want: types.Library{
-				Name:    "test-unit",
-				Version: "3.3.7",
-				License: "Ruby, BSDL, PSFL",
+				Name:     "test-unit",
+				Version:  "3.3.7",
+				Licenses: []string{"Ruby", "BSDL", "PSFL"},
+				License:  "Ruby, BSDL, PSFL",
 			},
 		},
 		{
@@ -1,35 +1,306 @@
 package cargo
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
 	"io"
+	"io/fs"
+	"path"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
 	"golang.org/x/xerrors"
 )
 
 type Lockfile struct {
-	Packages []struct {
-		Name         string   `toml:"name"`
-		Version      string   `toml:"version"`
-		Source       string   `toml:"source,omitempty"`
-		Dependencies []string `toml:"dependencies,omitempty"`
-	} `toml:"package"`
+	Packages []Package `toml:"package"`
 	Metadata interface{}
 }
 
+type Package struct {
+	Name         string   `toml:"name"`
+	Version      string   `toml:"version"`
+	Source       string   `toml:"source,omitempty"`
+	Dependencies []string `toml:"dependencies,omitempty"`
+}
+
+var (
+	packageHeader = []byte("[[package]]")
+	// Sub-tables of packages. e.g. [package.metadata]
+	packageSubtablePrefixes = [][]byte{[]byte("[package."), []byte("[[package.")}
+)
+
+// Parser implements types.Parser and types.FSParser for Cargo.lock
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return parse(r, nil)
+}
+
+// ParseFS parses Cargo.lock at filePath in fsys. The packages of Cargo.toml in the same directory,
+// including its workspace members, are returned as the roots.
+// Without Cargo.toml, the roots are told from Cargo.lock as Parse does.
+func (p *Parser) ParseFS(fsys fs.FS, filePath string) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	members, err := workspaceMembers(fsys, path.Dir(filePath))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("file open error: %w", err)
+	}
+	defer f.Close()
+	return parse(f, members)
+}
+
+// Parse parses Cargo.lock and returns the libraries.
+// The dependency graph between them is returned by Parser.Parse.
 func Parse(r io.Reader) ([]types.Library, error) {
-	var lockfile Lockfile
-	if _, err := toml.DecodeReader(r, &lockfile); err != nil {
-		return nil, xerrors.Errorf("decode error: %w", err)
+	libs, _, err := parse(r, nil)
+	return libs, err
+}
+
+// parse returns the libraries and the dependency graph between them.
+// The roots are the packages named in members, or the packages without a source which no other package depends on
+// if members is nil. Path dependencies don't have a source either, but are depended on by the roots.
+func parse(r io.Reader, members map[string]bool) (_ []types.Library, _ []types.Dependency, err error) {
+	defer utils.RecoverPanic(&err)
+
+	pkgs, locs, err := decodePackages(r)
+	if err != nil {
+		return nil, nil, err
 	}
+	lockfile := Lockfile{Packages: pkgs}
 
-	var libs []types.Library
+	versions := map[string][]string{}
 	for _, pkg := range lockfile.Packages {
-		libs = append(libs, types.Library{
-			Name:    pkg.Name,
-			Version: pkg.Version,
-		})
+		versions[pkg.Name] = append(versions[pkg.Name], pkg.Version)
+	}
+
+	ids := make([]string, len(lockfile.Packages))
+	dependsOn := make([][]string, len(lockfile.Packages))
+	dependedOn := map[string]bool{}
+	for i, pkg := range lockfile.Packages {
+		ids[i] = utils.PackageID(pkg.Name, pkg.Version)
+		for _, dep := range pkg.Dependencies {
+			if depID, ok := dependencyID(dep, versions); ok {
+				dependsOn[i] = append(dependsOn[i], depID)
+				dependedOn[depID] = true
+			}
+		}
+	}
+
+	roots := make([]bool, len(lockfile.Packages))
+	for i, pkg := range lockfile.Packages {
+		switch {
+		case pkg.Source != "":
+		case members != nil:
+			roots[i] = members[pkg.Name]
+		default:
+			roots[i] = !dependedOn[ids[i]]
+		}
+	}
+
+	// The dependencies of the roots are direct
+	direct := map[string]bool{}
+	for i := range lockfile.Packages {
+		if !roots[i] {
+			continue
+		}
+		for _, depID := range dependsOn[i] {
+			direct[depID] = true
+		}
+	}
+
+	var libs []types.Library
+	var deps []types.Dependency
+	for i, pkg := range lockfile.Packages {
+		lib := types.Library{
+			ID:       ids[i],
+			Name:     pkg.Name,
+			Version:  pkg.Version,
+			Indirect: !roots[i] && !direct[ids[i]],
+			Root:     roots[i],
+		}
+		lib.Locations = []types.Location{locs[i]}
+		libs = append(libs, lib)
+
+		if len(dependsOn[i]) > 0 {
+			deps = append(deps, types.Dependency{
+				ID:        ids[i],
+				DependsOn: dependsOn[i],
+			})
+		}
+	}
+	return libs, deps, nil
+}
+
+type manifest struct {
+	Package struct {
+		Name string `toml:"name"`
+	} `toml:"package"`
+	Workspace struct {
+		// Paths of the members, which may be globs. e.g. crates/*
+		Members []string `toml:"members"`
+		Exclude []string `toml:"exclude"`
+	} `toml:"workspace"`
+}
+
+// workspaceMembers returns the names of the package of Cargo.toml in dir and its workspace members.
+// It returns nil if Cargo.toml doesn't exist or names no package.
+func workspaceMembers(fsys fs.FS, dir string) (map[string]bool, error) {
+	root, err := readManifest(fsys, path.Join(dir, "Cargo.toml"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	members := map[string]bool{}
+	if root.Package.Name != "" {
+		members[root.Package.Name] = true
+	}
+	excluded := map[string]bool{}
+	for _, exclude := range root.Workspace.Exclude {
+		excluded[path.Join(dir, exclude)] = true
+	}
+	for _, pattern := range root.Workspace.Members {
+		matches, err := fs.Glob(fsys, path.Join(dir, pattern))
+		if err != nil {
+			return nil, xerrors.Errorf("workspace member error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		for _, match := range matches {
+			if excluded[match] {
+				continue
+			}
+			member, err := readManifest(fsys, path.Join(match, "Cargo.toml"))
+			if errors.Is(err, fs.ErrNotExist) {
+				// Globs may match files and directories without packages
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			if member.Package.Name != "" {
+				members[member.Package.Name] = true
+			}
+		}
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return members, nil
+}
+
+func readManifest(fsys fs.FS, filePath string) (manifest, error) {
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return manifest{}, xerrors.Errorf("file open error: %w", err)
+	}
+	defer f.Close()
+
+	var m manifest
+	if _, err = toml.DecodeReader(f, &m); err != nil {
+		return manifest{}, xerrors.Errorf("%s: decode error: %w", filePath, &types.ErrMalformedInput{Err: err})
+	}
+	return m, nil
+}
+
+// dependencyID returns the ID of the locked package the dependency refers to.
+// The version is only written when several versions of the crate are locked.
+// e.g. "libc", "libc 0.2.54" or "libc 0.2.54 (registry+https://github.com/rust-lang/crates.io-index)"
+func dependencyID(dep string, versions map[string][]string) (string, bool) {
+	fields := strings.Fields(dep)
+	switch {
+	case len(fields) == 0:
+		return "", false
+	case len(fields) > 1:
+		return utils.PackageID(fields[0], fields[1]), true
+	case len(versions[fields[0]]) == 1:
+		return utils.PackageID(fields[0], versions[fields[0]][0]), true
+	}
+	return "", false
+}
+
+// decodePackages decodes the [[package]] tables one by one while reading r, instead of decoding the whole document,
+// so that lock files of large workspaces are never held in memory. The other tables, such as [metadata], are skipped.
+// Table headers are told by the lines starting with "[", which can't start values in Cargo.lock.
+func decodePackages(r io.Reader) ([]Package, []types.Location, error) {
+	var pkgs []Package
+	var locs []types.Location
+	var table bytes.Buffer
+	var inTable bool
+
+	decode := func() error {
+		if !inTable {
+			return nil
+		}
+		var lockfile Lockfile
+		if _, err := toml.Decode(table.String(), &lockfile); err != nil {
+			return xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{
+				Err: xerrors.Errorf("package at line %d: %w", locs[len(locs)-1].StartLine, err),
+			})
+		}
+		if len(lockfile.Packages) != 1 {
+			return &types.ErrMalformedInput{Err: xerrors.Errorf("invalid package at line %d", locs[len(locs)-1].StartLine)}
+		}
+		pkgs = append(pkgs, lockfile.Packages[0])
+		table.Reset()
+		inTable = false
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		trimmed := bytes.TrimSpace(line)
+		switch {
+		case bytes.Equal(trimmed, packageHeader):
+			if err := decode(); err != nil {
+				return nil, nil, err
+			}
+			inTable = true
+			locs = append(locs, types.Location{StartLine: lineNum, EndLine: lineNum})
+		case bytes.HasPrefix(trimmed, []byte("[")) && !isPackageSubtable(trimmed):
+			if err := decode(); err != nil {
+				return nil, nil, err
+			}
+		}
+		if !inTable {
+			continue
+		}
+
+		table.Write(line)
+		table.WriteByte('\n')
+		if len(trimmed) > 0 && trimmed[0] != '#' {
+			locs[len(locs)-1].EndLine = lineNum
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+	if err := decode(); err != nil {
+		return nil, nil, err
+	}
+	return pkgs, locs, nil
+}
+
+func isPackageSubtable(header []byte) bool {
+	for _, prefix := range packageSubtablePrefixes {
+		if bytes.HasPrefix(header, prefix) {
+			return true
+		}
 	}
-	return libs, nil
+	return false
 }
@@ -1,6 +1,8 @@
 package cargo
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"path"
 	"sort"
@@ -15,20 +17,29 @@ import (
 
 func TestParse(t *testing.T) {
 	vectors := []struct {
-		file string // Test input file
-		want []types.Library
+		file     string // Test input file
+		want     []types.Library
+		wantDeps []types.Dependency
 	}{
 		{
-			file: "testdata/cargo_normal.lock",
-			want: cargoNormal,
+			file:     "testdata/cargo_normal.lock",
+			want:     cargoNormal,
+			wantDeps: cargoNormalDeps,
 		},
 		{
-			file: "testdata/cargo_many.lock",
-			want: cargoMany,
+			file:     "testdata/cargo_many.lock",
+			want:     cargoMany,
+			wantDeps: cargoManyDeps,
 		},
 		{
-			file: "testdata/cargo_nickel.lock",
-			want: cargoNickel,
+			file:     "testdata/cargo_nickel.lock",
+			want:     cargoNickel,
+			wantDeps: cargoNickelDeps,
+		},
+		{
+			file:     "testdata/cargo_path.lock",
+			want:     cargoPath,
+			wantDeps: cargoPathDeps,
 		},
 	}
 
@@ -37,7 +48,7 @@ func TestParse(t *testing.T) {
 			f, err := os.Open(v.file)
 			require.NoError(t, err)
 
-			got, err := Parse(f)
+			got, gotDeps, err := NewParser().Parse(f)
 			require.NoError(t, err)
 
 			sort.Slice(got, func(i, j int) bool {
@@ -57,6 +68,71 @@ func TestParse(t *testing.T) {
 			})
 
 			assert.Equal(t, v.want, got)
+			assert.Equal(t, v.wantDeps, gotDeps)
 		})
 	}
 }
+
+func TestParser_ParseFS(t *testing.T) {
+	fsys := os.DirFS("testdata")
+
+	t.Run("workspace", func(t *testing.T) {
+		got, gotDeps, err := (&Parser{}).ParseFS(fsys, "workspace/Cargo.lock")
+		require.NoError(t, err)
+		assert.Equal(t, cargoWorkspace, got)
+		assert.Equal(t, cargoWorkspaceDeps, gotDeps)
+	})
+
+	// Without Cargo.toml, "engine" depended on by "cli" is not told from path dependencies
+	t.Run("workspace without Cargo.toml", func(t *testing.T) {
+		f, err := fsys.Open("workspace/Cargo.lock")
+		require.NoError(t, err)
+		defer f.Close()
+
+		got, _, err := NewParser().Parse(f)
+		require.NoError(t, err)
+		require.Len(t, got, 4)
+		assert.True(t, got[0].Root)
+		assert.False(t, got[1].Root)
+	})
+
+	t.Run("no Cargo.toml", func(t *testing.T) {
+		got, gotDeps, err := (&Parser{}).ParseFS(fsys, "cargo_path.lock")
+		require.NoError(t, err)
+		assert.Equal(t, cargoPath, got)
+		assert.Equal(t, cargoPathDeps, gotDeps)
+	})
+}
+
+func TestParse_Malformed(t *testing.T) {
+	lock := "[[package]]\nname = \"libc\"\nversion = \"0.2.54\"\n\n[[package]]\nname = \"broken\nversion = \"1.0.0\"\n"
+	_, err := Parse(strings.NewReader(lock))
+	require.Error(t, err)
+
+	var malformedErr *types.ErrMalformedInput
+	assert.True(t, errors.As(err, &malformedErr), err)
+	assert.Contains(t, err.Error(), "package at line 5")
+}
+
+// BenchmarkParse parses a lock file of a large workspace, whose packages are decoded one by one.
+func BenchmarkParse(b *testing.B) {
+	var lock strings.Builder
+	lock.WriteString("# This file is automatically @generated by Cargo.\nversion = 3\n")
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&lock, "\n[[package]]\nname = \"crate-%d\"\nversion = \"1.0.%d\"\n", i, i)
+		lock.WriteString("source = \"registry+https://github.com/rust-lang/crates.io-index\"\n")
+		lock.WriteString("checksum = \"c6785aa7dd976f5fbf3b71cfd9cd49d7f783c1ff565a858d71031c6c313aa5c6\"\n")
+		if i > 0 {
+			fmt.Fprintf(&lock, "dependencies = [\n \"crate-%d\",\n]\n", i-1)
+		}
+	}
+	s := lock.String()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := NewParser().Parse(strings.NewReader(s)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -12,8 +12,8 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoNormal = []types.Library{
-		{"normal", "0.1.0", ""},
-		{"libc", "0.2.54", ""},
+		{ID: "normal@0.1.0", Name: "normal", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 8, EndLine: 13}}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Locations: []types.Location{{StartLine: 3, EndLine: 6}}},
 	}
 
 	// docker run --name cargo --rm -it rust:1.45 bash
@@ -25,105 +25,105 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoMany = []types.Library{
-		{"many", "0.1.0", ""},
-		{"aho-corasick", "0.7.3", ""},
-		{"autocfg", "0.1.2", ""},
-		{"base64", "0.10.1", ""},
-		{"base64", "0.9.3", ""},
-		{"bitflags", "1.0.4", ""},
-		{"block-buffer", "0.7.3", ""},
-		{"block-padding", "0.1.4", ""},
-		{"byte-tools", "0.3.1", ""},
-		{"byteorder", "1.3.1", ""},
-		{"cc", "1.0.36", ""},
-		{"cfg-if", "0.1.7", ""},
-		{"cloudabi", "0.0.3", ""},
-		{"cookie", "0.11.1", ""},
-		{"devise", "0.2.0", ""},
-		{"devise_codegen", "0.2.0", ""},
-		{"devise_core", "0.2.0", ""},
-		{"digest", "0.8.0", ""},
-		{"fake-simd", "0.1.2", ""},
-		{"fuchsia-cprng", "0.1.1", ""},
-		{"generic-array", "0.12.0", ""},
-		{"handlebars", "1.1.0", ""},
-		{"httparse", "1.3.3", ""},
-		{"hyper", "0.10.16", ""},
-		{"idna", "0.1.5", ""},
-		{"indexmap", "1.0.2", ""},
-		{"isatty", "0.1.9", ""},
-		{"itoa", "0.4.4", ""},
-		{"language-tags", "0.2.2", ""},
-		{"lazy_static", "1.3.0", ""},
-		{"libc", "0.2.54", ""},
-		{"log", "0.3.9", ""},
-		{"log", "0.4.6", ""},
-		{"maplit", "1.0.1", ""},
-		{"matches", "0.1.8", ""},
-		{"memchr", "2.2.0", ""},
-		{"mime", "0.2.6", ""},
-		{"num_cpus", "1.10.0", ""},
-		{"opaque-debug", "0.2.2", ""},
-		{"pear", "0.1.2", ""},
-		{"pear_codegen", "0.1.2", ""},
-		{"percent-encoding", "1.0.1", ""},
-		{"pest", "2.1.1", ""},
-		{"pest_derive", "2.1.0", ""},
-		{"pest_generator", "2.1.0", ""},
-		{"pest_meta", "2.1.1", ""},
-		{"proc-macro2", "0.4.30", ""},
-		{"quick-error", "1.2.2", ""},
-		{"quote", "0.6.12", ""},
-		{"rand", "0.6.5", ""},
-		{"rand_chacha", "0.1.1", ""},
-		{"rand_core", "0.3.1", ""},
-		{"rand_core", "0.4.0", ""},
-		{"rand_hc", "0.1.0", ""},
-		{"rand_isaac", "0.1.1", ""},
-		{"rand_jitter", "0.1.4", ""},
-		{"rand_os", "0.1.3", ""},
-		{"rand_pcg", "0.1.2", ""},
-		{"rand_xorshift", "0.1.1", ""},
-		{"rdrand", "0.4.0", ""},
-		{"redox_syscall", "0.1.54", ""},
-		{"regex", "1.1.6", ""},
-		{"regex-syntax", "0.6.6", ""},
-		{"ring", "0.13.5", ""},
-		{"rocket", "0.4.0", ""},
-		{"rocket_codegen", "0.4.0", ""},
-		{"rocket_http", "0.4.0", ""},
-		{"ryu", "0.2.8", ""},
-		{"safemem", "0.3.0", ""},
-		{"same-file", "1.0.4", ""},
-		{"serde", "1.0.91", ""},
-		{"serde_json", "1.0.39", ""},
-		{"sha-1", "0.8.1", ""},
-		{"smallvec", "0.6.9", ""},
-		{"state", "0.4.1", ""},
-		{"syn", "0.15.34", ""},
-		{"thread_local", "0.3.6", ""},
-		{"time", "0.1.42", ""},
-		{"toml", "0.4.10", ""},
-		{"traitobject", "0.1.0", ""},
-		{"typeable", "0.1.2", ""},
-		{"typenum", "1.10.0", ""},
-		{"ucd-trie", "0.1.1", ""},
-		{"ucd-util", "0.1.3", ""},
-		{"unicase", "1.4.2", ""},
-		{"unicode-bidi", "0.3.4", ""},
-		{"unicode-normalization", "0.1.8", ""},
-		{"unicode-xid", "0.1.0", ""},
-		{"untrusted", "0.6.2", ""},
-		{"url", "1.7.2", ""},
-		{"utf8-ranges", "1.0.2", ""},
-		{"version_check", "0.1.5", ""},
-		{"walkdir", "2.2.7", ""},
-		{"winapi", "0.3.7", ""},
-		{"winapi-i686-pc-windows-gnu", "0.4.0", ""},
-		{"winapi-util", "0.1.2", ""},
-		{"winapi-x86_64-pc-windows-gnu", "0.4.0", ""},
-		{"yansi", "0.4.0", ""},
-		{"yansi", "0.5.0", ""},
+		{ID: "many@0.1.0", Name: "many", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 252, EndLine: 266}}},
+		{ID: "aho-corasick@0.7.3", Name: "aho-corasick", Version: "0.7.3", Indirect: true, Locations: []types.Location{{StartLine: 3, EndLine: 9}}},
+		{ID: "autocfg@0.1.2", Name: "autocfg", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 11, EndLine: 14}}},
+		{ID: "base64@0.10.1", Name: "base64", Version: "0.10.1", Indirect: true, Locations: []types.Location{{StartLine: 25, EndLine: 31}}},
+		{ID: "base64@0.9.3", Name: "base64", Version: "0.9.3", Indirect: true, Locations: []types.Location{{StartLine: 16, EndLine: 23}}},
+		{ID: "bitflags@1.0.4", Name: "bitflags", Version: "1.0.4", Locations: []types.Location{{StartLine: 33, EndLine: 36}}},
+		{ID: "block-buffer@0.7.3", Name: "block-buffer", Version: "0.7.3", Indirect: true, Locations: []types.Location{{StartLine: 38, EndLine: 47}}},
+		{ID: "block-padding@0.1.4", Name: "block-padding", Version: "0.1.4", Indirect: true, Locations: []types.Location{{StartLine: 49, EndLine: 55}}},
+		{ID: "byte-tools@0.3.1", Name: "byte-tools", Version: "0.3.1", Indirect: true, Locations: []types.Location{{StartLine: 57, EndLine: 60}}},
+		{ID: "byteorder@1.3.1", Name: "byteorder", Version: "1.3.1", Indirect: true, Locations: []types.Location{{StartLine: 62, EndLine: 65}}},
+		{ID: "cc@1.0.36", Name: "cc", Version: "1.0.36", Indirect: true, Locations: []types.Location{{StartLine: 67, EndLine: 70}}},
+		{ID: "cfg-if@0.1.7", Name: "cfg-if", Version: "0.1.7", Indirect: true, Locations: []types.Location{{StartLine: 72, EndLine: 75}}},
+		{ID: "cloudabi@0.0.3", Name: "cloudabi", Version: "0.0.3", Indirect: true, Locations: []types.Location{{StartLine: 77, EndLine: 83}}},
+		{ID: "cookie@0.11.1", Name: "cookie", Version: "0.11.1", Indirect: true, Locations: []types.Location{{StartLine: 85, EndLine: 94}}},
+		{ID: "devise@0.2.0", Name: "devise", Version: "0.2.0", Indirect: true, Locations: []types.Location{{StartLine: 96, EndLine: 103}}},
+		{ID: "devise_codegen@0.2.0", Name: "devise_codegen", Version: "0.2.0", Indirect: true, Locations: []types.Location{{StartLine: 105, EndLine: 112}}},
+		{ID: "devise_core@0.2.0", Name: "devise_core", Version: "0.2.0", Indirect: true, Locations: []types.Location{{StartLine: 114, EndLine: 123}}},
+		{ID: "digest@0.8.0", Name: "digest", Version: "0.8.0", Indirect: true, Locations: []types.Location{{StartLine: 125, EndLine: 131}}},
+		{ID: "fake-simd@0.1.2", Name: "fake-simd", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 133, EndLine: 136}}},
+		{ID: "fuchsia-cprng@0.1.1", Name: "fuchsia-cprng", Version: "0.1.1", Indirect: true, Locations: []types.Location{{StartLine: 138, EndLine: 141}}},
+		{ID: "generic-array@0.12.0", Name: "generic-array", Version: "0.12.0", Indirect: true, Locations: []types.Location{{StartLine: 143, EndLine: 149}}},
+		{ID: "handlebars@1.1.0", Name: "handlebars", Version: "1.1.0", Locations: []types.Location{{StartLine: 151, EndLine: 165}}},
+		{ID: "httparse@1.3.3", Name: "httparse", Version: "1.3.3", Indirect: true, Locations: []types.Location{{StartLine: 167, EndLine: 170}}},
+		{ID: "hyper@0.10.16", Name: "hyper", Version: "0.10.16", Indirect: true, Locations: []types.Location{{StartLine: 172, EndLine: 188}}},
+		{ID: "idna@0.1.5", Name: "idna", Version: "0.1.5", Indirect: true, Locations: []types.Location{{StartLine: 190, EndLine: 198}}},
+		{ID: "indexmap@1.0.2", Name: "indexmap", Version: "1.0.2", Indirect: true, Locations: []types.Location{{StartLine: 200, EndLine: 203}}},
+		{ID: "isatty@0.1.9", Name: "isatty", Version: "0.1.9", Indirect: true, Locations: []types.Location{{StartLine: 205, EndLine: 214}}},
+		{ID: "itoa@0.4.4", Name: "itoa", Version: "0.4.4", Indirect: true, Locations: []types.Location{{StartLine: 216, EndLine: 219}}},
+		{ID: "language-tags@0.2.2", Name: "language-tags", Version: "0.2.2", Indirect: true, Locations: []types.Location{{StartLine: 221, EndLine: 224}}},
+		{ID: "lazy_static@1.3.0", Name: "lazy_static", Version: "1.3.0", Locations: []types.Location{{StartLine: 226, EndLine: 229}}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Indirect: true, Locations: []types.Location{{StartLine: 231, EndLine: 234}}},
+		{ID: "log@0.3.9", Name: "log", Version: "0.3.9", Indirect: true, Locations: []types.Location{{StartLine: 236, EndLine: 242}}},
+		{ID: "log@0.4.6", Name: "log", Version: "0.4.6", Locations: []types.Location{{StartLine: 244, EndLine: 250}}},
+		{ID: "maplit@1.0.1", Name: "maplit", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 268, EndLine: 271}}},
+		{ID: "matches@0.1.8", Name: "matches", Version: "0.1.8", Indirect: true, Locations: []types.Location{{StartLine: 273, EndLine: 276}}},
+		{ID: "memchr@2.2.0", Name: "memchr", Version: "2.2.0", Indirect: true, Locations: []types.Location{{StartLine: 278, EndLine: 281}}},
+		{ID: "mime@0.2.6", Name: "mime", Version: "0.2.6", Indirect: true, Locations: []types.Location{{StartLine: 283, EndLine: 289}}},
+		{ID: "num_cpus@1.10.0", Name: "num_cpus", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 291, EndLine: 297}}},
+		{ID: "opaque-debug@0.2.2", Name: "opaque-debug", Version: "0.2.2", Indirect: true, Locations: []types.Location{{StartLine: 299, EndLine: 302}}},
+		{ID: "pear@0.1.2", Name: "pear", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 304, EndLine: 310}}},
+		{ID: "pear_codegen@0.1.2", Name: "pear_codegen", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 312, EndLine: 322}}},
+		{ID: "percent-encoding@1.0.1", Name: "percent-encoding", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 324, EndLine: 327}}},
+		{ID: "pest@2.1.1", Name: "pest", Version: "2.1.1", Indirect: true, Locations: []types.Location{{StartLine: 329, EndLine: 335}}},
+		{ID: "pest_derive@2.1.0", Name: "pest_derive", Version: "2.1.0", Indirect: true, Locations: []types.Location{{StartLine: 337, EndLine: 344}}},
+		{ID: "pest_generator@2.1.0", Name: "pest_generator", Version: "2.1.0", Indirect: true, Locations: []types.Location{{StartLine: 346, EndLine: 356}}},
+		{ID: "pest_meta@2.1.1", Name: "pest_meta", Version: "2.1.1", Indirect: true, Locations: []types.Location{{StartLine: 358, EndLine: 366}}},
+		{ID: "proc-macro2@0.4.30", Name: "proc-macro2", Version: "0.4.30", Indirect: true, Locations: []types.Location{{StartLine: 368, EndLine: 374}}},
+		{ID: "quick-error@1.2.2", Name: "quick-error", Version: "1.2.2", Indirect: true, Locations: []types.Location{{StartLine: 376, EndLine: 379}}},
+		{ID: "quote@0.6.12", Name: "quote", Version: "0.6.12", Locations: []types.Location{{StartLine: 381, EndLine: 387}}},
+		{ID: "rand@0.6.5", Name: "rand", Version: "0.6.5", Locations: []types.Location{{StartLine: 389, EndLine: 405}}},
+		{ID: "rand_chacha@0.1.1", Name: "rand_chacha", Version: "0.1.1", Indirect: true, Locations: []types.Location{{StartLine: 407, EndLine: 414}}},
+		{ID: "rand_core@0.3.1", Name: "rand_core", Version: "0.3.1", Indirect: true, Locations: []types.Location{{StartLine: 416, EndLine: 422}}},
+		{ID: "rand_core@0.4.0", Name: "rand_core", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 424, EndLine: 427}}},
+		{ID: "rand_hc@0.1.0", Name: "rand_hc", Version: "0.1.0", Indirect: true, Locations: []types.Location{{StartLine: 429, EndLine: 435}}},
+		{ID: "rand_isaac@0.1.1", Name: "rand_isaac", Version: "0.1.1", Indirect: true, Locations: []types.Location{{StartLine: 437, EndLine: 443}}},
+		{ID: "rand_jitter@0.1.4", Name: "rand_jitter", Version: "0.1.4", Indirect: true, Locations: []types.Location{{StartLine: 445, EndLine: 453}}},
+		{ID: "rand_os@0.1.3", Name: "rand_os", Version: "0.1.3", Indirect: true, Locations: []types.Location{{StartLine: 455, EndLine: 466}}},
+		{ID: "rand_pcg@0.1.2", Name: "rand_pcg", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 468, EndLine: 475}}},
+		{ID: "rand_xorshift@0.1.1", Name: "rand_xorshift", Version: "0.1.1", Indirect: true, Locations: []types.Location{{StartLine: 477, EndLine: 483}}},
+		{ID: "rdrand@0.4.0", Name: "rdrand", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 485, EndLine: 491}}},
+		{ID: "redox_syscall@0.1.54", Name: "redox_syscall", Version: "0.1.54", Indirect: true, Locations: []types.Location{{StartLine: 493, EndLine: 496}}},
+		{ID: "regex@1.1.6", Name: "regex", Version: "1.1.6", Locations: []types.Location{{StartLine: 498, EndLine: 508}}},
+		{ID: "regex-syntax@0.6.6", Name: "regex-syntax", Version: "0.6.6", Indirect: true, Locations: []types.Location{{StartLine: 510, EndLine: 516}}},
+		{ID: "ring@0.13.5", Name: "ring", Version: "0.13.5", Indirect: true, Locations: []types.Location{{StartLine: 518, EndLine: 527}}},
+		{ID: "rocket@0.4.0", Name: "rocket", Version: "0.4.0", Locations: []types.Location{{StartLine: 529, EndLine: 547}}},
+		{ID: "rocket_codegen@0.4.0", Name: "rocket_codegen", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 549, EndLine: 560}}},
+		{ID: "rocket_http@0.4.0", Name: "rocket_http", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 562, EndLine: 576}}},
+		{ID: "ryu@0.2.8", Name: "ryu", Version: "0.2.8", Indirect: true, Locations: []types.Location{{StartLine: 578, EndLine: 581}}},
+		{ID: "safemem@0.3.0", Name: "safemem", Version: "0.3.0", Indirect: true, Locations: []types.Location{{StartLine: 583, EndLine: 586}}},
+		{ID: "same-file@1.0.4", Name: "same-file", Version: "1.0.4", Indirect: true, Locations: []types.Location{{StartLine: 588, EndLine: 594}}},
+		{ID: "serde@1.0.91", Name: "serde", Version: "1.0.91", Locations: []types.Location{{StartLine: 596, EndLine: 599}}},
+		{ID: "serde_json@1.0.39", Name: "serde_json", Version: "1.0.39", Indirect: true, Locations: []types.Location{{StartLine: 601, EndLine: 609}}},
+		{ID: "sha-1@0.8.1", Name: "sha-1", Version: "0.8.1", Indirect: true, Locations: []types.Location{{StartLine: 611, EndLine: 620}}},
+		{ID: "smallvec@0.6.9", Name: "smallvec", Version: "0.6.9", Indirect: true, Locations: []types.Location{{StartLine: 622, EndLine: 625}}},
+		{ID: "state@0.4.1", Name: "state", Version: "0.4.1", Indirect: true, Locations: []types.Location{{StartLine: 627, EndLine: 630}}},
+		{ID: "syn@0.15.34", Name: "syn", Version: "0.15.34", Locations: []types.Location{{StartLine: 632, EndLine: 640}}},
+		{ID: "thread_local@0.3.6", Name: "thread_local", Version: "0.3.6", Indirect: true, Locations: []types.Location{{StartLine: 642, EndLine: 648}}},
+		{ID: "time@0.1.42", Name: "time", Version: "0.1.42", Indirect: true, Locations: []types.Location{{StartLine: 650, EndLine: 658}}},
+		{ID: "toml@0.4.10", Name: "toml", Version: "0.4.10", Indirect: true, Locations: []types.Location{{StartLine: 660, EndLine: 666}}},
+		{ID: "traitobject@0.1.0", Name: "traitobject", Version: "0.1.0", Indirect: true, Locations: []types.Location{{StartLine: 668, EndLine: 671}}},
+		{ID: "typeable@0.1.2", Name: "typeable", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 673, EndLine: 676}}},
+		{ID: "typenum@1.10.0", Name: "typenum", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 678, EndLine: 681}}},
+		{ID: "ucd-trie@0.1.1", Name: "ucd-trie", Version: "0.1.1", Indirect: true, Locations: []types.Location{{StartLine: 683, EndLine: 686}}},
+		{ID: "ucd-util@0.1.3", Name: "ucd-util", Version: "0.1.3", Indirect: true, Locations: []types.Location{{StartLine: 688, EndLine: 691}}},
+		{ID: "unicase@1.4.2", Name: "unicase", Version: "1.4.2", Indirect: true, Locations: []types.Location{{StartLine: 693, EndLine: 699}}},
+		{ID: "unicode-bidi@0.3.4", Name: "unicode-bidi", Version: "0.3.4", Indirect: true, Locations: []types.Location{{StartLine: 701, EndLine: 707}}},
+		{ID: "unicode-normalization@0.1.8", Name: "unicode-normalization", Version: "0.1.8", Indirect: true, Locations: []types.Location{{StartLine: 709, EndLine: 715}}},
+		{ID: "unicode-xid@0.1.0", Name: "unicode-xid", Version: "0.1.0", Indirect: true, Locations: []types.Location{{StartLine: 717, EndLine: 720}}},
+		{ID: "untrusted@0.6.2", Name: "untrusted", Version: "0.6.2", Indirect: true, Locations: []types.Location{{StartLine: 722, EndLine: 725}}},
+		{ID: "url@1.7.2", Name: "url", Version: "1.7.2", Indirect: true, Locations: []types.Location{{StartLine: 727, EndLine: 735}}},
+		{ID: "utf8-ranges@1.0.2", Name: "utf8-ranges", Version: "1.0.2", Indirect: true, Locations: []types.Location{{StartLine: 737, EndLine: 740}}},
+		{ID: "version_check@0.1.5", Name: "version_check", Version: "0.1.5", Indirect: true, Locations: []types.Location{{StartLine: 742, EndLine: 745}}},
+		{ID: "walkdir@2.2.7", Name: "walkdir", Version: "2.2.7", Indirect: true, Locations: []types.Location{{StartLine: 747, EndLine: 755}}},
+		{ID: "winapi@0.3.7", Name: "winapi", Version: "0.3.7", Indirect: true, Locations: []types.Location{{StartLine: 757, EndLine: 764}}},
+		{ID: "winapi-i686-pc-windows-gnu@0.4.0", Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 766, EndLine: 769}}},
+		{ID: "winapi-util@0.1.2", Name: "winapi-util", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 771, EndLine: 777}}},
+		{ID: "winapi-x86_64-pc-windows-gnu@0.4.0", Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 779, EndLine: 782}}},
+		{ID: "yansi@0.4.0", Name: "yansi", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 784, EndLine: 787}}},
+		{ID: "yansi@0.5.0", Name: "yansi", Version: "0.5.0", Indirect: true, Locations: []types.Location{{StartLine: 789, EndLine: 792}}},
 	}
 
 	// docker run --name cargo --rm -it rust:1.45 bash
@@ -135,53 +135,170 @@ var (
 	// cargo update
 	// cargo metadata  | jq -rc '.packages[] | "{\"\(.name)\", \"\(.version)\", \"\"},"'
 	cargoNickel = []types.Library{
-		{"web", "0.1.0", ""},
-		{"aho-corasick", "0.7.3", ""},
-		{"base64", "0.9.3", ""},
-		{"byteorder", "1.3.1", ""},
-		{"cfg-if", "0.1.7", ""},
-		{"groupable", "0.2.0", ""},
-		{"httparse", "1.3.3", ""},
-		{"hyper", "0.10.16", ""},
-		{"idna", "0.1.5", ""},
-		{"itoa", "0.4.4", ""},
-		{"language-tags", "0.2.2", ""},
-		{"lazy_static", "1.3.0", ""},
-		{"libc", "0.2.54", ""},
-		{"log", "0.3.9", ""},
-		{"log", "0.4.6", ""},
-		{"matches", "0.1.8", ""},
-		{"memchr", "2.2.0", ""},
-		{"mime", "0.2.6", ""},
-		{"modifier", "0.1.0", ""},
-		{"mustache", "0.9.0", ""},
-		{"nickel", "0.11.0", ""},
-		{"num_cpus", "1.10.0", ""},
-		{"percent-encoding", "1.0.1", ""},
-		{"plugin", "0.2.6", ""},
-		{"redox_syscall", "0.1.54", ""},
-		{"regex", "1.1.6", ""},
-		{"regex-syntax", "0.6.6", ""},
-		{"ryu", "0.2.8", ""},
-		{"safemem", "0.3.0", ""},
-		{"serde", "1.0.91", ""},
-		{"serde_json", "1.0.39", ""},
-		{"smallvec", "0.6.9", ""},
-		{"thread_local", "0.3.6", ""},
-		{"time", "0.1.42", ""},
-		{"traitobject", "0.1.0", ""},
-		{"typeable", "0.1.2", ""},
-		{"typemap", "0.3.3", ""},
-		{"ucd-util", "0.1.3", ""},
-		{"unicase", "1.4.2", ""},
-		{"unicode-bidi", "0.3.4", ""},
-		{"unicode-normalization", "0.1.8", ""},
-		{"unsafe-any", "0.4.2", ""},
-		{"url", "1.7.2", ""},
-		{"utf8-ranges", "1.0.2", ""},
-		{"version_check", "0.1.5", ""},
-		{"winapi", "0.3.7", ""},
-		{"winapi-i686-pc-windows-gnu", "0.4.0", ""},
-		{"winapi-x86_64-pc-windows-gnu", "0.4.0", ""},
+		{ID: "web@0.1.0", Name: "web", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 136, EndLine: 141}}},
+		{ID: "aho-corasick@0.7.3", Name: "aho-corasick", Version: "0.7.3", Indirect: true, Locations: []types.Location{{StartLine: 3, EndLine: 9}}},
+		{ID: "base64@0.9.3", Name: "base64", Version: "0.9.3", Indirect: true, Locations: []types.Location{{StartLine: 11, EndLine: 18}}},
+		{ID: "byteorder@1.3.1", Name: "byteorder", Version: "1.3.1", Indirect: true, Locations: []types.Location{{StartLine: 20, EndLine: 23}}},
+		{ID: "cfg-if@0.1.7", Name: "cfg-if", Version: "0.1.7", Indirect: true, Locations: []types.Location{{StartLine: 25, EndLine: 28}}},
+		{ID: "groupable@0.2.0", Name: "groupable", Version: "0.2.0", Indirect: true, Locations: []types.Location{{StartLine: 30, EndLine: 33}}},
+		{ID: "httparse@1.3.3", Name: "httparse", Version: "1.3.3", Indirect: true, Locations: []types.Location{{StartLine: 35, EndLine: 38}}},
+		{ID: "hyper@0.10.16", Name: "hyper", Version: "0.10.16", Indirect: true, Locations: []types.Location{{StartLine: 40, EndLine: 56}}},
+		{ID: "idna@0.1.5", Name: "idna", Version: "0.1.5", Indirect: true, Locations: []types.Location{{StartLine: 58, EndLine: 66}}},
+		{ID: "itoa@0.4.4", Name: "itoa", Version: "0.4.4", Indirect: true, Locations: []types.Location{{StartLine: 68, EndLine: 71}}},
+		{ID: "language-tags@0.2.2", Name: "language-tags", Version: "0.2.2", Indirect: true, Locations: []types.Location{{StartLine: 73, EndLine: 76}}},
+		{ID: "lazy_static@1.3.0", Name: "lazy_static", Version: "1.3.0", Indirect: true, Locations: []types.Location{{StartLine: 78, EndLine: 81}}},
+		{ID: "libc@0.2.54", Name: "libc", Version: "0.2.54", Indirect: true, Locations: []types.Location{{StartLine: 83, EndLine: 86}}},
+		{ID: "log@0.3.9", Name: "log", Version: "0.3.9", Indirect: true, Locations: []types.Location{{StartLine: 88, EndLine: 94}}},
+		{ID: "log@0.4.6", Name: "log", Version: "0.4.6", Indirect: true, Locations: []types.Location{{StartLine: 96, EndLine: 102}}},
+		{ID: "matches@0.1.8", Name: "matches", Version: "0.1.8", Indirect: true, Locations: []types.Location{{StartLine: 104, EndLine: 107}}},
+		{ID: "memchr@2.2.0", Name: "memchr", Version: "2.2.0", Indirect: true, Locations: []types.Location{{StartLine: 109, EndLine: 112}}},
+		{ID: "mime@0.2.6", Name: "mime", Version: "0.2.6", Indirect: true, Locations: []types.Location{{StartLine: 114, EndLine: 120}}},
+		{ID: "modifier@0.1.0", Name: "modifier", Version: "0.1.0", Indirect: true, Locations: []types.Location{{StartLine: 122, EndLine: 125}}},
+		{ID: "mustache@0.9.0", Name: "mustache", Version: "0.9.0", Indirect: true, Locations: []types.Location{{StartLine: 127, EndLine: 134}}},
+		{ID: "nickel@0.11.0", Name: "nickel", Version: "0.11.0", Locations: []types.Location{{StartLine: 143, EndLine: 161}}},
+		{ID: "num_cpus@1.10.0", Name: "num_cpus", Version: "1.10.0", Indirect: true, Locations: []types.Location{{StartLine: 163, EndLine: 169}}},
+		{ID: "percent-encoding@1.0.1", Name: "percent-encoding", Version: "1.0.1", Indirect: true, Locations: []types.Location{{StartLine: 171, EndLine: 174}}},
+		{ID: "plugin@0.2.6", Name: "plugin", Version: "0.2.6", Indirect: true, Locations: []types.Location{{StartLine: 176, EndLine: 182}}},
+		{ID: "redox_syscall@0.1.54", Name: "redox_syscall", Version: "0.1.54", Indirect: true, Locations: []types.Location{{StartLine: 184, EndLine: 187}}},
+		{ID: "regex@1.1.6", Name: "regex", Version: "1.1.6", Indirect: true, Locations: []types.Location{{StartLine: 189, EndLine: 199}}},
+		{ID: "regex-syntax@0.6.6", Name: "regex-syntax", Version: "0.6.6", Indirect: true, Locations: []types.Location{{StartLine: 201, EndLine: 207}}},
+		{ID: "ryu@0.2.8", Name: "ryu", Version: "0.2.8", Indirect: true, Locations: []types.Location{{StartLine: 209, EndLine: 212}}},
+		{ID: "safemem@0.3.0", Name: "safemem", Version: "0.3.0", Indirect: true, Locations: []types.Location{{StartLine: 214, EndLine: 217}}},
+		{ID: "serde@1.0.91", Name: "serde", Version: "1.0.91", Indirect: true, Locations: []types.Location{{StartLine: 219, EndLine: 222}}},
+		{ID: "serde_json@1.0.39", Name: "serde_json", Version: "1.0.39", Indirect: true, Locations: []types.Location{{StartLine: 224, EndLine: 232}}},
+		{ID: "smallvec@0.6.9", Name: "smallvec", Version: "0.6.9", Indirect: true, Locations: []types.Location{{StartLine: 234, EndLine: 237}}},
+		{ID: "thread_local@0.3.6", Name: "thread_local", Version: "0.3.6", Indirect: true, Locations: []types.Location{{StartLine: 239, EndLine: 245}}},
+		{ID: "time@0.1.42", Name: "time", Version: "0.1.42", Indirect: true, Locations: []types.Location{{StartLine: 247, EndLine: 255}}},
+		{ID: "traitobject@0.1.0", Name: "traitobject", Version: "0.1.0", Indirect: true, Locations: []types.Location{{StartLine: 257, EndLine: 260}}},
+		{ID: "typeable@0.1.2", Name: "typeable", Version: "0.1.2", Indirect: true, Locations: []types.Location{{StartLine: 262, EndLine: 265}}},
+		{ID: "typemap@0.3.3", Name: "typemap", Version: "0.3.3", Indirect: true, Locations: []types.Location{{StartLine: 267, EndLine: 273}}},
+		{ID: "ucd-util@0.1.3", Name: "ucd-util", Version: "0.1.3", Indirect: true, Locations: []types.Location{{StartLine: 275, EndLine: 278}}},
+		{ID: "unicase@1.4.2", Name: "unicase", Version: "1.4.2", Indirect: true, Locations: []types.Location{{StartLine: 280, EndLine: 286}}},
+		{ID: "unicode-bidi@0.3.4", Name: "unicode-bidi", Version: "0.3.4", Indirect: true, Locations: []types.Location{{StartLine: 288, EndLine: 294}}},
+		{ID: "unicode-normalization@0.1.8", Name: "unicode-normalization", Version: "0.1.8", Indirect: true, Locations: []types.Location{{StartLine: 296, EndLine: 302}}},
+		{ID: "unsafe-any@0.4.2", Name: "unsafe-any", Version: "0.4.2", Indirect: true, Locations: []types.Location{{StartLine: 304, EndLine: 310}}},
+		{ID: "url@1.7.2", Name: "url", Version: "1.7.2", Indirect: true, Locations: []types.Location{{StartLine: 312, EndLine: 320}}},
+		{ID: "utf8-ranges@1.0.2", Name: "utf8-ranges", Version: "1.0.2", Indirect: true, Locations: []types.Location{{StartLine: 322, EndLine: 325}}},
+		{ID: "version_check@0.1.5", Name: "version_check", Version: "0.1.5", Indirect: true, Locations: []types.Location{{StartLine: 327, EndLine: 330}}},
+		{ID: "winapi@0.3.7", Name: "winapi", Version: "0.3.7", Indirect: true, Locations: []types.Location{{StartLine: 332, EndLine: 339}}},
+		{ID: "winapi-i686-pc-windows-gnu@0.4.0", Name: "winapi-i686-pc-windows-gnu", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 341, EndLine: 344}}},
+		{ID: "winapi-x86_64-pc-windows-gnu@0.4.0", Name: "winapi-x86_64-pc-windows-gnu", Version: "0.4.0", Indirect: true, Locations: []types.Location{{StartLine: 346, EndLine: 349}}},
+	}
+
+	cargoNormalDeps = []types.Dependency{
+		{ID: "normal@0.1.0", DependsOn: []string{"libc@0.2.54"}},
+	}
+
+	cargoManyDeps = []types.Dependency{
+		{ID: "aho-corasick@0.7.3", DependsOn: []string{"memchr@2.2.0"}},
+		{ID: "base64@0.9.3", DependsOn: []string{"byteorder@1.3.1", "safemem@0.3.0"}},
+		{ID: "base64@0.10.1", DependsOn: []string{"byteorder@1.3.1"}},
+		{ID: "block-buffer@0.7.3", DependsOn: []string{"block-padding@0.1.4", "byte-tools@0.3.1", "byteorder@1.3.1", "generic-array@0.12.0"}},
+		{ID: "block-padding@0.1.4", DependsOn: []string{"byte-tools@0.3.1"}},
+		{ID: "cloudabi@0.0.3", DependsOn: []string{"bitflags@1.0.4"}},
+		{ID: "cookie@0.11.1", DependsOn: []string{"base64@0.9.3", "ring@0.13.5", "time@0.1.42", "url@1.7.2"}},
+		{ID: "devise@0.2.0", DependsOn: []string{"devise_codegen@0.2.0", "devise_core@0.2.0"}},
+		{ID: "devise_codegen@0.2.0", DependsOn: []string{"devise_core@0.2.0", "quote@0.6.12"}},
+		{ID: "devise_core@0.2.0", DependsOn: []string{"bitflags@1.0.4", "proc-macro2@0.4.30", "quote@0.6.12", "syn@0.15.34"}},
+		{ID: "digest@0.8.0", DependsOn: []string{"generic-array@0.12.0"}},
+		{ID: "generic-array@0.12.0", DependsOn: []string{"typenum@1.10.0"}},
+		{ID: "handlebars@1.1.0", DependsOn: []string{"lazy_static@1.3.0", "log@0.4.6", "pest@2.1.1", "pest_derive@2.1.0", "quick-error@1.2.2", "regex@1.1.6", "serde@1.0.91", "serde_json@1.0.39", "walkdir@2.2.7"}},
+		{ID: "hyper@0.10.16", DependsOn: []string{"base64@0.9.3", "httparse@1.3.3", "language-tags@0.2.2", "log@0.3.9", "mime@0.2.6", "num_cpus@1.10.0", "time@0.1.42", "traitobject@0.1.0", "typeable@0.1.2", "unicase@1.4.2", "url@1.7.2"}},
+		{ID: "idna@0.1.5", DependsOn: []string{"matches@0.1.8", "unicode-bidi@0.3.4", "unicode-normalization@0.1.8"}},
+		{ID: "isatty@0.1.9", DependsOn: []string{"cfg-if@0.1.7", "libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "log@0.3.9", DependsOn: []string{"log@0.4.6"}},
+		{ID: "log@0.4.6", DependsOn: []string{"cfg-if@0.1.7"}},
+		{ID: "many@0.1.0", DependsOn: []string{"bitflags@1.0.4", "handlebars@1.1.0", "lazy_static@1.3.0", "log@0.4.6", "quote@0.6.12", "rand@0.6.5", "regex@1.1.6", "rocket@0.4.0", "serde@1.0.91", "syn@0.15.34"}},
+		{ID: "mime@0.2.6", DependsOn: []string{"log@0.3.9"}},
+		{ID: "num_cpus@1.10.0", DependsOn: []string{"libc@0.2.54"}},
+		{ID: "pear@0.1.2", DependsOn: []string{"pear_codegen@0.1.2"}},
+		{ID: "pear_codegen@0.1.2", DependsOn: []string{"proc-macro2@0.4.30", "quote@0.6.12", "syn@0.15.34", "version_check@0.1.5", "yansi@0.4.0"}},
+		{ID: "pest@2.1.1", DependsOn: []string{"ucd-trie@0.1.1"}},
+		{ID: "pest_derive@2.1.0", DependsOn: []string{"pest@2.1.1", "pest_generator@2.1.0"}},
+		{ID: "pest_generator@2.1.0", DependsOn: []string{"pest@2.1.1", "pest_meta@2.1.1", "proc-macro2@0.4.30", "quote@0.6.12", "syn@0.15.34"}},
+		{ID: "pest_meta@2.1.1", DependsOn: []string{"maplit@1.0.1", "pest@2.1.1", "sha-1@0.8.1"}},
+		{ID: "proc-macro2@0.4.30", DependsOn: []string{"unicode-xid@0.1.0"}},
+		{ID: "quote@0.6.12", DependsOn: []string{"proc-macro2@0.4.30"}},
+		{ID: "rand@0.6.5", DependsOn: []string{"autocfg@0.1.2", "libc@0.2.54", "rand_chacha@0.1.1", "rand_core@0.4.0", "rand_hc@0.1.0", "rand_isaac@0.1.1", "rand_jitter@0.1.4", "rand_os@0.1.3", "rand_pcg@0.1.2", "rand_xorshift@0.1.1", "winapi@0.3.7"}},
+		{ID: "rand_chacha@0.1.1", DependsOn: []string{"autocfg@0.1.2", "rand_core@0.3.1"}},
+		{ID: "rand_core@0.3.1", DependsOn: []string{"rand_core@0.4.0"}},
+		{ID: "rand_hc@0.1.0", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rand_isaac@0.1.1", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rand_jitter@0.1.4", DependsOn: []string{"libc@0.2.54", "rand_core@0.4.0", "winapi@0.3.7"}},
+		{ID: "rand_os@0.1.3", DependsOn: []string{"cloudabi@0.0.3", "fuchsia-cprng@0.1.1", "libc@0.2.54", "rand_core@0.4.0", "rdrand@0.4.0", "winapi@0.3.7"}},
+		{ID: "rand_pcg@0.1.2", DependsOn: []string{"autocfg@0.1.2", "rand_core@0.4.0"}},
+		{ID: "rand_xorshift@0.1.1", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "rdrand@0.4.0", DependsOn: []string{"rand_core@0.3.1"}},
+		{ID: "regex@1.1.6", DependsOn: []string{"aho-corasick@0.7.3", "memchr@2.2.0", "regex-syntax@0.6.6", "thread_local@0.3.6", "utf8-ranges@1.0.2"}},
+		{ID: "regex-syntax@0.6.6", DependsOn: []string{"ucd-util@0.1.3"}},
+		{ID: "ring@0.13.5", DependsOn: []string{"cc@1.0.36", "lazy_static@1.3.0", "libc@0.2.54", "untrusted@0.6.2"}},
+		{ID: "rocket@0.4.0", DependsOn: []string{"base64@0.10.1", "isatty@0.1.9", "log@0.4.6", "memchr@2.2.0", "num_cpus@1.10.0", "pear@0.1.2", "rocket_codegen@0.4.0", "rocket_http@0.4.0", "state@0.4.1", "time@0.1.42", "toml@0.4.10", "version_check@0.1.5", "yansi@0.5.0"}},
+		{ID: "rocket_codegen@0.4.0", DependsOn: []string{"devise@0.2.0", "indexmap@1.0.2", "quote@0.6.12", "rocket_http@0.4.0", "version_check@0.1.5", "yansi@0.5.0"}},
+		{ID: "rocket_http@0.4.0", DependsOn: []string{"cookie@0.11.1", "hyper@0.10.16", "indexmap@1.0.2", "pear@0.1.2", "percent-encoding@1.0.1", "smallvec@0.6.9", "state@0.4.1", "time@0.1.42", "unicode-xid@0.1.0"}},
+		{ID: "same-file@1.0.4", DependsOn: []string{"winapi-util@0.1.2"}},
+		{ID: "serde_json@1.0.39", DependsOn: []string{"itoa@0.4.4", "ryu@0.2.8", "serde@1.0.91"}},
+		{ID: "sha-1@0.8.1", DependsOn: []string{"block-buffer@0.7.3", "digest@0.8.0", "fake-simd@0.1.2", "opaque-debug@0.2.2"}},
+		{ID: "syn@0.15.34", DependsOn: []string{"proc-macro2@0.4.30", "quote@0.6.12", "unicode-xid@0.1.0"}},
+		{ID: "thread_local@0.3.6", DependsOn: []string{"lazy_static@1.3.0"}},
+		{ID: "time@0.1.42", DependsOn: []string{"libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "toml@0.4.10", DependsOn: []string{"serde@1.0.91"}},
+		{ID: "unicase@1.4.2", DependsOn: []string{"version_check@0.1.5"}},
+		{ID: "unicode-bidi@0.3.4", DependsOn: []string{"matches@0.1.8"}},
+		{ID: "unicode-normalization@0.1.8", DependsOn: []string{"smallvec@0.6.9"}},
+		{ID: "url@1.7.2", DependsOn: []string{"idna@0.1.5", "matches@0.1.8", "percent-encoding@1.0.1"}},
+		{ID: "walkdir@2.2.7", DependsOn: []string{"same-file@1.0.4", "winapi@0.3.7", "winapi-util@0.1.2"}},
+		{ID: "winapi@0.3.7", DependsOn: []string{"winapi-i686-pc-windows-gnu@0.4.0", "winapi-x86_64-pc-windows-gnu@0.4.0"}},
+		{ID: "winapi-util@0.1.2", DependsOn: []string{"winapi@0.3.7"}},
+	}
+
+	cargoNickelDeps = []types.Dependency{
+		{ID: "aho-corasick@0.7.3", DependsOn: []string{"memchr@2.2.0"}},
+		{ID: "base64@0.9.3", DependsOn: []string{"byteorder@1.3.1", "safemem@0.3.0"}},
+		{ID: "hyper@0.10.16", DependsOn: []string{"base64@0.9.3", "httparse@1.3.3", "language-tags@0.2.2", "log@0.3.9", "mime@0.2.6", "num_cpus@1.10.0", "time@0.1.42", "traitobject@0.1.0", "typeable@0.1.2", "unicase@1.4.2", "url@1.7.2"}},
+		{ID: "idna@0.1.5", DependsOn: []string{"matches@0.1.8", "unicode-bidi@0.3.4", "unicode-normalization@0.1.8"}},
+		{ID: "log@0.3.9", DependsOn: []string{"log@0.4.6"}},
+		{ID: "log@0.4.6", DependsOn: []string{"cfg-if@0.1.7"}},
+		{ID: "mime@0.2.6", DependsOn: []string{"log@0.3.9"}},
+		{ID: "mustache@0.9.0", DependsOn: []string{"log@0.3.9", "serde@1.0.91"}},
+		{ID: "web@0.1.0", DependsOn: []string{"nickel@0.11.0"}},
+		{ID: "nickel@0.11.0", DependsOn: []string{"groupable@0.2.0", "hyper@0.10.16", "lazy_static@1.3.0", "log@0.3.9", "modifier@0.1.0", "mustache@0.9.0", "plugin@0.2.6", "regex@1.1.6", "serde@1.0.91", "serde_json@1.0.39", "time@0.1.42", "typemap@0.3.3", "url@1.7.2"}},
+		{ID: "num_cpus@1.10.0", DependsOn: []string{"libc@0.2.54"}},
+		{ID: "plugin@0.2.6", DependsOn: []string{"typemap@0.3.3"}},
+		{ID: "regex@1.1.6", DependsOn: []string{"aho-corasick@0.7.3", "memchr@2.2.0", "regex-syntax@0.6.6", "thread_local@0.3.6", "utf8-ranges@1.0.2"}},
+		{ID: "regex-syntax@0.6.6", DependsOn: []string{"ucd-util@0.1.3"}},
+		{ID: "serde_json@1.0.39", DependsOn: []string{"itoa@0.4.4", "ryu@0.2.8", "serde@1.0.91"}},
+		{ID: "thread_local@0.3.6", DependsOn: []string{"lazy_static@1.3.0"}},
+		{ID: "time@0.1.42", DependsOn: []string{"libc@0.2.54", "redox_syscall@0.1.54", "winapi@0.3.7"}},
+		{ID: "typemap@0.3.3", DependsOn: []string{"unsafe-any@0.4.2"}},
+		{ID: "unicase@1.4.2", DependsOn: []string{"version_check@0.1.5"}},
+		{ID: "unicode-bidi@0.3.4", DependsOn: []string{"matches@0.1.8"}},
+		{ID: "unicode-normalization@0.1.8", DependsOn: []string{"smallvec@0.6.9"}},
+		{ID: "unsafe-any@0.4.2", DependsOn: []string{"traitobject@0.1.0"}},
+		{ID: "url@1.7.2", DependsOn: []string{"idna@0.1.5", "matches@0.1.8", "percent-encoding@1.0.1"}},
+		{ID: "winapi@0.3.7", DependsOn: []string{"winapi-i686-pc-windows-gnu@0.4.0", "winapi-x86_64-pc-windows-gnu@0.4.0"}},
+	}
+
+	// "utils" is a path dependency without a source, which is not the project itself
+	cargoPath = []types.Library{
+		{ID: "app@0.1.0", Name: "app", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 5, EndLine: 11}}},
+		{ID: "libc@0.2.139", Name: "libc", Version: "0.2.139", Locations: []types.Location{{StartLine: 13, EndLine: 17}}},
+		{ID: "log@0.4.17", Name: "log", Version: "0.4.17", Indirect: true, Locations: []types.Location{{StartLine: 19, EndLine: 23}}},
+		{ID: "utils@0.1.0", Name: "utils", Version: "0.1.0", Locations: []types.Location{{StartLine: 25, EndLine: 30}}},
+	}
+	cargoPathDeps = []types.Dependency{
+		{ID: "app@0.1.0", DependsOn: []string{"libc@0.2.139", "utils@0.1.0"}},
+		{ID: "utils@0.1.0", DependsOn: []string{"log@0.4.17"}},
+	}
+
+	// The workspace members "cli" and "engine" are the roots, and "vendored" is a path dependency of "cli"
+	cargoWorkspace = []types.Library{
+		{ID: "cli@0.1.0", Name: "cli", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 5, EndLine: 11}}},
+		{ID: "engine@0.1.0", Name: "engine", Version: "0.1.0", Root: true, Locations: []types.Location{{StartLine: 13, EndLine: 18}}},
+		{ID: "log@0.4.17", Name: "log", Version: "0.4.17", Locations: []types.Location{{StartLine: 20, EndLine: 24}}},
+		{ID: "vendored@0.2.0", Name: "vendored", Version: "0.2.0", Locations: []types.Location{{StartLine: 26, EndLine: 28}}},
+	}
+	cargoWorkspaceDeps = []types.Dependency{
+		{ID: "cli@0.1.0", DependsOn: []string{"engine@0.1.0", "vendored@0.2.0"}},
+		{ID: "engine@0.1.0", DependsOn: []string{"log@0.4.17"}},
 	}
 )
@@ -0,0 +1,192 @@
+// Package cyclonedx converts the results of the parsers into CycloneDX BOMs.
+// See https://cyclonedx.org/docs/1.5/json/
+//
+// Only the fields the parsers can fill are defined.
+package cyclonedx
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+const (
+	bomFormat   = "CycloneDX"
+	specVersion = "1.5"
+
+	componentTypeLibrary     = "library"
+	componentTypeApplication = "application"
+)
+
+// The types of Library.ExternalReferences and their names in CycloneDX
+var refTypes = map[types.RefType]string{
+	types.RefTypeVCS:          "vcs",
+	types.RefTypeWebsite:      "website",
+	types.RefTypeRegistry:     "distribution",
+	types.RefTypeDistribution: "distribution",
+}
+
+// The hash algorithms of Library.Digest and their names in CycloneDX
+var hashAlgorithms = map[string]string{
+	sbom.MD5:    "MD5",
+	sbom.SHA1:   "SHA-1",
+	sbom.SHA256: "SHA-256",
+	sbom.SHA384: "SHA-384",
+	sbom.SHA512: "SHA-512",
+}
+
+type BOM struct {
+	BOMFormat    string       `json:"bomFormat"`
+	SpecVersion  string       `json:"specVersion"`
+	SerialNumber string       `json:"serialNumber,omitempty"`
+	Version      int          `json:"version"`
+	Metadata     *Metadata    `json:"metadata,omitempty"`
+	Components   []Component  `json:"components"`
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+}
+
+type Metadata struct {
+	// e.g. 2022-07-01T00:00:00Z
+	Timestamp string     `json:"timestamp,omitempty"`
+	Component *Component `json:"component,omitempty"`
+}
+
+type Component struct {
+	BOMRef             string              `json:"bom-ref"`
+	Type               string              `json:"type"`
+	Name               string              `json:"name"`
+	Version            string              `json:"version,omitempty"`
+	PURL               string              `json:"purl,omitempty"`
+	Hashes             []Hash              `json:"hashes,omitempty"`
+	Licenses           []LicenseChoice     `json:"licenses,omitempty"`
+	ExternalReferences []ExternalReference `json:"externalReferences,omitempty"`
+}
+
+type Hash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type LicenseChoice struct {
+	License License `json:"license"`
+}
+
+type License struct {
+	Name string `json:"name"`
+}
+
+type ExternalReference struct {
+	URL  string `json:"url"`
+	Type string `json:"type"`
+	// e.g. the revision of a VCS reference
+	Comment string `json:"comment,omitempty"`
+}
+
+type Dependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// New converts the libraries and the dependency graph returned by a parser into a BOM.
+// Duplicated libraries are merged, and the edges to unknown libraries are dropped.
+// The first library marked as Root is the component of the metadata, which the BOM describes.
+//
+// The bom-ref of each component is the first available of Library.PURL, Library.ID and name@version,
+// so purl.Fill should be called beforehand for the components to have Package URLs.
+func New(libs []types.Library, deps []types.Dependency) *BOM {
+	bom := &BOM{
+		BOMFormat:   bomFormat,
+		SpecVersion: specVersion,
+		Version:     1,
+		Components:  []Component{},
+	}
+
+	// Dependency refers to Library.ID
+	refs := map[string]string{}
+	for _, lib := range utils.UniqueLibraries(libs) {
+		c := component(lib)
+		if lib.ID != "" {
+			refs[lib.ID] = c.BOMRef
+		}
+		if lib.Root && bom.Metadata == nil {
+			c.Type = componentTypeApplication
+			bom.Metadata = &Metadata{Component: &c}
+			continue
+		}
+		bom.Components = append(bom.Components, c)
+	}
+
+	for _, dep := range deps {
+		ref, ok := refs[dep.ID]
+		if !ok {
+			continue
+		}
+		d := Dependency{Ref: ref}
+		for _, id := range dep.DependsOn {
+			if r, ok := refs[id]; ok {
+				d.DependsOn = append(d.DependsOn, r)
+			}
+		}
+		bom.Dependencies = append(bom.Dependencies, d)
+	}
+	return bom
+}
+
+// Encode writes the BOM of the libraries and the dependency graph as JSON.
+func Encode(w io.Writer, libs []types.Library, deps []types.Dependency) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(New(libs, deps)); err != nil {
+		return xerrors.Errorf("json encode error: %w", err)
+	}
+	return nil
+}
+
+func component(lib types.Library) Component {
+	c := Component{
+		BOMRef:  bomRef(lib),
+		Type:    componentTypeLibrary,
+		Name:    lib.Name,
+		Version: lib.Version,
+		PURL:    lib.PURL,
+	}
+	if h, ok := hash(lib.Digest); ok {
+		c.Hashes = []Hash{h}
+	}
+	for _, l := range lib.Licenses {
+		c.Licenses = append(c.Licenses, LicenseChoice{License: License{Name: l}})
+	}
+	for _, ref := range lib.ExternalReferences {
+		typ, ok := refTypes[ref.Type]
+		if !ok {
+			typ = "other"
+		}
+		c.ExternalReferences = append(c.ExternalReferences, ExternalReference{URL: ref.URL, Type: typ, Comment: ref.Ref})
+	}
+	return c
+}
+
+func bomRef(lib types.Library) string {
+	switch {
+	case lib.PURL != "":
+		return lib.PURL
+	case lib.ID != "":
+		return lib.ID
+	}
+	return utils.PackageID(lib.Name, lib.Version)
+}
+
+// hash converts the digest into a CycloneDX hash.
+// CycloneDX only accepts hex encoded hashes, so other digests such as h1: of Go and Terraform are dropped.
+func hash(digest string) (Hash, bool) {
+	alg, content, ok := sbom.SplitDigest(digest)
+	if !ok {
+		return Hash{}, false
+	}
+	return Hash{Alg: hashAlgorithms[alg], Content: content}, true
+}
@@ -0,0 +1,95 @@
+package cyclonedx_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom/cyclonedx"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestEncode(t *testing.T) {
+	libs := []types.Library{
+		{
+			ID:       "express@4.18.1",
+			Name:     "express",
+			Version:  "4.18.1",
+			PURL:     "pkg:npm/express@4.18.1",
+			Licenses: []string{"MIT"},
+			Digest:   "sha1:3F3AD2C6E4B2C8BA1D9B0E2B3C35D0C1F2B0A1C2",
+			ExternalReferences: []types.ExternalReference{
+				{Type: types.RefTypeWebsite, URL: "http://expressjs.com/"},
+				{Type: types.RefTypeVCS, URL: "https://github.com/expressjs/express.git", Ref: "8368dc178af16b91b576c4c1d135f701a0007e5d"},
+			},
+		},
+		{
+			ID:      "body-parser@1.20.0",
+			Name:    "body-parser",
+			Version: "1.20.0",
+			PURL:    "pkg:npm/body-parser@1.20.0",
+			// Not hex encoded
+			Digest: "h1:1r7VKSJuJyycnxTwSExpaKmbE2yLqVBdNy0Cq5OhRtg=",
+		},
+		{
+			ID:      "debug@2.6.9",
+			Name:    "debug",
+			Version: "2.6.9",
+		},
+		// Duplicated
+		{
+			ID:      "debug@2.6.9",
+			Name:    "debug",
+			Version: "2.6.9",
+		},
+	}
+	deps := []types.Dependency{
+		{
+			ID:        "express@4.18.1",
+			DependsOn: []string{"body-parser@1.20.0", "debug@2.6.9", "unknown@1.0.0"},
+		},
+		{
+			ID:        "body-parser@1.20.0",
+			DependsOn: []string{"debug@2.6.9"},
+		},
+		{
+			ID:        "unknown@1.0.0",
+			DependsOn: []string{"debug@2.6.9"},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := cyclonedx.Encode(&buf, libs, deps)
+	require.NoError(t, err)
+
+	want, err := os.ReadFile("testdata/bom.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), buf.String())
+}
+
+func TestNew_Empty(t *testing.T) {
+	bom := cyclonedx.New(nil, nil)
+	assert.Equal(t, &cyclonedx.BOM{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: "1.5",
+		Version:     1,
+		Components:  []cyclonedx.Component{},
+	}, bom)
+}
+
+func TestNew_Root(t *testing.T) {
+	libs := []types.Library{
+		{ID: "app@1.0.0", Name: "app", Version: "1.0.0", Root: true},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9"},
+	}
+	deps := []types.Dependency{{ID: "app@1.0.0", DependsOn: []string{"debug@2.6.9"}}}
+
+	bom := cyclonedx.New(libs, deps)
+	require.NotNil(t, bom.Metadata)
+	assert.Equal(t, &cyclonedx.Component{BOMRef: "app@1.0.0", Type: "application", Name: "app", Version: "1.0.0"}, bom.Metadata.Component)
+	assert.Equal(t, []cyclonedx.Component{{BOMRef: "debug@2.6.9", Type: "library", Name: "debug", Version: "2.6.9"}}, bom.Components)
+	assert.Equal(t, []cyclonedx.Dependency{{Ref: "app@1.0.0", DependsOn: []string{"debug@2.6.9"}}}, bom.Dependencies)
+}
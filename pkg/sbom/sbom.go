@@ -0,0 +1,36 @@
+// Package sbom has the helpers shared by the SBOM formats in its sub-packages.
+package sbom
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// Hash algorithms of Library.Digest which SBOM formats support
+const (
+	MD5    = "md5"
+	SHA1   = "sha1"
+	SHA256 = "sha256"
+	SHA384 = "sha384"
+	SHA512 = "sha512"
+)
+
+// SplitDigest splits Library.Digest into the algorithm and the hex encoded hash.
+// It returns false for unknown algorithms and hashes which are not hex encoded, such as h1: of Go and Terraform.
+// e.g. sha256:2cf24dba... => sha256, 2cf24dba...
+func SplitDigest(digest string) (string, string, bool) {
+	ss := strings.SplitN(digest, ":", 2)
+	if len(ss) != 2 {
+		return "", "", false
+	}
+	alg := strings.ToLower(ss[0])
+	switch alg {
+	case MD5, SHA1, SHA256, SHA384, SHA512:
+	default:
+		return "", "", false
+	}
+	if _, err := hex.DecodeString(ss[1]); err != nil || ss[1] == "" {
+		return "", "", false
+	}
+	return alg, strings.ToLower(ss[1]), true
+}
@@ -0,0 +1,234 @@
+// Package spdx converts the results of the parsers into SPDX documents.
+// See https://spdx.github.io/spdx-spec/v2.3/
+//
+// Only the fields the parsers can fill are defined.
+package spdx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/license"
+	"github.com/aquasecurity/go-dep-parser/pkg/sbom"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+const (
+	spdxVersion    = "SPDX-2.3"
+	dataLicense    = "CC0-1.0"
+	documentID     = "SPDXRef-DOCUMENT"
+	namespaceBase  = "https://spdx.org/spdxdocs/"
+	creator        = "Tool: go-dep-parser"
+	noAssertion    = "NOASSERTION"
+	packageIDBase  = "SPDXRef-Package-"
+	categoryPkgMgr = "PACKAGE-MANAGER"
+	refTypePURL    = "purl"
+
+	relationshipDescribes = "DESCRIBES"
+	relationshipDependsOn = "DEPENDS_ON"
+)
+
+var (
+	// The hash algorithms of Library.Digest and their names in SPDX
+	checksumAlgorithms = map[string]string{
+		sbom.MD5:    "MD5",
+		sbom.SHA1:   "SHA1",
+		sbom.SHA256: "SHA256",
+		sbom.SHA384: "SHA384",
+		sbom.SHA512: "SHA512",
+	}
+
+	// Licenses which look like SPDX license identifiers are declared as they are.
+	// e.g. MIT, Apache-2.0 and GPL-2.0+
+	licenseIDRegexp = regexp.MustCompile(`^[A-Za-z0-9.+-]+$`)
+
+	// for testing
+	now = time.Now
+)
+
+type Document struct {
+	SPDXVersion       string         `json:"spdxVersion"`
+	DataLicense       string         `json:"dataLicense"`
+	SPDXID            string         `json:"SPDXID"`
+	Name              string         `json:"name"`
+	DocumentNamespace string         `json:"documentNamespace"`
+	CreationInfo      CreationInfo   `json:"creationInfo"`
+	Packages          []Package      `json:"packages"`
+	Relationships     []Relationship `json:"relationships,omitempty"`
+}
+
+type CreationInfo struct {
+	// e.g. 2022-07-01T00:00:00Z
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type Package struct {
+	SPDXID           string        `json:"SPDXID"`
+	Name             string        `json:"name"`
+	VersionInfo      string        `json:"versionInfo,omitempty"`
+	DownloadLocation string        `json:"downloadLocation"`
+	FilesAnalyzed    bool          `json:"filesAnalyzed"`
+	Checksums        []Checksum    `json:"checksums,omitempty"`
+	LicenseConcluded string        `json:"licenseConcluded"`
+	LicenseDeclared  string        `json:"licenseDeclared"`
+	ExternalRefs     []ExternalRef `json:"externalRefs,omitempty"`
+}
+
+type Checksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type ExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type Relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	RelationshipType   string `json:"relationshipType"`
+}
+
+// New converts the libraries and the dependency graph returned by a parser into an SPDX document.
+// Duplicated libraries are merged, and the edges to unknown libraries are dropped.
+//
+// The document describes the libraries marked as Root if any, and otherwise the libraries no other library depends on,
+// which are all the libraries when the parser doesn't return the dependency graph.
+// The name is used for the document namespace as well, which callers may replace with a unique URI.
+func New(name string, libs []types.Library, deps []types.Dependency) *Document {
+	doc := &Document{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       dataLicense,
+		SPDXID:            documentID,
+		Name:              name,
+		DocumentNamespace: namespaceBase + name,
+		CreationInfo: CreationInfo{
+			Created:  now().UTC().Format(time.RFC3339),
+			Creators: []string{creator},
+		},
+		Packages: []Package{},
+	}
+
+	// Dependency refers to Library.ID
+	ids := map[string]string{}
+	var pkgIDs, rootIDs []string
+	for i, lib := range utils.UniqueLibraries(libs) {
+		pkg := newPackage(fmt.Sprintf("%s%d", packageIDBase, i+1), lib)
+		doc.Packages = append(doc.Packages, pkg)
+		pkgIDs = append(pkgIDs, pkg.SPDXID)
+		if lib.Root {
+			rootIDs = append(rootIDs, pkg.SPDXID)
+		}
+		if lib.ID != "" {
+			ids[lib.ID] = pkg.SPDXID
+		}
+	}
+
+	var edges []Relationship
+	dependedOn := map[string]bool{}
+	for _, dep := range deps {
+		from, ok := ids[dep.ID]
+		if !ok {
+			continue
+		}
+		for _, id := range dep.DependsOn {
+			to, ok := ids[id]
+			if !ok {
+				continue
+			}
+			edges = append(edges, Relationship{
+				SPDXElementID:      from,
+				RelatedSPDXElement: to,
+				RelationshipType:   relationshipDependsOn,
+			})
+			dependedOn[to] = true
+		}
+	}
+
+	described := rootIDs
+	if len(described) == 0 {
+		for _, id := range pkgIDs {
+			if !dependedOn[id] {
+				described = append(described, id)
+			}
+		}
+	}
+	for _, id := range described {
+		doc.Relationships = append(doc.Relationships, Relationship{
+			SPDXElementID:      documentID,
+			RelatedSPDXElement: id,
+			RelationshipType:   relationshipDescribes,
+		})
+	}
+	doc.Relationships = append(doc.Relationships, edges...)
+	return doc
+}
+
+// Encode writes the SPDX document of the libraries and the dependency graph as JSON.
+func Encode(w io.Writer, name string, libs []types.Library, deps []types.Dependency) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	if err := e.Encode(New(name, libs, deps)); err != nil {
+		return xerrors.Errorf("json encode error: %w", err)
+	}
+	return nil
+}
+
+func newPackage(id string, lib types.Library) Package {
+	pkg := Package{
+		SPDXID:      id,
+		Name:        lib.Name,
+		VersionInfo: lib.Version,
+		// Lock files don't tell where the archives are downloaded from
+		DownloadLocation: noAssertion,
+		LicenseConcluded: noAssertion,
+		LicenseDeclared:  licenseDeclared(lib.Licenses),
+	}
+	if alg, value, ok := sbom.SplitDigest(lib.Digest); ok {
+		pkg.Checksums = []Checksum{{Algorithm: checksumAlgorithms[alg], ChecksumValue: value}}
+	}
+	if lib.PURL != "" {
+		pkg.ExternalRefs = []ExternalRef{{
+			ReferenceCategory: categoryPkgMgr,
+			ReferenceType:     refTypePURL,
+			ReferenceLocator:  lib.PURL,
+		}}
+	}
+	return pkg
+}
+
+// licenseDeclared joins the licenses into a license expression.
+// Free-form license names such as "The Apache Software License, Version 2.0" are not valid in SPDX,
+// so NOASSERTION is declared when any license is not a license identifier.
+func licenseDeclared(licenses []string) string {
+	if len(licenses) == 0 {
+		return noAssertion
+	}
+	var exprs []string
+	for _, l := range licenses {
+		if licenseIDRegexp.MatchString(l) {
+			exprs = append(exprs, l)
+			continue
+		}
+		// Expressions normalized by the parsers. e.g. MIT OR Apache-2.0
+		if expr, confidence := license.Normalize(l); confidence == 1 && expr == l {
+			if len(licenses) > 1 {
+				expr = "(" + expr + ")"
+			}
+			exprs = append(exprs, expr)
+			continue
+		}
+		return noAssertion
+	}
+	return strings.Join(exprs, " AND ")
+}
@@ -0,0 +1,116 @@
+package spdx
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestEncode(t *testing.T) {
+	now = func() time.Time {
+		return time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	}
+	defer func() { now = time.Now }()
+
+	libs := []types.Library{
+		{
+			ID:       "express@4.18.1",
+			Name:     "express",
+			Version:  "4.18.1",
+			PURL:     "pkg:npm/express@4.18.1",
+			Licenses: []string{"MIT"},
+			Digest:   "sha1:3f3ad2c6e4b2c8ba1d9b0e2b3c35d0c1f2b0a1c2",
+		},
+		{
+			ID:       "body-parser@1.20.0",
+			Name:     "body-parser",
+			Version:  "1.20.0",
+			Licenses: []string{"The MIT License"},
+		},
+		{
+			ID:      "debug@2.6.9",
+			Name:    "debug",
+			Version: "2.6.9",
+		},
+		// Duplicated
+		{
+			ID:      "debug@2.6.9",
+			Name:    "debug",
+			Version: "2.6.9",
+		},
+	}
+	deps := []types.Dependency{
+		{
+			ID:        "express@4.18.1",
+			DependsOn: []string{"body-parser@1.20.0", "unknown@1.0.0"},
+		},
+		{
+			ID:        "body-parser@1.20.0",
+			DependsOn: []string{"debug@2.6.9"},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := Encode(&buf, "app", libs, deps)
+	require.NoError(t, err)
+
+	want, err := os.ReadFile("testdata/spdx.json")
+	require.NoError(t, err)
+	assert.JSONEq(t, string(want), buf.String())
+}
+
+func TestNew_Root(t *testing.T) {
+	libs := []types.Library{
+		{ID: "app@1.0.0", Name: "app", Version: "1.0.0", Root: true},
+		{ID: "debug@2.6.9", Name: "debug", Version: "2.6.9"},
+		// Not depended on, but not described either
+		{ID: "ms@2.0.0", Name: "ms", Version: "2.0.0"},
+	}
+	deps := []types.Dependency{{ID: "app@1.0.0", DependsOn: []string{"debug@2.6.9"}}}
+
+	doc := New("app", libs, deps)
+	want := []Relationship{
+		{SPDXElementID: "SPDXRef-DOCUMENT", RelatedSPDXElement: "SPDXRef-Package-1", RelationshipType: "DESCRIBES"},
+		{SPDXElementID: "SPDXRef-Package-1", RelatedSPDXElement: "SPDXRef-Package-2", RelationshipType: "DEPENDS_ON"},
+	}
+	assert.Equal(t, want, doc.Relationships)
+}
+
+func Test_licenseDeclared(t *testing.T) {
+	tests := []struct {
+		name     string
+		licenses []string
+		want     string
+	}{
+		{
+			name: "no license",
+			want: "NOASSERTION",
+		},
+		{
+			name:     "license identifiers",
+			licenses: []string{"MIT", "Apache-2.0"},
+			want:     "MIT AND Apache-2.0",
+		},
+		{
+			name:     "license expressions",
+			licenses: []string{"MIT OR Apache-2.0", "BSD-3-Clause"},
+			want:     "(MIT OR Apache-2.0) AND BSD-3-Clause",
+		},
+		{
+			name:     "license name",
+			licenses: []string{"MIT", "The Apache Software License, Version 2.0"},
+			want:     "NOASSERTION",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, licenseDeclared(tt.licenses))
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package scanner
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var zipMagic = []byte("PK\x03\x04")
+
+// "ustar" is found at tarMagicOffset of the first header, both in POSIX and GNU tar archives.
+var tarMagic = []byte("ustar")
+
+const tarMagicOffset = 257
+
+// ScanArchive parses the files in the tar or zip archive read from r in the same way as Scan,
+// without extracting it to disk. e.g. sdists, GitHub release archives and container layers
+// Tar archives may be compressed with gzip or zstd, and the format is told by the magic bytes.
+//
+// Only the files with parsers are kept in memory, together with the text files requirements.txt may include.
+// Each of them is kept up to MaxInputSize of WithLimits, so that larger files fail as they do in Scan.
+// Zip archives are read in place when r implements io.ReaderAt and Size, such as *io.SectionReader of a file.
+// Otherwise they are read into memory as a whole up to MaxArchiveSize, as their entries are listed at the end.
+// Symlinks and hard links in the archive are skipped, and WithFollowSymlinks is ignored.
+//
+// Entries failing to be read don't stop scanning the others, and are reported as *types.ErrPartialResult
+// together with the files failing to be parsed.
+func ScanArchive(r io.Reader, opts ...Option) ([]Application, error) {
+	o := newOptions(opts)
+	o.followSymlinks = false
+
+	fsys, errs, err := readArchive(r, o.limits)
+	if err != nil {
+		return nil, xerrors.Errorf("archive error: %w", err)
+	}
+
+	apps, scanErrs, err := scan(fsys, ".", o)
+	if err != nil {
+		return nil, err
+	}
+	return apps, types.NewErrPartialResult(append(errs, scanErrs...))
+}
+
+// sizedReaderAt is implemented by the inputs whose zip entries can be read without buffering them.
+// e.g. *bytes.Reader and *io.SectionReader
+type sizedReaderAt interface {
+	io.ReaderAt
+	Size() int64
+}
+
+// readArchive returns the files kept from the archive, and the errors of the entries failing to be read.
+func readArchive(r io.Reader, limits types.Limits) (archiveFS, []error, error) {
+	if ra, ok := r.(sizedReaderAt); ok && isZipAt(ra) {
+		fsys := archiveFS{".": newArchiveDir(".")}
+		errs, err := fsys.readZipAt(ra, ra.Size(), limits)
+		return fsys, errs, err
+	}
+
+	dr, err := utils.NewDecompressReader(r, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+	br := bufio.NewReader(dr)
+	magic, err := br.Peek(tarMagicOffset + len(tarMagic))
+	if err != nil && err != io.EOF {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	fsys := archiveFS{".": newArchiveDir(".")}
+	switch {
+	case bytes.HasPrefix(magic, zipMagic):
+		errs, err := fsys.readZip(br, limits)
+		return fsys, errs, err
+	case len(magic) == tarMagicOffset+len(tarMagic) && bytes.Equal(magic[tarMagicOffset:], tarMagic):
+		return fsys, fsys.readTar(br, limits), nil
+	}
+	return nil, nil, &types.ErrMalformedInput{Err: xerrors.New("neither tar nor zip archive")}
+}
+
+// readTar keeps the files in the tar archive. Later entries replace the earlier ones of the same path, as in container layers.
+// Reading stops at the first broken header, as the following entries can't be found.
+func (fsys archiveFS) readTar(r io.Reader, limits types.Limits) []error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return []error{xerrors.Errorf("tar error: %w", &types.ErrMalformedInput{Err: err})}
+		}
+
+		name, ok := keptPath(hdr.Name)
+		if !ok || !hdr.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		data, err := readEntry(tr, limits)
+		if err != nil {
+			// The rest of the stream is broken as well. e.g. exceeding MaxExpansionRatio of gzip
+			return []error{xerrors.Errorf("%s: %w", name, err)}
+		}
+		fsys.add(name, data, hdr.ModTime)
+	}
+}
+
+func isZipAt(ra io.ReaderAt) bool {
+	magic := make([]byte, len(zipMagic))
+	_, err := ra.ReadAt(magic, 0)
+	return err == nil && bytes.Equal(magic, zipMagic)
+}
+
+// readZip reads the zip archive into memory, failing with *types.ErrLimitExceeded once it exceeds MaxArchiveSize.
+func (fsys archiveFS) readZip(r io.Reader, limits types.Limits) ([]error, error) {
+	if limits.MaxArchiveSize > 0 {
+		r = io.LimitReader(r, limits.MaxArchiveSize+1)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+	if limits.MaxArchiveSize > 0 && int64(len(b)) > limits.MaxArchiveSize {
+		return nil, &types.ErrLimitExceeded{Limit: "MaxArchiveSize", Max: limits.MaxArchiveSize}
+	}
+	return fsys.readZipAt(bytes.NewReader(b), int64(len(b)), limits)
+}
+
+func (fsys archiveFS) readZipAt(ra io.ReaderAt, size int64, limits types.Limits) ([]error, error) {
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		return nil, xerrors.Errorf("zip error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var errs []error
+	for _, f := range zr.File {
+		name, ok := keptPath(f.Name)
+		if !ok || !f.Mode().IsRegular() {
+			continue
+		}
+		data, err := readZipEntry(f, limits)
+		if err != nil {
+			errs = append(errs, xerrors.Errorf("%s: %w", name, err))
+			continue
+		}
+		fsys.add(name, data, f.Modified)
+	}
+	return errs, nil
+}
+
+// readZipEntry reads the file, refusing it when it is decompressed to more than MaxExpansionRatio times of the compressed size.
+func readZipEntry(f *zip.File, limits types.Limits) ([]byte, error) {
+	ratio := uint64(limits.MaxExpansionRatio)
+	if ratio > 0 && f.UncompressedSize64 > f.CompressedSize64*ratio {
+		return nil, &types.ErrLimitExceeded{Limit: "MaxExpansionRatio", Max: int64(ratio)}
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, xerrors.Errorf("zip error: %w", &types.ErrMalformedInput{Err: err})
+	}
+	defer rc.Close()
+	return readEntry(rc, limits)
+}
+
+// readEntry reads up to a byte more than MaxInputSize, which is enough for parsing the entry to fail with it.
+func readEntry(r io.Reader, limits types.Limits) ([]byte, error) {
+	if limits.MaxInputSize > 0 {
+		r = io.LimitReader(r, limits.MaxInputSize+1)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+	return b, nil
+}
+
+// keptPath returns the path of the entry in fsys, and whether the entry is kept.
+// Absolute paths and parents of the root are resolved under the root.
+func keptPath(name string) (string, bool) {
+	name = path.Clean("/" + name)[1:]
+	if name == "" || !fs.ValidPath(name) {
+		return "", false
+	}
+
+	// Files included by requirements.txt can be named anything, but are text files in practice
+	if path.Ext(name) == ".txt" {
+		return name, true
+	}
+	trimmed, _ := trimCompressedExt(name)
+	_, ok := registry.Lookup(trimmed)
+	return name, ok
+}
+
+// archiveFS holds the files kept from an archive by their paths, together with their parent directories.
+// The root is ".".
+type archiveFS map[string]*archiveEntry
+
+type archiveEntry struct {
+	info archiveFileInfo
+	data []byte
+	// The entries of directories by their names
+	children map[string]*archiveEntry
+}
+
+func newArchiveDir(name string) *archiveEntry {
+	return &archiveEntry{
+		info:     archiveFileInfo{name: path.Base(name), dir: true},
+		children: map[string]*archiveEntry{},
+	}
+}
+
+// add adds the file and its parent directories.
+// Files conflicting with directories are skipped, as they can't be told apart.
+func (fsys archiveFS) add(name string, data []byte, modTime time.Time) {
+	if e, ok := fsys[name]; ok && e.info.dir {
+		return
+	}
+	parent := fsys.mkdirAll(path.Dir(name))
+	if parent == nil {
+		return
+	}
+	e := &archiveEntry{
+		info: archiveFileInfo{name: path.Base(name), size: int64(len(data)), modTime: modTime},
+		data: data,
+	}
+	fsys[name] = e
+	parent.children[e.info.name] = e
+}
+
+// mkdirAll returns the directory, adding it and its parents if missing. It returns nil if any of them is a file.
+func (fsys archiveFS) mkdirAll(name string) *archiveEntry {
+	if e, ok := fsys[name]; ok {
+		if !e.info.dir {
+			return nil
+		}
+		return e
+	}
+	parent := fsys.mkdirAll(path.Dir(name))
+	if parent == nil {
+		return nil
+	}
+	e := newArchiveDir(name)
+	fsys[name] = e
+	parent.children[e.info.name] = e
+	return e
+}
+
+func (fsys archiveFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	e, ok := fsys[name]
+	if !ok {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	if !e.info.dir {
+		return &archiveFile{Reader: bytes.NewReader(e.data), info: e.info}, nil
+	}
+
+	entries := make([]fs.DirEntry, 0, len(e.children))
+	for _, child := range e.children {
+		entries = append(entries, child.info)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return &archiveDir{info: e.info, entries: entries}, nil
+}
+
+type archiveFile struct {
+	*bytes.Reader
+	info archiveFileInfo
+}
+
+func (f *archiveFile) Stat() (fs.FileInfo, error) { return f.info, nil }
+func (f *archiveFile) Close() error               { return nil }
+
+type archiveDir struct {
+	info    archiveFileInfo
+	entries []fs.DirEntry
+}
+
+func (d *archiveDir) Stat() (fs.FileInfo, error) { return d.info, nil }
+func (d *archiveDir) Close() error               { return nil }
+
+func (d *archiveDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.info.name, Err: xerrors.New("is a directory")}
+}
+
+// ReadDir implements fs.ReadDirFile.
+func (d *archiveDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n <= 0 || n >= len(d.entries) {
+		entries := d.entries
+		d.entries = nil
+		if n > 0 && len(entries) == 0 {
+			return nil, io.EOF
+		}
+		return entries, nil
+	}
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
+
+// archiveFileInfo implements both fs.FileInfo and fs.DirEntry.
+type archiveFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	dir     bool
+}
+
+func (fi archiveFileInfo) Name() string               { return fi.name }
+func (fi archiveFileInfo) Size() int64                { return fi.size }
+func (fi archiveFileInfo) ModTime() time.Time         { return fi.modTime }
+func (fi archiveFileInfo) IsDir() bool                { return fi.dir }
+func (fi archiveFileInfo) Sys() interface{}           { return nil }
+func (fi archiveFileInfo) Type() fs.FileMode          { return fi.Mode().Type() }
+func (fi archiveFileInfo) Info() (fs.FileInfo, error) { return fi, nil }
+
+func (fi archiveFileInfo) Mode() fs.FileMode {
+	if fi.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
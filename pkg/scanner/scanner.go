@@ -0,0 +1,487 @@
+// Package scanner walks a directory tree and parses every file the registry has a parser for.
+//
+// e.g.
+//
+//	apps, err := scanner.Scan(os.DirFS("/path/to/project"), ".")
+//	for _, app := range apps {
+//		fmt.Println(app.FilePath, len(app.Libraries))
+//	}
+package scanner
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/cache"
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// Prefix of the keys of the results in cache.Cache
+const cacheKeyPrefix = "scanner:"
+
+// Suffixes of compressed files, which are parsed by the parser of the name without it.
+// e.g. package-lock.json.gz and Cargo.lock.zst
+var compressedExts = []string{".gz", ".zst"}
+
+// Application is the result of parsing a manifest or lock file.
+type Application struct {
+	// FilePath is the path of the file in fsys. e.g. "app/package-lock.json"
+	FilePath     string
+	Libraries    []types.Library
+	Dependencies []types.Dependency
+}
+
+type options struct {
+	skipDirs       map[string]struct{}
+	limits         types.Limits
+	followSymlinks bool
+	hooks          metrics.Hooks
+	cache          cache.Cache
+	parallel       int
+	timeout        time.Duration
+}
+
+type Option func(*options)
+
+// WithSkipDirs skips the directories with the names. ".git" is skipped by default.
+func WithSkipDirs(names ...string) Option {
+	return func(o *options) {
+		for _, name := range names {
+			o.skipDirs[name] = struct{}{}
+		}
+	}
+}
+
+// WithLimits bounds the resources used for parsing each file, including decompression.
+// Parsers following other files, such as requirements.txt, are not bounded.
+func WithLimits(limits types.Limits) Option {
+	return func(o *options) {
+		o.limits = limits
+	}
+}
+
+// WithFollowSymlinks follows symlinks to files and directories, which are ignored by default.
+// fsys must implement ReadLinkFS. Symlinks to the outside of the root given to Scan are reported as errors,
+// and symlinks to the directories being walked are skipped to avoid cycles.
+func WithFollowSymlinks() Option {
+	return func(o *options) {
+		o.followSymlinks = true
+	}
+}
+
+// WithHooks reports the files parsed to hooks.
+func WithHooks(hooks metrics.Hooks) Option {
+	return func(o *options) {
+		o.hooks = hooks
+	}
+}
+
+// WithCache keeps the results of the files in c by their contents, and the files with the same contents
+// are not parsed again in the following scans. Files failing to be parsed are not cached,
+// nor are the files parsed by types.FSParser, whose results depend on the other files.
+func WithCache(c cache.Cache) Option {
+	return func(o *options) {
+		o.cache = c
+	}
+}
+
+// WithParallel parses up to n files concurrently. The result is the same as parsing them one by one.
+// Functions given by WithHooks are called from multiple goroutines if n > 1.
+// n <= 1 parses them one by one, which is the default.
+func WithParallel(n int) Option {
+	return func(o *options) {
+		o.parallel = n
+	}
+}
+
+// WithTimeout gives up parsing each file after d, and reports it as an error.
+// Reads of the files fail after d, so parsers busy after reading the files still finish.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.timeout = d
+	}
+}
+
+// Scan walks fsys from root, and parses the files with the parsers found by registry.Lookup.
+// Applications are returned in the lexical order of the file paths.
+// Files compressed with gzip and zstd are decompressed, and parsed by the parser of the name without ".gz" or ".zst".
+//
+// Files failing to be parsed don't stop scanning the others.
+// Their errors are returned as *types.ErrPartialResult together with the other applications,
+// and the libraries of partial results are kept.
+func Scan(fsys fs.FS, root string, opts ...Option) ([]Application, error) {
+	apps, errs, err := scan(fsys, root, newOptions(opts))
+	if err != nil {
+		return nil, err
+	}
+	return apps, types.NewErrPartialResult(errs)
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		skipDirs: map[string]struct{}{".git": {}},
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// scan returns the applications, and the errors of the files failing to be parsed.
+func scan(fsys fs.FS, root string, o options) ([]Application, []error, error) {
+	s := scanner{fsys: fsys, options: o}
+	dir := root
+	if o.followSymlinks {
+		lfs, ok := fsys.(ReadLinkFS)
+		if !ok {
+			return nil, nil, xerrors.New("following symlinks requires ReadLinkFS")
+		}
+		s.linkFS = lfs
+
+		// Cycles are detected with the paths without symlinks
+		var err error
+		if dir, err = evalSymlinks(lfs, root); err != nil {
+			return nil, nil, xerrors.Errorf("symlink error: %w", err)
+		}
+		s.root = dir
+	}
+
+	if err := s.walk(dir, root, []string{dir}); err != nil {
+		return nil, nil, xerrors.Errorf("walk error: %w", err)
+	}
+
+	// Files are walked first, and parsed in parallel if WithParallel is given.
+	// Their results are collected in the walked order.
+	results := s.parseAll()
+	var apps []Application
+	var errs []error
+	for i, t := range s.targets {
+		res := results[i]
+		switch {
+		case t.err != nil:
+			errs = append(errs, t.err)
+			continue
+		case res.err != nil:
+			errs = append(errs, xerrors.Errorf("%s: %w", t.filePath, res.err))
+		}
+		if len(res.app.Libraries) > 0 {
+			res.app.FilePath = t.filePath
+			apps = append(apps, res.app)
+		}
+	}
+	return apps, errs, nil
+}
+
+// ParseFile parses the file in fsys with the parser found by registry.Lookup, in the same way as Scan.
+// It fails when no parser is registered for the file.
+func ParseFile(fsys fs.FS, filePath string, opts ...Option) (Application, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	name, _ := trimCompressedExt(filePath)
+	if _, ok := registry.Lookup(name); !ok {
+		return Application{}, xerrors.Errorf("no parser for %s", filePath)
+	}
+
+	s := scanner{fsys: fsys, options: o}
+	res := s.parseFile(filePath, filePath)
+	res.app.FilePath = filePath
+	return res.app, res.err
+}
+
+type scanner struct {
+	fsys fs.FS
+	// Set if symlinks are followed
+	linkFS ReadLinkFS
+	// The root without symlinks, which symlinks must not point outside of
+	root string
+	options
+
+	// The files to be parsed and the errors found in walking, in the walked order
+	targets []target
+}
+
+// target is a file to be parsed, or an error found in walking.
+type target struct {
+	realPath string
+	filePath string
+	err      error
+}
+
+type result struct {
+	app Application
+	err error
+}
+
+func (s *scanner) addFile(realPath, filePath string) {
+	s.targets = append(s.targets, target{realPath: realPath, filePath: filePath})
+}
+
+func (s *scanner) addError(err error) {
+	s.targets = append(s.targets, target{err: err})
+}
+
+// parseAll parses the targets with the workers, and returns the results indexed in the same way as the targets.
+func (s *scanner) parseAll() []result {
+	results := make([]result, len(s.targets))
+	workers := s.parallel
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = s.parseFile(s.targets[i].realPath, s.targets[i].filePath)
+			}
+		}()
+	}
+	for i, t := range s.targets {
+		if t.err == nil {
+			indexes <- i
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	return results
+}
+
+// walk walks dir, and reports the files under displayDir, which is the path of the symlink to dir if it is followed.
+// ancestors are the directories containing the symlinks being followed, which must not be walked again.
+func (s *scanner) walk(dir, displayDir string, ancestors []string) error {
+	return fs.WalkDir(s.fsys, dir, func(realPath string, d fs.DirEntry, err error) error {
+		filePath := displayPath(dir, displayDir, realPath)
+		if err != nil {
+			s.addError(xerrors.Errorf("walk error: %w", err))
+			return nil
+		}
+		if d.IsDir() {
+			if _, ok := s.skipDirs[d.Name()]; ok && realPath != dir {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.Type()&fs.ModeSymlink != 0 && s.linkFS != nil {
+			if err = s.followSymlink(realPath, filePath, ancestors); err != nil {
+				s.addError(xerrors.Errorf("%s: %w", filePath, err))
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		s.addFile(realPath, filePath)
+		return nil
+	})
+}
+
+func (s *scanner) followSymlink(realPath, filePath string, ancestors []string) error {
+	target, err := evalSymlinks(s.linkFS, realPath)
+	if err != nil {
+		return xerrors.Errorf("symlink error: %w", err)
+	}
+	// Sibling directories of the root are in fsys, but not to be scanned
+	if !containsPath(s.root, target) {
+		return xerrors.Errorf("symlink error: %s: outside the root: %s", filePath, target)
+	}
+	info, err := fs.Stat(s.fsys, target)
+	if err != nil {
+		return xerrors.Errorf("stat error: %w", err)
+	}
+
+	if info.Mode().IsRegular() {
+		s.addFile(target, filePath)
+		return nil
+	} else if !info.IsDir() {
+		return nil
+	}
+
+	if _, ok := s.skipDirs[path.Base(filePath)]; ok {
+		return nil
+	}
+	ancestors = append(ancestors[:len(ancestors):len(ancestors)], path.Dir(realPath))
+	for _, dir := range ancestors {
+		if containsPath(target, dir) {
+			return nil
+		}
+	}
+	return s.walk(target, filePath, ancestors)
+}
+
+// displayPath returns the path of name under dir, which is displayed under displayDir.
+func displayPath(dir, displayDir, name string) string {
+	switch {
+	case dir == displayDir:
+		return name
+	case dir == ".":
+		return path.Join(displayDir, name)
+	}
+	return path.Join(displayDir, strings.TrimPrefix(name, dir))
+}
+
+// parseFile parses realPath with the parser for filePath.
+// Files without parsers result in no libraries.
+func (s *scanner) parseFile(realPath, filePath string) result {
+	name, compressed := trimCompressedExt(filePath)
+	p, ok := registry.Lookup(name)
+	if !ok {
+		return result{}
+	}
+	parserName := metrics.ParserName(p)
+
+	// Unchanged files are not parsed again
+	var key string
+	if _, ok := p.(types.FSParser); s.cache != nil && !ok {
+		var err error
+		if key, err = s.cacheKey(realPath, parserName); err != nil {
+			return result{err: err}
+		}
+		if app, ok := s.cached(key, parserName); ok {
+			return result{app: app}
+		}
+	}
+
+	ctx := context.Background()
+	fsys := s.fsys
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+		fsys = contextFS{FS: fsys, ctx: ctx}
+	}
+
+	done := s.hooks.ParseStarted(parserName, filePath)
+	app, err := parse(ctx, fsys, realPath, p, compressed, s.limits)
+	if ctx.Err() != nil {
+		app, err = Application{}, xerrors.Errorf("parse timeout after %s: %w", s.timeout, ctx.Err())
+	}
+	done(len(app.Libraries), err)
+	if err != nil {
+		return result{app: app, err: err}
+	} else if key != "" {
+		s.setCache(key, app)
+	}
+	return result{app: app}
+}
+
+// cachedResult is the result of a file in cache.Cache.
+type cachedResult struct {
+	Libraries    []types.Library    `json:",omitempty"`
+	Dependencies []types.Dependency `json:",omitempty"`
+}
+
+// cacheKey returns the key of the result, which is the SHA-256 digest of the file and the name of the parser.
+func (s *scanner) cacheKey(filePath, parserName string) (string, error) {
+	f, err := s.fsys.Open(filePath)
+	if err != nil {
+		return "", xerrors.Errorf("file open error: %w", err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", xerrors.Errorf("read error: %w", err)
+	}
+	return fmt.Sprintf("%s%s:sha256:%x", cacheKeyPrefix, parserName, h.Sum(nil)), nil
+}
+
+func (s *scanner) cached(key, parserName string) (Application, bool) {
+	var res cachedResult
+	b, ok := s.cache.Get(key)
+	hit := ok && json.Unmarshal(b, &res) == nil
+	s.hooks.CacheLookedUp(parserName, key, hit)
+	return Application{Libraries: res.Libraries, Dependencies: res.Dependencies}, hit
+}
+
+func (s *scanner) setCache(key string, app Application) {
+	// The file was parsed even if the result can't be cached
+	b, err := json.Marshal(cachedResult{Libraries: app.Libraries, Dependencies: app.Dependencies})
+	if err == nil {
+		err = s.cache.Set(key, b, 0)
+	}
+	if err != nil {
+		log.Logger.Debugw("Unable to cache the result", zap.String("key", key), zap.Error(err))
+	}
+}
+
+// parse parses filePath in fsys with p. Parsers implementing types.ContextParser are given ctx,
+// so that their requests over the network are given up as well as the reads from fsys.
+func parse(ctx context.Context, fsys fs.FS, filePath string, p types.Parser, compressed bool, limits types.Limits) (Application, error) {
+	app := Application{FilePath: filePath}
+
+	// Parsers following other files resolve them in fsys
+	if fp, ok := p.(types.FSParser); ok && !compressed {
+		var err error
+		app.Libraries, app.Dependencies, err = fp.ParseFS(fsys, filePath)
+		return app, err
+	}
+
+	f, err := fsys.Open(filePath)
+	if err != nil {
+		return app, xerrors.Errorf("file open error: %w", err)
+	}
+	defer f.Close()
+
+	// Compressed files are told by the magic bytes, so that they are decompressed even without the suffix
+	app.Libraries, app.Dependencies, err = utils.ParseCompressedWithContext(ctx, limits, p, f)
+	return app, err
+}
+
+func trimCompressedExt(filePath string) (string, bool) {
+	for _, ext := range compressedExts {
+		if strings.HasSuffix(filePath, ext) {
+			return strings.TrimSuffix(filePath, ext), true
+		}
+	}
+	return filePath, false
+}
+
+// contextFS fails to read the files once ctx is done, so that parsers give up files taking too long.
+type contextFS struct {
+	fs.FS
+	ctx context.Context
+}
+
+func (fsys contextFS) Open(name string) (fs.File, error) {
+	if err := fsys.ctx.Err(); err != nil {
+		return nil, err
+	}
+	f, err := fsys.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return contextFile{File: f, ctx: fsys.ctx}, nil
+}
+
+type contextFile struct {
+	fs.File
+	ctx context.Context
+}
+
+func (f contextFile) Read(p []byte) (int, error) {
+	if err := f.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return f.File.Read(p)
+}
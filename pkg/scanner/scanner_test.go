@@ -0,0 +1,537 @@
+package scanner_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/cache"
+	"github.com/aquasecurity/go-dep-parser/pkg/metrics"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestScan(t *testing.T) {
+	fsys := fstest.MapFS{
+		"go.sum":                          {Data: []byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n")},
+		"app/requirements.txt":            {Data: []byte("-r base.txt\nclick==8.0.0\n")},
+		"app/base.txt":                    {Data: []byte("Flask==2.0.0\n")},
+		"app/main.py":                     {Data: []byte("import click\n")},
+		"broken/Pipfile.lock":             {Data: []byte("{")},
+		".git/requirements.txt":           {Data: []byte("click==7.0.0\n")},
+		"vendor/requirements.txt":         {Data: []byte("click==6.0.0\n")},
+		"empty/requirements.txt":          {Data: []byte("# no requirements\n")},
+		"node_modules/abc/package.json":   {Data: []byte(`{"name": "abc", "version": "1.0.0"}`)},
+		"node_modules/abc/index.js":       {Data: []byte("")},
+		"node_modules/abc/lib/dummy.json": {Data: []byte("{}")},
+	}
+
+	got, err := scanner.Scan(fsys, ".", scanner.WithSkipDirs("vendor"))
+	require.Error(t, err)
+
+	var partial *types.ErrPartialResult
+	require.True(t, errors.As(err, &partial), err)
+	require.Len(t, partial.Errs, 1)
+	assert.Contains(t, partial.Errs[0].Error(), "broken/Pipfile.lock")
+
+	want := []scanner.Application{
+		{
+			FilePath: "app/requirements.txt",
+			Libraries: []types.Library{
+				{Name: "click", Version: "8.0.0", FilePath: "app/requirements.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+				{Name: "Flask", Version: "2.0.0", FilePath: "app/base.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+			},
+		},
+		{
+			FilePath:  "go.sum",
+			Libraries: []types.Library{{Name: "github.com/pkg/errors", Version: "0.9.1"}},
+		},
+		{
+			FilePath:  "node_modules/abc/package.json",
+			Libraries: []types.Library{{Name: "abc", Version: "1.0.0"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestParseFile(t *testing.T) {
+	fsys := fstest.MapFS{
+		"app/requirements.txt": {Data: []byte("-r base.txt\nclick==8.0.0\n")},
+		"app/base.txt":         {Data: []byte("Flask==2.0.0\n")},
+		"README.md":            {Data: []byte("# app\n")},
+	}
+
+	got, err := scanner.ParseFile(fsys, "app/requirements.txt")
+	require.NoError(t, err)
+	want := scanner.Application{
+		FilePath: "app/requirements.txt",
+		Libraries: []types.Library{
+			{Name: "click", Version: "8.0.0", FilePath: "app/requirements.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+			{Name: "Flask", Version: "2.0.0", FilePath: "app/base.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = scanner.ParseFile(fsys, "README.md")
+	assert.EqualError(t, err, "no parser for README.md")
+}
+
+func TestScan_Compressed(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n"))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	var zstdBuf bytes.Buffer
+	zstdWriter, err := zstd.NewWriter(&zstdBuf)
+	require.NoError(t, err)
+	_, err = zstdWriter.Write([]byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n"))
+	require.NoError(t, err)
+	require.NoError(t, zstdWriter.Close())
+
+	fsys := fstest.MapFS{
+		"go.sum.gz":      {Data: buf.Bytes()},
+		"app/go.sum.zst": {Data: zstdBuf.Bytes()},
+	}
+
+	got, err := scanner.Scan(fsys, ".", scanner.WithLimits(types.Limits{MaxExpansionRatio: 100}))
+	require.NoError(t, err)
+
+	want := []scanner.Application{
+		{
+			FilePath:  "app/go.sum.zst",
+			Libraries: []types.Library{{Name: "github.com/pkg/errors", Version: "0.9.1"}},
+		},
+		{
+			FilePath:  "go.sum.gz",
+			Libraries: []types.Library{{Name: "github.com/pkg/errors", Version: "0.9.1"}},
+		},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = scanner.Scan(fsys, ".", scanner.WithLimits(types.Limits{MaxInputSize: 10}))
+	var partial *types.ErrPartialResult
+	require.True(t, errors.As(err, &partial), err)
+	assert.Contains(t, partial.Error(), "MaxInputSize limit exceeded")
+}
+
+func tarArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	// Sorted for the entries replacing the earlier ones
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(files[name]))}))
+		_, err := tw.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./flask-2.0.0/link.txt", Typeflag: tar.TypeSymlink, Linkname: "/etc/passwd"}))
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestScanArchive(t *testing.T) {
+	files := map[string]string{
+		"./flask-2.0.0/requirements.txt":          "-r requirements/base.txt\nclick==8.0.0\n",
+		"./flask-2.0.0/requirements/base.txt":     "Jinja2==3.0.0\n",
+		"./flask-2.0.0/src/flask/app.py":          "import click\n",
+		"./flask-2.0.0/broken/Pipfile.lock":       "{",
+		"./flask-2.0.0/.git/requirements.txt":     "click==7.0.0\n",
+		"../../flask-2.0.0/docs/requirements.txt": "Sphinx==4.0.0\n",
+	}
+	want := []scanner.Application{
+		{
+			FilePath:  "flask-2.0.0/docs/requirements.txt",
+			Libraries: []types.Library{{Name: "Sphinx", Version: "4.0.0", FilePath: "flask-2.0.0/docs/requirements.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}}},
+		},
+		{
+			FilePath: "flask-2.0.0/requirements.txt",
+			Libraries: []types.Library{
+				{Name: "click", Version: "8.0.0", FilePath: "flask-2.0.0/requirements.txt", Locations: []types.Location{{StartLine: 2, EndLine: 2}}},
+				{Name: "Jinja2", Version: "3.0.0", FilePath: "flask-2.0.0/requirements/base.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		archive []byte
+	}{
+		{
+			name:    "tar.gz",
+			archive: tarArchive(t, files),
+		},
+		{
+			name:    "zip",
+			archive: zipArchive(t, files),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scanner.ScanArchive(bytes.NewReader(tt.archive), scanner.WithLimits(types.Limits{MaxExpansionRatio: 100}))
+
+			var partial *types.ErrPartialResult
+			require.True(t, errors.As(err, &partial), err)
+			require.Len(t, partial.Errs, 1)
+			assert.Contains(t, partial.Errs[0].Error(), "flask-2.0.0/broken/Pipfile.lock")
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestScanArchive_Limits(t *testing.T) {
+	files := map[string]string{
+		"app/go.sum":           "github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n",
+		"app/requirements.txt": "click==8.0.0\n",
+	}
+	got, err := scanner.ScanArchive(bytes.NewReader(zipArchive(t, files)), scanner.WithLimits(types.Limits{MaxInputSize: 20}))
+
+	var partial *types.ErrPartialResult
+	require.True(t, errors.As(err, &partial), err)
+	require.Len(t, partial.Errs, 1)
+	assert.Contains(t, partial.Errs[0].Error(), "app/go.sum: MaxInputSize limit exceeded")
+	require.Len(t, got, 1)
+	assert.Equal(t, "app/requirements.txt", got[0].FilePath)
+}
+
+// Zip archives read from streams are buffered up to MaxArchiveSize
+func TestScanArchive_MaxArchiveSize(t *testing.T) {
+	archive := zipArchive(t, map[string]string{"app/requirements.txt": "click==8.0.0\n"})
+
+	tests := []struct {
+		name      string
+		r         io.Reader
+		limits    types.Limits
+		wantLimit bool
+	}{
+		{
+			name:   "stream",
+			r:      struct{ io.Reader }{bytes.NewReader(archive)},
+			limits: types.Limits{MaxArchiveSize: int64(len(archive))},
+		},
+		{
+			name:      "stream exceeding the limit",
+			r:         struct{ io.Reader }{bytes.NewReader(archive)},
+			limits:    types.Limits{MaxArchiveSize: int64(len(archive)) - 1},
+			wantLimit: true,
+		},
+		{
+			name:   "read in place",
+			r:      bytes.NewReader(archive),
+			limits: types.Limits{MaxArchiveSize: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scanner.ScanArchive(tt.r, scanner.WithLimits(tt.limits))
+			if tt.wantLimit {
+				var limitErr *types.ErrLimitExceeded
+				require.True(t, errors.As(err, &limitErr), err)
+				assert.Equal(t, "MaxArchiveSize", limitErr.Limit)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, "app/requirements.txt", got[0].FilePath)
+		})
+	}
+}
+
+func TestScanArchive_NotArchive(t *testing.T) {
+	_, err := scanner.ScanArchive(strings.NewReader("click==8.0.0\n"))
+	assert.EqualError(t, err, "archive error: neither tar nor zip archive")
+}
+
+func TestScan_Symlinks(t *testing.T) {
+	fsys := fstest.MapFS{
+		"app/go.sum":              {Data: []byte("github.com/pkg/errors v0.9.1 h1:FEBLx1zS214owpjy7qsBeixbURkuhQAwrK5UwLGTwt4=\n")},
+		"app/parent":              {Data: []byte(".."), Mode: fs.ModeSymlink},
+		"linked":                  {Data: []byte("app"), Mode: fs.ModeSymlink},
+		"requirements.txt":        {Data: []byte("shared/requirements.txt"), Mode: fs.ModeSymlink},
+		"shared/requirements.txt": {Data: []byte("click==8.0.0\n")},
+		"shared/self":             {Data: []byte("."), Mode: fs.ModeSymlink},
+		"escape":                  {Data: []byte("../outside"), Mode: fs.ModeSymlink},
+		"absolute":                {Data: []byte("/etc"), Mode: fs.ModeSymlink},
+	}
+
+	goSum := []types.Library{{Name: "github.com/pkg/errors", Version: "0.9.1"}}
+	requirements := []types.Library{
+		{Name: "click", Version: "8.0.0", FilePath: "shared/requirements.txt", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+	}
+
+	t.Run("ignored", func(t *testing.T) {
+		got, err := scanner.Scan(fsys, ".")
+		require.NoError(t, err)
+
+		want := []scanner.Application{
+			{FilePath: "app/go.sum", Libraries: goSum},
+			{FilePath: "shared/requirements.txt", Libraries: requirements},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("followed", func(t *testing.T) {
+		got, err := scanner.Scan(fsys, ".", scanner.WithFollowSymlinks())
+		require.Error(t, err)
+
+		var partial *types.ErrPartialResult
+		require.True(t, errors.As(err, &partial), err)
+		require.Len(t, partial.Errs, 2)
+		assert.Contains(t, partial.Errs[0].Error(), "absolute: symlink error: absolute: outside the root: /etc")
+		assert.Contains(t, partial.Errs[1].Error(), "escape: symlink error: escape: outside the root")
+
+		// Cycles of "app/parent" and "shared/self" are not walked
+		want := []scanner.Application{
+			{FilePath: "app/go.sum", Libraries: goSum},
+			{FilePath: "linked/go.sum", Libraries: goSum},
+			{FilePath: "requirements.txt", Libraries: requirements},
+			{FilePath: "shared/requirements.txt", Libraries: requirements},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("sibling directory of the root", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			"app/requirements.txt":    {Data: []byte("flask==2.0.0\n")},
+			"app/shared":              {Data: []byte("../shared"), Mode: fs.ModeSymlink},
+			"app/linked.txt":          {Data: []byte("../shared/requirements.txt"), Mode: fs.ModeSymlink},
+			"shared/requirements.txt": {Data: []byte("click==8.0.0\n")},
+		}
+		got, err := scanner.Scan(fsys, "app", scanner.WithFollowSymlinks())
+
+		var partial *types.ErrPartialResult
+		require.True(t, errors.As(err, &partial), err)
+		require.Len(t, partial.Errs, 2)
+		assert.Contains(t, partial.Errs[0].Error(), "app/linked.txt: symlink error: app/linked.txt: outside the root: shared/requirements.txt")
+		assert.Contains(t, partial.Errs[1].Error(), "app/shared: symlink error: app/shared: outside the root: shared")
+
+		require.Len(t, got, 1)
+		assert.Equal(t, "app/requirements.txt", got[0].FilePath)
+	})
+
+	t.Run("without ReadLinkFS", func(t *testing.T) {
+		_, err := scanner.Scan(struct{ fs.FS }{fsys}, ".", scanner.WithFollowSymlinks())
+		assert.EqualError(t, err, "following symlinks requires ReadLinkFS")
+	})
+}
+
+func TestWithHooks(t *testing.T) {
+	fsys := fstest.MapFS{
+		"app/requirements.txt": {Data: []byte("click==8.0.0\nFlask==2.0.0\n")},
+		"broken/Pipfile.lock":  {Data: []byte("{")},
+		"main.py":              {Data: []byte("import click\n")},
+	}
+
+	var got []metrics.ParseEvent
+	hooks := metrics.Hooks{
+		StartParse: func(parser, filePath string) func(metrics.ParseEvent) {
+			return func(e metrics.ParseEvent) {
+				got = append(got, e)
+			}
+		},
+	}
+	_, err := scanner.Scan(fsys, ".", scanner.WithHooks(hooks))
+	require.Error(t, err)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "python/pip", got[0].Parser)
+	assert.Equal(t, "app/requirements.txt", got[0].FilePath)
+	assert.Equal(t, 2, got[0].Libraries)
+	assert.NoError(t, got[0].Err)
+
+	assert.Equal(t, "python/pipenv", got[1].Parser)
+	assert.Equal(t, "broken/Pipfile.lock", got[1].FilePath)
+	assert.Error(t, got[1].Err)
+}
+
+type fakeParser struct{}
+
+func (fakeParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{{Name: strings.TrimSpace(string(b)), Version: "1.0.0"}}, nil, nil
+}
+
+func TestScan_Registered(t *testing.T) {
+	require.NoError(t, registry.Register("*.fake.lock", func() types.Parser { return fakeParser{} }))
+	defer registry.Unregister("*.fake.lock")
+
+	fsys := fstest.MapFS{
+		"deps/app.fake.lock": {Data: []byte("fake\n")},
+	}
+	got, err := scanner.Scan(fsys, ".")
+	require.NoError(t, err)
+
+	want := []scanner.Application{
+		{
+			FilePath:  "deps/app.fake.lock",
+			Libraries: []types.Library{{Name: "fake", Version: "1.0.0"}},
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+type countingParser struct {
+	fakeParser
+	parsed *int
+}
+
+func (p countingParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	*p.parsed++
+	return p.fakeParser.Parse(r)
+}
+
+func TestWithCache(t *testing.T) {
+	var parsed int
+	require.NoError(t, registry.Register("*.fake.lock", func() types.Parser { return countingParser{parsed: &parsed} }))
+	defer registry.Unregister("*.fake.lock")
+
+	fsys := fstest.MapFS{
+		"a/app.fake.lock":  {Data: []byte("fake\n")},
+		"b/app.fake.lock":  {Data: []byte("fake\n")},
+		"requirements.txt": {Data: []byte("click==8.0.0\n")},
+	}
+	c := cache.NewMemory()
+
+	want, err := scanner.Scan(fsys, ".", scanner.WithCache(c))
+	require.NoError(t, err)
+	// The files with the same contents are parsed once
+	assert.Equal(t, 1, parsed)
+
+	got, err := scanner.Scan(fsys, ".", scanner.WithCache(c))
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, parsed)
+
+	// Changed files are parsed again
+	fsys["b/app.fake.lock"] = &fstest.MapFile{Data: []byte("changed\n")}
+	got, err = scanner.Scan(fsys, ".", scanner.WithCache(c))
+	require.NoError(t, err)
+	assert.Equal(t, 2, parsed)
+	require.Len(t, got, 3)
+	assert.Equal(t, []types.Library{{Name: "changed", Version: "1.0.0"}}, got[1].Libraries)
+}
+
+func TestWithParallel(t *testing.T) {
+	fsys := fstest.MapFS{
+		"broken/Pipfile.lock": {Data: []byte("{")},
+		"broken/go.sum":       {Data: []byte("{")},
+	}
+	for i := 0; i < 20; i++ {
+		fsys[fmt.Sprintf("app%02d/requirements.txt", i)] = &fstest.MapFile{Data: []byte(fmt.Sprintf("click==8.0.%d\n", i))}
+	}
+
+	want, wantErr := scanner.Scan(fsys, ".")
+	require.Error(t, wantErr)
+
+	got, err := scanner.Scan(fsys, ".", scanner.WithParallel(4))
+	require.Error(t, err)
+
+	// The applications and the errors are in the walked order
+	assert.Equal(t, want, got)
+	assert.Equal(t, wantErr.Error(), err.Error())
+	assert.Len(t, got, 20)
+}
+
+type slowParser struct{}
+
+func (slowParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	time.Sleep(50 * time.Millisecond)
+	if _, err := io.ReadAll(r); err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{{Name: "slow", Version: "1.0.0"}}, nil, nil
+}
+
+func TestWithTimeout(t *testing.T) {
+	require.NoError(t, registry.Register("*.slow.lock", func() types.Parser { return slowParser{} }))
+	defer registry.Unregister("*.slow.lock")
+
+	fsys := fstest.MapFS{
+		"app.slow.lock":    {Data: []byte("slow\n")},
+		"requirements.txt": {Data: []byte("click==8.0.0\n")},
+	}
+	got, err := scanner.Scan(fsys, ".", scanner.WithTimeout(10*time.Millisecond))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "app.slow.lock: parse timeout after 10ms")
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	// The other files are still parsed
+	require.Len(t, got, 1)
+	assert.Equal(t, "requirements.txt", got[0].FilePath)
+}
+
+// networkParser waits for the response of a slow remote repository, until ctx is done
+type networkParser struct {
+	canceled chan struct{}
+}
+
+func (p networkParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return p.ParseWithContext(context.Background(), r)
+}
+
+func (p networkParser) ParseWithContext(ctx context.Context, _ io.Reader) ([]types.Library, []types.Dependency, error) {
+	select {
+	case <-ctx.Done():
+		close(p.canceled)
+		return nil, nil, ctx.Err()
+	case <-time.After(5 * time.Second):
+		return []types.Library{{Name: "remote", Version: "1.0.0"}}, nil, nil
+	}
+}
+
+func TestWithTimeout_ContextParser(t *testing.T) {
+	canceled := make(chan struct{})
+	require.NoError(t, registry.Register("*.remote.lock", func() types.Parser { return networkParser{canceled: canceled} }))
+	defer registry.Unregister("*.remote.lock")
+
+	fsys := fstest.MapFS{"app.remote.lock": {Data: []byte("remote\n")}}
+	_, err := scanner.Scan(fsys, ".", scanner.WithTimeout(10*time.Millisecond))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), err)
+
+	// The parser was given the context, instead of running after the timeout
+	select {
+	case <-canceled:
+	default:
+		t.Fatal("the parser was not canceled")
+	}
+}
@@ -0,0 +1,80 @@
+package scanner
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Symlinks followed in a path at most, like MAXSYMLINKS of Linux
+const maxSymlinks = 40
+
+// ReadLinkFS is the file system with symlinks, which is the same as fs.ReadLinkFS since Go 1.25.
+// e.g. os.DirFS and fstest.MapFS
+type ReadLinkFS interface {
+	fs.FS
+
+	// ReadLink returns the destination of the named symlink.
+	ReadLink(name string) (string, error)
+
+	// Lstat returns the fs.FileInfo of the named file without following the symlink.
+	Lstat(name string) (fs.FileInfo, error)
+}
+
+// evalSymlinks returns name after evaluating the symlinks in it, like filepath.EvalSymlinks.
+// Symlinks to absolute paths or to the parents of the root are refused, as they are outside fsys.
+func evalSymlinks(fsys ReadLinkFS, name string) (string, error) {
+	var resolved []string
+	rest := strings.Split(name, "/")
+	var links int
+	for len(rest) > 0 {
+		elem := rest[0]
+		rest = rest[1:]
+
+		switch elem {
+		case "", ".":
+			continue
+		case "..":
+			if len(resolved) == 0 {
+				return "", xerrors.Errorf("%s: outside the root", name)
+			}
+			resolved = resolved[:len(resolved)-1]
+			continue
+		}
+
+		current := path.Join(append(resolved, elem)...)
+		info, err := fsys.Lstat(current)
+		if err != nil {
+			return "", xerrors.Errorf("lstat error: %w", err)
+		}
+		if info.Mode()&fs.ModeSymlink == 0 {
+			resolved = append(resolved, elem)
+			continue
+		}
+
+		if links++; links > maxSymlinks {
+			return "", xerrors.Errorf("%s: too many levels of symlinks", name)
+		}
+		target, err := fsys.ReadLink(current)
+		if err != nil {
+			return "", xerrors.Errorf("readlink error: %w", err)
+		}
+		if path.IsAbs(target) {
+			return "", xerrors.Errorf("%s: outside the root: %s", name, target)
+		}
+		// The target is relative to the directory containing the symlink
+		rest = append(strings.Split(target, "/"), rest...)
+	}
+
+	if len(resolved) == 0 {
+		return ".", nil
+	}
+	return path.Join(resolved...), nil
+}
+
+// containsPath reports whether dir is the same as or a parent of name.
+func containsPath(dir, name string) bool {
+	return dir == "." || dir == name || strings.HasPrefix(name, dir+"/")
+}
@@ -0,0 +1,222 @@
+// Package server serves the parsers over HTTP, so that programs not written in Go can use them.
+//
+// Files are uploaded to POST /parse as a multipart form, and the parser of each file is looked up by
+// the file name of its part, as registry.Lookup does. The results are written in the format given by
+// the "format" query parameter, which is one of output.Formats and JSON by default.
+//
+// e.g.
+//
+//	curl -F file=@package-lock.json -F file=@app/requirements.txt;filename=app/requirements.txt \
+//	  'http://localhost:8080/parse?format=cyclonedx'
+//
+// Files without parsers are refused with 400, and files failing to be parsed with 422.
+// Skipped entries of partial results are not reported, while the other libraries are returned.
+//
+// Uploaded artifacts are not looked up over the network unless WithNetwork is given, and parsing
+// is given up once the client disconnects.
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/java/jar"
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/registry"
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+// The default of WithMaxUploadSize
+const defaultMaxUploadSize = 32 << 20
+
+type options struct {
+	limits        types.Limits
+	maxUploadSize int64
+	network       bool
+}
+
+type Option func(*options)
+
+// WithLimits bounds the resources used for parsing each file, including decompression.
+func WithLimits(limits types.Limits) Option {
+	return func(o *options) {
+		o.limits = limits
+	}
+}
+
+// WithMaxUploadSize refuses requests larger than n bytes with 413. The default is 32 MiB.
+func WithMaxUploadSize(n int64) Option {
+	return func(o *options) {
+		o.maxUploadSize = n
+	}
+}
+
+// WithNetwork lets the parsers send requests over the network, such as the jar parser searching Maven Central
+// for the uploaded artifacts. They are offline by default, so that any client can't make the server send requests.
+func WithNetwork() Option {
+	return func(o *options) {
+		o.network = true
+	}
+}
+
+type handler struct {
+	options
+	mux *http.ServeMux
+}
+
+// errorResponse is the body of the responses other than 200.
+type errorResponse struct {
+	Error string `json:"Error"`
+}
+
+// NewHandler returns the handler serving /parse.
+func NewHandler(opts ...Option) http.Handler {
+	o := options{maxUploadSize: defaultMaxUploadSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	h := &handler{options: o, mux: http.NewServeMux()}
+	h.mux.HandleFunc("/parse", h.parse)
+	return h
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *handler) parse(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, xerrors.Errorf("method %s not allowed", r.Method))
+		return
+	}
+
+	format := output.Format(r.URL.Query().Get("format"))
+	if format == "" {
+		format = output.FormatJSON
+	}
+	if !format.Valid() {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("unknown format: %s", format))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxUploadSize)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, xerrors.Errorf("multipart error: %w", err))
+		return
+	}
+
+	// Parts are parsed as they are read, so that uploaded files are not buffered
+	var apps []scanner.Application
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			writeError(w, uploadErrorStatus(err), xerrors.Errorf("multipart error: %w", err))
+			return
+		}
+
+		filePath := fileName(part.Header.Get("Content-Disposition"))
+		if filePath == "" {
+			// Form fields other than files are ignored
+			part.Close()
+			continue
+		}
+		app, status, err := h.parseFile(r.Context(), filePath, part)
+		part.Close()
+		if err != nil {
+			writeError(w, status, xerrors.Errorf("%s: %w", filePath, err))
+			return
+		}
+		apps = append(apps, app)
+	}
+
+	// The output is written after all the files are parsed, so that errors are returned with their statuses
+	var buf bytes.Buffer
+	name := "parse"
+	if len(apps) == 1 {
+		name = path.Base(apps[0].FilePath)
+	}
+	if err := output.Write(&buf, format, name, apps); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if format == output.FormatTable {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	} else {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	_, _ = buf.WriteTo(w)
+}
+
+// parseFile parses r with the parser of filePath, and returns the status code for the error.
+// Parsers implementing types.ContextParser give up their requests once ctx is done.
+func (h *handler) parseFile(ctx context.Context, filePath string, r io.Reader) (scanner.Application, int, error) {
+	app := scanner.Application{FilePath: filePath}
+
+	// Compressed files are parsed by the parser of the name without ".gz" or ".zst", as the scanner does
+	p, ok := registry.Lookup(trimCompressedExt(filePath))
+	if !ok {
+		return app, http.StatusBadRequest, xerrors.New("no parser for the file")
+	}
+	if _, ok := p.(*jar.Parser); ok && !h.network {
+		p = jar.NewParser(jar.WithOffline())
+	}
+
+	var err error
+	app.Libraries, app.Dependencies, err = utils.ParseCompressedWithContext(ctx, h.limits, p, r)
+	var partial *types.ErrPartialResult
+	switch {
+	case err == nil, xerrors.As(err, &partial):
+		return app, http.StatusOK, nil
+	case uploadErrorStatus(err) == http.StatusRequestEntityTooLarge:
+		return app, http.StatusRequestEntityTooLarge, err
+	}
+	return app, http.StatusUnprocessableEntity, err
+}
+
+// fileName returns the file name of the part as it is sent, as multipart.Part.FileName drops the directories
+// which some parsers are looked up by. e.g. node_modules/lodash/package.json
+func fileName(contentDisposition string) string {
+	_, params, err := mime.ParseMediaType(contentDisposition)
+	if err != nil || params["filename"] == "" {
+		return ""
+	}
+	// The path is only matched against the registry, and never opened
+	return path.Clean("/" + params["filename"])[1:]
+}
+
+func trimCompressedExt(filePath string) string {
+	if ext := path.Ext(filePath); ext == ".gz" || ext == ".zst" {
+		return filePath[:len(filePath)-len(ext)]
+	}
+	return filePath
+}
+
+// uploadErrorStatus returns 413 when the request body exceeds the upload size, and 400 otherwise.
+func uploadErrorStatus(err error) int {
+	// http.MaxBytesError is only available since Go 1.19, and parsers may not wrap it
+	if strings.Contains(err.Error(), "http: request body too large") {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error()})
+}
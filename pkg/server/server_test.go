@@ -0,0 +1,153 @@
+package server_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/output"
+	"github.com/aquasecurity/go-dep-parser/pkg/server"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+type file struct {
+	name    string
+	content string
+}
+
+func upload(t *testing.T, url string, files ...file) *http.Response {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("comment", "ignored"))
+	for _, f := range files {
+		w, err := mw.CreateFormFile("file", f.name)
+		require.NoError(t, err)
+		_, err = io.WriteString(w, f.content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, mw.Close())
+
+	resp, err := http.Post(url, mw.FormDataContentType(), &body)
+	require.NoError(t, err)
+	return resp
+}
+
+func TestNewHandler(t *testing.T) {
+	ts := httptest.NewServer(server.NewHandler(server.WithMaxUploadSize(1024)))
+	defer ts.Close()
+
+	requirements := file{name: "app/requirements.txt", content: "Flask==2.0.0\n"}
+	goMod := file{name: "go.mod", content: "module example.com/app\n"}
+
+	tests := []struct {
+		name       string
+		query      string
+		files      []file
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "table",
+			query:      "?format=table",
+			files:      []file{requirements},
+			wantStatus: http.StatusOK,
+			wantBody: "FILE                  NAME   VERSION  SCOPE\n" +
+				"app/requirements.txt  Flask  2.0.0    \n",
+		},
+		{
+			name:       "no parser",
+			files:      []file{requirements, goMod},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"Error":"go.mod: no parser for the file"}` + "\n",
+		},
+		{
+			name:       "malformed",
+			files:      []file{{name: "package-lock.json", content: "{"}},
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "too large",
+			files:      []file{{name: "requirements.txt", content: strings.Repeat("Flask==2.0.0\n", 100)}},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "unknown format",
+			query:      "?format=xml",
+			files:      []file{requirements},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `{"Error":"unknown format: xml"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := upload(t, ts.URL+"/parse"+tt.query, tt.files...)
+			defer resp.Body.Close()
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+
+			b, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, string(b))
+			}
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		resp := upload(t, ts.URL+"/parse", requirements)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var got []output.Application
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+		want := []output.Application{
+			{
+				FilePath:      "app/requirements.txt",
+				SchemaVersion: types.SchemaVersion,
+				Libraries: []types.Library{
+					{Name: "Flask", Version: "2.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 1}}},
+				},
+				Dependencies: []types.Dependency{},
+			},
+		}
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("GET", func(t *testing.T) {
+		resp, err := http.Get(ts.URL + "/parse")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Equal(t, http.MethodPost, resp.Header.Get("Allow"))
+	})
+}
+
+func TestNewHandler_Offline(t *testing.T) {
+	ts := httptest.NewServer(server.NewHandler())
+	defer ts.Close()
+
+	// A JAR without pom.properties, which would be searched for in Maven Central by its SHA-1 digest
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	_, err := zw.Create("com/example/Example.class")
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	resp := upload(t, ts.URL+"/parse", file{name: "example-1.0.0.jar", content: buf.String()})
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var got []output.Application
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	require.Len(t, got, 1)
+	assert.Empty(t, got[0].Libraries)
+}
@@ -0,0 +1,112 @@
+package swiftpm
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type lockFile struct {
+	Version int
+	// Version 1
+	Object struct {
+		Pins []pin
+	}
+	// Version 2 and later
+	Pins []pin
+}
+
+type pin struct {
+	// Version 1
+	RepositoryURL string
+	// Version 2 and later
+	Location string
+	State    struct {
+		Branch   string
+		Revision string
+		Version  string
+	}
+}
+
+// Parser implements types.Parser for Package.resolved
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Package.resolved
+// It is also stored in Xcode projects. e.g. *.xcodeproj/project.xcworkspace/xcshareddata/swiftpm/Package.resolved
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var lockFile lockFile
+	if err := json.NewDecoder(r).Decode(&lockFile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var pins []pin
+	switch lockFile.Version {
+	case 1:
+		pins = lockFile.Object.Pins
+	case 2, 3:
+		pins = lockFile.Pins
+	default:
+		return nil, &types.ErrUnsupportedLockfileVersion{File: "Package.resolved", Version: strconv.Itoa(lockFile.Version)}
+	}
+
+	var libs []types.Library
+	for _, p := range pins {
+		location := p.Location
+		if location == "" {
+			location = p.RepositoryURL
+		}
+
+		version := p.State.Version
+		if version == "" {
+			// Packages depending on a branch or a revision
+			version = p.State.Revision
+		}
+
+		libs = append(libs, types.Library{
+			Name:    PackageName(location),
+			Version: version,
+			ExternalReferences: []types.ExternalReference{{
+				Type: types.RefTypeVCS,
+				URL:  location,
+				Ref:  p.State.Revision,
+			}},
+		})
+	}
+	return libs, nil
+}
+
+// PackageName returns the name of the package from the repository URL.
+// e.g. https://github.com/Alamofire/Alamofire.git and git@github.com:Alamofire/Alamofire.git
+// => github.com/Alamofire/Alamofire
+func PackageName(url string) string {
+	name := url
+	if i := strings.Index(name, "://"); i != -1 {
+		name = name[i+3:]
+	} else if i = strings.Index(name, "@"); i != -1 {
+		// scp-like syntax
+		name = strings.Replace(name[i+1:], ":", "/", 1)
+	}
+	if i := strings.Index(name, "@"); i != -1 && i < strings.Index(name, "/") {
+		// User info, e.g. git@github.com/...
+		name = name[i+1:]
+	}
+	name = strings.TrimSuffix(name, "/")
+	return strings.TrimSuffix(name, ".git")
+}
@@ -0,0 +1,63 @@
+package swiftpm
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/Package_v1.resolved",
+			want: swiftpmV1,
+		},
+		{
+			file: "testdata/Package_v2.resolved",
+			want: swiftpmV2,
+		},
+		{
+			file:    "testdata/unsupported.resolved",
+			wantErr: "unsupported Package.resolved version",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
+
+func TestParse_UnsupportedVersion(t *testing.T) {
+	f, err := os.Open("testdata/unsupported.resolved")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = Parse(f)
+
+	var unsupported *types.ErrUnsupportedLockfileVersion
+	require.True(t, xerrors.As(err, &unsupported), err)
+	assert.Equal(t, "Package.resolved", unsupported.File)
+}
@@ -0,0 +1,19 @@
+package swiftpm
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// Xcode 13: *.xcodeproj/project.xcworkspace/xcshareddata/swiftpm/Package.resolved
+	swiftpmV1 = []types.Library{
+		{Name: "github.com/Alamofire/Alamofire", Version: "5.6.1", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/Alamofire/Alamofire.git", Ref: "8dd85aee02e39dd280c75eef88ffdb86eed4b07b"}}},
+		{Name: "github.com/onevcat/Kingfisher", Version: "3ec0ab0bca4feb56e8b33e289c9496e89059dd08", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "git@github.com:onevcat/Kingfisher.git", Ref: "3ec0ab0bca4feb56e8b33e289c9496e89059dd08"}}},
+	}
+
+	// docker run --name swift --rm -it swift:5.6 bash
+	// swift package init && (add Alamofire and swift-log to Package.swift)
+	// swift package resolve
+	swiftpmV2 = []types.Library{
+		{Name: "github.com/Alamofire/Alamofire", Version: "5.6.1", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/Alamofire/Alamofire.git", Ref: "8dd85aee02e39dd280c75eef88ffdb86eed4b07b"}}},
+		{Name: "github.com/apple/swift-log", Version: "1.4.4", ExternalReferences: []types.ExternalReference{{Type: types.RefTypeVCS, URL: "https://github.com/apple/swift-log", Ref: "6fe203dc33195667ce1759bf0182975e4653ba1c"}}},
+	}
+)
@@ -0,0 +1,137 @@
+package xcode
+
+import (
+	"bufio"
+	"io"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/swift/swiftpm"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+const (
+	sectionBegin = "/* Begin XCRemoteSwiftPackageReference section */"
+	sectionEnd   = "/* End XCRemoteSwiftPackageReference section */"
+)
+
+type packageReference struct {
+	repositoryURL string
+	// e.g. exactVersion, upToNextMajorVersion, upToNextMinorVersion, versionRange, branch, revision
+	kind           string
+	version        string
+	minimumVersion string
+	branch         string
+	revision       string
+}
+
+// Parser implements types.Parser for project.pbxproj
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses XCRemoteSwiftPackageReference entries in project.pbxproj
+//
+// e.g.
+//
+//	8A1B2C3D4E5F6A7B8C9D0E1F /* XCRemoteSwiftPackageReference "Alamofire" */ = {
+//		isa = XCRemoteSwiftPackageReference;
+//		repositoryURL = "https://github.com/Alamofire/Alamofire.git";
+//		requirement = {
+//			kind = upToNextMajorVersion;
+//			minimumVersion = 5.6.1;
+//		};
+//	};
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	var inSection bool
+	var depth int
+	var ref packageReference
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == sectionBegin:
+			inSection = true
+			continue
+		case line == sectionEnd:
+			inSection = false
+			continue
+		case !inSection:
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(line, "{"):
+			depth++
+			if depth == 1 {
+				ref = packageReference{}
+			}
+			continue
+		case line == "};":
+			depth--
+			if depth == 0 && ref.repositoryURL != "" {
+				libs = append(libs, ref.library())
+			}
+			continue
+		}
+
+		ss := strings.SplitN(strings.TrimSuffix(line, ";"), "=", 2)
+		if len(ss) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(ss[0])
+		value := strings.Trim(strings.TrimSpace(ss[1]), `"`)
+		switch key {
+		case "repositoryURL":
+			ref.repositoryURL = value
+		case "kind":
+			ref.kind = value
+		case "version":
+			ref.version = value
+		case "minimumVersion":
+			ref.minimumVersion = value
+		case "branch":
+			ref.branch = value
+		case "revision":
+			ref.revision = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	return libs, nil
+}
+
+// library returns the package with the requested version.
+// The minimum version is used for version-based requirements,
+// as the resolved version is only stored in Package.resolved.
+func (r packageReference) library() types.Library {
+	var version string
+	switch r.kind {
+	case "exactVersion":
+		version = r.version
+	case "upToNextMajorVersion", "upToNextMinorVersion", "versionRange":
+		version = r.minimumVersion
+	case "branch":
+		version = r.branch
+	case "revision":
+		version = r.revision
+	}
+	return types.Library{
+		Name:    swiftpm.PackageName(r.repositoryURL),
+		Version: version,
+	}
+}
@@ -0,0 +1,12 @@
+package xcode
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// Xcode 14: File > Add Packages... (Alamofire, swift-log and Kingfisher)
+	xcodeNormal = []types.Library{
+		{Name: "github.com/Alamofire/Alamofire", Version: "5.6.1"},
+		{Name: "github.com/apple/swift-log", Version: "1.4.4"},
+		{Name: "github.com/onevcat/Kingfisher", Version: "master"},
+	}
+)
@@ -0,0 +1,183 @@
+package lock
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type provider struct {
+	source      string
+	version     string
+	constraints string
+	hashes      []string
+	startLine   int
+	// broken is set when the block has a malformed line, so that the provider is skipped
+	broken bool
+}
+
+// Parser implements types.Parser for .terraform.lock.hcl
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses .terraform.lock.hcl
+//
+// The dependency lock file uses a small subset of HCL, so it is parsed line by line.
+// The hashes may also be listed on a single line, e.g. hashes = ["h1:...", "zh:..."]
+// Providers with malformed lines are skipped and returned as *types.ErrPartialResult.
+// e.g.
+//
+//	provider "registry.terraform.io/hashicorp/aws" {
+//	  version     = "4.22.0"
+//	  constraints = "~> 4.0"
+//	  hashes = [
+//	    "h1:...",
+//	  ]
+//	}
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var libs []types.Library
+	var p *provider
+	var inHashes bool
+	var lineNum int
+	var errs []error
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		switch {
+		case inHashes:
+			inHashes = !strings.HasSuffix(line, "]")
+			hashes, err := parseHashes(strings.TrimSuffix(line, "]"))
+			if err != nil {
+				errs = append(errs, xerrors.Errorf("invalid hash at line %d: %w", lineNum, &types.ErrMalformedInput{Err: err}))
+				p.broken = true
+				continue
+			}
+			p.hashes = append(p.hashes, hashes...)
+		case p == nil:
+			// Only provider blocks are recorded in the lock file
+			if !strings.HasPrefix(line, "provider ") || !strings.HasSuffix(line, "{") {
+				errs = append(errs, &types.ErrMalformedInput{Err: xerrors.Errorf("unexpected line %d: %s", lineNum, line)})
+				continue
+			}
+			label := strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(line, "provider "), "{"))
+			source, err := strconv.Unquote(label)
+			if err != nil {
+				errs = append(errs, xerrors.Errorf("invalid provider address at line %d: %w", lineNum, &types.ErrMalformedInput{Err: err}))
+			}
+			p = &provider{source: source, startLine: lineNum, broken: err != nil}
+		case line == "}":
+			if !p.broken {
+				lib := p.library()
+				lib.Locations = []types.Location{{StartLine: p.startLine, EndLine: lineNum}}
+				libs = append(libs, lib)
+			}
+			p = nil
+		default:
+			ss := strings.SplitN(line, "=", 2)
+			if len(ss) != 2 {
+				errs = append(errs, &types.ErrMalformedInput{Err: xerrors.Errorf("unexpected line %d: %s", lineNum, line)})
+				p.broken = true
+				continue
+			}
+			key, value := strings.TrimSpace(ss[0]), strings.TrimSpace(ss[1])
+			switch key {
+			case "version", "constraints":
+				s, err := strconv.Unquote(value)
+				if err != nil {
+					errs = append(errs, xerrors.Errorf("invalid %s at line %d: %w", key, lineNum, &types.ErrMalformedInput{Err: err}))
+					p.broken = true
+					continue
+				}
+				if key == "version" {
+					p.version = s
+				} else {
+					p.constraints = s
+				}
+			case "hashes":
+				// The list is written on a line, or the hashes follow on their own lines
+				// e.g. hashes = ["h1:...", "zh:..."]
+				if !strings.HasPrefix(value, "[") {
+					errs = append(errs, &types.ErrMalformedInput{Err: xerrors.Errorf("invalid hashes at line %d: %s", lineNum, value)})
+					p.broken = true
+					continue
+				}
+				inHashes = !strings.HasSuffix(value, "]")
+				hashes, err := parseHashes(strings.TrimSuffix(strings.TrimPrefix(value, "["), "]"))
+				if err != nil {
+					errs = append(errs, xerrors.Errorf("invalid hash at line %d: %w", lineNum, &types.ErrMalformedInput{Err: err}))
+					p.broken = true
+					continue
+				}
+				p.hashes = append(p.hashes, hashes...)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, xerrors.Errorf("scan error: %w", err)
+	}
+	if p != nil {
+		errs = append(errs, &types.ErrMalformedInput{Err: xerrors.Errorf("unterminated provider block: %s", p.source)})
+	}
+	return libs, types.NewErrPartialResult(errs)
+}
+
+func (p provider) library() types.Library {
+	return types.Library{
+		Name:       p.source,
+		Version:    p.version,
+		Constraint: p.constraints,
+		Digest:     p.digest(),
+	}
+}
+
+// parseHashes parses the quoted hashes separated by commas, which may end with a trailing comma.
+func parseHashes(list string) ([]string, error) {
+	var hashes []string
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		hash, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// digest prefers the "h1:" hash as it covers the package contents on every platform,
+// while "zh:" hashes are only valid for a single platform's zip archive.
+func (p provider) digest() string {
+	for _, h := range p.hashes {
+		if strings.HasPrefix(h, "h1:") {
+			return h
+		}
+	}
+	if len(p.hashes) > 0 {
+		return p.hashes[0]
+	}
+	return ""
+}
@@ -0,0 +1,58 @@
+package lock
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/terraform_normal.lock.hcl",
+			want: terraformNormal,
+		},
+		{
+			file: "testdata/terraform_inline.lock.hcl",
+			want: terraformInline,
+		},
+		{
+			file:    "testdata/terraform_unterminated.lock.hcl",
+			wantErr: "unterminated provider block",
+		},
+		{
+			file:    "testdata/terraform_partial.lock.hcl",
+			want:    terraformPartial,
+			wantErr: "invalid version at line 5",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+
+				// The valid providers are still returned
+				assert.Equal(t, v.want, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package lock
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name terraform --rm -it hashicorp/terraform:1.2.5 init
+	terraformNormal = []types.Library{
+		{Name: "registry.terraform.io/hashicorp/aws", Version: "4.22.0", Constraint: "~> 4.0", Digest: "h1:1r7VKSJuJyycnxTwSExpaKmbE2yLqVBdNy0Cq5OhRtg=", Locations: []types.Location{{StartLine: 4, EndLine: 12}}},
+		{Name: "registry.terraform.io/hashicorp/random", Version: "3.3.2", Digest: "h1:H5V+7iXol/EHB2+BUMzGlpIiCOdV74H8YjzCxnSAWcg=", Locations: []types.Location{{StartLine: 14, EndLine: 20}}},
+		{Name: "registry.terraform.io/integrations/github", Version: "4.26.1", Constraint: ">= 4.0.0", Locations: []types.Location{{StartLine: 22, EndLine: 25}}},
+	}
+
+	// The hashes are listed on a single line
+	terraformInline = []types.Library{
+		{Name: "registry.terraform.io/hashicorp/aws", Version: "4.22.0", Constraint: "~> 4.0", Digest: "h1:1r7VKSJuJyycnxTwSExpaKmbE2yLqVBdNy0Cq5OhRtg=", Locations: []types.Location{{StartLine: 4, EndLine: 8}}},
+		{Name: "registry.terraform.io/hashicorp/random", Version: "3.3.2", Digest: "zh:038293aebfede983e45ee55c328e3fde82ae2e5719c9bd233c324cfacc437f9c", Locations: []types.Location{{StartLine: 10, EndLine: 13}}},
+		{Name: "registry.terraform.io/integrations/github", Version: "4.26.1", Constraint: ">= 4.0.0", Locations: []types.Location{{StartLine: 15, EndLine: 19}}},
+	}
+
+	// The version of aws is not quoted
+	terraformPartial = []types.Library{
+		{Name: "registry.terraform.io/hashicorp/random", Version: "3.3.2", Digest: "h1:H5V+7iXol/EHB2+BUMzGlpIiCOdV74H8YjzCxnSAWcg=", Locations: []types.Location{{StartLine: 9, EndLine: 14}}},
+	}
+)
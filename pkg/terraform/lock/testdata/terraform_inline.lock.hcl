@@ -0,0 +1,19 @@
+# This file is maintained automatically by "terraform init".
+# Manual edits may be lost in future updates.
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "4.22.0"
+  constraints = "~> 4.0"
+  hashes      = ["zh:299efb8ba733b7742f0ef1c5c5467819e0c7bf46264f5f36ba6b6674304a5244", "h1:1r7VKSJuJyycnxTwSExpaKmbE2yLqVBdNy0Cq5OhRtg="]
+}
+
+provider "registry.terraform.io/hashicorp/random" {
+  version = "3.3.2"
+  hashes  = ["zh:038293aebfede983e45ee55c328e3fde82ae2e5719c9bd233c324cfacc437f9c"]
+}
+
+provider "registry.terraform.io/integrations/github" {
+  version     = "4.26.1"
+  constraints = ">= 4.0.0"
+  hashes      = []
+}
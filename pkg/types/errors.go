@@ -0,0 +1,164 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// ErrMalformedInput is returned when the input can't be decoded as the expected format.
+// The message is the one of the underlying error.
+//
+// Parsers wrap the error types in this file, so that callers can tell them apart with errors.As.
+// e.g.
+//
+//	var malformed *types.ErrMalformedInput
+//	if errors.As(err, &malformed) {
+//		// skip the file
+//	}
+type ErrMalformedInput struct {
+	Err error
+}
+
+func (e *ErrMalformedInput) Error() string {
+	if e.Err == nil {
+		return "malformed input"
+	}
+	return e.Err.Error()
+}
+
+func (e *ErrMalformedInput) Unwrap() error {
+	return e.Err
+}
+
+// ErrUnsupportedLockfileVersion is returned when the format version of the file is not supported.
+type ErrUnsupportedLockfileVersion struct {
+	// File describes the file. e.g. "lock file" and "Package.resolved"
+	File    string
+	Version string
+}
+
+func (e *ErrUnsupportedLockfileVersion) Error() string {
+	return fmt.Sprintf("unsupported %s version: %s", e.File, e.Version)
+}
+
+// ErrUnsupportedSchemaVersion is returned when a Result of another SchemaVersion is read.
+// It is about the output of this module, unlike ErrUnsupportedLockfileVersion about the files parsed.
+type ErrUnsupportedSchemaVersion struct {
+	Version int
+}
+
+func (e *ErrUnsupportedSchemaVersion) Error() string {
+	return fmt.Sprintf("unsupported result schema version: %d", e.Version)
+}
+
+// ErrArtifactNotFound is returned when an artifact is not found in the remote repositories.
+// Only the fields known when searching are filled.
+type ErrArtifactNotFound struct {
+	GroupID    string
+	ArtifactID string
+	Version    string
+	// Digest is the SHA-1 digest the artifact was searched by
+	Digest string
+}
+
+func (e *ErrArtifactNotFound) Error() string {
+	var name string
+	switch {
+	case e.ArtifactID != "":
+		name = e.ArtifactID
+		if e.GroupID != "" {
+			name = e.GroupID + ":" + name
+		}
+		if e.Version != "" {
+			name += ":" + e.Version
+		}
+	case e.Digest != "":
+		name = "digest " + e.Digest
+	}
+	if name == "" {
+		return "no artifact found"
+	}
+	return fmt.Sprintf("%s: no artifact found", name)
+}
+
+// Is makes any ErrArtifactNotFound match, regardless of the fields.
+func (e *ErrArtifactNotFound) Is(target error) bool {
+	_, ok := target.(*ErrArtifactNotFound)
+	return ok
+}
+
+// ErrLimitExceeded is returned when the input exceeds one of Limits,
+// so that huge or deeply nested inputs can't exhaust the memory.
+type ErrLimitExceeded struct {
+	// Limit is the name of the field of Limits. e.g. "MaxInputSize"
+	Limit string
+	Max   int64
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("%s limit exceeded: %d", e.Limit, e.Max)
+}
+
+// ErrPartialResult is returned together with the libraries parsed from the valid parts of the input,
+// when malformed entries are skipped.
+// Errs holds the error of each skipped entry.
+// e.g.
+//
+//	libs, _, err := p.Parse(r)
+//	var partial *types.ErrPartialResult
+//	if errors.As(err, &partial) {
+//		// libs are still usable
+//	}
+type ErrPartialResult struct {
+	Errs []error
+}
+
+// NewErrPartialResult returns nil if errs is empty, and ErrPartialResult otherwise.
+// Nested ErrPartialResult, such as those of included files, are flattened.
+func NewErrPartialResult(errs []error) error {
+	var flattened []error
+	for _, err := range errs {
+		if partial, ok := err.(*ErrPartialResult); ok {
+			flattened = append(flattened, partial.Errs...)
+			continue
+		}
+		flattened = append(flattened, err)
+	}
+	if len(flattened) == 0 {
+		return nil
+	}
+	return &ErrPartialResult{Errs: flattened}
+}
+
+func (e *ErrPartialResult) Error() string {
+	if len(e.Errs) == 1 {
+		return e.Errs[0].Error()
+	}
+	msgs := make([]string, 0, len(e.Errs))
+	for _, err := range e.Errs {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d errors occurred:\n\t* %s", len(e.Errs), strings.Join(msgs, "\n\t* "))
+}
+
+// Is reports whether any error of the skipped entries matches target, so that errors.Is looks into them.
+func (e *ErrPartialResult) Is(target error) bool {
+	for _, err := range e.Errs {
+		if xerrors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error of the skipped entries matching target, so that errors.As looks into them.
+func (e *ErrPartialResult) As(target interface{}) bool {
+	for _, err := range e.Errs {
+		if xerrors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestErrArtifactNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *types.ErrArtifactNotFound
+		want string
+	}{
+		{
+			name: "coordinates",
+			err:  &types.ErrArtifactNotFound{GroupID: "org.example", ArtifactID: "app", Version: "1.0.0"},
+			want: "org.example:app:1.0.0: no artifact found",
+		},
+		{
+			name: "artifactId",
+			err:  &types.ErrArtifactNotFound{ArtifactID: "app"},
+			want: "app: no artifact found",
+		},
+		{
+			name: "digest",
+			err:  &types.ErrArtifactNotFound{Digest: "c666f5bc47eb64ed3bbd13505a26f58be71f33f0"},
+			want: "digest c666f5bc47eb64ed3bbd13505a26f58be71f33f0: no artifact found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.err.Error())
+
+			err := xerrors.Errorf("search error: %w", tt.err)
+			assert.True(t, xerrors.Is(err, &types.ErrArtifactNotFound{}))
+
+			var notFound *types.ErrArtifactNotFound
+			assert.True(t, xerrors.As(err, &notFound))
+			assert.Equal(t, tt.err, notFound)
+		})
+	}
+}
+
+func TestErrMalformedInput(t *testing.T) {
+	cause := xerrors.New("unexpected EOF")
+	err := xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: cause})
+
+	assert.Equal(t, "decode error: unexpected EOF", err.Error())
+	assert.True(t, xerrors.Is(err, cause))
+}
+
+func TestNewErrPartialResult(t *testing.T) {
+	assert.NoError(t, types.NewErrPartialResult(nil))
+
+	malformed := &types.ErrMalformedInput{Err: xerrors.New("invalid line 3")}
+	nested := types.NewErrPartialResult([]error{xerrors.New("invalid line 5"), xerrors.New("invalid line 8")})
+	err := types.NewErrPartialResult([]error{malformed, nested})
+
+	assert.Equal(t, "3 errors occurred:\n\t* invalid line 3\n\t* invalid line 5\n\t* invalid line 8", err.Error())
+	assert.Equal(t, "invalid line 3", types.NewErrPartialResult([]error{malformed}).Error())
+
+	var partial *types.ErrPartialResult
+	assert.True(t, errors.As(xerrors.Errorf("parse error: %w", err), &partial))
+	assert.Len(t, partial.Errs, 3)
+
+	var got *types.ErrMalformedInput
+	assert.True(t, errors.As(err, &got))
+	assert.Equal(t, malformed, got)
+
+	assert.True(t, errors.Is(err, malformed.Err))
+	assert.False(t, errors.Is(err, xerrors.New("invalid line 3")))
+}
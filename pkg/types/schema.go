@@ -0,0 +1,47 @@
+package types
+
+import "encoding/json"
+
+// SchemaVersion is the version of the JSON representation of Result and the types in it.
+//
+// Within a version, fields are only added, and those which may be empty are omitted when they are.
+// Renaming or removing fields, or changing their meaning, increments the version,
+// so that programs reading results from other processes can tell what they are given.
+const SchemaVersion = 1
+
+// result has the fields of Result without its methods, to be embedded in the JSON representation.
+type result Result
+
+type versionedResult struct {
+	SchemaVersion int `json:"SchemaVersion"`
+	result
+}
+
+// MarshalJSON writes the result with SchemaVersion.
+// Libraries and dependencies are written as empty arrays rather than null, so that the output is the same
+// whether or not the parser builds them.
+func (r Result) MarshalJSON() ([]byte, error) {
+	v := versionedResult{SchemaVersion: SchemaVersion, result: result(r)}
+	if v.Libraries == nil {
+		v.Libraries = []Library{}
+	}
+	if v.Dependencies == nil {
+		v.Dependencies = []Dependency{}
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON reads the result written by MarshalJSON.
+// Results of other schema versions are refused with *ErrUnsupportedSchemaVersion,
+// and those without SchemaVersion are read as the current one.
+func (r *Result) UnmarshalJSON(b []byte) error {
+	var v versionedResult
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if v.SchemaVersion != 0 && v.SchemaVersion != SchemaVersion {
+		return &ErrUnsupportedSchemaVersion{Version: v.SchemaVersion}
+	}
+	*r = Result(v.result)
+	return nil
+}
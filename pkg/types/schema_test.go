@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestResult_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		result types.Result
+		want   string
+	}{
+		{
+			name: "happy path",
+			result: types.Result{
+				Libraries: []types.Library{
+					{
+						ID:         "lodash@4.17.21",
+						Name:       "lodash",
+						Version:    "4.17.21",
+						Constraint: "^4.17.0",
+						Locations:  []types.Location{{StartLine: 3, EndLine: 5}},
+					},
+				},
+				Dependencies: []types.Dependency{{ID: "lodash@4.17.21"}},
+				Warnings:     []types.Warning{{Kind: types.WarningSkippedEntry, Message: "invalid line 7"}},
+				Stats:        types.Stats{BytesRead: 120, Libraries: 1, Dependencies: 1, Skipped: 1},
+			},
+			want: `{
+  "SchemaVersion": 1,
+  "Libraries": [
+    {
+      "ID": "lodash@4.17.21",
+      "Name": "lodash",
+      "Version": "4.17.21",
+      "Constraint": "^4.17.0",
+      "Locations": [{"StartLine": 3, "EndLine": 5}]
+    }
+  ],
+  "Dependencies": [{"ID": "lodash@4.17.21"}],
+  "Warnings": [{"Kind": "skipped-entry", "Message": "invalid line 7"}],
+  "Stats": {"BytesRead": 120, "Libraries": 1, "Dependencies": 1, "Skipped": 1}
+}`,
+		},
+		{
+			name: "licenses",
+			result: types.Result{
+				Libraries: []types.Library{
+					{Name: "attrs", Version: "21.4.0", Licenses: []string{"MIT", "Apache-2.0"}, License: "MIT, Apache-2.0"},
+				},
+			},
+			// License is kept for the programs reading the key
+			want: `{
+  "SchemaVersion": 1,
+  "Libraries": [
+    {"Name": "attrs", "Version": "21.4.0", "Licenses": ["MIT", "Apache-2.0"], "License": "MIT, Apache-2.0"}
+  ],
+  "Dependencies": [],
+  "Stats": {"BytesRead": 0, "Libraries": 0, "Dependencies": 0, "Skipped": 0}
+}`,
+		},
+		{
+			name:   "empty",
+			result: types.Result{},
+			want: `{
+  "SchemaVersion": 1,
+  "Libraries": [],
+  "Dependencies": [],
+  "Stats": {"BytesRead": 0, "Libraries": 0, "Dependencies": 0, "Skipped": 0}
+}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.result)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(b))
+
+			var got types.Result
+			require.NoError(t, json.Unmarshal(b, &got))
+			if tt.result.Libraries == nil {
+				tt.result.Libraries = []types.Library{}
+			}
+			if tt.result.Dependencies == nil {
+				tt.result.Dependencies = []types.Dependency{}
+			}
+			assert.Equal(t, tt.result, got)
+		})
+	}
+}
+
+func TestResult_UnmarshalJSON(t *testing.T) {
+	var got types.Result
+	err := json.Unmarshal([]byte(`{"SchemaVersion": 2, "Libraries": []}`), &got)
+
+	var versionErr *types.ErrUnsupportedSchemaVersion
+	require.True(t, errors.As(err, &versionErr), err)
+	assert.Equal(t, 2, versionErr.Version)
+	assert.Equal(t, "unsupported result schema version: 2", err.Error())
+}
+
+func TestLibrary_SetLicenses(t *testing.T) {
+	var lib types.Library
+	lib.SetLicenses([]string{"MIT", "Apache-2.0"})
+	assert.Equal(t, []string{"MIT", "Apache-2.0"}, lib.Licenses)
+	assert.Equal(t, "MIT, Apache-2.0", lib.License)
+}
@@ -1,7 +1,264 @@
 package types
 
+import (
+	"context"
+	"io"
+	"io/fs"
+	"strings"
+)
+
+// Library is a package found in a file.
+// The JSON keys are fixed by the tags regardless of the field names. See SchemaVersion for the compatibility.
 type Library struct {
-	Name    string
-	Version string
-	License string `json:",omitempty"`
+	// ID identifies the library when the name alone is ambiguous,
+	// or refers to the library from Dependency for parsers returning a dependency graph.
+	// e.g. the UUID of a Julia package, or name@version
+	ID      string `json:"ID,omitempty"`
+	Name    string `json:"Name"`
+	Version string `json:"Version"`
+
+	// Constraint is the requirement declared for the library, as it is written.
+	// e.g. ^1.2.0, [1.0,2.0) and ~> 3.1
+	// It tells whether Version is pinned exactly or resolved from a range,
+	// and is empty when the file doesn't record requirements.
+	// Parsers of manifests without resolved versions set it to Version as well.
+	Constraint string `json:"Constraint,omitempty"`
+
+	// PURL is the Package URL of the library, filled by the purl package.
+	// e.g. pkg:npm/%40babel/core@7.18.6
+	PURL string `json:"PURL,omitempty"`
+
+	// Qualifiers tell apart the artifacts of the same version, as the qualifiers of Package URLs do.
+	// They are added to PURL by the purl package, and only set by parsers whose files record them.
+	// e.g. classifier=sources of Maven, platform=x86_64-linux of gems and repository_url of npm
+	Qualifiers map[string]string `json:"Qualifiers,omitempty"`
+
+	// Indirect is true when the library is only pulled in by other dependencies.
+	// It is left false when the file doesn't tell direct dependencies from transitive ones.
+	Indirect bool `json:"Indirect,omitempty"`
+
+	// Root is true when the library is the project itself rather than one of its dependencies.
+	// e.g. the artifact of a JAR file, and the workspace members of Cargo.lock
+	// Workspaces can have several roots, and parsers which only return dependencies have none.
+	Root bool `json:"Root,omitempty"`
+
+	// Scope tells what the library is needed for, as recorded by the file.
+	// It is empty when the file doesn't tell.
+	// Parsers which skip development and test dependencies still do, so Scope only sorts out the rest.
+	Scope Scope `json:"Scope,omitempty"`
+
+	// Licenses lists the declared licenses.
+	// Names recognized by license.NormalizeAll are replaced with SPDX license expressions,
+	// and the others are kept as they are written in the metadata.
+	// e.g. MIT, Apache-2.0, "GPL-2.0-only WITH Classpath-exception-2.0"
+	Licenses []string `json:"Licenses,omitempty"`
+
+	// License is Licenses joined by ", ", which is set by SetLicenses.
+	//
+	// Deprecated: use Licenses. License is kept for the programs reading the field or its JSON key.
+	License string `json:"License,omitempty"`
+
+	// Digest is the checksum recorded by the lock file, prefixed by its algorithm.
+	// e.g. md5:470851b6d5d0ac559e9d01bb352b4021
+	Digest string `json:"Digest,omitempty"`
+
+	// ExternalReferences point to where the library comes from and where it is described,
+	// as recorded by the file.
+	ExternalReferences []ExternalReference `json:"ExternalReferences,omitempty"`
+
+	// FilePath is the file the library was found in, when a parser reads several files.
+	// e.g. WEB-INF/lib/commons-lang3-3.11.jar in a WAR file
+	FilePath string `json:"FilePath,omitempty"`
+
+	// Locations are the lines the library is declared at in the parsed file.
+	// They are only populated by parsers that can keep track of lines.
+	Locations []Location `json:"Locations,omitempty"`
+}
+
+// SetLicenses sets Licenses, and the deprecated License joined from them.
+func (lib *Library) SetLicenses(licenses []string) {
+	lib.Licenses = licenses
+	lib.License = strings.Join(licenses, ", ")
+}
+
+// Scope is the normalized relationship between a project and a library across ecosystems.
+type Scope string
+
+const (
+	// ScopeRuntime is needed to run the project. e.g. compile and runtime scopes of Maven
+	ScopeRuntime Scope = "runtime"
+	// ScopeDev is only needed to develop the project. e.g. devDependencies of npm
+	ScopeDev Scope = "dev"
+	// ScopeTest is only needed to test the project.
+	ScopeTest Scope = "test"
+	// ScopeBuild is only needed to build the project.
+	ScopeBuild Scope = "build"
+	// ScopeOptional is installed only when available or requested. e.g. optionalDependencies of npm
+	ScopeOptional Scope = "optional"
+	// ScopeProvided is expected to be provided by the runtime environment. e.g. provided scope of Maven
+	ScopeProvided Scope = "provided"
+)
+
+// ExternalReference is a URL related to a library.
+type ExternalReference struct {
+	Type RefType `json:"Type"`
+	URL  string  `json:"URL"`
+	// Ref is the revision, tag or branch of a VCS reference. e.g. a commit hash
+	Ref string `json:"Ref,omitempty"`
+}
+
+// RefType is the kind of an external reference.
+type RefType string
+
+const (
+	// RefTypeVCS is the repository of the source code. e.g. https://github.com/google/googletest.git
+	RefTypeVCS RefType = "vcs"
+	// RefTypeWebsite is the home page of the library.
+	RefTypeWebsite RefType = "website"
+	// RefTypeRegistry is the package registry the library is fetched from. e.g. https://registry.npmjs.org
+	RefTypeRegistry RefType = "registry"
+	// RefTypeDistribution is the URL of the archive the library is downloaded as.
+	RefTypeDistribution RefType = "distribution"
+)
+
+// Location is a range of lines, starting from 1.
+type Location struct {
+	// FilePath is set when libraries found in several files are merged,
+	// to tell which file the lines are in.
+	FilePath  string `json:"FilePath,omitempty"`
+	StartLine int    `json:"StartLine"`
+	EndLine   int    `json:"EndLine"`
+}
+
+// Dependency represents the edges from a library to the libraries it depends on.
+// ID and DependsOn refer to Library.ID.
+type Dependency struct {
+	ID        string   `json:"ID"`
+	DependsOn []string `json:"DependsOn,omitempty"`
+}
+
+// Parser is implemented by the parser of each file format.
+// Parsers which don't build a dependency graph return nil dependencies.
+// When some entries are malformed, parsers skip them and return the rest with *ErrPartialResult,
+// so that libraries may be returned even if the error is not nil.
+// The built-in parsers recover from panics caused by adversarial input, and return them as *ErrMalformedInput.
+// Libraries are returned in the order of the file, or sorted by utils.SortLibraries if the format has no order,
+// so that the output is the same between runs. Use utils.ParseSorted to sort them regardless of the format.
+//
+// Parsers keep no state between calls, so a single Parser, including the options it's configured with,
+// can be used from multiple goroutines at the same time.
+// Callbacks and caches given to them must be safe for concurrent use then.
+type Parser interface {
+	Parse(r io.Reader) ([]Library, []Dependency, error)
+}
+
+// Result is the outcome of parsing a file, with the issues which didn't stop parsing. See utils.ParseResult.
+type Result struct {
+	Libraries    []Library    `json:"Libraries"`
+	Dependencies []Dependency `json:"Dependencies"`
+	Warnings     []Warning    `json:"Warnings,omitempty"`
+	Stats        Stats        `json:"Stats"`
+}
+
+// WarningKind classifies the issues reported as Warning.
+type WarningKind string
+
+const (
+	// WarningSkippedEntry is a malformed entry which was skipped. e.g. the errors of *ErrPartialResult
+	WarningSkippedEntry WarningKind = "skipped-entry"
+	// WarningUnresolved is a library whose name or version couldn't be determined exactly,
+	// and was either guessed or left out.
+	WarningUnresolved WarningKind = "unresolved"
+	// WarningTruncated is a resolution which was given up in the middle, leaving the result incomplete.
+	WarningTruncated WarningKind = "truncated"
+)
+
+// Warning is a non-fatal issue found while parsing.
+type Warning struct {
+	Kind    WarningKind `json:"Kind"`
+	Message string      `json:"Message"`
+	// FilePath is the file the issue is found in, such as nested artifacts and included files.
+	// It may be empty for the parsed file itself. e.g. WEB-INF/lib/commons-lang3-3.11.jar
+	FilePath string `json:"FilePath,omitempty"`
+	// Line is where the issue is in the file, starting from 1.
+	// It is 0 when the parser doesn't keep track of lines.
+	Line int `json:"Line,omitempty"`
+}
+
+// Stats summarizes the parsing.
+type Stats struct {
+	// BytesRead is the number of bytes read from the input
+	BytesRead    int64 `json:"BytesRead"`
+	Libraries    int   `json:"Libraries"`
+	Dependencies int   `json:"Dependencies"`
+	// Skipped is the number of the entries skipped as malformed
+	Skipped int `json:"Skipped"`
+}
+
+// Mode decides how malformed entries are treated. See utils.ParseWithMode.
+type Mode int
+
+const (
+	// ModePartial returns the valid libraries together with *ErrPartialResult, as parsers do by themselves.
+	ModePartial Mode = iota
+	// ModeStrict fails on any malformed entry and returns no libraries. e.g. validating lock files in CI
+	ModeStrict
+	// ModeLenient skips malformed entries with warnings and returns no error for them.
+	// e.g. scanning arbitrary third-party repositories
+	ModeLenient
+)
+
+// Limits bounds the resources used for parsing untrusted inputs. See utils.ParseWithLimits.
+// Zero values mean no limit.
+type Limits struct {
+	// MaxInputSize is the maximum number of bytes read from the input
+	MaxInputSize int64
+	// MaxDepth is the maximum nesting depth of JSON and XML inputs
+	MaxDepth int
+	// MaxEntries is the maximum number of libraries
+	MaxEntries int
+	// MaxExpansionRatio is the maximum ratio of the uncompressed size to the compressed size of archive entries
+	MaxExpansionRatio int
+	// MaxArchiveSize is the maximum number of bytes of archives read into memory as a whole, such as zip archives
+	// read from streams. See scanner.ScanArchive
+	MaxArchiveSize int64
+}
+
+// ContextParser is implemented by parsers which can give up in the middle,
+// such as those sending requests over the network.
+type ContextParser interface {
+	Parser
+	ParseWithContext(ctx context.Context, r io.Reader) ([]Library, []Dependency, error)
+}
+
+// FSParser is implemented by parsers which follow references to other files,
+// such as requirement files including each other.
+// Paths are resolved in fsys, so that archives and container layers can be parsed without extracting them.
+type FSParser interface {
+	Parser
+	ParseFS(fsys fs.FS, filePath string) ([]Library, []Dependency, error)
+}
+
+// ResultParser is implemented by parsers which report warnings other than the skipped entries,
+// such as libraries guessed from file names.
+// Skipped entries are still reported as Warnings, and not as *ErrPartialResult.
+type ResultParser interface {
+	Parser
+	ParseResult(r io.Reader) (Result, error)
+}
+
+// WarningParser is implemented by parsers which report warnings to fn as soon as they are found,
+// so that interactive tools can show them while parsing is in progress. See utils.ParseWithWarnings.
+// Skipped entries are reported to fn too, and are not returned as *ErrPartialResult.
+type WarningParser interface {
+	Parser
+	ParseWithWarnings(r io.Reader, fn func(Warning)) ([]Library, []Dependency, error)
+}
+
+// StreamParser is implemented by parsers which can emit libraries as they are decoded,
+// so that huge files don't have to be held in memory.
+// Parsing stops at the first error returned by fn, which is returned as it is.
+type StreamParser interface {
+	Parser
+	ParseStream(r io.Reader, fn func(Library) error) error
 }
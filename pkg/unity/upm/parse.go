@@ -0,0 +1,73 @@
+package upm
+
+import (
+	"encoding/json"
+	"io"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+type lockFile struct {
+	Dependencies map[string]dependency
+}
+
+type dependency struct {
+	Version string
+	Depth   int
+	// e.g. registry, builtin, embedded, local, git
+	Source string
+	// Commit hash of git packages
+	Hash string
+}
+
+// Parser implements types.Parser for Packages/packages-lock.json
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses Packages/packages-lock.json
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	var lockFile lockFile
+	if err := json.NewDecoder(r).Decode(&lockFile); err != nil {
+		return nil, xerrors.Errorf("decode error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	var libs []types.Library
+	for name, dep := range lockFile.Dependencies {
+		var version string
+		switch dep.Source {
+		case "registry":
+			version = dep.Version
+		case "git":
+			// The version of git packages is the repository URL,
+			// so the resolved commit is used instead.
+			version = dep.Hash
+		default:
+			// Built-in modules ship with the editor, and embedded/local packages
+			// are part of the project itself, so they are not third-party dependencies.
+			continue
+		}
+
+		libs = append(libs, types.Library{
+			Name:    name,
+			Version: version,
+			// Packages listed in manifest.json have depth 0
+			Indirect: dep.Depth > 0,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
@@ -0,0 +1,13 @@
+package upm
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// Unity 2021.3 project with Version Control, glTFUtility (git) and
+	// an embedded and a local package added through the Package Manager window
+	upmNormal = []types.Library{
+		{Name: "com.github.siccity.gltfutility", Version: "b8f7a4e3b2c1b0a1f8d7e6c5b4a39281706f5e4d"},
+		{Name: "com.unity.collab-proxy", Version: "1.15.16"},
+		{Name: "com.unity.services.core", Version: "1.0.1", Indirect: true},
+	}
+)
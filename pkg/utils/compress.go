@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// zstdMaxWindow bounds the memory of zstd decompression. zstd shrinks the window to the size of smaller inputs,
+// and inputs compressed with larger windows, such as by "zstd --long", fail as malformed.
+const zstdMaxWindow = 8 << 20
+
+// ParseCompressed parses r with ParseWithLimits, decompressing it first if it is compressed.
+// e.g. lock files stored gzipped in artifact stores
+func ParseCompressed(limits types.Limits, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return ParseCompressedWithContext(context.Background(), limits, p, r)
+}
+
+// ParseCompressedWithContext is the same as ParseCompressed, but stops once ctx is done as ParseWithContext,
+// so that parsers sending requests over the network give them up.
+func ParseCompressedWithContext(ctx context.Context, limits types.Limits, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	dr, err := NewDecompressReader(r, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+	libs, deps, err := parseWithLimits(ctx, limits, p, dr)
+	// Parsers may wrap the error or ignore it, as for ParseWithLimits
+	if er, ok := dr.(*expansionReader); ok && er.err != nil {
+		return nil, nil, er.err
+	}
+	return libs, deps, err
+}
+
+// NewDecompressReader sniffs the magic bytes of r, and decompresses gzip and zstd transparently.
+// Other inputs are read as they are.
+//
+// The decompressed data fails with *types.ErrLimitExceeded once it exceeds MaxExpansionRatio times of
+// the compressed data read so far. MaxInputSize is left to the readers of the decompressed data, e.g. ParseWithLimits.
+func NewDecompressReader(r io.Reader, limits types.Limits) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		cr := &countingReader{r: br}
+		zr, err := gzip.NewReader(cr)
+		if err != nil {
+			return nil, xerrors.Errorf("gzip error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		return &expansionReader{r: zr, format: "gzip", compressed: cr, ratio: int64(limits.MaxExpansionRatio)}, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		cr := &countingReader{r: br}
+		// Blocks are decoded synchronously with the concurrency of 1, so that no goroutine is left behind
+		// without closing the decoder.
+		zr, err := zstd.NewReader(cr, zstd.WithDecoderConcurrency(1), zstd.WithDecoderLowmem(true),
+			zstd.WithDecoderMaxWindow(zstdMaxWindow))
+		if err != nil {
+			return nil, xerrors.Errorf("zstd error: %w", &types.ErrMalformedInput{Err: err})
+		}
+		return &expansionReader{r: zr, format: "zstd", compressed: cr, ratio: int64(limits.MaxExpansionRatio)}, nil
+	}
+	return br, nil
+}
+
+// expansionReader reads the decompressed data, and fails when it is too large for the compressed data.
+type expansionReader struct {
+	r io.Reader
+	// e.g. gzip
+	format     string
+	compressed *countingReader
+	ratio      int64
+	n          int64
+	err        error
+}
+
+func (r *expansionReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.ratio > 0 && r.n > r.compressed.n*r.ratio {
+		r.err = &types.ErrLimitExceeded{Limit: "MaxExpansionRatio", Max: r.ratio}
+		return 0, r.err
+	}
+	if err != nil && err != io.EOF {
+		return n, xerrors.Errorf("%s error: %w", r.format, &types.ErrMalformedInput{Err: err})
+	}
+	return n, err
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func gzipped(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func zstdCompressed(t *testing.T, s string) []byte {
+	var buf bytes.Buffer
+	zw, err := zstd.NewWriter(&buf)
+	require.NoError(t, err)
+	_, err = zw.Write([]byte(s))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestParseCompressed(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		limits    types.Limits
+		want      []types.Library
+		wantLimit string
+		wantErr   string
+	}{
+		{
+			name:  "uncompressed",
+			input: []byte("abc"),
+			want:  []types.Library{{Name: "abc"}},
+		},
+		{
+			name:  "short input",
+			input: []byte("a"),
+			want:  []types.Library{{Name: "a"}},
+		},
+		{
+			name:   "gzip",
+			input:  gzipped(t, "abc"),
+			limits: types.Limits{MaxExpansionRatio: 10},
+			want:   []types.Library{{Name: "abc"}},
+		},
+		{
+			name:      "input size after decompression",
+			input:     gzipped(t, strings.Repeat("a", 100)),
+			limits:    types.Limits{MaxInputSize: 99},
+			wantLimit: "MaxInputSize",
+		},
+		{
+			name:      "expansion ratio",
+			input:     gzipped(t, strings.Repeat("a", 1<<20)),
+			limits:    types.Limits{MaxExpansionRatio: 100},
+			wantLimit: "MaxExpansionRatio",
+		},
+		{
+			name:    "broken gzip",
+			input:   []byte{0x1f, 0x8b, 0x00},
+			wantErr: "gzip error",
+		},
+		{
+			name:   "zstd",
+			input:  zstdCompressed(t, "abc"),
+			limits: types.Limits{MaxExpansionRatio: 10},
+			want:   []types.Library{{Name: "abc"}},
+		},
+		{
+			name:      "zstd expansion ratio",
+			input:     zstdCompressed(t, strings.Repeat("a", 1<<20)),
+			limits:    types.Limits{MaxExpansionRatio: 100},
+			wantLimit: "MaxExpansionRatio",
+		},
+		{
+			name:    "broken zstd",
+			input:   []byte{0x28, 0xb5, 0x2f, 0xfd, 0x00},
+			wantErr: "zstd error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := ParseCompressed(tt.limits, readAllParser{}, bytes.NewReader(tt.input))
+			switch {
+			case tt.wantLimit != "":
+				var limitErr *types.ErrLimitExceeded
+				require.True(t, errors.As(err, &limitErr), err)
+				assert.Equal(t, tt.wantLimit, limitErr.Limit)
+				return
+			case tt.wantErr != "":
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
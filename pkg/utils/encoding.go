@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+var (
+	utf8BOM    = []byte{0xef, 0xbb, 0xbf}
+	utf16LEBOM = []byte{0xff, 0xfe}
+	utf16BEBOM = []byte{0xfe, 0xff}
+
+	// XML documents in UTF-16 without the byte order mark start with "<?"
+	utf16LEXML = []byte{'<', 0, '?', 0}
+	utf16BEXML = []byte{0, '<', 0, '?'}
+)
+
+// NewUTF8Reader returns a reader of r transcoded into UTF-8, for files generated on Windows.
+// Inputs starting with the byte order mark of UTF-16, or an XML declaration in UTF-16, are transcoded,
+// and the byte order mark of UTF-8 is removed. Other inputs are read as they are.
+func NewUTF8Reader(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	// Errors are returned by the following reads
+	head, _ := br.Peek(len(utf16LEXML))
+
+	switch {
+	case bytes.HasPrefix(head, utf8BOM):
+		_, _ = br.Discard(len(utf8BOM))
+	case bytes.HasPrefix(head, utf16LEBOM):
+		_, _ = br.Discard(len(utf16LEBOM))
+		return &utf16Reader{r: br, order: binary.LittleEndian}
+	case bytes.HasPrefix(head, utf16BEBOM):
+		_, _ = br.Discard(len(utf16BEBOM))
+		return &utf16Reader{r: br, order: binary.BigEndian}
+	case bytes.Equal(head, utf16LEXML):
+		return &utf16Reader{r: br, order: binary.LittleEndian}
+	case bytes.Equal(head, utf16BEXML):
+		return &utf16Reader{r: br, order: binary.BigEndian}
+	}
+	return br
+}
+
+// CharsetReader is set to xml.Decoder reading from NewUTF8Reader,
+// so that documents declaring UTF-16 are accepted after they are transcoded.
+func CharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "utf-16", "utf-16le", "utf-16be", "unicode":
+		return input, nil
+	}
+	return nil, xerrors.Errorf("unsupported charset: %s", charset)
+}
+
+// utf16Reader transcodes UTF-16 into UTF-8.
+type utf16Reader struct {
+	r     *bufio.Reader
+	order binary.ByteOrder
+	// Transcoded bytes not read yet
+	buf []byte
+	err error
+}
+
+func (r *utf16Reader) Read(p []byte) (int, error) {
+	for len(r.buf) < len(p) && r.err == nil {
+		var rn rune
+		rn, r.err = r.readRune()
+		if r.err != nil {
+			break
+		}
+		var b [utf8.UTFMax]byte
+		n := utf8.EncodeRune(b[:], rn)
+		r.buf = append(r.buf, b[:n]...)
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	if len(r.buf) == 0 && r.err != nil {
+		return n, r.err
+	}
+	return n, nil
+}
+
+func (r *utf16Reader) readRune() (rune, error) {
+	u, err := r.readUnit()
+	if err != nil {
+		return 0, err
+	}
+	if !utf16.IsSurrogate(rune(u)) {
+		return rune(u), nil
+	}
+	u2, err := r.readUnit()
+	if err == io.EOF {
+		return 0, &types.ErrMalformedInput{Err: xerrors.New("unexpected EOF in UTF-16 surrogate pair")}
+	} else if err != nil {
+		return 0, err
+	}
+	// Broken pairs are decoded into U+FFFD
+	return utf16.DecodeRune(rune(u), rune(u2)), nil
+}
+
+func (r *utf16Reader) readUnit() (uint16, error) {
+	var b [2]byte
+	n, err := io.ReadFull(r.r, b[:])
+	switch {
+	case err == io.EOF:
+		return 0, io.EOF
+	case err == io.ErrUnexpectedEOF:
+		return 0, &types.ErrMalformedInput{Err: xerrors.Errorf("odd number of bytes in UTF-16: %d", n)}
+	case err != nil:
+		return 0, xerrors.Errorf("read error: %w", err)
+	}
+	return r.order.Uint16(b[:]), nil
+}
@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestNewUTF8Reader(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "UTF-8",
+			input: "Flask==2.0.0",
+			want:  "Flask==2.0.0",
+		},
+		{
+			name:  "UTF-8 with byte order mark",
+			input: "\xef\xbb\xbfFlask==2.0.0",
+			want:  "Flask==2.0.0",
+		},
+		{
+			name:  "UTF-16LE",
+			input: "\xff\xfeF\x00=\x00\xe9\x00=\x00\x3d\xd8\x00\xde",
+			want:  "F=é=\U0001f600",
+		},
+		{
+			name:  "UTF-16BE",
+			input: "\xfe\xff\x00F\x00=\x00\xe9\x00=\xd8\x3d\xde\x00",
+			want:  "F=é=\U0001f600",
+		},
+		{
+			name:  "UTF-16LE XML without byte order mark",
+			input: "<\x00?\x00x\x00",
+			want:  "<?x",
+		},
+		{
+			name:  "UTF-16BE XML without byte order mark",
+			input: "\x00<\x00?\x00x",
+			want:  "<?x",
+		},
+		{
+			name:  "short input",
+			input: "a",
+			want:  "a",
+		},
+		{
+			name:    "odd number of bytes",
+			input:   "\xff\xfeF\x00=",
+			wantErr: true,
+		},
+		{
+			name:    "truncated surrogate pair",
+			input:   "\xff\xfe\x3d\xd8",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Read a byte at a time to test the buffering of transcoded runes
+			got, err := io.ReadAll(iotest.OneByteReader(NewUTF8Reader(strings.NewReader(tt.input))))
+			if tt.wantErr {
+				var malformedErr *types.ErrMalformedInput
+				assert.True(t, errors.As(err, &malformedErr), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestCharsetReader(t *testing.T) {
+	r := strings.NewReader("")
+	got, err := CharsetReader("UTF-16", r)
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+
+	_, err = CharsetReader("shift_jis", r)
+	assert.EqualError(t, err, "unsupported charset: shift_jis")
+}
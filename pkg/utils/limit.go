@@ -0,0 +1,233 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// ParseWithLimits parses r with p, and fails with *types.ErrLimitExceeded once the input exceeds limits.
+// MaxExpansionRatio is only applied by parsers of archives. e.g. jar.WithLimits
+func ParseWithLimits(limits types.Limits, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	return parseWithLimits(context.Background(), limits, p, r)
+}
+
+func parseWithLimits(ctx context.Context, limits types.Limits, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	lr := NewLimitReader(r, limits)
+	libs, deps, err := ParseWithContext(ctx, p, lr)
+	// Parsers may wrap the error or ignore it, e.g. after decoding the first JSON value
+	if limitErr := lr.Err(); limitErr != nil {
+		return nil, nil, limitErr
+	}
+	if err != nil {
+		return libs, deps, err
+	}
+	if limits.MaxEntries > 0 && len(libs) > limits.MaxEntries {
+		return nil, nil, &types.ErrLimitExceeded{Limit: "MaxEntries", Max: int64(limits.MaxEntries)}
+	}
+	return libs, deps, nil
+}
+
+type format int
+
+const (
+	formatUnknown format = iota
+	formatJSON
+	formatXML
+	// The first byte is not a whitespace, '{' or '<'.
+	// Inputs starting with '[' are not counted, as TOML tables look like JSON arrays.
+	formatOther
+)
+
+type xmlState int
+
+const (
+	xmlText xmlState = iota
+	// Just after '<'
+	xmlOpen
+	// In "<!", which can be a comment, CDATA or a declaration
+	xmlBang
+	xmlTag
+	xmlQuoted
+	// Until '>' of closing tags and declarations
+	xmlSkipTag
+	// Until the terminator of comments, CDATA and processing instructions
+	xmlSkipUntil
+)
+
+// LimitReader reads from the underlying reader until MaxInputSize and MaxDepth of the limits are exceeded.
+// The nesting depth of JSON objects and XML documents is counted on the raw bytes,
+// so that it fails before decoders allocate for it.
+type LimitReader struct {
+	r      io.Reader
+	limits types.Limits
+	n      int64
+	err    error
+
+	format format
+	depth  int
+
+	// for JSON
+	inString bool
+	escaped  bool
+
+	// for XML
+	state      xmlState
+	quote      byte
+	slash      bool
+	bang       []byte
+	terminator string
+	window     []byte
+}
+
+// NewLimitReader returns LimitReader reading from r.
+func NewLimitReader(r io.Reader, limits types.Limits) *LimitReader {
+	return &LimitReader{r: r, limits: limits}
+}
+
+// Err returns *types.ErrLimitExceeded if any limit has been exceeded.
+func (r *LimitReader) Err() error {
+	return r.err
+}
+
+func (r *LimitReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.limits.MaxInputSize > 0 && r.n > r.limits.MaxInputSize {
+		r.err = &types.ErrLimitExceeded{Limit: "MaxInputSize", Max: r.limits.MaxInputSize}
+		return 0, r.err
+	}
+	if r.limits.MaxDepth > 0 {
+		for _, b := range p[:n] {
+			r.scan(b)
+			if r.depth > r.limits.MaxDepth {
+				r.err = &types.ErrLimitExceeded{Limit: "MaxDepth", Max: int64(r.limits.MaxDepth)}
+				return 0, r.err
+			}
+		}
+	}
+	return n, err
+}
+
+func (r *LimitReader) scan(b byte) {
+	if r.format == formatUnknown {
+		switch b {
+		case ' ', '\t', '\r', '\n', 0xEF, 0xBB, 0xBF: // whitespaces and UTF-8 BOM
+			return
+		case '{':
+			r.format = formatJSON
+		case '<':
+			r.format = formatXML
+		default:
+			r.format = formatOther
+		}
+	}
+
+	switch r.format {
+	case formatJSON:
+		r.scanJSON(b)
+	case formatXML:
+		r.scanXML(b)
+	}
+}
+
+func (r *LimitReader) scanJSON(b byte) {
+	if r.inString {
+		switch {
+		case r.escaped:
+			r.escaped = false
+		case b == '\\':
+			r.escaped = true
+		case b == '"':
+			r.inString = false
+		}
+		return
+	}
+
+	switch b {
+	case '"':
+		r.inString = true
+	case '{', '[':
+		r.depth++
+	case '}', ']':
+		r.depth--
+	}
+}
+
+func (r *LimitReader) scanXML(b byte) {
+	switch r.state {
+	case xmlText:
+		if b == '<' {
+			r.state = xmlOpen
+		}
+	case xmlOpen:
+		switch b {
+		case '/':
+			r.depth--
+			r.state = xmlSkipTag
+		case '?':
+			r.skipUntil("?>")
+		case '!':
+			r.bang = r.bang[:0]
+			r.state = xmlBang
+		default:
+			r.depth++
+			r.slash = false
+			r.state = xmlTag
+		}
+	case xmlBang:
+		r.bang = append(r.bang, b)
+		switch {
+		case string(r.bang) == "--":
+			r.skipUntil("-->")
+		case string(r.bang) == "[CDATA[":
+			r.skipUntil("]]>")
+		case !bytes.HasPrefix([]byte("--"), r.bang) && !bytes.HasPrefix([]byte("[CDATA["), r.bang):
+			// e.g. <!DOCTYPE ...>
+			r.state = xmlSkipTag
+			if b == '>' {
+				r.state = xmlText
+			}
+		}
+	case xmlTag:
+		switch b {
+		case '"', '\'':
+			r.quote = b
+			r.state = xmlQuoted
+		case '>':
+			// Empty-element tags don't nest. e.g. <br/>
+			if r.slash {
+				r.depth--
+			}
+			r.state = xmlText
+		}
+		r.slash = b == '/'
+	case xmlQuoted:
+		if b == r.quote {
+			r.state = xmlTag
+		}
+	case xmlSkipTag:
+		if b == '>' {
+			r.state = xmlText
+		}
+	case xmlSkipUntil:
+		r.window = append(r.window, b)
+		if len(r.window) > len(r.terminator) {
+			r.window = r.window[1:]
+		}
+		if string(r.window) == r.terminator {
+			r.state = xmlText
+		}
+	}
+}
+
+func (r *LimitReader) skipUntil(terminator string) {
+	r.terminator = terminator
+	r.window = r.window[:0]
+	r.state = xmlSkipUntil
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestLimitReader(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		limits    types.Limits
+		wantLimit string
+	}{
+		{
+			name:   "within limits",
+			input:  `{"a": {"b": [1, 2]}}`,
+			limits: types.Limits{MaxInputSize: 20, MaxDepth: 3},
+		},
+		{
+			name:      "input size",
+			input:     `{"a": {"b": [1, 2]}}`,
+			limits:    types.Limits{MaxInputSize: 19},
+			wantLimit: "MaxInputSize",
+		},
+		{
+			name:      "JSON depth",
+			input:     `{"a": {"b": [1, 2]}}`,
+			limits:    types.Limits{MaxDepth: 2},
+			wantLimit: "MaxDepth",
+		},
+		{
+			name:   "brackets in JSON strings",
+			input:  `{"a": "{[\"{["}`,
+			limits: types.Limits{MaxDepth: 1},
+		},
+		{
+			name: "XML",
+			input: `<?xml version="1.0"?>
+<!DOCTYPE project>
+<!-- <a><b><c> -->
+<project a="<x>" b='/>'>
+  <dependencies><dependency/><dependency/></dependencies>
+  <description><![CDATA[<a><b><c>]]></description>
+</project>`,
+			limits: types.Limits{MaxDepth: 3},
+		},
+		{
+			name:      "XML depth",
+			input:     `<project><dependencies><dependency><version/></dependency></dependencies></project>`,
+			limits:    types.Limits{MaxDepth: 3},
+			wantLimit: "MaxDepth",
+		},
+		{
+			name:   "TOML",
+			input:  "[[package]]\nname = \"a\"\n[[package]]\nname = \"b\"\n",
+			limits: types.Limits{MaxDepth: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Read byte by byte so that the states are kept across reads
+			r := NewLimitReader(iotest.OneByteReader(strings.NewReader(tt.input)), tt.limits)
+			_, err := ioutil.ReadAll(r)
+			if tt.wantLimit == "" {
+				require.NoError(t, err)
+				return
+			}
+			var limitErr *types.ErrLimitExceeded
+			require.True(t, errors.As(err, &limitErr), err)
+			assert.Equal(t, tt.wantLimit, limitErr.Limit)
+			assert.Equal(t, err, r.Err())
+		})
+	}
+}
+
+// charParser returns a library for each character
+type charParser struct{}
+
+func (p charParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, xerrors.Errorf("read error: %w", err)
+	}
+	var libs []types.Library
+	for _, c := range b {
+		libs = append(libs, types.Library{Name: string(c)})
+	}
+	return libs, nil, nil
+}
+
+func TestParseWithLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		limits    types.Limits
+		want      []types.Library
+		wantLimit string
+	}{
+		{
+			name:   "within limits",
+			limits: types.Limits{MaxInputSize: 3, MaxEntries: 3},
+			want:   []types.Library{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		},
+		{
+			name:      "input size",
+			limits:    types.Limits{MaxInputSize: 2},
+			wantLimit: "MaxInputSize",
+		},
+		{
+			name:      "entries",
+			limits:    types.Limits{MaxEntries: 2},
+			wantLimit: "MaxEntries",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := ParseWithLimits(tt.limits, charParser{}, strings.NewReader("abc"))
+			if tt.wantLimit != "" {
+				// The limit error is returned as it is, even if the parser wraps it
+				var limitErr *types.ErrLimitExceeded
+				require.True(t, errors.As(err, &limitErr), err)
+				assert.Equal(t, err, limitErr)
+				assert.Equal(t, tt.wantLimit, limitErr.Limit)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
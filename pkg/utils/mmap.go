@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+)
+
+// BytesReader is implemented by readers whose contents are already in memory, such as MappedFile.
+type BytesReader interface {
+	io.Reader
+	// Bytes returns the unread contents without copying them.
+	Bytes() []byte
+}
+
+// MappedFile is a file mapped into memory, so that large inputs such as fat JARs are not copied into the Go heap.
+// It must not be closed until the libraries parsed from it are returned.
+type MappedFile struct {
+	*bytes.Reader
+	data  []byte
+	unmap func() error
+}
+
+// Bytes returns the unread contents of the file.
+func (f *MappedFile) Bytes() []byte {
+	return f.data[len(f.data)-f.Len():]
+}
+
+// Close unmaps the file. The bytes returned by Bytes must not be used after that.
+func (f *MappedFile) Close() error {
+	if f.unmap == nil {
+		return nil
+	}
+	err := f.unmap()
+	f.unmap = nil
+	f.data = nil
+	f.Reader = bytes.NewReader(nil)
+	return err
+}
+
+// ReadAll returns the contents of r. Readers implementing BytesReader, such as MappedFile, are not copied.
+func ReadAll(r io.Reader) ([]byte, error) {
+	if br, ok := r.(BytesReader); ok {
+		return br.Bytes(), nil
+	}
+	return ioutil.ReadAll(r)
+}
@@ -0,0 +1,20 @@
+//go:build !linux && !darwin && !dragonfly && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!dragonfly,!freebsd,!netbsd,!openbsd
+
+package utils
+
+import (
+	"bytes"
+	"os"
+
+	"golang.org/x/xerrors"
+)
+
+// OpenMapped reads the file into memory, as mapping files is not supported on this platform.
+func OpenMapped(name string) (*MappedFile, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, xerrors.Errorf("file read error: %w", err)
+	}
+	return &MappedFile{Reader: bytes.NewReader(data), data: data}, nil
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenMapped(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "requirements.txt")
+	require.NoError(t, os.WriteFile(name, []byte("click==8.0.0\nFlask==2.0.0\n"), 0o600))
+
+	f, err := OpenMapped(name)
+	require.NoError(t, err)
+
+	line := make([]byte, len("click==8.0.0\n"))
+	_, err = io.ReadFull(f, line)
+	require.NoError(t, err)
+	assert.Equal(t, "click==8.0.0\n", string(line))
+
+	// The unread contents are returned without being copied
+	b, err := ReadAll(f)
+	require.NoError(t, err)
+	assert.Equal(t, "Flask==2.0.0\n", string(b))
+	assert.Equal(t, &f.Bytes()[0], &b[0])
+
+	require.NoError(t, f.Close())
+	require.NoError(t, f.Close())
+	assert.Empty(t, f.Bytes())
+}
+
+func TestOpenMapped_Empty(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "empty.lock")
+	require.NoError(t, os.WriteFile(name, nil, 0o600))
+
+	f, err := OpenMapped(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	b, err := ReadAll(f)
+	require.NoError(t, err)
+	assert.Empty(t, b)
+}
+
+func TestReadAll(t *testing.T) {
+	b, err := ReadAll(strings.NewReader("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(b))
+}
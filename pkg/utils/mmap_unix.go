@@ -0,0 +1,45 @@
+//go:build linux || darwin || dragonfly || freebsd || netbsd || openbsd
+// +build linux darwin dragonfly freebsd netbsd openbsd
+
+package utils
+
+import (
+	"bytes"
+	"os"
+	"syscall"
+
+	"golang.org/x/xerrors"
+)
+
+// OpenMapped maps the file into memory. Empty files are not mapped.
+func OpenMapped(name string) (*MappedFile, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, xerrors.Errorf("file open error: %w", err)
+	}
+	// The mapping is kept after the file is closed
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, xerrors.Errorf("stat error: %w", err)
+	}
+	size := info.Size()
+	if size == 0 {
+		return &MappedFile{Reader: bytes.NewReader(nil)}, nil
+	} else if size != int64(int(size)) {
+		return nil, xerrors.Errorf("file too large to map: %d bytes", size)
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(size), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, xerrors.Errorf("mmap error: %w", err)
+	}
+	return &MappedFile{
+		Reader: bytes.NewReader(data),
+		data:   data,
+		unmap: func() error {
+			return syscall.Munmap(data)
+		},
+	}, nil
+}
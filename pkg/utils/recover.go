@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"go.uber.org/zap"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// RecoverPanic recovers a panic of the parser, and sets *err to *types.ErrMalformedInput with the panic
+// and where it happened, as the parsers run on untrusted files. It must be deferred directly by the parser.
+//
+// e.g.
+//
+//	func Parse(r io.Reader) (_ []types.Library, err error) {
+//		defer utils.RecoverPanic(&err)
+func RecoverPanic(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	log.Logger.Debugw("Recovered from a panic", zap.Any("panic", r), zap.ByteString("stack", debug.Stack()))
+	if loc := panicLocation(); loc != "" {
+		*err = &types.ErrMalformedInput{Err: xerrors.Errorf("panic at %s: %v", loc, r)}
+		return
+	}
+	*err = &types.ErrMalformedInput{Err: xerrors.Errorf("panic: %v", r)}
+}
+
+// panicLocation returns the file and line of the function which panicked, skipping the frames of the runtime.
+// e.g. pkg/nodejs/yarn/parse.go:120
+func panicLocation() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	var panicked bool
+	for {
+		frame, more := frames.Next()
+		switch {
+		case frame.Function == "runtime.gopanic":
+			panicked = true
+		case panicked && !strings.HasPrefix(frame.Function, "runtime."):
+			file := frame.File
+			if i := strings.LastIndex(file, "/pkg/"); i != -1 {
+				file = file[i+1:]
+			}
+			return fmt.Sprintf("%s:%d", file, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
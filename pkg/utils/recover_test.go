@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestRecoverPanic(t *testing.T) {
+	parse := func(ss []string) (_ []types.Library, err error) {
+		defer RecoverPanic(&err)
+		return []types.Library{{Name: ss[1]}}, nil
+	}
+
+	got, err := parse([]string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Library{{Name: "b"}}, got)
+
+	got, err = parse([]string{"a"})
+	assert.Nil(t, got)
+	var malformedErr *types.ErrMalformedInput
+	require.True(t, errors.As(err, &malformedErr), err)
+	assert.Regexp(t, `^panic at pkg/utils/recover_test\.go:\d+: runtime error: index out of range \[1\] with length 1$`, err.Error())
+}
@@ -0,0 +1,301 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/log"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+// PackageID returns the ID used to refer to libraries in a dependency graph.
+// e.g. lodash@4.17.21
+func PackageID(name, version string) string {
+	return fmt.Sprintf("%s@%s", name, version)
+}
+
+// Interner deduplicates the strings repeated across a parse, such as groupIds, scopes and registry URLs.
+// The first occurrence is copied, so that interned strings don't keep the lines they were sliced from alive.
+// The zero value is ready to use. It is not safe for concurrent use.
+type Interner struct {
+	strings map[string]string
+}
+
+// Intern returns the string equal to s which was interned first.
+func (in *Interner) Intern(s string) string {
+	if interned, ok := in.strings[s]; ok {
+		return interned
+	}
+	if in.strings == nil {
+		in.strings = map[string]string{}
+	}
+	interned := string([]byte(s))
+	in.strings[interned] = interned
+	return interned
+}
+
+// UniqueLibraries removes duplicated libraries while keeping the order of first appearance.
+// Libraries are considered the same when their ID, name, version and qualifiers are equal,
+// and the locations of duplicates are merged into the first one.
+func UniqueLibraries(libs []types.Library) []types.Library {
+	type key struct {
+		id, name, version, qualifiers string
+	}
+
+	var uniqLibs []types.Library
+	unique := map[key]int{}
+	for _, lib := range libs {
+		k := key{id: lib.ID, name: lib.Name, version: lib.Version, qualifiers: qualifiersKey(lib.Qualifiers)}
+		if i, ok := unique[k]; ok {
+			if len(lib.Locations) > 0 {
+				locs := append([]types.Location{}, uniqLibs[i].Locations...)
+				uniqLibs[i].Locations = append(locs, lib.Locations...)
+			}
+			continue
+		}
+		unique[k] = len(uniqLibs)
+		uniqLibs = append(uniqLibs, lib)
+	}
+	return uniqLibs
+}
+
+// qualifiersKey returns the qualifiers in the sorted order. e.g. classifier=sources&type=jar
+func qualifiersKey(q map[string]string) string {
+	var pairs []string
+	for k, v := range q {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// SortLibraries sorts libraries by name, version and file path, so that the output doesn't depend on map iteration.
+// The order of libraries with the same keys is kept.
+func SortLibraries(libs []types.Library) {
+	sort.SliceStable(libs, func(i, j int) bool {
+		if libs[i].Name != libs[j].Name {
+			return libs[i].Name < libs[j].Name
+		}
+		if libs[i].Version != libs[j].Version {
+			return libs[i].Version < libs[j].Version
+		}
+		return libs[i].FilePath < libs[j].FilePath
+	})
+}
+
+// SortDependencies sorts dependencies by ID, and the IDs they depend on.
+func SortDependencies(deps []types.Dependency) {
+	for _, dep := range deps {
+		sort.Strings(dep.DependsOn)
+	}
+	sort.SliceStable(deps, func(i, j int) bool {
+		return deps[i].ID < deps[j].ID
+	})
+}
+
+// TOMLTableLocations returns the lines of each element of an array of tables, in order.
+// Sub-tables such as [package.dependencies] belong to the preceding element.
+//
+// e.g. name = "package" for Cargo.lock and poetry.lock
+//
+//	[[package]]
+//	name = "libc"
+//	version = "0.2.54"
+func TOMLTableLocations(b []byte, name string) []types.Location {
+	header := "[[" + name + "]]"
+	var locs []types.Location
+	var inTable bool
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	var lineNum int
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case line == header:
+			inTable = true
+			locs = append(locs, types.Location{StartLine: lineNum, EndLine: lineNum})
+			continue
+		case strings.HasPrefix(line, "["):
+			inTable = len(locs) > 0 && (strings.HasPrefix(line, "["+name+".") || strings.HasPrefix(line, "[["+name+"."))
+		}
+		if inTable {
+			locs[len(locs)-1].EndLine = lineNum
+		}
+	}
+	return locs
+}
+
+// ParseWithContext parses r with p, and stops once ctx is done.
+// Parsers implementing types.ContextParser are given ctx,
+// and the others fail at their next read from r.
+func ParseWithContext(ctx context.Context, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	if cp, ok := p.(types.ContextParser); ok {
+		return cp.ParseWithContext(ctx, r)
+	}
+	// Contexts which are never done, such as context.Background(), leave r as it is
+	if ctx.Done() == nil {
+		return p.Parse(r)
+	}
+	return p.Parse(contextReader{ctx: ctx, r: r})
+}
+
+// ParseWithMode parses r with p, and treats malformed entries according to mode.
+func ParseWithMode(mode types.Mode, p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, deps, err := p.Parse(r)
+	return ApplyMode(mode, libs, deps, err)
+}
+
+// ApplyMode converts the result of a parser according to mode,
+// so that it can also be applied to ParseWithContext and types.FSParser.
+// Errors other than *types.ErrPartialResult are returned as they are in any mode.
+func ApplyMode(mode types.Mode, libs []types.Library, deps []types.Dependency, err error) ([]types.Library, []types.Dependency, error) {
+	var partial *types.ErrPartialResult
+	if err == nil || !errors.As(err, &partial) {
+		return libs, deps, err
+	}
+
+	switch mode {
+	case types.ModeStrict:
+		return nil, nil, err
+	case types.ModeLenient:
+		for _, e := range partial.Errs {
+			log.Logger.Warnw("Skipped a malformed entry", zap.Error(e))
+		}
+		return libs, deps, nil
+	}
+	return libs, deps, err
+}
+
+// ParseSorted parses r with p, and sorts the result with SortLibraries and SortDependencies.
+// Parsers return libraries in the order of the file where it is meaningful, e.g. for locations,
+// and callers comparing the results of different runs or parsers should use this.
+func ParseSorted(p types.Parser, r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, deps, err := p.Parse(r)
+	SortLibraries(libs)
+	SortDependencies(deps)
+	return libs, deps, err
+}
+
+// ParseStream parses r with p and calls fn for each library.
+// Parsers implementing types.StreamParser emit libraries as they are decoded,
+// and the others parse the whole file first.
+// Libraries of a partial result are emitted before *types.ErrPartialResult is returned.
+func ParseStream(p types.Parser, r io.Reader, fn func(types.Library) error) error {
+	if sp, ok := p.(types.StreamParser); ok {
+		return sp.ParseStream(r, fn)
+	}
+	libs, _, parseErr := p.Parse(r)
+	var partial *types.ErrPartialResult
+	if parseErr != nil && !errors.As(parseErr, &partial) {
+		return parseErr
+	}
+	for _, lib := range libs {
+		if err := fn(lib); err != nil {
+			return err
+		}
+	}
+	return parseErr
+}
+
+// ParseResult parses r with p, and returns the non-fatal issues as the warnings of types.Result.
+// Parsers implementing types.ResultParser or types.WarningParser report their own warnings,
+// and the errors of *types.ErrPartialResult are reported as types.WarningSkippedEntry for the others.
+// Stats are filled regardless of the parser.
+func ParseResult(p types.Parser, r io.Reader) (types.Result, error) {
+	cr := &countingReader{r: r}
+
+	var result types.Result
+	var err error
+	if rp, ok := p.(types.ResultParser); ok {
+		result, err = rp.ParseResult(cr)
+	} else {
+		result.Libraries, result.Dependencies, err = ParseWithWarnings(p, cr, func(w types.Warning) {
+			result.Warnings = append(result.Warnings, w)
+		})
+	}
+
+	var partial *types.ErrPartialResult
+	if err != nil && !errors.As(err, &partial) {
+		return types.Result{}, err
+	}
+	if partial != nil {
+		result.Warnings = append(result.Warnings, SkippedEntryWarnings(partial)...)
+	}
+
+	result.Stats = types.Stats{
+		BytesRead:    cr.n,
+		Libraries:    len(result.Libraries),
+		Dependencies: len(result.Dependencies),
+	}
+	for _, w := range result.Warnings {
+		if w.Kind == types.WarningSkippedEntry {
+			result.Stats.Skipped++
+		}
+	}
+	return result, nil
+}
+
+// ParseWithWarnings parses r with p, and calls fn for each warning.
+// Parsers implementing types.WarningParser call fn as soon as the issues are found,
+// and the errors of *types.ErrPartialResult are reported after parsing for the others.
+// *types.ErrPartialResult is not returned either way.
+func ParseWithWarnings(p types.Parser, r io.Reader, fn func(types.Warning)) ([]types.Library, []types.Dependency, error) {
+	if wp, ok := p.(types.WarningParser); ok {
+		return wp.ParseWithWarnings(r, fn)
+	}
+	libs, deps, err := p.Parse(r)
+	var partial *types.ErrPartialResult
+	if err == nil || !errors.As(err, &partial) {
+		return libs, deps, err
+	}
+	for _, w := range SkippedEntryWarnings(partial) {
+		fn(w)
+	}
+	return libs, deps, nil
+}
+
+// SkippedEntryWarnings converts the errors of a partial result into warnings.
+func SkippedEntryWarnings(partial *types.ErrPartialResult) []types.Warning {
+	var warnings []types.Warning
+	for _, e := range partial.Errs {
+		warnings = append(warnings, types.Warning{Kind: types.WarningSkippedEntry, Message: e.Error()})
+	}
+	return warnings
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
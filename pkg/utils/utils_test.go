@@ -0,0 +1,389 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestUniqueLibraries(t *testing.T) {
+	tests := []struct {
+		name string
+		libs []types.Library
+		want []types.Library
+	}{
+		{
+			name: "duplicates",
+			libs: []types.Library{
+				{Name: "b", Version: "1.0.0"},
+				{Name: "a", Version: "1.0.0"},
+				{Name: "b", Version: "1.0.0", Licenses: []string{"MIT"}},
+				{Name: "b", Version: "2.0.0"},
+			},
+			want: []types.Library{
+				{Name: "b", Version: "1.0.0"},
+				{Name: "a", Version: "1.0.0"},
+				{Name: "b", Version: "2.0.0"},
+			},
+		},
+		{
+			name: "different IDs",
+			libs: []types.Library{
+				{ID: "1", Name: "a", Version: "1.0.0"},
+				{ID: "2", Name: "a", Version: "1.0.0"},
+			},
+			want: []types.Library{
+				{ID: "1", Name: "a", Version: "1.0.0"},
+				{ID: "2", Name: "a", Version: "1.0.0"},
+			},
+		},
+		{
+			name: "locations",
+			libs: []types.Library{
+				{Name: "a", Version: "1.0.0", Locations: []types.Location{{StartLine: 1, EndLine: 3}}},
+				{Name: "a", Version: "1.0.0", Locations: []types.Location{{StartLine: 5, EndLine: 7}}},
+			},
+			want: []types.Library{
+				{
+					Name:    "a",
+					Version: "1.0.0",
+					Locations: []types.Location{
+						{StartLine: 1, EndLine: 3},
+						{StartLine: 5, EndLine: 7},
+					},
+				},
+			},
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UniqueLibraries(tt.libs)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInterner(t *testing.T) {
+	var in Interner
+	line := "org.apache.commons:commons-lang3:jar:3.12.0:compile"
+	assert.Equal(t, "org.apache.commons", in.Intern(line[:18]))
+	assert.Equal(t, "compile", in.Intern(line[44:]))
+	assert.Equal(t, "", in.Intern(""))
+
+	// Interned strings are not copied again
+	other := "org.apache.commons:commons-text:jar:1.10.0:compile"
+	allocs := testing.AllocsPerRun(10, func() {
+		in.Intern(other[:18])
+		in.Intern(other[43:])
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestParseSorted(t *testing.T) {
+	libs, deps, err := ParseSorted(unsortedParser{}, strings.NewReader(""))
+	require.NoError(t, err)
+
+	want := []types.Library{
+		{Name: "a", Version: "1.0.0", FilePath: "a/package.json"},
+		{Name: "a", Version: "1.0.0", FilePath: "b/package.json"},
+		{Name: "a", Version: "2.0.0"},
+		{Name: "b", Version: "1.0.0"},
+	}
+	assert.Equal(t, want, libs)
+
+	wantDeps := []types.Dependency{
+		{ID: "a@2.0.0", DependsOn: []string{"b@1.0.0"}},
+		{ID: "b@1.0.0", DependsOn: []string{"a@1.0.0", "a@2.0.0"}},
+	}
+	assert.Equal(t, wantDeps, deps)
+}
+
+type unsortedParser struct{}
+
+func (p unsortedParser) Parse(_ io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs := []types.Library{
+		{Name: "b", Version: "1.0.0"},
+		{Name: "a", Version: "2.0.0"},
+		{Name: "a", Version: "1.0.0", FilePath: "b/package.json"},
+		{Name: "a", Version: "1.0.0", FilePath: "a/package.json"},
+	}
+	deps := []types.Dependency{
+		{ID: "b@1.0.0", DependsOn: []string{"a@2.0.0", "a@1.0.0"}},
+		{ID: "a@2.0.0", DependsOn: []string{"b@1.0.0"}},
+	}
+	return libs, deps, nil
+}
+
+func TestTOMLTableLocations(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []types.Location
+	}{
+		{
+			name: "sub-tables and other tables",
+			input: `# comment
+[[package]]
+name = "a"
+version = "1.0.0"
+
+[package.dependencies]
+b = "*"
+
+[[package]]
+name = "b"
+version = "2.0.0"
+
+[metadata]
+content-hash = "abc"
+`,
+			want: []types.Location{
+				{StartLine: 2, EndLine: 7},
+				{StartLine: 9, EndLine: 11},
+			},
+		},
+		{
+			name:  "no tables",
+			input: "[metadata]\nversion = 1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TOMLTableLocations([]byte(tt.input), "package")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type readAllParser struct{}
+
+func (p readAllParser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []types.Library{{Name: string(b)}}, nil, nil
+}
+
+type contextParser struct {
+	readAllParser
+}
+
+func (p contextParser) ParseWithContext(ctx context.Context, _ io.Reader) ([]types.Library, []types.Dependency, error) {
+	return []types.Library{{Name: "context"}}, nil, nil
+}
+
+func TestParseWithContext(t *testing.T) {
+	t.Run("not canceled", func(t *testing.T) {
+		libs, _, err := ParseWithContext(context.Background(), readAllParser{}, strings.NewReader("a"))
+		require.NoError(t, err)
+		assert.Equal(t, []types.Library{{Name: "a"}}, libs)
+	})
+
+	t.Run("canceled while reading", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		go func() {
+			_, _ = pw.Write([]byte("a"))
+			cancel()
+			_, _ = pw.Write([]byte("b"))
+			_ = pw.Close()
+		}()
+
+		_, _, err := ParseWithContext(ctx, readAllParser{}, pr)
+		assert.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("context parser", func(t *testing.T) {
+		libs, _, err := ParseWithContext(context.Background(), contextParser{}, strings.NewReader("a"))
+		require.NoError(t, err)
+		assert.Equal(t, []types.Library{{Name: "context"}}, libs)
+	})
+}
+
+type streamParser struct {
+	readAllParser
+}
+
+func (p streamParser) ParseStream(r io.Reader, fn func(types.Library) error) error {
+	for _, name := range []string{"a", "b", "c"} {
+		if err := fn(types.Library{Name: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestParseStream(t *testing.T) {
+	errStop := errors.New("stop")
+	tests := []struct {
+		name    string
+		parser  types.Parser
+		stopAt  string
+		want    []types.Library
+		wantErr error
+	}{
+		{
+			name:   "parser",
+			parser: readAllParser{},
+			want:   []types.Library{{Name: "abc"}},
+		},
+		{
+			name:   "stream parser",
+			parser: streamParser{},
+			want:   []types.Library{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+		},
+		{
+			name:    "stopped by callback",
+			parser:  streamParser{},
+			stopAt:  "b",
+			want:    []types.Library{{Name: "a"}, {Name: "b"}},
+			wantErr: errStop,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []types.Library
+			err := ParseStream(tt.parser, strings.NewReader("abc"), func(lib types.Library) error {
+				got = append(got, lib)
+				if lib.Name == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type partialParser struct{}
+
+func (p partialParser) Parse(_ io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs := []types.Library{{Name: "a"}}
+	return libs, nil, types.NewErrPartialResult([]error{errors.New("invalid line 2")})
+}
+
+func TestParseWithMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    types.Mode
+		parser  types.Parser
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			name:    "partial",
+			mode:    types.ModePartial,
+			parser:  partialParser{},
+			want:    []types.Library{{Name: "a"}},
+			wantErr: "invalid line 2",
+		},
+		{
+			name:    "strict",
+			mode:    types.ModeStrict,
+			parser:  partialParser{},
+			wantErr: "invalid line 2",
+		},
+		{
+			name:   "lenient",
+			mode:   types.ModeLenient,
+			parser: partialParser{},
+			want:   []types.Library{{Name: "a"}},
+		},
+		{
+			name:   "no error",
+			mode:   types.ModeStrict,
+			parser: readAllParser{},
+			want:   []types.Library{{Name: "abc"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _, err := ParseWithMode(tt.mode, tt.parser, strings.NewReader("abc"))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type resultParser struct {
+	readAllParser
+}
+
+func (p resultParser) ParseResult(r io.Reader) (types.Result, error) {
+	libs, _, err := p.Parse(r)
+	return types.Result{
+		Libraries: libs,
+		Warnings:  []types.Warning{{Kind: types.WarningUnresolved, Message: "version was guessed"}},
+	}, err
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name   string
+		parser types.Parser
+		want   types.Result
+	}{
+		{
+			name:   "partial",
+			parser: partialParser{},
+			want: types.Result{
+				Libraries: []types.Library{{Name: "a"}},
+				Warnings:  []types.Warning{{Kind: types.WarningSkippedEntry, Message: "invalid line 2"}},
+				Stats:     types.Stats{Libraries: 1, Skipped: 1},
+			},
+		},
+		{
+			name:   "no warning",
+			parser: readAllParser{},
+			want: types.Result{
+				Libraries: []types.Library{{Name: "abc"}},
+				Stats:     types.Stats{BytesRead: 3, Libraries: 1},
+			},
+		},
+		{
+			name:   "result parser",
+			parser: resultParser{},
+			want: types.Result{
+				Libraries: []types.Library{{Name: "abc"}},
+				Warnings:  []types.Warning{{Kind: types.WarningUnresolved, Message: "version was guessed"}},
+				Stats:     types.Stats{BytesRead: 3, Libraries: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseResult(tt.parser, strings.NewReader("abc"))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseWithWarnings(t *testing.T) {
+	var got []types.Warning
+	libs, _, err := ParseWithWarnings(partialParser{}, strings.NewReader("abc"), func(w types.Warning) {
+		got = append(got, w)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Library{{Name: "a"}}, libs)
+	assert.Equal(t, []types.Warning{{Kind: types.WarningSkippedEntry, Message: "invalid line 2"}}, got)
+}
@@ -0,0 +1,114 @@
+package gem
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Operators of requirements
+const (
+	OpEqual          = "="
+	OpNotEqual       = "!="
+	OpGreater        = ">"
+	OpLess           = "<"
+	OpGreaterOrEqual = ">="
+	OpLessOrEqual    = "<="
+	OpPessimistic    = "~>"
+)
+
+// Longer operators come first, so that ">=" is not taken for ">"
+var operators = []string{
+	OpNotEqual,
+	OpGreaterOrEqual,
+	OpLessOrEqual,
+	OpPessimistic,
+	OpEqual,
+	OpGreater,
+	OpLess,
+}
+
+// Requirement is a set of constraints which versions must all satisfy, as Gem::Requirement.
+// e.g. "~> 2.7, >= 2.7.1"
+type Requirement struct {
+	original    string
+	constraints []constraint
+}
+
+type constraint struct {
+	op      string
+	version Version
+}
+
+// ParseRequirement parses the comma-separated constraints. Constraints without operators are "=".
+// An empty requirement accepts any version, as ">= 0" does.
+func ParseRequirement(s string) (Requirement, error) {
+	r := Requirement{original: strings.TrimSpace(s)}
+	if r.original == "" {
+		return r, nil
+	}
+	for _, c := range strings.Split(s, ",") {
+		c = strings.TrimSpace(c)
+		op := OpEqual
+		for _, o := range operators {
+			if strings.HasPrefix(c, o) {
+				op = o
+				c = c[len(o):]
+				break
+			}
+		}
+		v, err := Parse(c)
+		if err != nil || strings.TrimSpace(c) == "" {
+			return Requirement{}, xerrors.Errorf("invalid requirement: %s", s)
+		}
+		r.constraints = append(r.constraints, constraint{op: op, version: v})
+	}
+	return r, nil
+}
+
+// MustParseRequirement is like ParseRequirement but panics if the requirement can't be parsed.
+func MustParseRequirement(s string) Requirement {
+	r, err := ParseRequirement(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Check reports whether the version satisfies all the constraints.
+// Unlike npm, pre-releases are compared as any other version. e.g. 2.0.0.rc1 satisfies >= 1.0
+func (r Requirement) Check(v Version) bool {
+	for _, c := range r.constraints {
+		if !c.check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c constraint) check(v Version) bool {
+	cmp := v.Compare(c.version)
+	switch c.op {
+	case OpEqual:
+		return cmp == 0
+	case OpNotEqual:
+		return cmp != 0
+	case OpGreater:
+		return cmp > 0
+	case OpLess:
+		return cmp < 0
+	case OpGreaterOrEqual:
+		return cmp >= 0
+	case OpLessOrEqual:
+		return cmp <= 0
+	case OpPessimistic:
+		// e.g. ~> 2.2.3 is >= 2.2.3 and < 2.3
+		return cmp >= 0 && v.Release().Compare(c.version.Bump()) < 0
+	}
+	return false
+}
+
+// String returns the requirement as it was given.
+func (r Requirement) String() string {
+	return r.original
+}
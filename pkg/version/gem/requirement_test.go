@@ -0,0 +1,51 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequirement_Check(t *testing.T) {
+	tests := []struct {
+		requirement string
+		version     string
+		want        bool
+	}{
+		{requirement: "", version: "1.0", want: true},
+		{requirement: "1.0", version: "1.0.0", want: true},
+		{requirement: "= 1.0", version: "1.0.1", want: false},
+		{requirement: "!= 1.0", version: "1.0.1", want: true},
+		{requirement: "> 1.0", version: "1.0.1", want: true},
+		{requirement: "> 1.0", version: "1.0", want: false},
+		{requirement: "< 1.0", version: "1.0.a", want: true},
+		{requirement: ">= 1.0", version: "2.0.0.rc1", want: true},
+		{requirement: "<= 1.0", version: "1.0", want: true},
+		{requirement: "~> 2.2", version: "2.9", want: true},
+		{requirement: "~> 2.2", version: "3.0", want: false},
+		{requirement: "~> 2.2.3", version: "2.2.9", want: true},
+		{requirement: "~> 2.2.3", version: "2.3.0", want: false},
+		{requirement: "~> 2.2.3", version: "2.2.2", want: false},
+		{requirement: "~> 2", version: "2.9", want: true},
+		{requirement: "~> 2", version: "3.0", want: false},
+		// The release of pre-releases is compared with the upper bound
+		{requirement: "~> 2.2", version: "3.0.a", want: false},
+		{requirement: "~> 2.2.a", version: "2.2", want: true},
+		{requirement: "~> 2.7, >= 2.7.1", version: "2.7.0", want: false},
+		{requirement: "~> 2.7, >= 2.7.1", version: "2.7.1", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.requirement+" "+tt.version, func(t *testing.T) {
+			r := MustParseRequirement(tt.requirement)
+			assert.Equal(t, tt.want, r.Check(MustParse(tt.version)))
+		})
+	}
+}
+
+func TestParseRequirement(t *testing.T) {
+	for _, s := range []string{"~>", ">= 1.0,", "=> 1.0", "~> 1.0.0."} {
+		_, err := ParseRequirement(s)
+		assert.Error(t, err, s)
+	}
+	assert.Equal(t, "~> 2.7, >= 2.7.1", MustParseRequirement(" ~> 2.7, >= 2.7.1 ").String())
+}
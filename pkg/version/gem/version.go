@@ -0,0 +1,212 @@
+// Package gem compares RubyGems versions and checks them against requirements in the same way as Gem::Version
+// and Gem::Requirement do.
+// See https://guides.rubygems.org/patterns/#semantic-versioning
+//
+// e.g.
+//
+//	r, _ := gem.ParseRequirement("~> 2.7, >= 2.7.1")
+//	r.Check(gem.MustParse("2.9.0")) // true
+package gem
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// The same pattern as Gem::Version::ANCHORED_VERSION_PATTERN
+var versionRegexp = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9a-zA-Z]+)*(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?)?\s*$`)
+
+// segmentRegexp splits versions into numbers and letters. e.g. 1.0.pre1 => 1, 0, pre, 1
+var segmentRegexp = regexp.MustCompile(`[0-9]+|[a-zA-Z]+`)
+
+// Version is a parsed RubyGems version.
+type Version struct {
+	original string
+	segments []segment
+}
+
+// segment is a number without leading zeros, or a string.
+type segment struct {
+	s       string
+	numeric bool
+}
+
+// Parse parses the version. An empty version is 0, as RubyGems treats it.
+func Parse(v string) (Version, error) {
+	if !versionRegexp.MatchString(v) {
+		return Version{}, xerrors.Errorf("invalid version: %s", v)
+	}
+	v = strings.TrimSpace(v)
+	if v == "" {
+		v = "0"
+	}
+
+	ver := Version{original: v}
+	// "-" is a pre-release. e.g. 1.0-beta => 1.0.pre.beta
+	for _, s := range segmentRegexp.FindAllString(strings.ReplaceAll(v, "-", ".pre."), -1) {
+		ver.segments = append(ver.segments, newSegment(s))
+	}
+	return ver, nil
+}
+
+// MustParse is like Parse but panics if the version can't be parsed.
+func MustParse(v string) Version {
+	ver, err := Parse(v)
+	if err != nil {
+		panic(err)
+	}
+	return ver
+}
+
+func newSegment(s string) segment {
+	if s[0] < '0' || s[0] > '9' {
+		return segment{s: s}
+	}
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		s = "0"
+	}
+	return segment{s: s, numeric: true}
+}
+
+func (s segment) isZero() bool {
+	return s.numeric && s.s == "0"
+}
+
+// compare compares segments as Gem::Version does. Strings are lower than numbers.
+func (s segment) compare(other segment) int {
+	switch {
+	case !s.numeric && other.numeric:
+		return -1
+	case s.numeric && !other.numeric:
+		return 1
+	case s.numeric && len(s.s) != len(other.s):
+		if len(s.s) < len(other.s) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(s.s, other.s)
+}
+
+// String returns the version as it was given, without the surrounding spaces.
+func (v Version) String() string {
+	return v.original
+}
+
+// IsPrerelease reports whether the version has letters. e.g. 1.0.0.rc1 and 1.0-beta
+func (v Version) IsPrerelease() bool {
+	for _, s := range v.segments {
+		if !s.numeric {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical removes the trailing zeros of the release and pre-release segments,
+// which don't change the order. e.g. 1.0.0.a.0 => 1.a
+func (v Version) canonical() []segment {
+	i := len(v.segments)
+	for j, s := range v.segments {
+		if !s.numeric {
+			i = j
+			break
+		}
+	}
+	return append(trimZeros(v.segments[:i]), trimZeros(v.segments[i:])...)
+}
+
+func trimZeros(segments []segment) []segment {
+	n := len(segments)
+	for n > 0 && segments[n-1].isZero() {
+		n--
+	}
+	return segments[:n:n]
+}
+
+// Compare compares the version with the other as Gem::Version#<=> does.
+// It returns -1 when v < other, 0 when v == other and 1 when v > other.
+// e.g. 1.0.a < 1.0.b < 1.0 == 1.0.0 < 1.0.1
+func (v Version) Compare(other Version) int {
+	s1, s2 := v.canonical(), other.canonical()
+	n := len(s1)
+	if len(s2) > n {
+		n = len(s2)
+	}
+	zero := segment{s: "0", numeric: true}
+	for i := 0; i < n; i++ {
+		seg1, seg2 := zero, zero
+		if i < len(s1) {
+			seg1 = s1[i]
+		}
+		if i < len(s2) {
+			seg2 = s2[i]
+		}
+		if c := seg1.compare(seg2); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Release returns the version without the pre-release segments. e.g. 1.2.3 for 1.2.3.rc1
+func (v Version) Release() Version {
+	if !v.IsPrerelease() {
+		return v
+	}
+	var nums []string
+	for _, s := range v.segments {
+		if !s.numeric {
+			break
+		}
+		nums = append(nums, s.s)
+	}
+	return MustParse(strings.Join(nums, "."))
+}
+
+// Bump returns the upper bound of "~>", which increments the second last number of the release.
+// e.g. 1.3 for 1.2.3, 2 for 1.2 and 2 for 1
+func (v Version) Bump() Version {
+	var nums []string
+	for _, s := range v.Release().segments {
+		nums = append(nums, s.s)
+	}
+	if len(nums) > 1 {
+		nums = nums[:len(nums)-1]
+	}
+	nums[len(nums)-1] = increment(nums[len(nums)-1])
+	return MustParse(strings.Join(nums, "."))
+}
+
+// increment adds 1 to the decimal number of any length.
+func increment(n string) string {
+	b := []byte(n)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
+
+// Compare compares the versions.
+// Invalid versions are lower than any valid version, and compared lexically among them,
+// so that it can be given to merge.WithCompare.
+func Compare(v1, v2 string) int {
+	ver1, err1 := Parse(v1)
+	ver2, err2 := Parse(v2)
+	switch {
+	case err1 != nil && err2 != nil:
+		return strings.Compare(v1, v2)
+	case err1 != nil:
+		return -1
+	case err2 != nil:
+		return 1
+	}
+	return ver1.Compare(ver2)
+}
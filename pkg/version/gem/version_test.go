@@ -0,0 +1,85 @@
+package gem
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	// Taken from the tests of Gem::Version
+	ordered := []string{
+		"0.9",
+		"1.0.a",
+		"1.0.a.2",
+		"1.0.b1",
+		"1.0-beta",
+		"1.0.rc1",
+		"1.0",
+		"1.0.1",
+		"1.1.a",
+		"1.1",
+		"1.2",
+		"1.10",
+		"5.a",
+		"5.x",
+		"5",
+		"100000000000000000000",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		assert.Negative(t, Compare(ordered[i], ordered[i+1]), "%s < %s", ordered[i], ordered[i+1])
+		assert.Positive(t, Compare(ordered[i+1], ordered[i]), "%s > %s", ordered[i+1], ordered[i])
+	}
+
+	equal := [][2]string{
+		{"1.0", "1"},
+		{"1.0.0", "1"},
+		{"1.0.a.0", "1.a"},
+		{"01.2", "1.2"},
+		{" 1.2 ", "1.2"},
+		{"1.0-beta", "1.0.pre.beta"},
+		{"", "0"},
+	}
+	for _, e := range equal {
+		assert.Zero(t, Compare(e[0], e[1]), "%s == %s", e[0], e[1])
+	}
+
+	// Invalid versions are the lowest
+	assert.Negative(t, Compare("junk", "0.0.1"))
+	assert.Negative(t, Compare("1.0.0.", "1.0.0"))
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		v              string
+		wantPrerelease bool
+		wantRelease    string
+		wantBump       string
+		wantErr        bool
+	}{
+		{v: "1.2.3", wantRelease: "1.2.3", wantBump: "1.3"},
+		{v: "1.2", wantRelease: "1.2", wantBump: "2"},
+		{v: "1", wantRelease: "1", wantBump: "2"},
+		{v: "1.9.9", wantRelease: "1.9.9", wantBump: "1.10"},
+		{v: "1.2.3.rc1", wantPrerelease: true, wantRelease: "1.2.3", wantBump: "1.3"},
+		{v: "2.0-beta", wantPrerelease: true, wantRelease: "2.0", wantBump: "3"},
+		{v: "1.0.0.", wantErr: true},
+		{v: "1..0", wantErr: true},
+		{v: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := Parse(tt.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.v, v.String())
+			assert.Equal(t, tt.wantPrerelease, v.IsPrerelease())
+			assert.Equal(t, tt.wantRelease, v.Release().String())
+			assert.Equal(t, tt.wantBump, v.Bump().String())
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package maven
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Range is a version range of Maven.
+// See https://maven.apache.org/enforcer/enforcer-rules/versionRanges.html
+//
+// e.g.
+//
+//	1.0            recommended version, any version is accepted
+//	[1.0]          exactly 1.0
+//	[1.0,2.0)      1.0 <= x < 2.0
+//	(,1.0],[1.2,)  x <= 1.0 or x >= 1.2
+type Range struct {
+	// Recommended is the version of a soft requirement, which is empty for ranges
+	Recommended  string
+	restrictions []restriction
+}
+
+// restriction is a bounded range. nil bounds are unbounded.
+type restriction struct {
+	lower, upper                   *Version
+	lowerInclusive, upperInclusive bool
+}
+
+// ParseRange parses the version range.
+func ParseRange(spec string) (Range, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return Range{}, xerrors.New("empty version range")
+	}
+
+	if !strings.HasPrefix(spec, "[") && !strings.HasPrefix(spec, "(") {
+		return Range{Recommended: spec}, nil
+	}
+
+	var r Range
+	var upper *Version
+	for rest := spec; rest != ""; {
+		end := strings.IndexAny(rest, "])")
+		if end < 0 {
+			return Range{}, xerrors.Errorf("unbounded range: %s", spec)
+		}
+
+		res, err := parseRestriction(rest[:end+1])
+		if err != nil {
+			return Range{}, xerrors.Errorf("invalid version range %s: %w", spec, err)
+		}
+		if upper != nil && (res.lower == nil || res.lower.Compare(*upper) < 0) {
+			return Range{}, xerrors.Errorf("ranges overlap: %s", spec)
+		}
+		r.restrictions = append(r.restrictions, res)
+		upper = res.upper
+
+		rest = strings.TrimSpace(rest[end+1:])
+		if rest == "" {
+			break
+		}
+		if !strings.HasPrefix(rest, ",") {
+			return Range{}, xerrors.Errorf("invalid version range: %s", spec)
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if rest == "" {
+			return Range{}, xerrors.Errorf("trailing comma: %s", spec)
+		}
+	}
+	return r, nil
+}
+
+// MustParseRange is like ParseRange but panics if the range can't be parsed.
+func MustParseRange(spec string) Range {
+	r, err := ParseRange(spec)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// parseRestriction parses a range enclosed in brackets. e.g. [1.0,2.0)
+func parseRestriction(spec string) (restriction, error) {
+	res := restriction{
+		lowerInclusive: strings.HasPrefix(spec, "["),
+		upperInclusive: strings.HasSuffix(spec, "]"),
+	}
+	if !strings.HasPrefix(spec, "[") && !strings.HasPrefix(spec, "(") {
+		return restriction{}, xerrors.Errorf("missing opening bracket: %s", spec)
+	}
+	body := strings.TrimSpace(spec[1 : len(spec)-1])
+
+	bounds := strings.Split(body, ",")
+	switch len(bounds) {
+	case 1:
+		// [1.0]
+		if !res.lowerInclusive || !res.upperInclusive {
+			return restriction{}, xerrors.Errorf("single version must be surrounded by []: %s", spec)
+		}
+		if body == "" {
+			return restriction{}, xerrors.Errorf("empty range: %s", spec)
+		}
+		v := Parse(body)
+		res.lower, res.upper = &v, &v
+	case 2:
+		if lower := strings.TrimSpace(bounds[0]); lower != "" {
+			v := Parse(lower)
+			res.lower = &v
+		}
+		if upper := strings.TrimSpace(bounds[1]); upper != "" {
+			v := Parse(upper)
+			res.upper = &v
+		}
+		if res.lower != nil && res.upper != nil {
+			c := res.lower.Compare(*res.upper)
+			if c > 0 || (c == 0 && !(res.lowerInclusive && res.upperInclusive)) {
+				return restriction{}, xerrors.Errorf("lower bound is not lower than upper bound: %s", spec)
+			}
+		}
+	default:
+		return restriction{}, xerrors.Errorf("too many bounds: %s", spec)
+	}
+	return res, nil
+}
+
+// Contains reports whether the version is in the range.
+// A soft requirement accepts any version, as Maven picks another version on conflicts.
+func (r Range) Contains(v string) bool {
+	if r.Recommended != "" {
+		return true
+	}
+	ver := Parse(v)
+	for _, res := range r.restrictions {
+		if res.contains(ver) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r restriction) contains(v Version) bool {
+	if r.lower != nil {
+		c := v.Compare(*r.lower)
+		if c < 0 || (c == 0 && !r.lowerInclusive) {
+			return false
+		}
+	}
+	if r.upper != nil {
+		c := v.Compare(*r.upper)
+		if c > 0 || (c == 0 && !r.upperInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the range in the Maven syntax.
+func (r Range) String() string {
+	if r.Recommended != "" {
+		return r.Recommended
+	}
+	var ss []string
+	for _, res := range r.restrictions {
+		ss = append(ss, res.String())
+	}
+	return strings.Join(ss, ",")
+}
+
+func (r restriction) String() string {
+	var b strings.Builder
+	if r.lowerInclusive {
+		b.WriteByte('[')
+	} else {
+		b.WriteByte('(')
+	}
+	if r.lower != nil && r.upper != nil && r.lower == r.upper {
+		b.WriteString(r.lower.String())
+		b.WriteByte(']')
+		return b.String()
+	}
+	if r.lower != nil {
+		b.WriteString(r.lower.String())
+	}
+	b.WriteByte(',')
+	if r.upper != nil {
+		b.WriteString(r.upper.String())
+	}
+	if r.upperInclusive {
+		b.WriteByte(']')
+	} else {
+		b.WriteByte(')')
+	}
+	return b.String()
+}
@@ -0,0 +1,322 @@
+// Package maven compares Maven versions in the same order as Maven does.
+// See https://maven.apache.org/pom.html#version-order-specification
+//
+// e.g.
+//
+//	maven.Compare("1.0-alpha-1", "1.0") // -1
+//	maven.Compare("1.0.ga", "1")        // 0
+//
+// Compare can be given to merge.WithCompare to keep the highest versions of Java libraries.
+package maven
+
+import (
+	"strings"
+	"unicode"
+)
+
+// The well-known qualifiers in ascending order.
+// Unknown qualifiers are newer than all of them and compared lexically.
+var qualifiers = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
+
+// Aliases of the well-known qualifiers
+var aliases = map[string]string{
+	"ga":      "",
+	"final":   "",
+	"release": "",
+	"cr":      "rc",
+}
+
+// The index of the release qualifier in qualifiers
+var releaseIndex = comparableQualifier("")
+
+// Version is a parsed Maven version.
+type Version struct {
+	original string
+	items    *listItem
+}
+
+// Parse parses the version.
+// Any string is a valid Maven version, so it never fails.
+func Parse(v string) Version {
+	return Version{
+		original: v,
+		items:    parseItems(strings.ToLower(v)),
+	}
+}
+
+// Compare compares the versions.
+// It returns a negative number when v1 < v2, 0 when v1 == v2 and a positive number when v1 > v2.
+func Compare(v1, v2 string) int {
+	return Parse(v1).Compare(Parse(v2))
+}
+
+// Compare compares the version with the other.
+func (v Version) Compare(other Version) int {
+	return v.items.compare(other.items)
+}
+
+// Equal reports whether the versions are the same in Maven's order. e.g. 1.0 and 1.0.0.ga
+func (v Version) Equal(other Version) bool {
+	return v.Compare(other) == 0
+}
+
+// String returns the version as it was given.
+func (v Version) String() string {
+	return v.original
+}
+
+// Canonical returns the normalized form of the version,
+// which is the same for all versions that are equal. e.g. 1-rc-1 for 1.0-CR1
+func (v Version) Canonical() string {
+	return v.items.String()
+}
+
+// item is a part of a version: an integer, a qualifier or a list of items following "-"
+type item interface {
+	// compare compares the item with the other, which is nil at the end of the other list
+	compare(other item) int
+	isNull() bool
+	String() string
+}
+
+// intItem holds the digits without leading zeros, so that numbers of any size can be compared.
+type intItem string
+
+func newIntItem(s string) intItem {
+	s = strings.TrimLeft(s, "0")
+	if s == "" {
+		s = "0"
+	}
+	return intItem(s)
+}
+
+func (i intItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if i.isNull() {
+			return 0
+		}
+		return 1
+	case intItem:
+		if len(i) != len(o) {
+			if len(i) < len(o) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(string(i), string(o))
+	}
+	// 1.1 > 1-sp and 1.1 > 1-1
+	return 1
+}
+
+func (i intItem) isNull() bool {
+	return i == "0"
+}
+
+func (i intItem) String() string {
+	return string(i)
+}
+
+type stringItem string
+
+// newStringItem expands the short qualifiers followed by a digit and resolves aliases.
+// e.g. a1 => alpha, 1 and cr => rc
+func newStringItem(s string, followedByDigit bool) stringItem {
+	if followedByDigit && len(s) == 1 {
+		switch s {
+		case "a":
+			s = "alpha"
+		case "b":
+			s = "beta"
+		case "m":
+			s = "milestone"
+		}
+	}
+	if alias, ok := aliases[s]; ok {
+		s = alias
+	}
+	return stringItem(s)
+}
+
+func (s stringItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		// 1-rc < 1, 1-sp > 1
+		return strings.Compare(comparableQualifier(string(s)), releaseIndex)
+	case stringItem:
+		return strings.Compare(comparableQualifier(string(s)), comparableQualifier(string(o)))
+	}
+	// 1.any < 1.1 and 1.any < 1-1
+	return -1
+}
+
+func (s stringItem) isNull() bool {
+	return comparableQualifier(string(s)) == releaseIndex
+}
+
+func (s stringItem) String() string {
+	return string(s)
+}
+
+// comparableQualifier returns a string which sorts the well-known qualifiers by their order,
+// and the unknown ones after them.
+func comparableQualifier(q string) string {
+	for i, known := range qualifiers {
+		if q == known {
+			return string(rune('0' + i))
+		}
+	}
+	return string(rune('0'+len(qualifiers))) + "-" + q
+}
+
+type listItem struct {
+	items []item
+}
+
+func (l *listItem) add(i item) {
+	l.items = append(l.items, i)
+}
+
+// normalize removes the trailing null items, which don't change the order. e.g. 1.0.0 => 1
+func (l *listItem) normalize() {
+	for i := len(l.items) - 1; i >= 0; i-- {
+		last := l.items[i]
+		if last.isNull() {
+			l.items = append(l.items[:i], l.items[i+1:]...)
+			continue
+		}
+		if _, ok := last.(*listItem); !ok {
+			break
+		}
+	}
+}
+
+func (l *listItem) compare(other item) int {
+	switch o := other.(type) {
+	case nil:
+		if len(l.items) == 0 {
+			return 0
+		}
+		return l.items[0].compare(nil)
+	case intItem:
+		// 1-1 < 1.1
+		return -1
+	case stringItem:
+		// 1-1 > 1-sp
+		return 1
+	case *listItem:
+		for i := 0; i < len(l.items) || i < len(o.items); i++ {
+			var left, right item
+			if i < len(l.items) {
+				left = l.items[i]
+			}
+			if i < len(o.items) {
+				right = o.items[i]
+			}
+
+			var result int
+			switch {
+			case left == nil && right == nil:
+				result = 0
+			case left == nil:
+				result = -right.compare(nil)
+			default:
+				result = left.compare(right)
+			}
+			if result != 0 {
+				return result
+			}
+		}
+	}
+	return 0
+}
+
+func (l *listItem) isNull() bool {
+	return len(l.items) == 0
+}
+
+func (l *listItem) String() string {
+	var b strings.Builder
+	for i, it := range l.items {
+		if i > 0 {
+			if _, ok := it.(*listItem); ok {
+				b.WriteByte('-')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteString(it.String())
+	}
+	return b.String()
+}
+
+// parseItems splits the lower-cased version into items.
+// "." separates items, while "-" and the transitions between digits and letters start a sub list.
+// e.g. 1.0-alpha1 => [1, [alpha, [1]]]
+func parseItems(v string) *listItem {
+	root := &listItem{}
+	list := root
+	stack := []*listItem{root}
+
+	// starts a sub list following the current one
+	sublist := func() {
+		l := &listItem{}
+		list.add(l)
+		list = l
+		stack = append(stack, l)
+	}
+
+	isDigit := false
+	start := 0
+	for i, c := range v {
+		switch {
+		case c == '.':
+			if i == start {
+				list.add(intItem("0"))
+			} else {
+				list.add(parseItem(isDigit, v[start:i]))
+			}
+			start = i + 1
+		case c == '-':
+			if i == start {
+				list.add(intItem("0"))
+			} else {
+				list.add(parseItem(isDigit, v[start:i]))
+			}
+			start = i + 1
+			sublist()
+		case unicode.IsDigit(c):
+			if !isDigit && i > start {
+				// e.g. rc1 => rc-1
+				list.add(newStringItem(v[start:i], true))
+				start = i
+				sublist()
+			}
+			isDigit = true
+		default:
+			if isDigit && i > start {
+				// e.g. 1rc => 1-rc
+				list.add(parseItem(true, v[start:i]))
+				start = i
+				sublist()
+			}
+			isDigit = false
+		}
+	}
+	if len(v) > start {
+		list.add(parseItem(isDigit, v[start:]))
+	}
+
+	for i := len(stack) - 1; i >= 0; i-- {
+		stack[i].normalize()
+	}
+	return root
+}
+
+func parseItem(isDigit bool, s string) item {
+	if isDigit {
+		return newIntItem(s)
+	}
+	return newStringItem(s, false)
+}
@@ -0,0 +1,136 @@
+package maven
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	// Taken from ComparableVersionTest of Maven
+	ordered := []string{
+		"1-alpha2snapshot",
+		"1-alpha2",
+		"1-alpha-123",
+		"1-beta-2",
+		"1-beta123",
+		"1-m2",
+		"1-m11",
+		"1-rc",
+		"1-cr2",
+		"1-rc123",
+		"1-SNAPSHOT",
+		"1",
+		"1-sp",
+		"1-sp2",
+		"1-sp123",
+		"1-abc",
+		"1-def",
+		"1-pom-1",
+		"1-1-snapshot",
+		"1-1",
+		"1-2",
+		"1-123",
+		"1.1",
+		"1.2",
+		"1.123",
+		"2",
+		"10",
+		"100000000000000000000",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		for j := i + 1; j < len(ordered); j++ {
+			v1, v2 := ordered[i], ordered[j]
+			assert.Negative(t, Compare(v1, v2), "%s < %s", v1, v2)
+			assert.Positive(t, Compare(v2, v1), "%s > %s", v2, v1)
+		}
+	}
+
+	equal := [][]string{
+		{"1", "1.0", "1.0.0", "1-0", "1.ga", "1-ga", "1.final", "1-FINAL", "1.release", "1.0.0-0.0.0"},
+		{"1a1", "1-a1", "1-alpha-1", "1-ALPHA1"},
+		{"1b2", "1-b2", "1-beta-2", "1-BETA2"},
+		{"1m3", "1-m3", "1-milestone-3", "1-Milestone3"},
+		{"1rc", "1-rc", "1-cr", "1CR"},
+		{"1-01", "1-1"},
+	}
+	for _, vs := range equal {
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				assert.Zero(t, Compare(v1, v2), "%s == %s", v1, v2)
+			}
+		}
+	}
+}
+
+func TestVersion_Canonical(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+	}{
+		{version: "1.0.0", want: "1"},
+		{version: "1.0-CR1", want: "1-rc-1"},
+		{version: "1.0.0-ga", want: "1"},
+		{version: "1.2.3-SNAPSHOT", want: "1.2.3-snapshot"},
+		{version: "2.0.0.RELEASE", want: "2"},
+		{version: "1a", want: "1-a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v := Parse(tt.version)
+			assert.Equal(t, tt.want, v.Canonical())
+			assert.Equal(t, tt.version, v.String())
+			assert.True(t, v.Equal(Parse(tt.want)))
+		})
+	}
+}
+
+func TestRange_Contains(t *testing.T) {
+	tests := []struct {
+		spec    string
+		version string
+		want    bool
+	}{
+		{spec: "1.0", version: "0.1", want: true},
+		{spec: "[1.0]", version: "1.0.0", want: true},
+		{spec: "[1.0]", version: "1.0.1", want: false},
+		{spec: "[1.0,2.0)", version: "1.0", want: true},
+		{spec: "[1.0,2.0)", version: "1.9.9", want: true},
+		{spec: "[1.0,2.0)", version: "2.0", want: false},
+		{spec: "[1.0,2.0)", version: "2.0-rc1", want: true},
+		{spec: "(1.0,2.0]", version: "1.0", want: false},
+		{spec: "(1.0,2.0]", version: "2.0.ga", want: true},
+		{spec: "[1.5,)", version: "1.10", want: true},
+		{spec: "(,1.0],[1.2,)", version: "1.1", want: false},
+		{spec: "(,1.0],[1.2,)", version: "0.9", want: true},
+		{spec: "(,1.0],[1.2,)", version: "1.2", want: true},
+		{spec: "[30.0-jre,)", version: "31.1-jre", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec+" "+tt.version, func(t *testing.T) {
+			r, err := ParseRange(tt.spec)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Contains(tt.version))
+			assert.Equal(t, tt.spec, r.String())
+		})
+	}
+}
+
+func TestParseRange_Error(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"[1.0",
+		"(1.0)",
+		"[2.0,1.0]",
+		"[1.0,2.0,3.0]",
+		"[1.0,2.0),",
+		"[1.0,3.0),[2.0,4.0)",
+		"[1.0,2.0)x",
+	} {
+		t.Run(spec, func(t *testing.T) {
+			_, err := ParseRange(spec)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package pep440
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Operators of version specifiers
+const (
+	OpCompatible     = "~="
+	OpEqual          = "=="
+	OpNotEqual       = "!="
+	OpLessOrEqual    = "<="
+	OpGreaterOrEqual = ">="
+	OpLess           = "<"
+	OpGreater        = ">"
+	OpArbitraryEqual = "==="
+)
+
+const (
+	wildcardSuffix     = ".*"
+	specifierSeparator = ","
+)
+
+// Longer operators come first, so that "===" is not taken for "=="
+var operators = []string{
+	OpArbitraryEqual,
+	OpCompatible,
+	OpEqual,
+	OpNotEqual,
+	OpLessOrEqual,
+	OpGreaterOrEqual,
+	OpLess,
+	OpGreater,
+}
+
+// Specifier is a version specifier. e.g. >=1.0 and ==1.3.*
+type Specifier struct {
+	Operator string
+	// Version is the version as written, including ".*" of prefix matches
+	Version string
+
+	version  Version
+	wildcard bool
+}
+
+// NewSpecifier parses the version specifier.
+func NewSpecifier(s string) (Specifier, error) {
+	s = strings.TrimSpace(s)
+	var spec Specifier
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			spec.Operator = op
+			break
+		}
+	}
+	if spec.Operator == "" {
+		return Specifier{}, xerrors.Errorf("no operator: %s", s)
+	}
+	spec.Version = strings.TrimSpace(strings.TrimPrefix(s, spec.Operator))
+	if spec.Version == "" {
+		return Specifier{}, xerrors.Errorf("no version: %s", s)
+	}
+
+	// Arbitrary equality compares strings, so any version is allowed
+	if spec.Operator == OpArbitraryEqual {
+		return spec, nil
+	}
+
+	v := spec.Version
+	if strings.HasSuffix(v, wildcardSuffix) {
+		if spec.Operator != OpEqual && spec.Operator != OpNotEqual {
+			return Specifier{}, xerrors.Errorf("prefix match is only allowed with == and !=: %s", s)
+		}
+		spec.wildcard = true
+		v = strings.TrimSuffix(v, wildcardSuffix)
+	}
+
+	var err error
+	if spec.version, err = Parse(v); err != nil {
+		return Specifier{}, xerrors.Errorf("invalid specifier %s: %w", s, err)
+	}
+
+	switch {
+	case len(spec.version.Local) > 0 && (spec.wildcard || (spec.Operator != OpEqual && spec.Operator != OpNotEqual)):
+		return Specifier{}, xerrors.Errorf("local version label is not allowed: %s", s)
+	case spec.Operator == OpCompatible && len(spec.version.Release) < 2:
+		return Specifier{}, xerrors.Errorf("compatible release needs at least two release segments: %s", s)
+	}
+	return spec, nil
+}
+
+// Pinned reports whether the specifier only matches a single version. e.g. ==1.0 and ===1.0
+func (s Specifier) Pinned() bool {
+	return (s.Operator == OpEqual && !s.wildcard) || s.Operator == OpArbitraryEqual
+}
+
+// Check reports whether the version satisfies the specifier.
+//
+// Unlike pip, pre-releases are not excluded, as the versions checked here are the ones already locked.
+// Arbitrary equality compares the normalized form of the version with the specifier case-insensitively.
+func (s Specifier) Check(v Version) bool {
+	switch s.Operator {
+	case OpArbitraryEqual:
+		return strings.EqualFold(v.String(), s.Version)
+	case OpCompatible:
+		// ~=1.4.5 is >=1.4.5, ==1.4.*
+		prefix := Version{Epoch: s.version.Epoch, Release: s.version.Release[:len(s.version.Release)-1]}
+		return v.withoutLocal().Compare(s.version) >= 0 && matchPrefix(v, prefix)
+	case OpEqual:
+		return s.equal(v)
+	case OpNotEqual:
+		return !s.equal(v)
+	case OpLessOrEqual:
+		return v.withoutLocal().Compare(s.version) <= 0
+	case OpGreaterOrEqual:
+		return v.withoutLocal().Compare(s.version) >= 0
+	case OpLess:
+		// <3.1 doesn't match the pre-releases of 3.1
+		if v.withoutLocal().Compare(s.version) >= 0 {
+			return false
+		}
+		return s.version.IsPreRelease() || !v.IsPreRelease() || !sameBase(v, s.version)
+	case OpGreater:
+		// >3.1 doesn't match the post-releases and the local versions of 3.1
+		if v.withoutLocal().Compare(s.version) <= 0 {
+			return false
+		}
+		if !s.version.IsPostRelease() && v.IsPostRelease() && sameBase(v, s.version) {
+			return false
+		}
+		return len(v.Local) == 0 || !sameBase(v, s.version)
+	}
+	return false
+}
+
+func (s Specifier) equal(v Version) bool {
+	if s.wildcard {
+		return matchPrefix(v, s.version)
+	}
+	// The local version label of the candidate is ignored when the specifier doesn't have one
+	if len(s.version.Local) == 0 {
+		v = v.withoutLocal()
+	}
+	return v.Compare(s.version) == 0
+}
+
+// String returns the specifier as written, without spaces.
+func (s Specifier) String() string {
+	return s.Operator + s.Version
+}
+
+// Specifiers is a comma-separated list of version specifiers, all of which must be satisfied.
+// e.g. >=1.0, !=1.3.4, <2.0
+type Specifiers []Specifier
+
+// NewSpecifiers parses the comma-separated specifiers.
+// The empty string is valid and matches any version.
+func NewSpecifiers(s string) (Specifiers, error) {
+	if strings.TrimSpace(s) == "" {
+		return Specifiers{}, nil
+	}
+	var specs Specifiers
+	for _, ss := range strings.Split(s, specifierSeparator) {
+		spec, err := NewSpecifier(ss)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// Check reports whether the version satisfies all the specifiers.
+func (ss Specifiers) Check(v Version) bool {
+	for _, s := range ss {
+		if !s.Check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns the specifiers joined with commas.
+func (ss Specifiers) String() string {
+	var specs []string
+	for _, s := range ss {
+		specs = append(specs, s.String())
+	}
+	return strings.Join(specs, specifierSeparator)
+}
+
+func (v Version) withoutLocal() Version {
+	v.Local = nil
+	return v
+}
+
+// sameBase reports whether the versions have the same epoch and release segments.
+func sameBase(v1, v2 Version) bool {
+	return v1.Epoch == v2.Epoch && compareRelease(v1.Release, v2.Release) == 0
+}
+
+// matchPrefix reports whether the version starts with the prefix, padding the release segments with zeros.
+// e.g. 1.3.0.post1 and 1.3 match 1.3.*, while 1.30 doesn't
+func matchPrefix(v, prefix Version) bool {
+	n := len(prefix.Release)
+	if len(v.Release) > n {
+		n = len(v.Release)
+	}
+	vs, ps := v.segments(n), prefix.segments(len(prefix.Release))
+	if len(vs) < len(ps) {
+		return false
+	}
+	for i := range ps {
+		if vs[i] != ps[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// segments splits the normalized version without the local version label.
+// The release segments are padded with zeros to n. e.g. 0, 1, 0, rc1 for 1.0rc1
+func (v Version) segments(n int) []string {
+	release := make([]int, n)
+	copy(release, v.Release)
+	segs := []string{strconv.Itoa(v.Epoch)}
+	for _, r := range release {
+		segs = append(segs, strconv.Itoa(r))
+	}
+	if v.Pre != "" {
+		segs = append(segs, v.Pre+strconv.Itoa(v.PreNum))
+	}
+	if v.Post != nil {
+		segs = append(segs, "post"+strconv.Itoa(*v.Post))
+	}
+	if v.Dev != nil {
+		segs = append(segs, "dev"+strconv.Itoa(*v.Dev))
+	}
+	return segs
+}
@@ -0,0 +1,85 @@
+package pep440
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecifiers_Check(t *testing.T) {
+	tests := []struct {
+		specifiers string
+		version    string
+		want       bool
+	}{
+		{specifiers: "", version: "1.0", want: true},
+		{specifiers: "==1.0", version: "1.0.0", want: true},
+		{specifiers: "==1.0", version: "1.0+local", want: true},
+		{specifiers: "==1.0+local", version: "1.0", want: false},
+		{specifiers: "==1.0", version: "1.0.post1", want: false},
+		{specifiers: "==1.1.*", version: "1.1.post1", want: true},
+		{specifiers: "==1.1.*", version: "1.1a1", want: true},
+		{specifiers: "==1.1.*", version: "1.10", want: false},
+		{specifiers: "==1.1.0.*", version: "1.1", want: true},
+		{specifiers: "!=1.1.*", version: "1.2", want: true},
+		{specifiers: "~=2.2", version: "2.3", want: true},
+		{specifiers: "~=2.2", version: "3.0", want: false},
+		{specifiers: "~=1.4.5", version: "1.4.9", want: true},
+		{specifiers: "~=1.4.5", version: "1.5.0", want: false},
+		{specifiers: "~=1.4.5a4", version: "1.4.5", want: true},
+		{specifiers: "<3.1", version: "3.1.dev0", want: false},
+		{specifiers: "<3.1", version: "3.0.dev0", want: true},
+		{specifiers: "<3.1rc1", version: "3.1a1", want: true},
+		{specifiers: ">3.1", version: "3.1.post1", want: false},
+		{specifiers: ">3.1", version: "3.1+local", want: false},
+		{specifiers: ">3.1.post1", version: "3.1.post2", want: true},
+		{specifiers: "<=2.0", version: "2.0+local", want: true},
+		{specifiers: "===1.0", version: "1.0", want: true},
+		{specifiers: "===1.0", version: "1.0.0", want: false},
+		{specifiers: ">=1.0, !=1.3.4, <2", version: "1.3.4", want: false},
+		{specifiers: ">=1.0, !=1.3.4, <2", version: "1.3.5", want: true},
+		{specifiers: ">=1.0, !=1.3.4, <2", version: "2.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.specifiers+" "+tt.version, func(t *testing.T) {
+			specs, err := NewSpecifiers(tt.specifiers)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, specs.Check(MustParse(tt.version)))
+		})
+	}
+}
+
+func TestNewSpecifier(t *testing.T) {
+	tests := []struct {
+		spec       string
+		wantOp     string
+		wantPinned bool
+		wantErr    string
+	}{
+		{spec: "==1.0", wantOp: OpEqual, wantPinned: true},
+		{spec: "=== foobar", wantOp: OpArbitraryEqual, wantPinned: true},
+		{spec: "==1.0.*", wantOp: OpEqual},
+		{spec: " >= 1.0 ", wantOp: OpGreaterOrEqual},
+		{spec: "~=1.0", wantOp: OpCompatible},
+		{spec: "1.0", wantErr: "no operator"},
+		{spec: "==", wantErr: "no version"},
+		{spec: ">=1.0.*", wantErr: "prefix match is only allowed"},
+		{spec: "<1.0+local", wantErr: "local version label is not allowed"},
+		{spec: "~=1", wantErr: "at least two release segments"},
+		{spec: "==foo", wantErr: "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := NewSpecifier(tt.spec)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOp, got.Operator)
+			assert.Equal(t, tt.wantPinned, got.Pinned())
+		})
+	}
+}
@@ -0,0 +1,314 @@
+// Package pep440 parses and compares Python versions as defined by PEP 440.
+// See https://peps.python.org/pep-0440/
+//
+// e.g.
+//
+//	v, _ := pep440.Parse("1.0-RC1")
+//	v.String() // 1.0rc1
+//
+//	s, _ := pep440.NewSpecifiers(">=1.0, !=1.3.*, <2")
+//	s.Check(v) // false, as pre-releases of 1.0 are lower than 1.0
+package pep440
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// The regular expression in Appendix B of PEP 440
+var versionRegexp = regexp.MustCompile(`(?i)^\s*v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|c|rc)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>(?:-(?P<post_n1>[0-9]+))|(?:[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?))?` +
+	`(?P<dev>[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?` +
+	`\s*$`)
+
+// The spellings of pre-release labels and their normalized forms
+var preLabels = map[string]string{
+	"a":       "a",
+	"alpha":   "a",
+	"b":       "b",
+	"beta":    "b",
+	"c":       "rc",
+	"rc":      "rc",
+	"pre":     "rc",
+	"preview": "rc",
+}
+
+// The order of the normalized pre-release labels
+var preOrder = map[string]int{"a": 0, "b": 1, "rc": 2}
+
+// Version is a parsed PEP 440 version.
+type Version struct {
+	Epoch   int
+	Release []int
+	// Pre is the pre-release label, which is one of "a", "b" and "rc", or empty.
+	Pre    string
+	PreNum int
+	// Post and Dev are nil when the version is not a post-release or a development release.
+	Post *int
+	Dev  *int
+	// Local is the segments of the local version label. e.g. ubuntu, 1 for +ubuntu.1
+	Local []string
+}
+
+// Parse parses the version, accepting all the spellings allowed by the normalization rules of PEP 440.
+func Parse(v string) (Version, error) {
+	m := versionRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return Version{}, xerrors.Errorf("invalid version: %s", v)
+	}
+	group := func(name string) string {
+		return m[versionRegexp.SubexpIndex(name)]
+	}
+
+	var ver Version
+	var err error
+	if e := group("epoch"); e != "" {
+		if ver.Epoch, err = strconv.Atoi(e); err != nil {
+			return Version{}, xerrors.Errorf("invalid epoch %s: %w", v, err)
+		}
+	}
+
+	for _, s := range strings.Split(group("release"), ".") {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return Version{}, xerrors.Errorf("invalid release %s: %w", v, err)
+		}
+		ver.Release = append(ver.Release, n)
+	}
+
+	if group("pre") != "" {
+		ver.Pre = preLabels[strings.ToLower(group("pre_l"))]
+		if ver.PreNum, err = atoi(group("pre_n")); err != nil {
+			return Version{}, xerrors.Errorf("invalid pre-release %s: %w", v, err)
+		}
+	}
+
+	if group("post") != "" {
+		n := group("post_n1")
+		if n == "" {
+			n = group("post_n2")
+		}
+		post, err := atoi(n)
+		if err != nil {
+			return Version{}, xerrors.Errorf("invalid post-release %s: %w", v, err)
+		}
+		ver.Post = &post
+	}
+
+	if group("dev") != "" {
+		dev, err := atoi(group("dev_n"))
+		if err != nil {
+			return Version{}, xerrors.Errorf("invalid development release %s: %w", v, err)
+		}
+		ver.Dev = &dev
+	}
+
+	if local := group("local"); local != "" {
+		ver.Local = strings.FieldsFunc(strings.ToLower(local), func(r rune) bool {
+			return r == '-' || r == '_' || r == '.'
+		})
+	}
+	return ver, nil
+}
+
+// MustParse is like Parse but panics if the version can't be parsed.
+func MustParse(v string) Version {
+	ver, err := Parse(v)
+	if err != nil {
+		panic(err)
+	}
+	return ver
+}
+
+// Normalize returns the normalized form of the version. e.g. 1.0rc1 for 1.0-RC1
+func Normalize(v string) (string, error) {
+	ver, err := Parse(v)
+	if err != nil {
+		return "", err
+	}
+	return ver.String(), nil
+}
+
+// atoi converts the implicit number of a segment, which is 0 when omitted. e.g. 1.0a
+func atoi(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// String returns the normalized form of the version.
+func (v Version) String() string {
+	var b strings.Builder
+	if v.Epoch != 0 {
+		b.WriteString(strconv.Itoa(v.Epoch))
+		b.WriteByte('!')
+	}
+	b.WriteString(v.Public())
+	if len(v.Local) > 0 {
+		b.WriteByte('+')
+		b.WriteString(strings.Join(v.Local, "."))
+	}
+	return b.String()
+}
+
+// Public returns the normalized version without the epoch and the local version label.
+func (v Version) Public() string {
+	var b strings.Builder
+	for i, n := range v.Release {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(n))
+	}
+	if v.Pre != "" {
+		b.WriteString(v.Pre)
+		b.WriteString(strconv.Itoa(v.PreNum))
+	}
+	if v.Post != nil {
+		b.WriteString(".post")
+		b.WriteString(strconv.Itoa(*v.Post))
+	}
+	if v.Dev != nil {
+		b.WriteString(".dev")
+		b.WriteString(strconv.Itoa(*v.Dev))
+	}
+	return b.String()
+}
+
+// IsPreRelease reports whether the version is a pre-release or a development release.
+func (v Version) IsPreRelease() bool {
+	return v.Pre != "" || v.Dev != nil
+}
+
+// IsPostRelease reports whether the version is a post-release.
+func (v Version) IsPostRelease() bool {
+	return v.Post != nil
+}
+
+// Compare compares the version with the other.
+// It returns -1 when v < other, 0 when v == other and 1 when v > other.
+func (v Version) Compare(other Version) int {
+	if c := compareInt(v.Epoch, other.Epoch); c != 0 {
+		return c
+	}
+	if c := compareRelease(v.Release, other.Release); c != 0 {
+		return c
+	}
+	if c := compareInt(v.preKey(), other.preKey()); c != 0 {
+		return c
+	}
+	if v.Pre != "" && v.Pre == other.Pre {
+		if c := compareInt(v.PreNum, other.PreNum); c != 0 {
+			return c
+		}
+	}
+	if c := compareOptional(v.Post, other.Post, -1); c != 0 {
+		return c
+	}
+	if c := compareOptional(v.Dev, other.Dev, 1); c != 0 {
+		return c
+	}
+	return compareLocal(v.Local, other.Local)
+}
+
+// Compare compares the versions.
+// Versions which are not valid in PEP 440 are lower than any valid version, and compared lexically among them,
+// so that it can be given to merge.WithCompare.
+func Compare(v1, v2 string) int {
+	ver1, err1 := Parse(v1)
+	ver2, err2 := Parse(v2)
+	switch {
+	case err1 != nil && err2 != nil:
+		return strings.Compare(v1, v2)
+	case err1 != nil:
+		return -1
+	case err2 != nil:
+		return 1
+	}
+	return ver1.Compare(ver2)
+}
+
+// preKey orders the pre-release labels.
+// Development releases of a final release come before its pre-releases, and final releases after them.
+// e.g. 1.0.dev0 < 1.0a0 < 1.0b0 < 1.0rc0 < 1.0
+func (v Version) preKey() int {
+	switch {
+	case v.Pre != "":
+		return preOrder[v.Pre]
+	case v.Post == nil && v.Dev != nil:
+		return -1
+	}
+	return len(preOrder)
+}
+
+// compareRelease compares the release segments, padding the shorter one with zeros. e.g. 1.0 == 1.0.0
+func compareRelease(r1, r2 []int) int {
+	for i := 0; i < len(r1) || i < len(r2); i++ {
+		var n1, n2 int
+		if i < len(r1) {
+			n1 = r1[i]
+		}
+		if i < len(r2) {
+			n2 = r2[i]
+		}
+		if c := compareInt(n1, n2); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareOptional compares the optional numbers. missing is the result when only n1 is nil.
+func compareOptional(n1, n2 *int, missing int) int {
+	switch {
+	case n1 == nil && n2 == nil:
+		return 0
+	case n1 == nil:
+		return missing
+	case n2 == nil:
+		return -missing
+	}
+	return compareInt(*n1, *n2)
+}
+
+// compareLocal compares the local version labels.
+// Numeric segments are higher than alphanumeric ones, and a longer label is higher if the rest is equal.
+func compareLocal(l1, l2 []string) int {
+	for i := 0; i < len(l1) && i < len(l2); i++ {
+		n1, err1 := strconv.Atoi(l1[i])
+		n2, err2 := strconv.Atoi(l2[i])
+		var c int
+		switch {
+		case err1 == nil && err2 == nil:
+			c = compareInt(n1, n2)
+		case err1 == nil:
+			c = 1
+		case err2 == nil:
+			c = -1
+		default:
+			c = strings.Compare(l1[i], l2[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(l1), len(l2))
+}
+
+func compareInt(n1, n2 int) int {
+	switch {
+	case n1 < n2:
+		return -1
+	case n1 > n2:
+		return 1
+	}
+	return 0
+}
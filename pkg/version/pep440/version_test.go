@@ -0,0 +1,91 @@
+package pep440
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr string
+	}{
+		{version: "1.0", want: "1.0"},
+		{version: "v1.0", want: "1.0"},
+		{version: " 1.0\n", want: "1.0"},
+		{version: "01.02.003", want: "1.2.3"},
+		{version: "1!2.0", want: "1!2.0"},
+		{version: "0!2.0", want: "2.0"},
+		{version: "1.0-RC1", want: "1.0rc1"},
+		{version: "1.0.alpha.2", want: "1.0a2"},
+		{version: "1.0b", want: "1.0b0"},
+		{version: "1.0c3", want: "1.0rc3"},
+		{version: "1.0pre1", want: "1.0rc1"},
+		{version: "1.0preview_2", want: "1.0rc2"},
+		{version: "1.0-1", want: "1.0.post1"},
+		{version: "1.0post", want: "1.0.post0"},
+		{version: "1.0-r4", want: "1.0.post4"},
+		{version: "1.0rev", want: "1.0.post0"},
+		{version: "1.0-dev", want: "1.0.dev0"},
+		{version: "1.0a1.post2.dev3", want: "1.0a1.post2.dev3"},
+		{version: "1.0+Ubuntu-1", want: "1.0+ubuntu.1"},
+		{version: "1.0+abc_5.x", want: "1.0+abc.5.x"},
+		{version: "1.0-", wantErr: "invalid version"},
+		{version: "1.0.*", wantErr: "invalid version"},
+		{version: "foo", wantErr: "invalid version"},
+		{version: "", wantErr: "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := Normalize(tt.version)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// Taken from the examples of PEP 440
+	ordered := []string{
+		"1.0.dev456",
+		"1.0a1",
+		"1.0a2.dev456",
+		"1.0a12.dev456",
+		"1.0a12",
+		"1.0b1.dev456",
+		"1.0b2",
+		"1.0b2.post345.dev456",
+		"1.0b2.post345",
+		"1.0rc1.dev456",
+		"1.0rc1",
+		"1.0",
+		"1.0+abc.5",
+		"1.0+abc.7",
+		"1.0+5",
+		"1.0.post456.dev34",
+		"1.0.post456",
+		"1.0.15",
+		"1.1.dev1",
+		"1!0.1",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		for j := i + 1; j < len(ordered); j++ {
+			v1, v2 := ordered[i], ordered[j]
+			assert.Equal(t, -1, Compare(v1, v2), "%s < %s", v1, v2)
+			assert.Equal(t, 1, Compare(v2, v1), "%s > %s", v2, v1)
+		}
+	}
+
+	assert.Equal(t, 0, Compare("1.0", "1.0.0"))
+	assert.Equal(t, 0, Compare("1.0-RC1", "1.0rc1"))
+	assert.Equal(t, -1, Compare("invalid", "0.1"))
+	assert.Equal(t, 1, Compare("0.1", "invalid"))
+}
@@ -0,0 +1,349 @@
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	opEqual          = "="
+	opLess           = "<"
+	opLessOrEqual    = "<="
+	opGreater        = ">"
+	opGreaterOrEqual = ">="
+	opTilde          = "~"
+	opCaret          = "^"
+)
+
+// Longer operators come first, so that ">=" is not taken for ">"
+var operators = []string{opGreaterOrEqual, opLessOrEqual, opGreater, opLess, opEqual, opCaret, opTilde}
+
+var (
+	// e.g. 1.2.3 - 2.3.4
+	hyphenRegexp = regexp.MustCompile(`^\s*(\S+)\s+-\s+(\S+)\s*$`)
+	// npm allows spaces between operators and versions. e.g. >= 1.2.3
+	operatorSpaceRegexp = regexp.MustCompile(`(~>|<=|>=|<|>|=|~|\^)\s+`)
+)
+
+// Range is a set of comparators which versions are checked against.
+type Range struct {
+	original string
+	// Versions matching all the comparators of any set are in the range
+	sets [][]comparator
+}
+
+type comparator struct {
+	op string
+	v  Version
+}
+
+// partial is a version whose trailing parts may be omitted or wildcards. e.g. 1.2, 1.x and *
+type partial struct {
+	nums [3]uint64
+	// n is the number of parts given
+	n   int
+	pre []string
+}
+
+// ParseNPMRange parses the version range of npm.
+//
+// e.g.
+//
+//	^1.2.3, ~1.2, 1.x, >=1.0.0 <2.0.0, 1.2.3 - 2.3.4, 1.x || >=2.5.0
+func ParseNPMRange(s string) (Range, error) {
+	r := Range{original: s}
+	for _, set := range strings.Split(s, "||") {
+		comparators, err := parseNPMSet(set)
+		if err != nil {
+			return Range{}, xerrors.Errorf("invalid range %q: %w", s, err)
+		}
+		r.sets = append(r.sets, comparators)
+	}
+	return r, nil
+}
+
+func parseNPMSet(set string) ([]comparator, error) {
+	if m := hyphenRegexp.FindStringSubmatch(set); m != nil {
+		from, err := parsePartial(m[1])
+		if err != nil {
+			return nil, err
+		}
+		to, err := parsePartial(m[2])
+		if err != nil {
+			return nil, err
+		}
+		return hyphenRange(from, to), nil
+	}
+
+	set = operatorSpaceRegexp.ReplaceAllString(set, "$1")
+	var comparators []comparator
+	for _, token := range strings.Fields(set) {
+		// ~> is the same as ~ in npm
+		token = strings.Replace(token, "~>", opTilde, 1)
+		c, err := parseComparator(token, opEqual)
+		if err != nil {
+			return nil, err
+		}
+		comparators = append(comparators, c...)
+	}
+
+	// An empty set matches any version
+	if len(comparators) == 0 {
+		return anyVersion(), nil
+	}
+	return comparators, nil
+}
+
+// ParseCargoRange parses the version requirement of Cargo.
+// Comparators are separated by commas, and versions without operators are caret requirements.
+//
+// e.g.
+//
+//	1.2.3, ^1.2, ~1.2.3, =1.2.3, >=1.2, <1.5, 1.*
+func ParseCargoRange(s string) (Range, error) {
+	if strings.TrimSpace(s) == "" {
+		return Range{}, xerrors.New("empty version requirement")
+	}
+
+	var comparators []comparator
+	for _, token := range strings.Split(s, ",") {
+		c, err := parseComparator(strings.TrimSpace(token), opCaret)
+		if err != nil {
+			return Range{}, xerrors.Errorf("invalid version requirement %q: %w", s, err)
+		}
+		comparators = append(comparators, c...)
+	}
+	return Range{original: s, sets: [][]comparator{comparators}}, nil
+}
+
+// parseComparator converts the token into primitive comparators.
+// defaultOp is used when the token has no operator.
+func parseComparator(token, defaultOp string) ([]comparator, error) {
+	op := defaultOp
+	for _, o := range operators {
+		if strings.HasPrefix(token, o) {
+			op = o
+			token = strings.TrimSpace(strings.TrimPrefix(token, o))
+			break
+		}
+	}
+
+	p, err := parsePartial(token)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case opTilde:
+		return tildeRange(p), nil
+	case opCaret:
+		return caretRange(p), nil
+	case opEqual:
+		return xRange(p), nil
+	}
+	return primitive(op, p), nil
+}
+
+// parsePartial parses the version allowing omitted parts and wildcards. e.g. 1.2, 1.x and *
+func parsePartial(s string) (partial, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if v == "" {
+		return partial{}, xerrors.Errorf("empty version")
+	}
+
+	// Build metadata doesn't affect the precedence
+	if i := strings.Index(v, "+"); i >= 0 {
+		v = v[:i]
+	}
+
+	var p partial
+	if i := strings.Index(v, "-"); i >= 0 {
+		p.pre = strings.Split(v[i+1:], ".")
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return partial{}, xerrors.Errorf("too many parts: %s", s)
+	}
+	wildcard := false
+	for i, part := range parts {
+		switch part {
+		case "x", "X", "*":
+			wildcard = true
+			continue
+		}
+		if wildcard {
+			return partial{}, xerrors.Errorf("number after wildcard: %s", s)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return partial{}, xerrors.Errorf("invalid version %s: %w", s, err)
+		}
+		p.nums[i] = n
+		p.n = i + 1
+	}
+	if len(p.pre) > 0 && p.n < 3 {
+		return partial{}, xerrors.Errorf("pre-release of partial version: %s", s)
+	}
+	return p, nil
+}
+
+// version fills the omitted parts with zeros.
+func (p partial) version() Version {
+	return Version{Major: p.nums[0], Minor: p.nums[1], Patch: p.nums[2], Prerelease: p.pre}
+}
+
+// upperBound returns the lowest version of the major, minor and patch, including pre-releases.
+// e.g. 2.0.0-0
+func upperBound(major, minor, patch uint64) Version {
+	return Version{Major: major, Minor: minor, Patch: patch, Prerelease: []string{"0"}}
+}
+
+func anyVersion() []comparator {
+	return []comparator{{op: opGreaterOrEqual, v: Version{}}}
+}
+
+// nextBound returns the upper bound of the parts given. e.g. <1.3.0-0 for 1.2
+func (p partial) nextBound() comparator {
+	switch p.n {
+	case 1:
+		return comparator{op: opLess, v: upperBound(p.nums[0]+1, 0, 0)}
+	case 2:
+		return comparator{op: opLess, v: upperBound(p.nums[0], p.nums[1]+1, 0)}
+	}
+	return comparator{op: opLessOrEqual, v: p.version()}
+}
+
+// xRange converts 1.x into >=1.0.0 <2.0.0-0
+func xRange(p partial) []comparator {
+	switch p.n {
+	case 0:
+		return anyVersion()
+	case 3:
+		return []comparator{{op: opEqual, v: p.version()}}
+	}
+	return []comparator{{op: opGreaterOrEqual, v: p.version()}, p.nextBound()}
+}
+
+// tildeRange allows patch-level changes if the minor version is given, and minor-level changes if not.
+// e.g. ~1.2.3 is >=1.2.3 <1.3.0-0 and ~1 is >=1.0.0 <2.0.0-0
+func tildeRange(p partial) []comparator {
+	if p.n < 2 {
+		return xRange(p)
+	}
+	return []comparator{
+		{op: opGreaterOrEqual, v: p.version()},
+		{op: opLess, v: upperBound(p.nums[0], p.nums[1]+1, 0)},
+	}
+}
+
+// caretRange allows changes that don't modify the left-most non-zero part.
+// e.g. ^1.2.3 is >=1.2.3 <2.0.0-0, ^0.2.3 is >=0.2.3 <0.3.0-0 and ^0.0.3 is >=0.0.3 <0.0.4-0
+func caretRange(p partial) []comparator {
+	lower := comparator{op: opGreaterOrEqual, v: p.version()}
+	major, minor, patch := p.nums[0], p.nums[1], p.nums[2]
+	switch {
+	case p.n == 0:
+		return anyVersion()
+	case p.n == 1 || major > 0:
+		return []comparator{lower, {op: opLess, v: upperBound(major+1, 0, 0)}}
+	case p.n == 2 || minor > 0:
+		return []comparator{lower, {op: opLess, v: upperBound(0, minor+1, 0)}}
+	}
+	return []comparator{lower, {op: opLess, v: upperBound(0, 0, patch+1)}}
+}
+
+// primitive converts the comparison with a partial version into the one with a full version.
+// e.g. >1.2 is >=1.3.0 and <=1.2 is <1.3.0-0
+func primitive(op string, p partial) []comparator {
+	if p.n == 0 {
+		switch op {
+		case opLess, opGreater:
+			// Nothing is lower or higher than any version
+			return []comparator{{op: opLess, v: upperBound(0, 0, 0)}}
+		}
+		return anyVersion()
+	}
+	if p.n == 3 {
+		return []comparator{{op: op, v: p.version()}}
+	}
+
+	switch op {
+	case opGreater:
+		if p.n == 1 {
+			return []comparator{{op: opGreaterOrEqual, v: Version{Major: p.nums[0] + 1}}}
+		}
+		return []comparator{{op: opGreaterOrEqual, v: Version{Major: p.nums[0], Minor: p.nums[1] + 1}}}
+	case opLess:
+		return []comparator{{op: opLess, v: upperBound(p.nums[0], p.nums[1], 0)}}
+	case opLessOrEqual:
+		return []comparator{p.nextBound()}
+	}
+	return []comparator{{op: opGreaterOrEqual, v: p.version()}}
+}
+
+// hyphenRange converts 1.2 - 2.3 into >=1.2.0 <2.4.0-0
+func hyphenRange(from, to partial) []comparator {
+	comparators := anyVersion()
+	if from.n > 0 {
+		comparators = []comparator{{op: opGreaterOrEqual, v: from.version()}}
+	}
+	if to.n > 0 {
+		comparators = append(comparators, to.nextBound())
+	}
+	return comparators
+}
+
+// Check reports whether the version is in the range.
+//
+// Pre-releases are only in the range when a comparator of the same set has a pre-release of the same version,
+// as npm and Cargo do. e.g. 1.2.4-beta is in >=1.2.4-alpha but not in >=1.2.3
+func (r Range) Check(v Version) bool {
+	for _, set := range r.sets {
+		if checkSet(set, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkSet(set []comparator, v Version) bool {
+	for _, c := range set {
+		if !c.check(v) {
+			return false
+		}
+	}
+	if !v.IsPrerelease() {
+		return true
+	}
+	for _, c := range set {
+		if c.v.IsPrerelease() && c.v.Major == v.Major && c.v.Minor == v.Minor && c.v.Patch == v.Patch {
+			return true
+		}
+	}
+	return false
+}
+
+func (c comparator) check(v Version) bool {
+	cmp := v.Compare(c.v)
+	switch c.op {
+	case opLess:
+		return cmp < 0
+	case opLessOrEqual:
+		return cmp <= 0
+	case opGreater:
+		return cmp > 0
+	case opGreaterOrEqual:
+		return cmp >= 0
+	}
+	return cmp == 0
+}
+
+// String returns the range as it was given.
+func (r Range) String() string {
+	return r.original
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNPMRange(t *testing.T) {
+	tests := []struct {
+		rng     string
+		version string
+		want    bool
+	}{
+		{rng: "", version: "1.2.3", want: true},
+		{rng: "*", version: "1.2.3", want: true},
+		{rng: "*", version: "1.2.3-beta", want: false},
+		{rng: "1.2.3", version: "1.2.3", want: true},
+		{rng: "=1.2.3", version: "1.2.4", want: false},
+		{rng: "1.x", version: "1.9.9", want: true},
+		{rng: "1.x", version: "2.0.0", want: false},
+		{rng: "1.2.*", version: "1.2.0", want: true},
+		{rng: "1.2", version: "1.3.0", want: false},
+		{rng: "^1.2.3", version: "1.9.0", want: true},
+		{rng: "^1.2.3", version: "1.2.2", want: false},
+		{rng: "^1.2.3", version: "2.0.0", want: false},
+		{rng: "^1.2.3", version: "2.0.0-alpha", want: false},
+		{rng: "^0.2.3", version: "0.2.9", want: true},
+		{rng: "^0.2.3", version: "0.3.0", want: false},
+		{rng: "^0.0.3", version: "0.0.4", want: false},
+		{rng: "^0.0", version: "0.0.9", want: true},
+		{rng: "^1.2.3-beta.2", version: "1.2.3-beta.4", want: true},
+		{rng: "^1.2.3-beta.2", version: "1.2.4-beta.2", want: false},
+		{rng: "~1.2.3", version: "1.2.9", want: true},
+		{rng: "~1.2.3", version: "1.3.0", want: false},
+		{rng: "~1", version: "1.9.0", want: true},
+		{rng: "~>1.2", version: "1.2.5", want: true},
+		{rng: ">=1.0.0 <2.0.0", version: "1.5.0", want: true},
+		{rng: ">= 1.0.0 < 2.0.0", version: "2.0.0", want: false},
+		{rng: ">1.2", version: "1.2.9", want: false},
+		{rng: ">1.2", version: "1.3.0", want: true},
+		{rng: "<=1.2", version: "1.2.9", want: true},
+		{rng: "<1.2", version: "1.2.0-rc.1", want: false},
+		{rng: "1.2.3 - 2.3.4", version: "2.3.4", want: true},
+		{rng: "1.2 - 2.3", version: "2.3.9", want: true},
+		{rng: "1.2 - 2.3", version: "1.1.9", want: false},
+		{rng: "1.x || >=2.5.0", version: "2.4.0", want: false},
+		{rng: "1.x || >=2.5.0", version: "3.0.0", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rng+" "+tt.version, func(t *testing.T) {
+			r, err := ParseNPMRange(tt.rng)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Check(MustParse(tt.version)))
+			assert.Equal(t, tt.rng, r.String())
+		})
+	}
+}
+
+func TestParseCargoRange(t *testing.T) {
+	tests := []struct {
+		rng     string
+		version string
+		want    bool
+	}{
+		{rng: "1.2.3", version: "1.9.0", want: true},
+		{rng: "1.2.3", version: "2.0.0", want: false},
+		{rng: "0.2", version: "0.2.9", want: true},
+		{rng: "0.2", version: "0.3.0", want: false},
+		{rng: "0", version: "0.9.0", want: true},
+		{rng: "=1.2.3", version: "1.2.4", want: false},
+		{rng: "~1.2", version: "1.2.7", want: true},
+		{rng: "~1.2", version: "1.3.0", want: false},
+		{rng: "*", version: "3.0.0", want: true},
+		{rng: "1.*", version: "1.5.0", want: true},
+		{rng: ">=1.2, <1.5", version: "1.4.9", want: true},
+		{rng: ">=1.2, <1.5", version: "1.5.0", want: false},
+		{rng: "^1.0.0-alpha", version: "1.0.0-beta", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rng+" "+tt.version, func(t *testing.T) {
+			r, err := ParseCargoRange(tt.rng)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.Check(MustParse(tt.version)))
+		})
+	}
+}
+
+func TestParseRange_Error(t *testing.T) {
+	for _, rng := range []string{"1.2.3.4", "1.x.3", "1.2-beta", "a.b.c", ">=foo"} {
+		t.Run(rng, func(t *testing.T) {
+			_, err := ParseNPMRange(rng)
+			assert.Error(t, err)
+			_, err = ParseCargoRange(rng)
+			assert.Error(t, err)
+		})
+	}
+	_, err := ParseCargoRange(" ")
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+// Package semver compares Semantic Versions and checks them against the version ranges of npm and Cargo.
+// See https://semver.org/, https://github.com/npm/node-semver#ranges
+// and https://doc.rust-lang.org/cargo/reference/specifying-dependencies.html
+//
+// e.g.
+//
+//	r, _ := semver.ParseNPMRange("^1.2.3 || 2.x")
+//	r.Check(semver.MustParse("1.9.0")) // true
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// A leading "v" and "=" are accepted as npm does. e.g. v1.2.3
+var versionRegexp = regexp.MustCompile(`^\s*[v=]?\s*` +
+	`(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)\.(0|[1-9][0-9]*)` +
+	`(?:-([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?` +
+	`(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?\s*$`)
+
+// Version is a parsed Semantic Version.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      []string
+}
+
+// Parse parses the version.
+func Parse(v string) (Version, error) {
+	m := versionRegexp.FindStringSubmatch(v)
+	if m == nil {
+		return Version{}, xerrors.Errorf("invalid version: %s", v)
+	}
+
+	var ver Version
+	for i, p := range []*uint64{&ver.Major, &ver.Minor, &ver.Patch} {
+		n, err := strconv.ParseUint(m[i+1], 10, 64)
+		if err != nil {
+			return Version{}, xerrors.Errorf("invalid version %s: %w", v, err)
+		}
+		*p = n
+	}
+	if m[4] != "" {
+		ver.Prerelease = strings.Split(m[4], ".")
+	}
+	if m[5] != "" {
+		ver.Build = strings.Split(m[5], ".")
+	}
+	return ver, nil
+}
+
+// MustParse is like Parse but panics if the version can't be parsed.
+func MustParse(v string) Version {
+	ver, err := Parse(v)
+	if err != nil {
+		panic(err)
+	}
+	return ver
+}
+
+// String returns the version without the leading "v".
+func (v Version) String() string {
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+	return s
+}
+
+// IsPrerelease reports whether the version is a pre-release. e.g. 1.0.0-rc.1
+func (v Version) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Compare compares the version with the other by the precedence of Semantic Versioning.
+// It returns -1 when v < other, 0 when v == other and 1 when v > other.
+// Build metadata is ignored.
+func (v Version) Compare(other Version) int {
+	if c := compareUint(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// Compare compares the versions.
+// Invalid versions are lower than any valid version, and compared lexically among them,
+// so that it can be given to merge.WithCompare.
+func Compare(v1, v2 string) int {
+	ver1, err1 := Parse(v1)
+	ver2, err2 := Parse(v2)
+	switch {
+	case err1 != nil && err2 != nil:
+		return strings.Compare(v1, v2)
+	case err1 != nil:
+		return -1
+	case err2 != nil:
+		return 1
+	}
+	return ver1.Compare(ver2)
+}
+
+// comparePrerelease compares the pre-release identifiers.
+// A version without pre-release is higher, numeric identifiers are lower than alphanumeric ones,
+// and more identifiers are higher if the rest is equal. e.g. 1.0.0-alpha < 1.0.0-alpha.1 < 1.0.0-beta < 1.0.0
+func comparePrerelease(p1, p2 []string) int {
+	switch {
+	case len(p1) == 0 && len(p2) == 0:
+		return 0
+	case len(p1) == 0:
+		return 1
+	case len(p2) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(p1) && i < len(p2); i++ {
+		n1, err1 := strconv.ParseUint(p1[i], 10, 64)
+		n2, err2 := strconv.ParseUint(p2[i], 10, 64)
+		var c int
+		switch {
+		case err1 == nil && err2 == nil:
+			c = compareUint(n1, n2)
+		case err1 == nil:
+			c = -1
+		case err2 == nil:
+			c = 1
+		default:
+			c = strings.Compare(p1[i], p2[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(p1)), uint64(len(p2)))
+}
+
+func compareUint(n1, n2 uint64) int {
+	switch {
+	case n1 < n2:
+		return -1
+	case n1 > n2:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package semver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		version string
+		want    Version
+		wantErr string
+	}{
+		{version: "1.2.3", want: Version{Major: 1, Minor: 2, Patch: 3}},
+		{version: "v1.2.3", want: Version{Major: 1, Minor: 2, Patch: 3}},
+		{version: "=1.2.3", want: Version{Major: 1, Minor: 2, Patch: 3}},
+		{
+			version: "1.0.0-rc.1+build.5",
+			want:    Version{Major: 1, Prerelease: []string{"rc", "1"}, Build: []string{"build", "5"}},
+		},
+		{version: "1.2", wantErr: "invalid version"},
+		{version: "01.2.3", wantErr: "invalid version"},
+		{version: "1.2.3-", wantErr: "invalid version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := Parse(tt.version)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// Taken from the precedence example of Semantic Versioning
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := 0; i < len(ordered)-1; i++ {
+		for j := i + 1; j < len(ordered); j++ {
+			v1, v2 := ordered[i], ordered[j]
+			assert.Equal(t, -1, Compare(v1, v2), "%s < %s", v1, v2)
+			assert.Equal(t, 1, Compare(v2, v1), "%s > %s", v2, v1)
+		}
+	}
+
+	assert.Equal(t, 0, Compare("1.0.0+build.1", "v1.0.0"))
+	assert.Equal(t, -1, Compare("latest", "0.0.1"))
+}
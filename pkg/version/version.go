@@ -0,0 +1,98 @@
+// Package version canonicalizes the versions of each ecosystem,
+// so that the same version reported by different files is the same string.
+//
+// e.g.
+//
+//	version.Normalize(purl.TypePyPI, "1.0-RC1") // 1.0rc1
+//	version.Normalize(purl.TypeGolang, "v1.2.3") // 1.2.3
+package version
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/gem"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/maven"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/pep440"
+	"github.com/aquasecurity/go-dep-parser/pkg/version/semver"
+)
+
+// Normalize returns the canonical form of the version in the ecosystem of the purl type, such as purl.TypeNPM.
+// Versions which are invalid in the ecosystem and the ones of other ecosystems are only trimmed.
+//
+//   - golang: the leading "v" is removed, as the parsers do. e.g. v0.0.0-20210101000000-abcdef123456
+//   - npm and cargo: the leading "v" and "=" are removed
+//   - pypi: normalized as PEP 440 specifies. e.g. 1.0rc1 for 1.0-RC1
+//   - maven: qualifiers are lower-cased, as Maven compares them case-insensitively. e.g. 1.0-snapshot
+//   - nuget: normalized as NuGet does. e.g. 1.0.0 for 1.0 and 1.0.0.0, without build metadata
+func Normalize(typ, v string) string {
+	v = strings.TrimSpace(v)
+	switch typ {
+	case purl.TypeGolang:
+		return strings.TrimPrefix(v, "v")
+	case purl.TypeNPM, purl.TypeCargo:
+		if ver, err := semver.Parse(v); err == nil {
+			return ver.String()
+		}
+	case purl.TypePyPI:
+		if normalized, err := pep440.Normalize(v); err == nil {
+			return normalized
+		}
+	case purl.TypeMaven:
+		return strings.ToLower(v)
+	case purl.TypeNuGet:
+		return normalizeNuGet(v)
+	}
+	return v
+}
+
+// Compare compares the versions in the order of the ecosystem of the purl type.
+// It returns a negative number when v1 < v2, 0 when v1 == v2 and a positive number when v1 > v2.
+// Versions of ecosystems without a known order are compared lexically after Normalize.
+func Compare(typ, v1, v2 string) int {
+	switch typ {
+	case purl.TypeMaven:
+		return maven.Compare(v1, v2)
+	case purl.TypePyPI:
+		return pep440.Compare(v1, v2)
+	case purl.TypeNPM, purl.TypeCargo, purl.TypeGolang:
+		return semver.Compare(v1, v2)
+	case purl.TypeGem:
+		return gem.Compare(v1, v2)
+	}
+	return strings.Compare(Normalize(typ, v1), Normalize(typ, v2))
+}
+
+// normalizeNuGet removes the leading zeros and the build metadata, and pads the version to 3 numbers.
+// The fourth number is kept unless it is 0.
+// See https://learn.microsoft.com/en-us/nuget/concepts/package-versioning#normalized-version-numbers
+func normalizeNuGet(v string) string {
+	if i := strings.Index(v, "+"); i != -1 {
+		v = v[:i]
+	}
+	release, prerelease := v, ""
+	if i := strings.Index(v, "-"); i != -1 {
+		release, prerelease = v[:i], v[i:]
+	}
+
+	parts := strings.Split(release, ".")
+	if len(parts) > 4 {
+		return v
+	}
+	var nums []string
+	for _, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return v
+		}
+		nums = append(nums, strconv.FormatUint(n, 10))
+	}
+	for len(nums) < 3 {
+		nums = append(nums, "0")
+	}
+	if len(nums) == 4 && nums[3] == "0" {
+		nums = nums[:3]
+	}
+	return strings.Join(nums, ".") + prerelease
+}
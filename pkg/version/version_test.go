@@ -0,0 +1,74 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/purl"
+	"github.com/aquasecurity/go-dep-parser/pkg/version"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		typ  string
+		v    string
+		want string
+	}{
+		{typ: purl.TypeGolang, v: "v1.2.3", want: "1.2.3"},
+		{typ: purl.TypeGolang, v: "v0.0.0-20210101000000-abcdef123456", want: "0.0.0-20210101000000-abcdef123456"},
+		{typ: purl.TypeGolang, v: "v2.0.0+incompatible", want: "2.0.0+incompatible"},
+		{typ: purl.TypeNPM, v: "v1.2.3", want: "1.2.3"},
+		{typ: purl.TypeNPM, v: "=1.2.3-beta.1", want: "1.2.3-beta.1"},
+		{typ: purl.TypeNPM, v: "latest", want: "latest"},
+		{typ: purl.TypeCargo, v: " 0.4.20 ", want: "0.4.20"},
+		{typ: purl.TypePyPI, v: "1.0-RC1", want: "1.0rc1"},
+		{typ: purl.TypePyPI, v: "v2.0.post1", want: "2.0.post1"},
+		{typ: purl.TypePyPI, v: "not a version", want: "not a version"},
+		{typ: purl.TypeMaven, v: "1.0-SNAPSHOT", want: "1.0-snapshot"},
+		{typ: purl.TypeMaven, v: "2.5.6.SEC03", want: "2.5.6.sec03"},
+		{typ: purl.TypeNuGet, v: "1.0", want: "1.0.0"},
+		{typ: purl.TypeNuGet, v: "1.00.01.0", want: "1.0.1"},
+		{typ: purl.TypeNuGet, v: "1.0.0.4", want: "1.0.0.4"},
+		{typ: purl.TypeNuGet, v: "1.0.0-beta+build.1", want: "1.0.0-beta"},
+		{typ: purl.TypeNuGet, v: "1.0.0.0.0", want: "1.0.0.0.0"},
+		{typ: purl.TypeGem, v: "1.0.0.pre", want: "1.0.0.pre"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ+" "+tt.v, func(t *testing.T) {
+			assert.Equal(t, tt.want, version.Normalize(tt.typ, tt.v))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		typ  string
+		v1   string
+		v2   string
+		want int
+	}{
+		{typ: purl.TypeMaven, v1: "1.0", v2: "1.0.0", want: 0},
+		{typ: purl.TypeMaven, v1: "1.0-SNAPSHOT", v2: "1.0", want: -1},
+		{typ: purl.TypePyPI, v1: "1.0rc1", v2: "1.0", want: -1},
+		{typ: purl.TypeNPM, v1: "1.10.0", v2: "1.9.0", want: 1},
+		{typ: purl.TypeGolang, v1: "v0.0.0-20210101000000-abcdef123456", v2: "v0.1.0", want: -1},
+		{typ: purl.TypeNuGet, v1: "1.0", v2: "1.0.0.0", want: 0},
+		{typ: purl.TypeGem, v1: "1.0.0", v2: "1.0.1", want: -1},
+		{typ: purl.TypeGem, v1: "1.0.0.rc1", v2: "1.0", want: -1},
+		{typ: purl.TypeGem, v1: "1.10", v2: "1.9", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ+" "+tt.v1+" "+tt.v2, func(t *testing.T) {
+			got := version.Compare(tt.typ, tt.v1, tt.v2)
+			switch {
+			case tt.want < 0:
+				assert.Negative(t, got)
+			case tt.want > 0:
+				assert.Positive(t, got)
+			default:
+				assert.Zero(t, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package watch parses manifest and lock files again whenever they change, such as for editor plugins.
+//
+// Files are polled for changes of their sizes and modification times, so that any fs.FS can be watched.
+//
+// e.g.
+//
+//	for ev := range watch.Watch(ctx, os.DirFS("."), []string{"package-lock.json", "go.sum"}) {
+//		if ev.Err == nil {
+//			fmt.Println(ev.FilePath, len(ev.Libraries))
+//		}
+//	}
+package watch
+
+import (
+	"context"
+	"io/fs"
+	"time"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+)
+
+// The default of WithInterval
+const defaultInterval = time.Second
+
+// Event is the result of parsing a watched file.
+type Event struct {
+	scanner.Application
+	// Err is the error parsing the file, or the error of fs.Stat, which wraps fs.ErrNotExist when the file is removed.
+	// The libraries of partial results are kept as Scan does.
+	Err error
+}
+
+type options struct {
+	interval    time.Duration
+	scannerOpts []scanner.Option
+}
+
+type Option func(*options)
+
+// WithInterval polls the files every d. The default is a second.
+func WithInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.interval = d
+	}
+}
+
+// WithScannerOptions parses the files with the options of the scanner. e.g. scanner.WithLimits
+func WithScannerOptions(opts ...scanner.Option) Option {
+	return func(o *options) {
+		o.scannerOpts = opts
+	}
+}
+
+// state is what a change of a file is told by.
+type state struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+// Watch parses the files in fsys first, and then again whenever they change, until ctx is done.
+// An event is sent for each parse, in the order of filePaths when several files change at the same time.
+// Files which can't be found are reported once, and parsed when they are created.
+// The channel is closed after ctx is done.
+func Watch(ctx context.Context, fsys fs.FS, filePaths []string, opts ...Option) <-chan Event {
+	o := options{interval: defaultInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(o.interval)
+		defer ticker.Stop()
+
+		states := make(map[string]state, len(filePaths))
+		for {
+			for _, filePath := range filePaths {
+				current, err := stat(fsys, filePath)
+				if last, ok := states[filePath]; ok && last.equal(current) {
+					continue
+				}
+				states[filePath] = current
+
+				ev := Event{Application: scanner.Application{FilePath: filePath}}
+				if err != nil {
+					ev.Err = err
+				} else {
+					ev.Application, ev.Err = scanner.ParseFile(fsys, filePath, o.scannerOpts...)
+				}
+				select {
+				case events <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events
+}
+
+func stat(fsys fs.FS, filePath string) (state, error) {
+	fi, err := fs.Stat(fsys, filePath)
+	if err != nil {
+		return state{}, err
+	}
+	return state{exists: true, size: fi.Size(), modTime: fi.ModTime()}, nil
+}
+
+func (s state) equal(other state) bool {
+	return s.exists == other.exists && s.size == other.size && s.modTime.Equal(other.modTime)
+}
@@ -0,0 +1,75 @@
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/scanner"
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/watch"
+)
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "requirements.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("Flask==2.0.0\n"), 0o644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := watch.Watch(ctx, os.DirFS(dir), []string{"requirements.txt", "go.sum"}, watch.WithInterval(10*time.Millisecond))
+
+	next := func() watch.Event {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "no event")
+		}
+		return watch.Event{}
+	}
+	libs := func(names ...string) []types.Library {
+		var libs []types.Library
+		for i, name := range names {
+			libs = append(libs, types.Library{
+				Name:      name,
+				Version:   "2.0.0",
+				FilePath:  "requirements.txt",
+				Locations: []types.Location{{StartLine: i + 1, EndLine: i + 1}},
+			})
+		}
+		return libs
+	}
+
+	// The files are parsed first
+	ev := next()
+	require.NoError(t, ev.Err)
+	assert.Equal(t, scanner.Application{FilePath: "requirements.txt", Libraries: libs("Flask")}, ev.Application)
+	ev = next()
+	assert.Equal(t, "go.sum", ev.FilePath)
+	assert.True(t, errors.Is(ev.Err, fs.ErrNotExist), ev.Err)
+
+	// Changed
+	require.NoError(t, os.WriteFile(filePath, []byte("Flask==2.0.0\nJinja2==2.0.0\n"), 0o644))
+	ev = next()
+	require.NoError(t, ev.Err)
+	assert.Equal(t, scanner.Application{FilePath: "requirements.txt", Libraries: libs("Flask", "Jinja2")}, ev.Application)
+
+	// Removed
+	require.NoError(t, os.Remove(filePath))
+	ev = next()
+	assert.Equal(t, "requirements.txt", ev.FilePath)
+	assert.True(t, errors.Is(ev.Err, fs.ErrNotExist), ev.Err)
+
+	// The channel is closed after ctx is done
+	cancel()
+	for range events {
+	}
+}
@@ -0,0 +1,333 @@
+package zon
+
+import (
+	"io"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+	"github.com/aquasecurity/go-dep-parser/pkg/utils"
+)
+
+var (
+	// Archive extensions to be removed from a URL to guess the version
+	archiveExtensions = []string{".tar.gz", ".tgz", ".tar.xz", ".tar.zst", ".tar", ".zip"}
+
+	// e.g. v0.1.7-pre, 1.2.3
+	versionRegexp = regexp.MustCompile(`^v?\d+(\.\d+)*([-+].*)?$`)
+
+	// e.g. 3a8d4e2bbbf3c3bf6a4b9fb82bcc0e6cb2f8a09f
+	commitRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+// Parser implements types.Parser for build.zig.zon
+type Parser struct{}
+
+func NewParser() types.Parser {
+	return &Parser{}
+}
+
+func (p *Parser) Parse(r io.Reader) ([]types.Library, []types.Dependency, error) {
+	libs, err := Parse(r)
+	return libs, nil, err
+}
+
+// Parse parses the dependencies section of build.zig.zon
+//
+// e.g.
+//
+//	.dependencies = .{
+//	    .zap = .{
+//	        .url = "https://github.com/zigzap/zap/archive/refs/tags/v0.1.7-pre.tar.gz",
+//	        .hash = "1220002d24d73672fe8b1e39717c0671598acc8ec27b8af2e1caf623a4fd0ce0d1bd",
+//	    },
+//	},
+func Parse(r io.Reader) (_ []types.Library, err error) {
+	defer utils.RecoverPanic(&err)
+
+	b, err := utils.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("read error: %w", err)
+	}
+
+	p := &parser{lexer: newLexer(string(b))}
+	root, err := p.parseValue()
+	if err != nil {
+		return nil, xerrors.Errorf("parse error: %w", &types.ErrMalformedInput{Err: err})
+	}
+
+	manifest, ok := root.(map[string]interface{})
+	if !ok {
+		return nil, &types.ErrMalformedInput{Err: xerrors.New("parse error: the top level must be a struct")}
+	}
+	deps, _ := manifest["dependencies"].(map[string]interface{})
+
+	var libs []types.Library
+	for name, v := range deps {
+		dep, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		url, _ := dep["url"].(string)
+		hash, _ := dep["hash"].(string)
+
+		// Dependencies on the local file system don't have a URL
+		if url == "" {
+			continue
+		}
+
+		libs = append(libs, types.Library{
+			Name:    name,
+			Version: versionFromURL(url),
+			Digest:  hash,
+		})
+	}
+	// The order of map iteration is random
+	utils.SortLibraries(libs)
+	return libs, nil
+}
+
+// versionFromURL guesses the version from the archive name in the URL.
+// e.g. https://github.com/zigzap/zap/archive/refs/tags/v0.1.7-pre.tar.gz => v0.1.7-pre
+func versionFromURL(url string) string {
+	if i := strings.IndexAny(url, "?#"); i != -1 {
+		url = url[:i]
+	}
+	base := path.Base(url)
+	for _, ext := range archiveExtensions {
+		if strings.HasSuffix(base, ext) {
+			base = strings.TrimSuffix(base, ext)
+			break
+		}
+	}
+	if versionRegexp.MatchString(base) || commitRegexp.MatchString(base) {
+		return base
+	}
+	return ""
+}
+
+type parser struct {
+	*lexer
+}
+
+// parseValue parses a string, a struct (.{ .key = value }) or a tuple (.{ value, value }).
+// Structs are returned as map[string]interface{} and tuples as []interface{}.
+func (p *parser) parseValue() (interface{}, error) {
+	tok, err := p.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case tok.kind == tokenString:
+		return tok.value, nil
+	case tok.kind == tokenIdentifier:
+		// e.g. true, false, null and numbers
+		return tok.value, nil
+	case tok.kind == tokenDot:
+		if tok, err = p.next(); err != nil {
+			return nil, err
+		}
+		switch tok.kind {
+		case tokenLBrace:
+			return p.parseContainer()
+		case tokenIdentifier:
+			// Enum literal, e.g. .myapp
+			return tok.value, nil
+		}
+	}
+	return nil, xerrors.Errorf("unexpected token %q at offset %d", tok.value, tok.offset)
+}
+
+func (p *parser) parseContainer() (interface{}, error) {
+	fields := map[string]interface{}{}
+	var items []interface{}
+
+	for {
+		tok, err := p.peek()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenRBrace {
+			p.skip()
+			break
+		}
+
+		if key, ok := p.fieldName(); ok {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			fields[key] = value
+		} else {
+			value, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+
+		tok, err = p.next()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenRBrace {
+			break
+		} else if tok.kind != tokenComma {
+			return nil, xerrors.Errorf("expected ',' at offset %d", tok.offset)
+		}
+	}
+
+	if len(items) > 0 {
+		return items, nil
+	}
+	return fields, nil
+}
+
+// fieldName consumes ".name =" if present
+func (p *parser) fieldName() (string, bool) {
+	save := p.pos
+	tok, err := p.next()
+	if err != nil || tok.kind != tokenDot {
+		p.pos = save
+		return "", false
+	}
+	name, err := p.next()
+	if err != nil || (name.kind != tokenIdentifier && name.kind != tokenString) {
+		p.pos = save
+		return "", false
+	}
+	eq, err := p.next()
+	if err != nil || eq.kind != tokenEqual {
+		p.pos = save
+		return "", false
+	}
+	return name.value, true
+}
+
+type tokenKind int
+
+const (
+	tokenDot tokenKind = iota
+	tokenLBrace
+	tokenRBrace
+	tokenComma
+	tokenEqual
+	tokenString
+	tokenIdentifier
+)
+
+type token struct {
+	kind   tokenKind
+	value  string
+	offset int
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src}
+}
+
+func (l *lexer) peek() (token, error) {
+	save := l.pos
+	tok, err := l.next()
+	l.pos = save
+	return tok, err
+}
+
+func (l *lexer) skip() {
+	_, _ = l.next()
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipSpaceAndComments()
+	if l.pos >= len(l.src) {
+		return token{}, io.ErrUnexpectedEOF
+	}
+
+	start := l.pos
+	switch c := l.src[l.pos]; {
+	case c == '.':
+		l.pos++
+		return token{kind: tokenDot, value: ".", offset: start}, nil
+	case c == '{':
+		l.pos++
+		return token{kind: tokenLBrace, value: "{", offset: start}, nil
+	case c == '}':
+		l.pos++
+		return token{kind: tokenRBrace, value: "}", offset: start}, nil
+	case c == ',':
+		l.pos++
+		return token{kind: tokenComma, value: ",", offset: start}, nil
+	case c == '=':
+		l.pos++
+		return token{kind: tokenEqual, value: "=", offset: start}, nil
+	case c == '"':
+		return l.readString()
+	case c == '@' && l.pos+1 < len(l.src) && l.src[l.pos+1] == '"':
+		// Quoted identifier, e.g. @"known-folders"
+		l.pos++
+		tok, err := l.readString()
+		tok.kind = tokenIdentifier
+		return tok, err
+	case isIdentifierChar(c):
+		for l.pos < len(l.src) && isIdentifierChar(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenIdentifier, value: l.src[start:l.pos], offset: start}, nil
+	}
+	return token{}, xerrors.Errorf("unexpected character %q at offset %d", l.src[l.pos], l.pos)
+}
+
+func (l *lexer) readString() (token, error) {
+	start := l.pos
+	l.pos++ // opening quote
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '\\':
+			l.pos += 2
+			continue
+		case '"':
+			l.pos++
+			s, err := strconv.Unquote(l.src[start:l.pos])
+			if err != nil {
+				return token{}, xerrors.Errorf("invalid string at offset %d: %w", start, err)
+			}
+			return token{kind: tokenString, value: s, offset: start}, nil
+		case '\n':
+			return token{}, xerrors.Errorf("unterminated string at offset %d", start)
+		}
+		l.pos++
+	}
+	return token{}, xerrors.Errorf("unterminated string at offset %d", start)
+}
+
+func (l *lexer) skipSpaceAndComments() {
+	for l.pos < len(l.src) {
+		switch {
+		case strings.HasPrefix(l.src[l.pos:], "//"):
+			end := strings.IndexByte(l.src[l.pos:], '\n')
+			if end == -1 {
+				l.pos = len(l.src)
+				return
+			}
+			l.pos += end
+		case l.src[l.pos] == ' ' || l.src[l.pos] == '\t' || l.src[l.pos] == '\n' || l.src[l.pos] == '\r':
+			l.pos++
+		default:
+			return
+		}
+	}
+}
+
+func isIdentifierChar(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
@@ -0,0 +1,59 @@
+package zon
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/xerrors"
+
+	"github.com/aquasecurity/go-dep-parser/pkg/types"
+)
+
+func TestParse(t *testing.T) {
+	vectors := []struct {
+		file    string // Test input file
+		want    []types.Library
+		wantErr string
+	}{
+		{
+			file: "testdata/build.zig.zon",
+			want: zonNormal,
+		},
+		{
+			file:    "testdata/invalid.zon",
+			wantErr: "parse error",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(path.Base(v.file), func(t *testing.T) {
+			f, err := os.Open(v.file)
+			require.NoError(t, err)
+			defer f.Close()
+
+			got, err := Parse(f)
+			if v.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), v.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, v.want, got)
+		})
+	}
+}
+
+func TestParse_MalformedInput(t *testing.T) {
+	f, err := os.Open("testdata/invalid.zon")
+	require.NoError(t, err)
+	defer f.Close()
+
+	_, err = Parse(f)
+
+	var malformed *types.ErrMalformedInput
+	assert.True(t, xerrors.As(err, &malformed), err)
+}
@@ -0,0 +1,14 @@
+package zon
+
+import "github.com/aquasecurity/go-dep-parser/pkg/types"
+
+var (
+	// docker run --name zig --rm -it euantorano/zig:0.11.0 sh
+	// zig init-exe
+	// zig fetch --save https://github.com/zigzap/zap/archive/refs/tags/v0.1.7-pre.tar.gz
+	zonNormal = []types.Library{
+		{Name: "known-folders", Version: "53fe3b676f32e59d46f4fd201d7ab200e5f6cb98", Digest: "12203ef1a3c3ba9d1c26f1a4e1e4d7fa1d6ef4f5e5d4d7b0e8c3d5b8e1f2a3b4c5d6"},
+		{Name: "nightly", Digest: "1220b1c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6"},
+		{Name: "zap", Version: "v0.1.7-pre", Digest: "1220002d24d73672fe8b1e39717c0671598acc8ec27b8af2e1caf623a4fd0ce0d1bd"},
+	}
+)